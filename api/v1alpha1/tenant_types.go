@@ -17,11 +17,12 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // TenantTier represents the isolation level for a tenant.
-// +kubebuilder:validation:Enum=Bronze;Silver;Gold
+// +kubebuilder:validation:Enum=Bronze;Silver;Gold;Platinum
 type TenantTier string
 
 const (
@@ -36,6 +37,10 @@ const (
 	// GoldTier: Extreme isolation via dedicated vCluster.
 	// Tenant has their own Kubernetes API server and full admin privileges.
 	GoldTier TenantTier = "Gold"
+
+	// PlatinumTier: Full isolation via a dedicated workload cluster provisioned through
+	// Cluster API. Tenant has their own control plane and nodes.
+	PlatinumTier TenantTier = "Platinum"
 )
 
 // TenantState represents the reconciliation state of a tenant.
@@ -59,18 +64,224 @@ const (
 	StateTerminating TenantState = "Terminating"
 )
 
+// ConditionReady is the condition type reporting whether the tenant is fully provisioned.
+const ConditionReady = "Ready"
+
+// Provisioning step condition types. Each is True once its step completed for the
+// generation in its observedGeneration, so provisioning resumes after the last
+// completed step when the operator restarts.
+const (
+	// ConditionBaseResourcesProvisioned covers the namespace, quota, RBAC, and network policies.
+	ConditionBaseResourcesProvisioned = "BaseResourcesProvisioned"
+
+	// ConditionVClusterDeployed reports that the Gold tier vCluster is deployed and ready.
+	ConditionVClusterDeployed = "VClusterDeployed"
+
+	// ConditionKubeconfigAvailable reports that the Gold or Platinum tier kubeconfig
+	// Secret is stored.
+	ConditionKubeconfigAvailable = "KubeconfigAvailable"
+
+	// ConditionClusterProvisioned reports that the Platinum tier dedicated cluster is
+	// provisioned and its control plane is reachable.
+	ConditionClusterProvisioned = "ClusterProvisioned"
+
+	// ConditionPlacementReady reports that the cluster selected by spec.placement is
+	// registered and reachable.
+	ConditionPlacementReady = "PlacementReady"
+)
+
+// Per-resource readiness condition types, set on every reconcile so kubectl wait and
+// kstatus tooling can wait on individual child resources.
+const (
+	// ConditionNamespaceReady reports that the tenant namespace exists.
+	ConditionNamespaceReady = "NamespaceReady"
+
+	// ConditionQuotaReady reports that the ResourceQuotas match the spec.
+	ConditionQuotaReady = "QuotaReady"
+
+	// ConditionRBACReady reports that the ServiceAccount, Role, and RoleBinding exist.
+	ConditionRBACReady = "RBACReady"
+
+	// ConditionNetworkPolicyReady reports that the default-deny NetworkPolicy matches the spec.
+	ConditionNetworkPolicyReady = "NetworkPolicyReady"
+
+	// ConditionVClusterReady reports that the Gold tier vCluster is running.
+	ConditionVClusterReady = "VClusterReady"
+
+	// ConditionAPICertificateReady reports that cert-manager issued the certificate for
+	// the exposed vCluster API server. Only set when spec.exposure.tls is.
+	ConditionAPICertificateReady = "APICertificateReady"
+
+	// ConditionGitOpsReady reports that the objects handing the tenant to its GitOps
+	// controllers exist: the Argo CD AppProject and bootstrap Application, and the Flux
+	// GitRepository and Kustomization. Only set when spec.gitops.argocd or
+	// spec.gitops.flux is enabled.
+	ConditionGitOpsReady = "GitOpsReady"
+
+	// ConditionMeshReady reports that the tenant namespaces are enrolled in the service
+	// mesh, with strict mTLS and the Istio authorization policies in place. Only set
+	// when spec.mesh is.
+	ConditionMeshReady = "MeshReady"
+
+	// ConditionMonitoringReady reports that the tenant's ServiceMonitor and default
+	// PrometheusRule alerts exist. Only set when spec.observability.monitoring is.
+	ConditionMonitoringReady = "MonitoringReady"
+)
+
+// ConditionProvisioningStuck is True while a tenant has exceeded its tier's
+// provisioning SLA without reaching Ready.
+const ConditionProvisioningStuck = "ProvisioningStuck"
+
+// ConditionCleanupScheduled is True while a Failed tenant is scheduled for automatic
+// deletion or suspension; the owner was notified when it became True.
+const ConditionCleanupScheduled = "CleanupScheduled"
+
+// ConditionExpired is True once a tenant's spec.ttl has run out and it is scheduled for
+// deletion; the owner was notified when it became True.
+const ConditionExpired = "Expired"
+
+// ConditionSnapshotRestored is True once the TenantSnapshot in spec.restoreFrom has been
+// restored into the tenant namespace.
+const ConditionSnapshotRestored = "SnapshotRestored"
+
+// ConditionReconciliationPaused is the condition type reporting whether the operator
+// has stopped reconciling the tenant because of the tenant.platform.io/paused annotation.
+const ConditionReconciliationPaused = "ReconciliationPaused"
+
 // ResourceRequirements defines CPU, memory, and storage constraints for a tenant.
 type ResourceRequirements struct {
 	// CPU request/limit in millicores (e.g., "4000m").
 	// +kubebuilder:validation:Pattern=^(\d+m|\d+\.?\d*|\d*\.?\d+)$
+	// +kubebuilder:validation:MaxLength=32
 	CPU string `json:"cpu,omitempty"`
 
 	// Memory request/limit (e.g., "8Gi", "1024Mi").
 	// +kubebuilder:validation:Pattern=^(\d+Mi|\d+Gi|\d+Ti)$
+	// +kubebuilder:validation:MaxLength=32
 	Memory string `json:"memory,omitempty"`
 
+	// Requests caps the CPU and memory requested by the tenant's pods, overriding
+	// CPU and Memory for the requests side of the quota.
+	Requests *ResourceAmounts `json:"requests,omitempty"`
+
+	// Limits caps the CPU and memory limits of the tenant's pods, so bursty workloads
+	// can set limits above their requests. Unset amounts default to the requests times
+	// the operator's limits overcommit ratio.
+	Limits *ResourceAmounts `json:"limits,omitempty"`
+
 	// StorageClass name for PersistentVolumeClaims (e.g., "fast-ssd", "standard").
+	// It is recorded as the default StorageClass of the tenant namespaces, and with
+	// Storage set the tenant quota also caps the storage requested from this class.
 	StorageClass string `json:"storageClass,omitempty"`
+
+	// Storage caps the total storage requested by PersistentVolumeClaims (e.g., "100Gi").
+	// Unset means PVC storage is not limited by the tenant quota.
+	// +kubebuilder:validation:Pattern=^(\d+Mi|\d+Gi|\d+Ti)$
+	// +kubebuilder:validation:MaxLength=32
+	Storage string `json:"storage,omitempty"`
+
+	// Burst temporarily raises the tenant quota for a bounded duration.
+	// The controller reverts the quota automatically once the boost expires.
+	Burst *BurstConfig `json:"burst,omitempty"`
+}
+
+// ResourceAmounts is a CPU and memory amount for one side of the tenant quota.
+type ResourceAmounts struct {
+	// CPU in millicores (e.g., "4000m").
+	// +kubebuilder:validation:Pattern=^(\d+m|\d+\.?\d*|\d*\.?\d+)$
+	// +kubebuilder:validation:MaxLength=32
+	CPU string `json:"cpu,omitempty"`
+
+	// Memory (e.g., "8Gi", "1024Mi").
+	// +kubebuilder:validation:Pattern=^(\d+Mi|\d+Gi|\d+Ti)$
+	// +kubebuilder:validation:MaxLength=32
+	Memory string `json:"memory,omitempty"`
+}
+
+// BurstConfig describes a time-boxed quota boost on top of the regular resources.
+// +kubebuilder:validation:XValidation:rule="has(self.cpu) || has(self.memory)",message="at least one of cpu or memory must be set"
+// +kubebuilder:validation:XValidation:rule="duration(self.duration) > duration('0s') && duration(self.duration) <= duration('168h')",message="duration must be greater than 0 and at most 168h"
+type BurstConfig struct {
+	// CPU is the extra CPU added to the quota while the boost is active (e.g., "2000m").
+	// +kubebuilder:validation:Pattern=^(\d+m|\d+\.?\d*|\d*\.?\d+)$
+	// +kubebuilder:validation:MaxLength=32
+	CPU string `json:"cpu,omitempty"`
+
+	// Memory is the extra memory added to the quota while the boost is active (e.g., "4Gi").
+	// +kubebuilder:validation:Pattern=^(\d+Mi|\d+Gi|\d+Ti)$
+	// +kubebuilder:validation:MaxLength=32
+	Memory string `json:"memory,omitempty"`
+
+	// Duration is how long the boost lasts once applied (e.g., "2h").
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+}
+
+// BurstStatus records the state of the most recent quota boost.
+type BurstStatus struct {
+	// Active is true while the boost is applied to the quota.
+	Active bool `json:"active"`
+
+	// CPU and Memory are the extra amounts granted by the boost.
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+
+	// Duration is the requested boost length.
+	Duration metav1.Duration `json:"duration"`
+
+	// StartedAt is when the boost was applied.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// ExpiresAt is when the boost is (or was) scheduled to be reverted.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// EndedAt is when the boost was actually reverted.
+	EndedAt *metav1.Time `json:"endedAt,omitempty"`
+}
+
+// ProvisioningProgress reports how many provisioning steps of the tenant's tier are
+// complete for its current generation.
+type ProvisioningProgress struct {
+	// CompletedSteps is the number of completed steps.
+	CompletedSteps int32 `json:"completedSteps"`
+
+	// TotalSteps is the number of steps for the tenant's tier.
+	TotalSteps int32 `json:"totalSteps"`
+
+	// Percent is CompletedSteps as a percentage of TotalSteps.
+	Percent int32 `json:"percent"`
+
+	// CurrentStep is the first step not completed yet (e.g., "VCluster").
+	// Empty once every step is complete.
+	CurrentStep string `json:"currentStep,omitempty"`
+
+	// Summary is the progress for display, e.g. "3/6 NetworkPolicy" or "6/6".
+	Summary string `json:"summary,omitempty"`
+}
+
+// MeshProvider is a service mesh the tenant namespaces can be enrolled in.
+// +kubebuilder:validation:Enum=Istio;Linkerd
+type MeshProvider string
+
+const (
+	// MeshProviderIstio injects Istio sidecars and enforces mTLS and authorization with
+	// PeerAuthentication and AuthorizationPolicy objects.
+	MeshProviderIstio MeshProvider = "Istio"
+	// MeshProviderLinkerd injects Linkerd proxies that only accept authenticated traffic.
+	MeshProviderLinkerd MeshProvider = "Linkerd"
+)
+
+// MeshConfig enrolls the tenant namespaces in a service mesh, adding mutual TLS and
+// identity-based authorization on top of the NetworkPolicy isolation.
+type MeshConfig struct {
+	// Provider is the service mesh installed in the cluster.
+	Provider MeshProvider `json:"provider"`
+
+	// AllowedNamespaces are namespaces outside the tenant whose workloads may call the
+	// tenant's, such as the namespace of the mesh ingress gateway. Istio only.
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
 }
 
 // NetworkConfig defines network isolation and egress rules for a tenant.
@@ -80,149 +291,1797 @@ type NetworkConfig struct {
 	AllowInternetAccess bool `json:"allowInternetAccess,omitempty"`
 
 	// WhitelistedServices is a list of allowed egress destinations.
-	// Format: "namespace/service" or "namespace/service:port".
+	// Format: "namespace/service" or "namespace/service:port", where port is the number
+	// or name of a service port. Egress is allowed to the pods selected by the Service,
+	// on the target ports of all its service ports or only of the given one.
 	// Example: ["shared-services/auth-api", "monitoring/prometheus:9090"]
 	WhitelistedServices []string `json:"whitelistedServices,omitempty"`
+
+	// AllowExternalServices permits LoadBalancer/NodePort Services and external IPs
+	// in the tenant namespace. Default: false (Services stay cluster-internal).
+	AllowExternalServices bool `json:"allowExternalServices,omitempty"`
+
+	// AllowedCIDRs are IP ranges the tenant can reach without allowInternetAccess,
+	// e.g. a partner API or an on-premises network. Ignored with allowInternetAccess.
+	// Example: ["203.0.113.0/24", "2001:db8::/32"]
+	// +optional
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+
+	// DeniedCIDRs are IP ranges carved out of allowedCIDRs or, with allowInternetAccess,
+	// out of the internet. The cloud metadata endpoint is always denied.
+	// +optional
+	DeniedCIDRs []string `json:"deniedCIDRs,omitempty"`
+
+	// AllowedIngressNamespaces are namespaces whose pods may connect to the tenant's
+	// pods, e.g. an ingress controller or monitoring. Other tenants' namespaces are
+	// never allowed. Default: only pods of the tenant itself.
+	// Example: ["ingress-nginx", "monitoring"]
+	// +optional
+	AllowedIngressNamespaces []string `json:"allowedIngressNamespaces,omitempty"`
+
+	// DNSConfig adds nameservers and search domains to the tenant's pods, e.g. to
+	// resolve corporate internal zones. Egress to the nameservers on port 53 is allowed.
+	// +optional
+	DNSConfig *DNSConfig `json:"dnsConfig,omitempty"`
 }
 
-// TenantSpec defines the desired state of a Tenant.
-type TenantSpec struct {
-	// Tier defines the isolation level for this tenant.
+// DNSConfig is applied to every pod in the tenant's namespaces, including pods synced
+// from a Gold tier vCluster, after the cluster DNS settings.
+type DNSConfig struct {
+	// Nameservers are IP addresses of additional DNS servers.
+	// +kubebuilder:validation:MaxItems=3
+	Nameservers []string `json:"nameservers,omitempty"`
+
+	// Searches are additional DNS search domains, e.g. "corp.example.com".
+	// +kubebuilder:validation:MaxItems=32
+	Searches []string `json:"searches,omitempty"`
+}
+
+// PriorityClassQuota carves a separate budget for pods of one PriorityClass.
+type PriorityClassQuota struct {
+	// PriorityClassName is the PriorityClass this budget applies to.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	PriorityClassName string `json:"priorityClassName"`
+
+	// CPU is the CPU budget (requests and limits) for pods of this class.
+	// +kubebuilder:validation:Pattern=^(\d+m|\d+\.?\d*|\d*\.?\d+)$
+	// +kubebuilder:validation:MaxLength=32
+	CPU string `json:"cpu,omitempty"`
+
+	// Memory is the memory budget (requests and limits) for pods of this class.
+	// +kubebuilder:validation:Pattern=^(\d+Mi|\d+Gi|\d+Ti)$
+	// +kubebuilder:validation:MaxLength=32
+	Memory string `json:"memory,omitempty"`
+
+	// Pods caps the number of pods of this class.
+	// +kubebuilder:validation:Minimum=0
+	Pods *int64 `json:"pods,omitempty"`
+}
+
+// QuotaConfig defines additional, scoped quotas inside the tenant namespace.
+type QuotaConfig struct {
+	// ByPriorityClass creates one scoped ResourceQuota per PriorityClass, e.g. a
+	// guaranteed budget for high-priority workloads and a burst pool for best-effort ones.
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(q, self.exists_one(p, p.priorityClassName == q.priorityClassName))",message="priorityClassName values must be unique"
+	ByPriorityClass []PriorityClassQuota `json:"byPriorityClass,omitempty"`
+
+	// Objects overrides the tier default object counts in the tenant ResourceQuota.
+	Objects *ObjectQuotas `json:"objects,omitempty"`
+}
+
+// ObjectQuotas caps the number of objects per tenant namespace, so tenants cannot
+// exhaust cluster object counts. Unset fields use the tier default.
+type ObjectQuotas struct {
+	// Services caps the number of Services.
+	// +kubebuilder:validation:Minimum=0
+	Services *int64 `json:"services,omitempty"`
+
+	// LoadBalancers caps the number of LoadBalancer Services. Always 0 unless
+	// spec.network.allowExternalServices is set.
+	// +kubebuilder:validation:Minimum=0
+	LoadBalancers *int64 `json:"loadBalancers,omitempty"`
+
+	// NodePorts caps the number of NodePort Services. Always 0 unless
+	// spec.network.allowExternalServices is set.
+	// +kubebuilder:validation:Minimum=0
+	NodePorts *int64 `json:"nodePorts,omitempty"`
+
+	// PersistentVolumeClaims caps the number of PersistentVolumeClaims.
+	// +kubebuilder:validation:Minimum=0
+	PersistentVolumeClaims *int64 `json:"persistentVolumeClaims,omitempty"`
+
+	// ConfigMaps caps the number of ConfigMaps.
+	// +kubebuilder:validation:Minimum=0
+	ConfigMaps *int64 `json:"configMaps,omitempty"`
+
+	// Secrets caps the number of Secrets.
+	// +kubebuilder:validation:Minimum=0
+	Secrets *int64 `json:"secrets,omitempty"`
+}
+
+// TenantEnvironment declares an environment namespace (e.g., dev, staging, prod)
+// created alongside the tenant namespace.
+type TenantEnvironment struct {
+	// Name of the environment. The namespace is named "tenant-<tenant>-<name>".
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +kubebuilder:validation:MaxLength=20
+	Name string `json:"name"`
+
+	// QuotaPercent is the share of the tenant CPU, memory, and pod budget given to
+	// this environment. Environments without a share split whatever is left evenly.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	QuotaPercent *int32 `json:"quotaPercent,omitempty"`
+
+	// Isolated blocks traffic between this environment and the tenant's other
+	// environments. Default: true for "prod" and "production", false otherwise.
+	Isolated *bool `json:"isolated,omitempty"`
+}
+
+// VClusterPersistence configures the storage of the vCluster control plane.
+type VClusterPersistence struct {
+	// Enabled stores vCluster state on a PersistentVolume. Default: true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Size of the PersistentVolume per replica. Default: "10Gi".
+	// +kubebuilder:validation:Pattern=^(\d+Mi|\d+Gi|\d+Ti)$
+	// +kubebuilder:validation:MaxLength=32
+	Size string `json:"size,omitempty"`
+
+	// StorageClass for the PersistentVolume. Default: spec.resources.storageClass.
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
+// VClusterValuesReference points at raw Helm values stored in a ConfigMap or Secret
+// in the operator namespace.
+type VClusterValuesReference struct {
+	// Kind of the referenced object.
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	Kind string `json:"kind"`
+
+	// Name of the referenced object in the operator namespace.
 	// +kubebuilder:validation:Required
-	Tier TenantTier `json:"tier"`
+	Name string `json:"name"`
 
-	// Owner is the email/identifier of the tenant owner for notifications.
+	// ValuesKey is the data key holding the YAML values. Default: "values.yaml".
+	ValuesKey string `json:"valuesKey,omitempty"`
+
+	// Optional skips the reference when the object or key does not exist.
+	Optional bool `json:"optional,omitempty"`
+}
+
+// VClusterAuditSink is where vCluster API server audit logs are shipped. Exactly one
+// destination must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.objectStoragePath) != has(self.lokiURL)",message="exactly one of objectStoragePath or lokiURL must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.lokiURL) || self.lokiURL.matches('^https?://[^/:]+')",message="lokiURL must be an http(s) URL"
+type VClusterAuditSink struct {
+	// ObjectStoragePath is an S3 location (e.g., "s3://audit-logs/vclusters"). Logs are
+	// written under <path>/<tenant>/.
+	// +kubebuilder:validation:Pattern=`^s3://[a-z0-9][a-z0-9.-]{1,61}[a-z0-9](/.*)?$`
+	ObjectStoragePath string `json:"objectStoragePath,omitempty"`
+
+	// LokiURL is a Loki push endpoint (e.g., "https://loki.example.com/loki/api/v1/push").
+	// Streams are labelled tenant=<tenant>.
+	LokiURL string `json:"lokiURL,omitempty"`
+}
+
+// VClusterAuditConfig enables API server audit logging inside the vCluster.
+type VClusterAuditConfig struct {
+	// Level is the audit level for most requests. Secrets, ConfigMaps, and token
+	// reviews are always logged at Metadata so their contents never reach the sink.
+	// Default: Metadata.
+	// +kubebuilder:validation:Enum=Metadata;Request;RequestResponse
+	Level string `json:"level,omitempty"`
+
+	// Sink is where audit logs are shipped.
+	Sink VClusterAuditSink `json:"sink"`
+}
+
+// VClusterDistro is the Kubernetes distribution the vCluster control plane runs.
+// +kubebuilder:validation:Enum=k3s;k0s;k8s
+type VClusterDistro string
+
+const (
+	// VClusterDistroK3s runs k3s, the lightest control plane.
+	VClusterDistroK3s VClusterDistro = "k3s"
+	// VClusterDistroK0s runs k0s.
+	VClusterDistroK0s VClusterDistro = "k0s"
+	// VClusterDistroK8s runs upstream Kubernetes components with an etcd backend.
+	VClusterDistroK8s VClusterDistro = "k8s"
+)
+
+// VClusterConfig customizes the vCluster deployed for Gold tier tenants.
+type VClusterConfig struct {
+	// ChartVersion overrides the operator's vCluster chart version for this tenant,
+	// e.g. to pin it during an upgrade. It also tags the vCluster image.
+	// +kubebuilder:validation:Pattern=`^v?\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?$`
+	ChartVersion string `json:"chartVersion,omitempty"`
+
+	// Distro of the control plane: k3s, k0s, or k8s. It selects the matching vCluster
+	// chart and cannot change once the vCluster exists. Default: k3s.
+	Distro VClusterDistro `json:"distro,omitempty"`
+
+	// Replicas of the vCluster control plane. Values above 1 run it highly available.
+	// Default: 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=5
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Persistence configures the control plane storage.
+	Persistence *VClusterPersistence `json:"persistence,omitempty"`
+
+	// ValuesFrom lists ConfigMaps and Secrets with raw Helm values, merged in order
+	// over the generated values. Use it for overrides that should not live in the
+	// Tenant, such as OIDC client secrets or audit policies.
+	ValuesFrom []VClusterValuesReference `json:"valuesFrom,omitempty"`
+
+	// Values are raw Helm values, as YAML, merged over the generated values and under
+	// those from valuesFrom.
+	Values string `json:"values,omitempty"`
+
+	// Audit enables API server audit logging shipped to a per-tenant sink.
+	Audit *VClusterAuditConfig `json:"audit,omitempty"`
+
+	// OIDC lets users authenticate to the vCluster with ID tokens from an OIDC
+	// provider. The kubeconfig Secret then also holds a kubeconfig that logs in with
+	// the kubelogin exec plugin instead of embedded client certificates.
+	OIDC *VClusterOIDC `json:"oidc,omitempty"`
+}
+
+// VClusterOIDC configures OIDC authentication for the vCluster API server.
+type VClusterOIDC struct {
+	// IssuerURL is the OIDC provider's issuer, e.g. "https://login.example.com".
+	// +kubebuilder:validation:Pattern=`^https://`
+	IssuerURL string `json:"issuerURL"`
+
+	// ClientID is the OIDC client the ID tokens are issued for.
 	// +kubebuilder:validation:MinLength=1
-	Owner string `json:"owner"`
+	ClientID string `json:"clientID"`
 
-	// Resources defines CPU, memory, and storage constraints.
-	Resources ResourceRequirements `json:"resources,omitempty"`
+	// UsernameClaim is the ID token claim used as the user name. With the default
+	// "email", the tenant owner is granted cluster-admin inside the vCluster.
+	// Default: email.
+	UsernameClaim string `json:"usernameClaim,omitempty"`
 
-	// Network defines network policies and egress rules.
-	Network NetworkConfig `json:"network,omitempty"`
+	// GroupsClaim is the ID token claim with the user's groups. Default: groups.
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+}
 
-	// AllowTierMigration is a flag to allow unsafe downgrades (e.g., Gold -> Bronze).
-	// Must be explicitly set to true. Used for data migration workflows.
-	AllowTierMigration bool `json:"allowTierMigration,omitempty"`
+// ExposureType is how a Gold tier vCluster API server is reached from outside the cluster.
+// +kubebuilder:validation:Enum=Ingress;LoadBalancer;NodePort
+type ExposureType string
 
-	// Suspend can be set to true to scale the tenant to zero replicas (cost savings).
-	Suspend bool `json:"suspend,omitempty"`
+const (
+	// ExposureIngress routes the hostname through an Ingress controller with TLS passthrough.
+	ExposureIngress ExposureType = "Ingress"
+	// ExposureLoadBalancer creates a LoadBalancer Service for the API server.
+	ExposureLoadBalancer ExposureType = "LoadBalancer"
+	// ExposureNodePort creates a NodePort Service for the API server.
+	ExposureNodePort ExposureType = "NodePort"
+)
+
+// ExposureConfig exposes the vCluster API server outside the cluster.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Ingress' || has(self.hostname)",message="hostname is required for Ingress exposure"
+// +kubebuilder:validation:XValidation:rule="!has(self.tls) || has(self.hostname)",message="hostname is required for a cert-manager certificate"
+type ExposureConfig struct {
+	// Type is Ingress, LoadBalancer, or NodePort.
+	Type ExposureType `json:"type"`
+
+	// Hostname the API server is reached at. It is published through external-dns and
+	// added to the API server certificate. Required for Ingress.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Hostname string `json:"hostname,omitempty"`
+
+	// IngressClassName selects the Ingress controller, which must support TLS
+	// passthrough. Ingress only.
+	IngressClassName string `json:"ingressClassName,omitempty"`
+
+	// Annotations are added to the Ingress or Service, e.g. for cloud load balancer
+	// settings. They override the annotations the operator sets.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// TLS has cert-manager issue the certificate the API server presents for the
+	// hostname, so the kubeconfig trusts the issuer instead of the vCluster's own CA.
+	// Requires hostname.
+	TLS *ExposureTLS `json:"tls,omitempty"`
 }
 
-// TenantStatus defines the observed state of a Tenant.
-type TenantStatus struct {
-	// State represents the current provisioning state of the tenant.
-	State TenantState `json:"state,omitempty"`
+// ExposureTLS requests the external API server certificate from cert-manager.
+type ExposureTLS struct {
+	// IssuerRef is the cert-manager issuer that signs the certificate.
+	IssuerRef CertificateIssuerRef `json:"issuerRef"`
+}
 
-	// Namespace is the name of the Kubernetes namespace allocated to this tenant.
-	Namespace string `json:"namespace,omitempty"`
+// CertificateIssuerRef references a cert-manager Issuer or ClusterIssuer.
+type CertificateIssuerRef struct {
+	// Name of the issuer.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
 
-	// APIEndpoint is the connection address for Gold tier vClusters.
-	// Format: "https://acme.k8s.myplatform.com"
-	APIEndpoint string `json:"apiEndpoint,omitempty"`
+	// Kind is ClusterIssuer (default) or Issuer. An Issuer must exist in the tenant
+	// namespace.
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default=ClusterIssuer
+	Kind string `json:"kind,omitempty"`
 
-	// AdminKubeconfigSecret is the name of the Secret containing the kubeconfig for Gold tier.
-	// Populated only for Gold tier tenants.
-	AdminKubeconfigSecret string `json:"adminKubeconfigSecret,omitempty"`
+	// Group of the issuer, for external issuers. Defaults to cert-manager.io.
+	Group string `json:"group,omitempty"`
+}
 
-	// ProvisioningStartTime records when provisioning began.
-	ProvisioningStartTime *metav1.Time `json:"provisioningStartTime,omitempty"`
+// SecurityConfig relaxes the workload restrictions applied to Silver tenants. Bronze
+// tenants share a namespace, so they cannot opt out.
+type SecurityConfig struct {
+	// AllowPrivileged permits privileged containers, hostNetwork, and hostPath volumes
+	// in the tenant namespace. It only takes effect once a cluster admin approves it
+	// with the tenant.platform.io/privileged-approved-by annotation.
+	AllowPrivileged bool `json:"allowPrivileged,omitempty"`
+}
 
-	// LastUpdateTime records the last successful reconciliation.
-	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+// PackingPolicy controls how the pods of a tenant are placed across nodes.
+// +kubebuilder:validation:Enum=Spread;BinPack
+type PackingPolicy string
 
-	// LastError records the last error encountered during reconciliation.
-	LastError string `json:"lastError,omitempty"`
+const (
+	// PackingPolicySpread spreads the tenant's pods across nodes for availability.
+	PackingPolicySpread PackingPolicy = "Spread"
+	// PackingPolicyBinPack co-locates the tenant's pods on as few nodes as possible.
+	PackingPolicyBinPack PackingPolicy = "BinPack"
+)
 
-	// ObservedGeneration reflects the generation of the Spec that was last reconciled.
-	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+// SchedulingConfig gives the scheduler placement hints for the tenant's pods.
+type SchedulingConfig struct {
+	// PackingPolicy is injected into new pods in the tenant namespaces as a preferred
+	// pod affinity (BinPack) or a best-effort topology spread constraint (Spread).
+	// Pods that set their own affinity or spread constraints keep them. Default: no hint.
+	PackingPolicy PackingPolicy `json:"packingPolicy,omitempty"`
+
+	// Tolerations are added to every new pod in the tenant namespaces, e.g. to place
+	// the tenant on tainted dedicated nodes. Pods keep the tolerations they set themselves.
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(t, !has(t.operator) || t.operator != 'Exists' || !has(t.value) || t.value == '')",message="toleration value must be empty when operator is Exists"
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
 }
 
-// Tenant is the Schema for the tenants API.
-// +kubebuilder:object:root=true
-// +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=ten;plural=tenants
-// +kubebuilder:printcolumn:name="Tier",type=string,JSONPath=`.spec.tier`
-// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
-// +kubebuilder:printcolumn:name="Namespace",type=string,JSONPath=`.status.namespace`
-// +kubebuilder:printcolumn:name="Owner",type=string,JSONPath=`.spec.owner`
-// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
-type Tenant struct {
-	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata,omitempty"`
+// AccessRole names one of the Roles created in the tenant namespace.
+// +kubebuilder:validation:Enum=Admin;Edit;View
+type AccessRole string
 
-	Spec   TenantSpec   `json:"spec,omitempty"`
-	Status TenantStatus `json:"status,omitempty"`
+const (
+	// AccessRoleAdmin manages workloads, Secrets, and the namespace's Roles and RoleBindings.
+	AccessRoleAdmin AccessRole = "Admin"
+	// AccessRoleEdit manages workloads and Secrets.
+	AccessRoleEdit AccessRole = "Edit"
+	// AccessRoleView reads workloads and configuration, but not Secrets.
+	AccessRoleView AccessRole = "View"
+)
+
+// AccessControlConfig grants people authenticated by the cluster's identity provider,
+// e.g. OIDC, access to the tenant namespace.
+type AccessControlConfig struct {
+	// Users are usernames as reported by the API server's authenticator, e.g. the
+	// email claim of an OIDC token with its configured prefix.
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:XValidation:rule="self.all(u, size(u) > 0 && !u.startsWith('system:'))",message="users must be non-empty and must not be system users"
+	Users []string `json:"users,omitempty"`
+
+	// Groups are group names as reported by the API server's authenticator, e.g. the
+	// groups claim of an OIDC token with its configured prefix.
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:XValidation:rule="self.all(g, size(g) > 0 && !g.startsWith('system:'))",message="groups must be non-empty and must not be system groups"
+	Groups []string `json:"groups,omitempty"`
+
+	// Role the users and groups are bound to. Default: Admin.
+	Role AccessRole `json:"role,omitempty"`
 }
 
-// TenantList contains a list of Tenant objects.
-// +kubebuilder:object:root=true
-type TenantList struct {
-	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []Tenant `json:"items"`
+// NotificationConfig controls the notifications sent about a tenant.
+type NotificationConfig struct {
+	// Digest enables the scheduled usage digest sent to the owner. Default: the
+	// operator-wide --digest-default-enabled setting.
+	Digest *bool `json:"digest,omitempty"`
+
+	// Recipients receive notifications in addition to spec.owner.
+	Recipients []string `json:"recipients,omitempty"`
 }
 
-func init() {
-	SchemeBuilder.Register(&Tenant{}, &TenantList{})
+// LoggingConfig routes container logs from the tenant namespaces to a log stream
+// segregated per tenant.
+type LoggingConfig struct {
+	// Backend receiving the logs. The endpoint is configured operator-wide.
+	// +kubebuilder:validation:Enum=Loki;Elasticsearch
+	Backend string `json:"backend"`
+
+	// TenantID is the Loki tenant (X-Scope-OrgID), or the suffix of the Elasticsearch
+	// index "tenant-<tenantID>". Default: the tenant name.
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	TenantID string `json:"tenantID,omitempty"`
+
+	// Collector is the cluster log agent the routing config is rendered for.
+	// Default: FluentBit.
+	// +kubebuilder:validation:Enum=FluentBit;Vector
+	// +optional
+	Collector LogCollector `json:"collector,omitempty"`
 }
 
-// DeepCopyInto for nested types not generated by controller-gen in this layout.
-// These helpers ensure proper deep copies for slices and pointer fields.
-func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
-	*out = *in
+// LogCollector is a cluster log agent the operator renders tenant log routing for.
+type LogCollector string
+
+const (
+	// LogCollectorFluentBit renders Fluent Bit [OUTPUT] sections.
+	LogCollectorFluentBit LogCollector = "FluentBit"
+	// LogCollectorVector renders a Vector filter transform and sink.
+	LogCollectorVector LogCollector = "Vector"
+)
+
+// LoggingStatus reports where the logs of a tenant are routed.
+type LoggingStatus struct {
+	// Backend is the log backend receiving the logs.
+	Backend string `json:"backend,omitempty"`
+
+	// Collector is the log agent the routing config is rendered for.
+	Collector LogCollector `json:"collector,omitempty"`
+
+	// Stream is the Loki tenant (X-Scope-OrgID) or the Elasticsearch index holding the
+	// tenant's logs.
+	Stream string `json:"stream,omitempty"`
+
+	// Namespaces are the namespaces whose container logs are routed to Stream.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ConfigMap is the routing config, as namespace/name.
+	ConfigMap string `json:"configMap,omitempty"`
 }
 
-func (in *ResourceRequirements) DeepCopy() *ResourceRequirements {
-	if in == nil {
-		return nil
-	}
-	out := new(ResourceRequirements)
-	in.DeepCopyInto(out)
-	return out
+// GitOpsConfig deploys the tenant's workloads from Git through a GitOps controller.
+// +kubebuilder:validation:XValidation:rule="!has(self.flux) || !has(self.flux.enabled) || !self.flux.enabled || has(self.repoURL)",message="flux requires repoURL"
+type GitOpsConfig struct {
+	// RepoURL is the Git repository the tenant deploys from. With Argo CD it is the only
+	// source the tenant's AppProject allows, and a bootstrap Application syncs it into
+	// the tenant namespace. Without it the AppProject allows any repository.
+	// +kubebuilder:validation:Pattern=`^(https://|ssh://|git@)\S+$`
+	// +optional
+	RepoURL string `json:"repoURL,omitempty"`
+
+	// Path is the directory of the repository the bootstrap Application syncs.
+	// Default: the repository root.
+	Path string `json:"path,omitempty"`
+
+	// TargetRevision is the branch, tag, or commit the bootstrap Application syncs, and
+	// the branch the Flux GitRepository follows. Default: HEAD for Argo CD, the
+	// repository's default branch for Flux.
+	TargetRevision string `json:"targetRevision,omitempty"`
+
+	// ArgoCD configures the tenant's Argo CD AppProject.
+	ArgoCD *ArgoCDConfig `json:"argocd,omitempty"`
+
+	// Flux configures the tenant's Flux GitRepository and Kustomization.
+	Flux *FluxConfig `json:"flux,omitempty"`
 }
 
-func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
-	*out = *in
-	if in.WhitelistedServices != nil {
-		out.WhitelistedServices = make([]string, len(in.WhitelistedServices))
-		copy(out.WhitelistedServices, in.WhitelistedServices)
-	}
+// ArgoCDConfig configures the Argo CD integration of a tenant.
+type ArgoCDConfig struct {
+	// Enabled creates an AppProject that may only deploy to the tenant namespaces, with
+	// a project role for spec.accessControl.groups matching spec.accessControl.role.
+	Enabled bool `json:"enabled,omitempty"`
 }
 
-func (in *NetworkConfig) DeepCopy() *NetworkConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkConfig)
-	in.DeepCopyInto(out)
-	return out
+// FluxConfig configures the Flux integration of a tenant.
+type FluxConfig struct {
+	// Enabled creates a GitRepository for spec.gitops.repoURL and a Kustomization
+	// applying spec.gitops.path to the tenant namespace as the tenant ServiceAccount.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Interval is how often the repository is fetched and the Kustomization applied.
+	// Default: 5m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// SecretName is a Secret in the tenant namespace with the credentials of a private
+	// repository, in the format of the Flux GitRepository secretRef.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
 }
 
-func (in *TenantSpec) DeepCopyInto(out *TenantSpec) {
-	*out = *in
-	// Deep copy nested structs
-	in.Resources.DeepCopyInto(&out.Resources)
-	in.Network.DeepCopyInto(&out.Network)
+// ObservabilityConfig hooks the tenant namespaces into the cluster's monitoring stack.
+type ObservabilityConfig struct {
+	// Monitoring creates a ServiceMonitor scraping the tenant's Services, default
+	// PrometheusRule alerts for quota pressure and crashlooping pods, and labels the
+	// tenant namespaces for the Prometheus operator's namespace selectors.
+	Monitoring bool `json:"monitoring,omitempty"`
 }
 
-func (in *TenantSpec) DeepCopy() *TenantSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(TenantSpec)
-	in.DeepCopyInto(out)
-	return out
+// BackupConfig schedules recurring snapshots of a tenant.
+type BackupConfig struct {
+	// Schedule is a cron expression, such as "0 2 * * *", or a descriptor such as
+	// "@daily". Times are UTC unless prefixed with CRON_TZ=<zone>. Missed runs are
+	// taken once, as soon as the operator is back.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Retention is how many completed scheduled snapshots are kept; older ones are
+	// deleted along with their archived copy. Default: 7.
+	// +kubebuilder:default=7
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Retention int32 `json:"retention,omitempty"`
 }
 
-func (in *TenantStatus) DeepCopyInto(out *TenantStatus) {
-	*out = *in
-	if in.ProvisioningStartTime != nil {
+// BackupStatus records the most recent scheduled snapshot of a tenant.
+type BackupStatus struct {
+	// LastBackupTime is when the last scheduled snapshot was taken or attempted.
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+
+	// LastSnapshot is the TenantSnapshot of the last scheduled run.
+	LastSnapshot string `json:"lastSnapshot,omitempty"`
+
+	// LastResult is the phase of the last scheduled snapshot: Completed or Failed.
+	LastResult SnapshotPhase `json:"lastResult,omitempty"`
+
+	// NextBackupTime is when the next scheduled snapshot is due.
+	NextBackupTime *metav1.Time `json:"nextBackupTime,omitempty"`
+
+	// Message gives details when the last run failed or the schedule is invalid.
+	Message string `json:"message,omitempty"`
+}
+
+// PlacementConfig selects the cluster a tenant's namespaces and vCluster are created on.
+type PlacementConfig struct {
+	// Cluster is the name of a cluster registered with a kubeconfig Secret in the
+	// operator namespace, labeled tenant.platform.io/cluster-name=<name>.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Cluster string `json:"cluster"`
+}
+
+// PlacementStatus reports the cluster a placed tenant is provisioned on.
+type PlacementStatus struct {
+	// Cluster is the registered cluster the tenant is provisioned on.
+	Cluster string `json:"cluster,omitempty"`
+
+	// Server is the API server URL of the cluster.
+	Server string `json:"server,omitempty"`
+
+	// Connected reports whether the last reconcile reached the cluster.
+	Connected bool `json:"connected,omitempty"`
+
+	// LastConnectedTime is when the cluster was last reached.
+	LastConnectedTime *metav1.Time `json:"lastConnectedTime,omitempty"`
+
+	// Message gives details when the cluster is not registered or not reachable.
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterConfig sizes the dedicated workload cluster of a Platinum tier tenant. Unset
+// fields fall back to the operator's --platinum-* defaults.
+type ClusterConfig struct {
+	// Class is the Cluster API ClusterClass the cluster is created from, which selects
+	// the infrastructure provider and machine templates.
+	// +optional
+	Class string `json:"class,omitempty"`
+
+	// KubernetesVersion of the control plane and nodes, such as "v1.29.2".
+	// +kubebuilder:validation:Pattern=`^v\d+\.\d+\.\d+$`
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// ControlPlaneReplicas is the number of control plane machines. Default: 3.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ControlPlaneReplicas int32 `json:"controlPlaneReplicas,omitempty"`
+
+	// WorkerReplicas is the number of worker machines. Default: 3.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WorkerReplicas int32 `json:"workerReplicas,omitempty"`
+}
+
+// ClusterStatus reports the dedicated workload cluster of a Platinum tier tenant.
+type ClusterStatus struct {
+	// Name of the cluster in the tenant namespace of the management cluster.
+	Name string `json:"name,omitempty"`
+
+	// Phase is the provisioning phase reported by the cluster provider, such as
+	// Provisioning, Provisioned, or Deleting.
+	Phase string `json:"phase,omitempty"`
+}
+
+// HibernationConfig suspends a tenant automatically during recurring windows, such as
+// nights and weekends, and resumes it outside them.
+type HibernationConfig struct {
+	// Schedule lists the hibernation windows. The tenant is suspended while any
+	// window is open.
+	// +kubebuilder:validation:MinItems=1
+	Schedule []HibernationWindow `json:"schedule"`
+
+	// TimeZone is the IANA time zone the window schedules are evaluated in, such as
+	// "Europe/Berlin". Default: UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// HibernationWindow is a recurring period during which the tenant hibernates. Both ends
+// are cron expressions, such as "0 20 * * 1-5" and "0 7 * * 1-5"; the window opens at
+// each start and closes at the next end. Windows must recur at least weekly.
+type HibernationWindow struct {
+	// Start is when the window opens and the tenant is suspended.
+	// +kubebuilder:validation:MinLength=1
+	Start string `json:"start"`
+
+	// End is when the window closes and the tenant is resumed.
+	// +kubebuilder:validation:MinLength=1
+	End string `json:"end"`
+}
+
+// HibernationStatus reports whether a tenant is hibernating and for how long it has been.
+type HibernationStatus struct {
+	// Hibernating is true while a hibernation window is open.
+	Hibernating bool `json:"hibernating,omitempty"`
+
+	// Since is when the current hibernation started.
+	Since *metav1.Time `json:"since,omitempty"`
+
+	// NextTransitionTime is the next start or end of a hibernation window, when the
+	// schedule is evaluated again.
+	NextTransitionTime *metav1.Time `json:"nextTransitionTime,omitempty"`
+
+	// SuspendedSeconds is the total time the tenant spent hibernating, excluding the
+	// current hibernation.
+	SuspendedSeconds int64 `json:"suspendedSeconds,omitempty"`
+
+	// Message gives details when the schedule is invalid.
+	Message string `json:"message,omitempty"`
+}
+
+// TenantSpec defines the desired state of a Tenant. The CEL rules below repeat the
+// validating webhook's basic checks, so clusters without webhooks still reject
+// unsafe downgrades and budgets that do not fit.
+// +kubebuilder:validation:XValidation:rule="{'Bronze': 0, 'Silver': 1, 'Gold': 2, 'Platinum': 3}[self.tier] >= {'Bronze': 0, 'Silver': 1, 'Gold': 2, 'Platinum': 3}[oldSelf.tier] || (has(self.allowTierMigration) && self.allowTierMigration)",message="unsafe tier downgrade; set spec.allowTierMigration=true to proceed (DATA MAY BE LOST)"
+// +kubebuilder:validation:XValidation:rule="(self.tier == 'Platinum') == (oldSelf.tier == 'Platinum')",message="tenants cannot be migrated to or from the Platinum tier"
+// +kubebuilder:validation:XValidation:rule="!has(self.environments) || size(self.environments) == 0 || self.tier == 'Silver'",message="environments are only supported for Silver tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.vcluster) || self.tier == 'Gold'",message="vcluster settings are only supported for Gold tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.exposure) || self.tier == 'Gold'",message="exposure is only supported for Gold tier tenants"
+// +kubebuilder:validation:XValidation:rule="self.tier != 'Bronze' || !has(self.accessControl) || ((!has(self.accessControl.users) || size(self.accessControl.users) == 0) && (!has(self.accessControl.groups) || size(self.accessControl.groups) == 0))",message="accessControl users and groups are not supported for Bronze tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.quotas) || !has(self.quotas.byPriorityClass) || !has(self.resources) || self.quotas.byPriorityClass.all(q, (!has(q.cpu) || !has(self.resources.cpu) || quantity(q.cpu).compareTo(quantity(self.resources.cpu)) <= 0) && (!has(q.memory) || !has(self.resources.memory) || quantity(q.memory).compareTo(quantity(self.resources.memory)) <= 0))",message="priority class budgets must not exceed spec.resources.cpu and spec.resources.memory"
+// +kubebuilder:validation:XValidation:rule="!has(self.restoreFrom) || self.tier != 'Bronze'",message="restoreFrom is only supported for Silver and Gold tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.restoreFrom) || (has(oldSelf.restoreFrom) && self.restoreFrom == oldSelf.restoreFrom)",message="restoreFrom can only be set when the tenant is created"
+// +kubebuilder:validation:XValidation:rule="!has(self.backup) || self.tier != 'Bronze'",message="backup is only supported for Silver and Gold tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.hibernation) || (self.tier != 'Bronze' && self.tier != 'Platinum')",message="hibernation is only supported for Silver and Gold tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.cluster) || self.tier == 'Platinum'",message="cluster settings are only supported for Platinum tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.gitops) || self.tier == 'Silver' || self.tier == 'Gold'",message="gitops is only supported for Silver and Gold tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.mesh) || self.tier == 'Silver' || self.tier == 'Gold'",message="mesh is only supported for Silver and Gold tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.observability) || self.tier == 'Silver' || self.tier == 'Gold'",message="observability is only supported for Silver and Gold tier tenants"
+// +kubebuilder:validation:XValidation:rule="!has(self.placement) || self.tier != 'Platinum'",message="placement is not supported for Platinum tier tenants"
+// +kubebuilder:validation:XValidation:rule="has(self.placement) == has(oldSelf.placement) && (!has(self.placement) || self.placement.cluster == oldSelf.placement.cluster)",message="placement can only be set when the tenant is created"
+// +kubebuilder:validation:XValidation:rule="self.tier != 'Bronze' || !has(self.security) || !has(self.security.allowPrivileged) || !self.security.allowPrivileged",message="allowPrivileged is not supported for Bronze tier tenants"
+// +kubebuilder:validation:XValidation:rule="self.tier != 'Gold' || !has(self.resources) || !has(self.resources.storage) || (has(self.vcluster) && has(self.vcluster.persistence) && has(self.vcluster.persistence.enabled) && !self.vcluster.persistence.enabled) || quantity(has(self.vcluster) && has(self.vcluster.persistence) && has(self.vcluster.persistence.size) ? self.vcluster.persistence.size : '10Gi').asInteger() * (has(self.vcluster) && has(self.vcluster.replicas) ? self.vcluster.replicas : 1) <= quantity(self.resources.storage).asInteger()",message="vCluster replicas x persistence size (10Gi by default) must fit in spec.resources.storage"
+type TenantSpec struct {
+	// Tier defines the isolation level for this tenant. Defaults to Silver, also when
+	// the mutating webhook is disabled.
+	// +optional
+	// +kubebuilder:default=Silver
+	Tier TenantTier `json:"tier,omitempty"`
+
+	// Owner is the email/identifier of the tenant owner for notifications.
+	// +kubebuilder:validation:MinLength=1
+	Owner string `json:"owner"`
+
+	// TemplateRef names a TenantTemplate whose defaults the mutating webhook merges
+	// into the Tenant when it is created. Later changes to the template do not affect
+	// existing Tenants.
+	TemplateRef *TenantTemplateReference `json:"templateRef,omitempty"`
+
+	// ProfileRef names a TenantTemplate used as the Tenant's base profile. It is merged
+	// like templateRef, with the lowest precedence: profile < template < Tenant spec.
+	// Each TenantTemplate may name a parent of its own in spec.profileRef.
+	ProfileRef *TenantTemplateReference `json:"profileRef,omitempty"`
+
+	// RestoreFrom names a TenantSnapshot of this tenant whose namespace contents are
+	// restored into the new tenant namespace once, after it is provisioned. It can only
+	// be set when the tenant is created. Silver and Gold tiers only.
+	RestoreFrom *TenantSnapshotReference `json:"restoreFrom,omitempty"`
+
+	// Backup takes TenantSnapshots of the tenant on a schedule. Silver and Gold tiers only.
+	Backup *BackupConfig `json:"backup,omitempty"`
+
+	// Resources defines CPU, memory, and storage constraints.
+	Resources ResourceRequirements `json:"resources,omitempty"`
+
+	// Network defines network policies and egress rules.
+	Network NetworkConfig `json:"network,omitempty"`
+
+	// Mesh enrolls the tenant namespaces in an Istio or Linkerd service mesh with
+	// strict mTLS. Silver and Gold tiers only.
+	// +optional
+	Mesh *MeshConfig `json:"mesh,omitempty"`
+
+	// Quotas defines additional scoped quotas within the tenant namespace.
+	Quotas QuotaConfig `json:"quotas,omitempty"`
+
+	// Environments expands the tenant into one namespace per environment, each with
+	// a fraction of the tenant quota and its own NetworkPolicy. Silver tier only.
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:XValidation:rule="self.all(e, self.exists_one(f, f.name == e.name))",message="environment names must be unique"
+	// +kubebuilder:validation:XValidation:rule="self.filter(e, has(e.quotaPercent)).map(e, e.quotaPercent).sum() <= 100",message="quotaPercent values must not add up to more than 100"
+	// +kubebuilder:validation:XValidation:rule="self.filter(e, has(e.quotaPercent)).map(e, e.quotaPercent).sum() < 100 || self.all(e, has(e.quotaPercent))",message="quotaPercent values add up to 100, leaving no quota for environments without a quotaPercent"
+	Environments []TenantEnvironment `json:"environments,omitempty"`
+
+	// VCluster customizes the vCluster control plane. Gold tier only.
+	VCluster *VClusterConfig `json:"vcluster,omitempty"`
+
+	// Exposure makes the vCluster API server reachable from outside the cluster, and
+	// status.apiEndpoint then holds the external URL. Gold tier only.
+	Exposure *ExposureConfig `json:"exposure,omitempty"`
+
+	// Cluster sizes the dedicated workload cluster. Platinum tier only.
+	// +optional
+	Cluster *ClusterConfig `json:"cluster,omitempty"`
+
+	// Placement provisions the tenant on a registered remote cluster instead of the
+	// cluster the operator runs in. The Tenant itself stays here. Set at creation only;
+	// use a TenantMigration to move a tenant.
+	// +optional
+	Placement *PlacementConfig `json:"placement,omitempty"`
+
+	// Security relaxes workload restrictions for Silver tenants.
+	Security SecurityConfig `json:"security,omitempty"`
+
+	// Scheduling gives the scheduler placement hints for the tenant's pods.
+	Scheduling SchedulingConfig `json:"scheduling,omitempty"`
+
+	// Notifications controls the digests and notices sent to the tenant owner.
+	Notifications NotificationConfig `json:"notifications,omitempty"`
+
+	// AccessControl binds users and groups to a Role in the tenant namespace, in
+	// addition to the tenant ServiceAccount. Silver and Gold tiers only.
+	AccessControl AccessControlConfig `json:"accessControl,omitempty"`
+
+	// Logging routes the tenant's container logs to a per-tenant log stream.
+	Logging *LoggingConfig `json:"logging,omitempty"`
+
+	// GitOps deploys the tenant's workloads from Git through Argo CD or Flux. Silver
+	// and Gold tiers only.
+	// +optional
+	GitOps *GitOpsConfig `json:"gitops,omitempty"`
+
+	// Observability hooks the tenant into the Prometheus operator. Silver and Gold
+	// tiers only.
+	// +optional
+	Observability *ObservabilityConfig `json:"observability,omitempty"`
+
+	// AllowTierMigration is a flag to allow unsafe downgrades (e.g., Gold -> Bronze).
+	// Must be explicitly set to true. Used for data migration workflows.
+	AllowTierMigration bool `json:"allowTierMigration,omitempty"`
+
+	// Suspend can be set to true to scale the tenant to zero replicas (cost savings).
+	// Every Deployment and StatefulSet in the tenant namespaces, including the vCluster,
+	// is scaled to zero; clearing the flag restores the previous replica counts.
+	Suspend bool `json:"suspend,omitempty"`
+
+	// DeletionProtection can be set to true to guard a production tenant against an
+	// accidental delete: the validating webhook rejects deleting the Tenant, and the
+	// operator does not tear it down, until the flag is cleared.
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+
+	// TTL is how long after its creation an ephemeral tenant, such as a dev or preview
+	// environment, expires (e.g., "72h"). An expired tenant is marked Expired, its owner
+	// is notified, and it is deleted once the operator's expiry grace period has passed.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// Hibernation suspends the tenant on a schedule, for example outside working
+	// hours, and resumes it afterwards. spec.suspend takes precedence: a suspended
+	// tenant stays suspended outside its windows. Silver and Gold tiers only.
+	// +optional
+	Hibernation *HibernationConfig `json:"hibernation,omitempty"`
+}
+
+// ZoneUsage is the number and requests of a tenant's scheduled pods in one zone and
+// node pool, taken from the topology labels of their nodes.
+type ZoneUsage struct {
+	// Zone is the topology.kubernetes.io/zone label of the nodes, or "unknown".
+	Zone string `json:"zone"`
+
+	// NodePool is the node pool label of the nodes, if they have one.
+	NodePool string `json:"nodePool,omitempty"`
+
+	// Pods is the number of the tenant's pods scheduled there.
+	Pods int32 `json:"pods"`
+
+	// CPU and Memory are the summed container requests of those pods.
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+}
+
+// QuotaUsage is the consumption of one ResourceQuota resource against its hard limit.
+type QuotaUsage struct {
+	// Used is the amount in use, from the ResourceQuota status.
+	Used string `json:"used,omitempty"`
+
+	// Hard is the quota limit.
+	Hard string `json:"hard,omitempty"`
+
+	// Summary is the usage for display, e.g. "1500m/4".
+	Summary string `json:"summary,omitempty"`
+}
+
+// ResourceUsage is a tenant's live consumption of its ResourceQuotas, summed over the
+// tenant namespace and its environment namespaces.
+type ResourceUsage struct {
+	// CPU is the requests.cpu quota.
+	CPU QuotaUsage `json:"cpu,omitempty"`
+
+	// Memory is the requests.memory quota.
+	Memory QuotaUsage `json:"memory,omitempty"`
+
+	// Pods is the pods quota.
+	Pods QuotaUsage `json:"pods,omitempty"`
+
+	// Storage is the requests.storage quota, set when the tenant has a storage budget.
+	Storage QuotaUsage `json:"storage,omitempty"`
+
+	// Utilization is the actual consumption of the tenant's pods, sampled from
+	// metrics-server. It is unset when metrics.k8s.io is not available.
+	Utilization *ResourceUtilization `json:"utilization,omitempty"`
+
+	// Suspended is true while the tenant's workloads are scaled to zero by spec.suspend
+	// or an open hibernation window.
+	Suspended bool `json:"suspended,omitempty"`
+
+	// LastUpdateTime is when the usage last changed.
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// ResourceUtilization is the CPU and memory a tenant's pods are using, summed over the
+// PodMetrics of its namespaces.
+type ResourceUtilization struct {
+	// CPU is the CPU usage, e.g. "1250m".
+	CPU string `json:"cpu,omitempty"`
+
+	// Memory is the working set memory, e.g. "3Gi".
+	Memory string `json:"memory,omitempty"`
+
+	// Pods is the number of pods with metrics.
+	Pods int32 `json:"pods,omitempty"`
+
+	// SampleTime is when the utilization was last sampled.
+	SampleTime *metav1.Time `json:"sampleTime,omitempty"`
+}
+
+// BillingStatus is the requested resources metered for a tenant in a billing period, a
+// calendar month in UTC, and their cost at the unit prices of the tenant's tier. The
+// amounts are decimal strings.
+type BillingStatus struct {
+	// Period is the billing period, e.g. "2026-10".
+	Period string `json:"period,omitempty"`
+
+	// CPUCoreHours is the requested CPU metered in the period.
+	CPUCoreHours string `json:"cpuCoreHours,omitempty"`
+
+	// MemoryGiBHours is the requested memory metered in the period.
+	MemoryGiBHours string `json:"memoryGiBHours,omitempty"`
+
+	// StorageGiBHours is the requested storage metered in the period.
+	StorageGiBHours string `json:"storageGiBHours,omitempty"`
+
+	// Cost is the cost of the usage metered in the period.
+	Cost string `json:"cost,omitempty"`
+
+	// Day is the usage metered on the current day in UTC. Closed days and periods are
+	// exported as usage reports.
+	Day *BillingTotals `json:"day,omitempty"`
+
+	// LastSampleTime is when usage was last metered.
+	LastSampleTime *metav1.Time `json:"lastSampleTime,omitempty"`
+}
+
+// BillingTotals is the usage metered for a tenant in one day and its cost.
+type BillingTotals struct {
+	// Period is the day, e.g. "2026-10-17".
+	Period string `json:"period,omitempty"`
+
+	// CPUCoreHours is the requested CPU metered on the day.
+	CPUCoreHours string `json:"cpuCoreHours,omitempty"`
+
+	// MemoryGiBHours is the requested memory metered on the day.
+	MemoryGiBHours string `json:"memoryGiBHours,omitempty"`
+
+	// StorageGiBHours is the requested storage metered on the day.
+	StorageGiBHours string `json:"storageGiBHours,omitempty"`
+
+	// Cost is the cost of the usage metered on the day.
+	Cost string `json:"cost,omitempty"`
+}
+
+// AppliedTemplate is a TenantTemplate the mutating webhook merged into a Tenant.
+type AppliedTemplate struct {
+	// Name of the TenantTemplate.
+	Name string `json:"name"`
+
+	// Via is the Tenant field that led to the template: "profileRef" or "templateRef".
+	Via string `json:"via"`
+}
+
+// ManagedResource references a child object created by the operator for a tenant.
+type ManagedResource struct {
+	// APIVersion of the object (e.g., "v1", "networking.k8s.io/v1").
+	// Empty for non-Kubernetes artifacts such as Helm releases.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the object (e.g., "ResourceQuota", "VClusterRelease").
+	Kind string `json:"kind"`
+
+	// Namespace of the object. Empty for cluster-scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the object.
+	Name string `json:"name"`
+}
+
+// String returns "Kind/Name" or "Kind/Namespace/Name".
+func (m ManagedResource) String() string {
+	if m.Namespace == "" {
+		return m.Kind + "/" + m.Name
+	}
+	return m.Kind + "/" + m.Namespace + "/" + m.Name
+}
+
+// TenantStatus defines the observed state of a Tenant.
+type TenantStatus struct {
+	// State represents the current provisioning state of the tenant.
+	State TenantState `json:"state,omitempty"`
+
+	// Namespace is the name of the Kubernetes namespace allocated to this tenant.
+	Namespace string `json:"namespace,omitempty"`
+
+	// PodSecurityLevel is the Pod Security Admission level enforced in the tenant's
+	// namespaces: privileged, baseline, or restricted. Empty when none is enforced.
+	PodSecurityLevel string `json:"podSecurityLevel,omitempty"`
+
+	// APIEndpoint is the connection address for Gold tier vClusters, the external URL
+	// when spec.exposure is set, and for the dedicated cluster of Platinum tier tenants.
+	// Format: "https://acme.k8s.myplatform.com"
+	APIEndpoint string `json:"apiEndpoint,omitempty"`
+
+	// AdminKubeconfigSecret is the name of the Secret containing the tenant kubeconfig:
+	// the dedicated cluster's admin kubeconfig for Platinum tier, the vCluster admin
+	// kubeconfig for Gold tier, a namespace-scoped ServiceAccount kubeconfig for Silver
+	// tier. Not populated for Bronze tier tenants.
+	AdminKubeconfigSecret string `json:"adminKubeconfigSecret,omitempty"`
+
+	// KubeconfigExpiresAt is when the token in a Silver tier kubeconfig, or the client
+	// certificate in a Gold tier one, expires. The credentials are reissued before then.
+	KubeconfigExpiresAt *metav1.Time `json:"kubeconfigExpiresAt,omitempty"`
+
+	// KubeconfigRotatedAt is when the credentials in the kubeconfig Secret were last
+	// issued or rotated.
+	KubeconfigRotatedAt *metav1.Time `json:"kubeconfigRotatedAt,omitempty"`
+
+	// CredentialsRotatedAt records the last handled credential rotation request.
+	// Credentials issued before this time are no longer valid.
+	CredentialsRotatedAt *metav1.Time `json:"credentialsRotatedAt,omitempty"`
+
+	// LastDigestSentAt is when the owner was last sent a usage digest.
+	LastDigestSentAt *metav1.Time `json:"lastDigestSentAt,omitempty"`
+
+	// ProvisioningStartTime records when provisioning began.
+	ProvisioningStartTime *metav1.Time `json:"provisioningStartTime,omitempty"`
+
+	// LastUpdateTime records the last successful reconciliation.
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// LastError records the last error encountered during reconciliation.
+	LastError string `json:"lastError,omitempty"`
+
+	// ObservedGeneration reflects the generation of the Spec that was last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Burst tracks the current or most recent quota boost.
+	Burst *BurstStatus `json:"burst,omitempty"`
+
+	// Progress reports the completed provisioning steps, so long Gold tier provisions
+	// show how far they got.
+	Progress *ProvisioningProgress `json:"progress,omitempty"`
+
+	// RestoredFrom is the TenantSnapshot spec.restoreFrom was restored from.
+	RestoredFrom string `json:"restoredFrom,omitempty"`
+
+	// Backup reports the scheduled snapshots of spec.backup.
+	Backup *BackupStatus `json:"backup,omitempty"`
+
+	// ExpiresAt is when the tenant's spec.ttl runs out.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Hibernation reports the tenant's spec.hibernation schedule.
+	Hibernation *HibernationStatus `json:"hibernation,omitempty"`
+
+	// Cluster reports the dedicated workload cluster of a Platinum tier tenant.
+	Cluster *ClusterStatus `json:"cluster,omitempty"`
+
+	// Placement reports the remote cluster selected by spec.placement.
+	Placement *PlacementStatus `json:"placement,omitempty"`
+
+	// Logging reports the log stream spec.logging routes the tenant namespaces to.
+	Logging *LoggingStatus `json:"logging,omitempty"`
+
+	// ManagedResources lists the child objects the operator created for this tenant.
+	ManagedResources []ManagedResource `json:"managedResources,omitempty"`
+
+	// ZoneUsage breaks down where the tenant's scheduled pods run, per zone and node
+	// pool, for capacity planning and zone-specific charging.
+	ZoneUsage []ZoneUsage `json:"zoneUsage,omitempty"`
+
+	// ResourceUsage is the live consumption of the tenant's ResourceQuotas, refreshed
+	// on every reconcile and periodically in between.
+	ResourceUsage *ResourceUsage `json:"resourceUsage,omitempty"`
+
+	// Billing is the usage metered for chargeback in the current billing period and
+	// its cost.
+	Billing *BillingStatus `json:"billing,omitempty"`
+
+	// AppliedTemplates lists the TenantTemplates merged into the Tenant when it was
+	// created, from lowest to highest precedence. Each one overrides those before it,
+	// and the Tenant's own spec overrides them all.
+	AppliedTemplates []AppliedTemplate `json:"appliedTemplates,omitempty"`
+
+	// Conditions represent the latest available observations of the tenant's state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Tenant is the Schema for the tenants API.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName={ten,tnt};plural=tenants;categories=tenant-master
+// +kubebuilder:printcolumn:name="Tier",type=string,JSONPath=`.spec.tier`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Progress",type=string,JSONPath=`.status.progress.summary`
+// +kubebuilder:printcolumn:name="CPU",type=string,JSONPath=`.status.resourceUsage.cpu.summary`
+// +kubebuilder:printcolumn:name="Memory",type=string,JSONPath=`.status.resourceUsage.memory.summary`
+// +kubebuilder:printcolumn:name="Pods",type=string,JSONPath=`.status.resourceUsage.pods.summary`,priority=1
+// +kubebuilder:printcolumn:name="Suspended",type=boolean,JSONPath=`.status.resourceUsage.suspended`
+// +kubebuilder:printcolumn:name="Cost",type=string,JSONPath=`.status.billing.cost`
+// +kubebuilder:printcolumn:name="Namespace",type=string,JSONPath=`.status.namespace`
+// +kubebuilder:printcolumn:name="Owner",type=string,JSONPath=`.spec.owner`,priority=1
+// +kubebuilder:printcolumn:name="API Endpoint",type=string,JSONPath=`.status.apiEndpoint`,priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type Tenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantSpec   `json:"spec,omitempty"`
+	Status TenantStatus `json:"status,omitempty"`
+}
+
+// TenantList contains a list of Tenant objects.
+// +kubebuilder:object:root=true
+type TenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tenant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Tenant{}, &TenantList{})
+}
+
+// DeepCopyInto for nested types not generated by controller-gen in this layout.
+// These helpers ensure proper deep copies for slices and pointer fields.
+func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
+	*out = *in
+	if in.Requests != nil {
+		out.Requests = in.Requests.DeepCopy()
+	}
+	if in.Limits != nil {
+		out.Limits = in.Limits.DeepCopy()
+	}
+	if in.Burst != nil {
+		out.Burst = in.Burst.DeepCopy()
+	}
+}
+
+func (in *ResourceRequirements) DeepCopy() *ResourceRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ResourceAmounts) DeepCopyInto(out *ResourceAmounts) {
+	*out = *in
+}
+
+func (in *ResourceAmounts) DeepCopy() *ResourceAmounts {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAmounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *BurstConfig) DeepCopyInto(out *BurstConfig) {
+	*out = *in
+}
+
+func (in *BurstConfig) DeepCopy() *BurstConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BurstConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *BurstStatus) DeepCopyInto(out *BurstStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		out.StartedAt = in.StartedAt.DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		out.ExpiresAt = in.ExpiresAt.DeepCopy()
+	}
+	if in.EndedAt != nil {
+		out.EndedAt = in.EndedAt.DeepCopy()
+	}
+}
+
+func (in *BurstStatus) DeepCopy() *BurstStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BurstStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
+	*out = *in
+	if in.LastBackupTime != nil {
+		out.LastBackupTime = in.LastBackupTime.DeepCopy()
+	}
+	if in.NextBackupTime != nil {
+		out.NextBackupTime = in.NextBackupTime.DeepCopy()
+	}
+}
+
+func (in *BackupStatus) DeepCopy() *BackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *HibernationConfig) DeepCopyInto(out *HibernationConfig) {
+	*out = *in
+	if in.Schedule != nil {
+		out.Schedule = make([]HibernationWindow, len(in.Schedule))
+		copy(out.Schedule, in.Schedule)
+	}
+}
+
+func (in *HibernationConfig) DeepCopy() *HibernationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *HibernationStatus) DeepCopyInto(out *HibernationStatus) {
+	*out = *in
+	if in.Since != nil {
+		out.Since = in.Since.DeepCopy()
+	}
+	if in.NextTransitionTime != nil {
+		out.NextTransitionTime = in.NextTransitionTime.DeepCopy()
+	}
+}
+
+func (in *HibernationStatus) DeepCopy() *HibernationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *GitOpsConfig) DeepCopyInto(out *GitOpsConfig) {
+	*out = *in
+	if in.ArgoCD != nil {
+		out.ArgoCD = new(ArgoCDConfig)
+		*out.ArgoCD = *in.ArgoCD
+	}
+	if in.Flux != nil {
+		out.Flux = new(FluxConfig)
+		in.Flux.DeepCopyInto(out.Flux)
+	}
+}
+
+func (in *GitOpsConfig) DeepCopy() *GitOpsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GitOpsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *FluxConfig) DeepCopyInto(out *FluxConfig) {
+	*out = *in
+	if in.Interval != nil {
+		out.Interval = new(metav1.Duration)
+		*out.Interval = *in.Interval
+	}
+}
+
+func (in *FluxConfig) DeepCopy() *FluxConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FluxConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *PlacementStatus) DeepCopyInto(out *PlacementStatus) {
+	*out = *in
+	if in.LastConnectedTime != nil {
+		out.LastConnectedTime = in.LastConnectedTime.DeepCopy()
+	}
+}
+
+func (in *PlacementStatus) DeepCopy() *PlacementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ResourceUsage) DeepCopyInto(out *ResourceUsage) {
+	*out = *in
+	if in.Utilization != nil {
+		out.Utilization = in.Utilization.DeepCopy()
+	}
+	if in.LastUpdateTime != nil {
+		out.LastUpdateTime = in.LastUpdateTime.DeepCopy()
+	}
+}
+
+func (in *ResourceUsage) DeepCopy() *ResourceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ResourceUtilization) DeepCopyInto(out *ResourceUtilization) {
+	*out = *in
+	if in.SampleTime != nil {
+		out.SampleTime = in.SampleTime.DeepCopy()
+	}
+}
+
+func (in *ResourceUtilization) DeepCopy() *ResourceUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *BillingStatus) DeepCopyInto(out *BillingStatus) {
+	*out = *in
+	if in.Day != nil {
+		out.Day = in.Day.DeepCopy()
+	}
+	if in.LastSampleTime != nil {
+		out.LastSampleTime = in.LastSampleTime.DeepCopy()
+	}
+}
+
+func (in *BillingStatus) DeepCopy() *BillingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BillingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *BillingTotals) DeepCopyInto(out *BillingTotals) {
+	*out = *in
+}
+
+func (in *BillingTotals) DeepCopy() *BillingTotals {
+	if in == nil {
+		return nil
+	}
+	out := new(BillingTotals)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *LoggingStatus) DeepCopyInto(out *LoggingStatus) {
+	*out = *in
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		copy(out.Namespaces, in.Namespaces)
+	}
+}
+
+func (in *LoggingStatus) DeepCopy() *LoggingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
+	*out = *in
+	if in.WhitelistedServices != nil {
+		out.WhitelistedServices = make([]string, len(in.WhitelistedServices))
+		copy(out.WhitelistedServices, in.WhitelistedServices)
+	}
+	if in.AllowedCIDRs != nil {
+		out.AllowedCIDRs = make([]string, len(in.AllowedCIDRs))
+		copy(out.AllowedCIDRs, in.AllowedCIDRs)
+	}
+	if in.DeniedCIDRs != nil {
+		out.DeniedCIDRs = make([]string, len(in.DeniedCIDRs))
+		copy(out.DeniedCIDRs, in.DeniedCIDRs)
+	}
+	if in.AllowedIngressNamespaces != nil {
+		out.AllowedIngressNamespaces = make([]string, len(in.AllowedIngressNamespaces))
+		copy(out.AllowedIngressNamespaces, in.AllowedIngressNamespaces)
+	}
+	if in.DNSConfig != nil {
+		out.DNSConfig = in.DNSConfig.DeepCopy()
+	}
+}
+
+func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *MeshConfig) DeepCopyInto(out *MeshConfig) {
+	*out = *in
+	if in.AllowedNamespaces != nil {
+		out.AllowedNamespaces = make([]string, len(in.AllowedNamespaces))
+		copy(out.AllowedNamespaces, in.AllowedNamespaces)
+	}
+}
+
+func (in *MeshConfig) DeepCopy() *MeshConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DNSConfig) DeepCopyInto(out *DNSConfig) {
+	*out = *in
+	if in.Nameservers != nil {
+		out.Nameservers = make([]string, len(in.Nameservers))
+		copy(out.Nameservers, in.Nameservers)
+	}
+	if in.Searches != nil {
+		out.Searches = make([]string, len(in.Searches))
+		copy(out.Searches, in.Searches)
+	}
+}
+
+func (in *DNSConfig) DeepCopy() *DNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *PriorityClassQuota) DeepCopyInto(out *PriorityClassQuota) {
+	*out = *in
+	if in.Pods != nil {
+		out.Pods = new(int64)
+		*out.Pods = *in.Pods
+	}
+}
+
+func (in *PriorityClassQuota) DeepCopy() *PriorityClassQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityClassQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *QuotaConfig) DeepCopyInto(out *QuotaConfig) {
+	*out = *in
+	if in.ByPriorityClass != nil {
+		out.ByPriorityClass = make([]PriorityClassQuota, len(in.ByPriorityClass))
+		for i := range in.ByPriorityClass {
+			in.ByPriorityClass[i].DeepCopyInto(&out.ByPriorityClass[i])
+		}
+	}
+	if in.Objects != nil {
+		out.Objects = new(ObjectQuotas)
+		in.Objects.DeepCopyInto(out.Objects)
+	}
+}
+
+func (in *QuotaConfig) DeepCopy() *QuotaConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ObjectQuotas) DeepCopyInto(out *ObjectQuotas) {
+	*out = *in
+	if in.Services != nil {
+		out.Services = new(int64)
+		*out.Services = *in.Services
+	}
+	if in.LoadBalancers != nil {
+		out.LoadBalancers = new(int64)
+		*out.LoadBalancers = *in.LoadBalancers
+	}
+	if in.NodePorts != nil {
+		out.NodePorts = new(int64)
+		*out.NodePorts = *in.NodePorts
+	}
+	if in.PersistentVolumeClaims != nil {
+		out.PersistentVolumeClaims = new(int64)
+		*out.PersistentVolumeClaims = *in.PersistentVolumeClaims
+	}
+	if in.ConfigMaps != nil {
+		out.ConfigMaps = new(int64)
+		*out.ConfigMaps = *in.ConfigMaps
+	}
+	if in.Secrets != nil {
+		out.Secrets = new(int64)
+		*out.Secrets = *in.Secrets
+	}
+}
+
+func (in *ObjectQuotas) DeepCopy() *ObjectQuotas {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectQuotas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *TenantEnvironment) DeepCopyInto(out *TenantEnvironment) {
+	*out = *in
+	if in.QuotaPercent != nil {
+		out.QuotaPercent = new(int32)
+		*out.QuotaPercent = *in.QuotaPercent
+	}
+	if in.Isolated != nil {
+		out.Isolated = new(bool)
+		*out.Isolated = *in.Isolated
+	}
+}
+
+func (in *TenantEnvironment) DeepCopy() *TenantEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *VClusterPersistence) DeepCopyInto(out *VClusterPersistence) {
+	*out = *in
+	if in.Enabled != nil {
+		out.Enabled = new(bool)
+		*out.Enabled = *in.Enabled
+	}
+}
+
+func (in *VClusterPersistence) DeepCopy() *VClusterPersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(VClusterPersistence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *VClusterConfig) DeepCopyInto(out *VClusterConfig) {
+	*out = *in
+	if in.Replicas != nil {
+		out.Replicas = new(int32)
+		*out.Replicas = *in.Replicas
+	}
+	if in.Persistence != nil {
+		out.Persistence = in.Persistence.DeepCopy()
+	}
+	if in.ValuesFrom != nil {
+		out.ValuesFrom = make([]VClusterValuesReference, len(in.ValuesFrom))
+		copy(out.ValuesFrom, in.ValuesFrom)
+	}
+	if in.Audit != nil {
+		out.Audit = new(VClusterAuditConfig)
+		*out.Audit = *in.Audit
+	}
+	if in.OIDC != nil {
+		out.OIDC = new(VClusterOIDC)
+		*out.OIDC = *in.OIDC
+	}
+}
+
+func (in *VClusterConfig) DeepCopy() *VClusterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VClusterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ExposureConfig) DeepCopyInto(out *ExposureConfig) {
+	*out = *in
+	if in.Annotations != nil {
+		out.Annotations = make(map[string]string, len(in.Annotations))
+		for k, v := range in.Annotations {
+			out.Annotations[k] = v
+		}
+	}
+	if in.TLS != nil {
+		tls := *in.TLS
+		out.TLS = &tls
+	}
+}
+
+func (in *ExposureConfig) DeepCopy() *ExposureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExposureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *AccessControlConfig) DeepCopyInto(out *AccessControlConfig) {
+	*out = *in
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		copy(out.Users, in.Users)
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		copy(out.Groups, in.Groups)
+	}
+}
+
+func (in *AccessControlConfig) DeepCopy() *AccessControlConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NotificationConfig) DeepCopyInto(out *NotificationConfig) {
+	*out = *in
+	if in.Digest != nil {
+		out.Digest = new(bool)
+		*out.Digest = *in.Digest
+	}
+	if in.Recipients != nil {
+		out.Recipients = make([]string, len(in.Recipients))
+		copy(out.Recipients, in.Recipients)
+	}
+}
+
+func (in *NotificationConfig) DeepCopy() *NotificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *SchedulingConfig) DeepCopyInto(out *SchedulingConfig) {
+	*out = *in
+	if in.Tolerations != nil {
+		out.Tolerations = make([]corev1.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			in.Tolerations[i].DeepCopyInto(&out.Tolerations[i])
+		}
+	}
+}
+
+func (in *SchedulingConfig) DeepCopy() *SchedulingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *TenantSpec) DeepCopyInto(out *TenantSpec) {
+	*out = *in
+	// Deep copy nested structs
+	if in.TemplateRef != nil {
+		out.TemplateRef = new(TenantTemplateReference)
+		*out.TemplateRef = *in.TemplateRef
+	}
+	if in.ProfileRef != nil {
+		out.ProfileRef = new(TenantTemplateReference)
+		*out.ProfileRef = *in.ProfileRef
+	}
+	if in.RestoreFrom != nil {
+		out.RestoreFrom = new(TenantSnapshotReference)
+		*out.RestoreFrom = *in.RestoreFrom
+	}
+	if in.Backup != nil {
+		out.Backup = new(BackupConfig)
+		*out.Backup = *in.Backup
+	}
+	if in.TTL != nil {
+		out.TTL = new(metav1.Duration)
+		*out.TTL = *in.TTL
+	}
+	if in.Hibernation != nil {
+		out.Hibernation = in.Hibernation.DeepCopy()
+	}
+	if in.Cluster != nil {
+		out.Cluster = new(ClusterConfig)
+		*out.Cluster = *in.Cluster
+	}
+	if in.Placement != nil {
+		out.Placement = new(PlacementConfig)
+		*out.Placement = *in.Placement
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	in.Network.DeepCopyInto(&out.Network)
+	if in.Mesh != nil {
+		out.Mesh = in.Mesh.DeepCopy()
+	}
+	in.Quotas.DeepCopyInto(&out.Quotas)
+	if in.Environments != nil {
+		out.Environments = make([]TenantEnvironment, len(in.Environments))
+		for i := range in.Environments {
+			in.Environments[i].DeepCopyInto(&out.Environments[i])
+		}
+	}
+	if in.VCluster != nil {
+		out.VCluster = in.VCluster.DeepCopy()
+	}
+	if in.Exposure != nil {
+		out.Exposure = in.Exposure.DeepCopy()
+	}
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Notifications.DeepCopyInto(&out.Notifications)
+	in.AccessControl.DeepCopyInto(&out.AccessControl)
+	if in.Logging != nil {
+		out.Logging = new(LoggingConfig)
+		*out.Logging = *in.Logging
+	}
+	if in.GitOps != nil {
+		out.GitOps = in.GitOps.DeepCopy()
+	}
+	if in.Observability != nil {
+		out.Observability = new(ObservabilityConfig)
+		*out.Observability = *in.Observability
+	}
+}
+
+func (in *TenantSpec) DeepCopy() *TenantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *TenantStatus) DeepCopyInto(out *TenantStatus) {
+	*out = *in
+	if in.ProvisioningStartTime != nil {
 		out.ProvisioningStartTime = in.ProvisioningStartTime.DeepCopy()
 	}
 	if in.LastUpdateTime != nil {
 		out.LastUpdateTime = in.LastUpdateTime.DeepCopy()
 	}
+	if in.CredentialsRotatedAt != nil {
+		out.CredentialsRotatedAt = in.CredentialsRotatedAt.DeepCopy()
+	}
+	if in.KubeconfigExpiresAt != nil {
+		out.KubeconfigExpiresAt = in.KubeconfigExpiresAt.DeepCopy()
+	}
+	if in.KubeconfigRotatedAt != nil {
+		out.KubeconfigRotatedAt = in.KubeconfigRotatedAt.DeepCopy()
+	}
+	if in.LastDigestSentAt != nil {
+		out.LastDigestSentAt = in.LastDigestSentAt.DeepCopy()
+	}
+	if in.Burst != nil {
+		out.Burst = in.Burst.DeepCopy()
+	}
+	if in.Progress != nil {
+		out.Progress = new(ProvisioningProgress)
+		*out.Progress = *in.Progress
+	}
+	if in.Backup != nil {
+		out.Backup = in.Backup.DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		out.ExpiresAt = in.ExpiresAt.DeepCopy()
+	}
+	if in.Hibernation != nil {
+		out.Hibernation = in.Hibernation.DeepCopy()
+	}
+	if in.Cluster != nil {
+		out.Cluster = new(ClusterStatus)
+		*out.Cluster = *in.Cluster
+	}
+	if in.Placement != nil {
+		out.Placement = in.Placement.DeepCopy()
+	}
+	if in.Logging != nil {
+		out.Logging = in.Logging.DeepCopy()
+	}
+	if in.ManagedResources != nil {
+		out.ManagedResources = make([]ManagedResource, len(in.ManagedResources))
+		copy(out.ManagedResources, in.ManagedResources)
+	}
+	if in.ZoneUsage != nil {
+		out.ZoneUsage = make([]ZoneUsage, len(in.ZoneUsage))
+		copy(out.ZoneUsage, in.ZoneUsage)
+	}
+	if in.ResourceUsage != nil {
+		out.ResourceUsage = in.ResourceUsage.DeepCopy()
+	}
+	if in.Billing != nil {
+		out.Billing = in.Billing.DeepCopy()
+	}
+	if in.AppliedTemplates != nil {
+		out.AppliedTemplates = make([]AppliedTemplate, len(in.AppliedTemplates))
+		copy(out.AppliedTemplates, in.AppliedTemplates)
+	}
+	if in.Conditions != nil {
+		out.Conditions = make([]metav1.Condition, len(in.Conditions))
+		for i := range in.Conditions {
+			in.Conditions[i].DeepCopyInto(&out.Conditions[i])
+		}
+	}
 }
 
 func (in *TenantStatus) DeepCopy() *TenantStatus {
@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessRequestPhase represents the lifecycle phase of a TenantAccessRequest.
+// +kubebuilder:validation:Enum=Pending;Active;Expired;Revoked;Denied
+type AccessRequestPhase string
+
+const (
+	// AccessRequestPending: the request has not been processed yet.
+	AccessRequestPending AccessRequestPhase = "Pending"
+
+	// AccessRequestActive: the elevated RoleBinding exists.
+	AccessRequestActive AccessRequestPhase = "Active"
+
+	// AccessRequestExpired: the grant reached its expiry and was revoked.
+	AccessRequestExpired AccessRequestPhase = "Expired"
+
+	// AccessRequestRevoked: the request was deleted before it expired.
+	AccessRequestRevoked AccessRequestPhase = "Revoked"
+
+	// AccessRequestDenied: the request was invalid and nothing was granted.
+	AccessRequestDenied AccessRequestPhase = "Denied"
+)
+
+// AccessSubject identifies who receives the elevated access.
+type AccessSubject struct {
+	// Kind is the subject kind.
+	// +kubebuilder:validation:Enum=User;Group;ServiceAccount
+	Kind string `json:"kind"`
+
+	// Name is the user, group, or ServiceAccount name.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the ServiceAccount. Defaults to the tenant namespace.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// TenantAccessRequestSpec defines a time-limited elevation of RBAC in a tenant namespace.
+type TenantAccessRequestSpec struct {
+	// TenantRef is the name of the Tenant whose namespace is accessed.
+	// +kubebuilder:validation:MinLength=1
+	TenantRef string `json:"tenantRef"`
+
+	// Subject receives the elevated access.
+	Subject AccessSubject `json:"subject"`
+
+	// ClusterRole is the ClusterRole bound in the tenant namespace. Default: "admin".
+	ClusterRole string `json:"clusterRole,omitempty"`
+
+	// Duration is how long the access lasts once granted (e.g., "1h").
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+
+	// Reason explains why break-glass access is needed (incident ticket, etc.).
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// RequestedBy identifies the admin who raised the request.
+	RequestedBy string `json:"requestedBy,omitempty"`
+}
+
+// TenantAccessRequestStatus defines the observed state of a TenantAccessRequest.
+type TenantAccessRequestStatus struct {
+	// Phase is the current lifecycle phase.
+	Phase AccessRequestPhase `json:"phase,omitempty"`
+
+	// RoleBinding is the name of the RoleBinding created in the tenant namespace.
+	RoleBinding string `json:"roleBinding,omitempty"`
+
+	// Namespace is the tenant namespace the RoleBinding lives in.
+	Namespace string `json:"namespace,omitempty"`
+
+	// GrantedAt is when the RoleBinding was created.
+	GrantedAt *metav1.Time `json:"grantedAt,omitempty"`
+
+	// ExpiresAt is when the RoleBinding will be (or was) revoked.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Message gives details for Denied requests.
+	Message string `json:"message,omitempty"`
+}
+
+// TenantAccessRequest is the Schema for the tenantaccessrequests API.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=tar;plural=tenantaccessrequests;categories=tenant-master
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantRef`
+// +kubebuilder:printcolumn:name="Subject",type=string,JSONPath=`.spec.subject.name`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Expires",type=string,JSONPath=`.status.expiresAt`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type TenantAccessRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantAccessRequestSpec   `json:"spec,omitempty"`
+	Status TenantAccessRequestStatus `json:"status,omitempty"`
+}
+
+// TenantAccessRequestList contains a list of TenantAccessRequest objects.
+// +kubebuilder:object:root=true
+type TenantAccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantAccessRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantAccessRequest{}, &TenantAccessRequestList{})
+}
+
+// DeepCopyInto for nested TenantAccessRequest types.
+func (in *TenantAccessRequestSpec) DeepCopyInto(out *TenantAccessRequestSpec) {
+	*out = *in
+}
+
+func (in *TenantAccessRequestSpec) DeepCopy() *TenantAccessRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantAccessRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *TenantAccessRequestStatus) DeepCopyInto(out *TenantAccessRequestStatus) {
+	*out = *in
+	if in.GrantedAt != nil {
+		out.GrantedAt = in.GrantedAt.DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		out.ExpiresAt = in.ExpiresAt.DeepCopy()
+	}
+}
+
+func (in *TenantAccessRequestStatus) DeepCopy() *TenantAccessRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantAccessRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MigrationPhase represents the lifecycle phase of a TenantMigration.
+// +kubebuilder:validation:Enum=Pending;Snapshotting;Provisioning;Restoring;Verifying;Decommissioning;Completed;RolledBack;Failed
+type MigrationPhase string
+
+const (
+	// MigrationPending: the migration has not started yet.
+	MigrationPending MigrationPhase = "Pending"
+
+	// MigrationSnapshotting: the source tenant is being snapshotted.
+	MigrationSnapshotting MigrationPhase = "Snapshotting"
+
+	// MigrationProvisioning: the Tenant is being created on the target cluster.
+	MigrationProvisioning MigrationPhase = "Provisioning"
+
+	// MigrationRestoring: the tenant's ConfigMaps are being copied to the target namespace.
+	MigrationRestoring MigrationPhase = "Restoring"
+
+	// MigrationVerifying: waiting for the target Tenant to become Ready.
+	MigrationVerifying MigrationPhase = "Verifying"
+
+	// MigrationDecommissioning: the source Tenant is being deleted.
+	MigrationDecommissioning MigrationPhase = "Decommissioning"
+
+	// MigrationCompleted: the tenant runs on the target cluster only.
+	MigrationCompleted MigrationPhase = "Completed"
+
+	// MigrationRolledBack: the target Tenant was removed and the source left in place.
+	MigrationRolledBack MigrationPhase = "RolledBack"
+
+	// MigrationFailed: the migration could not start and nothing was changed.
+	MigrationFailed MigrationPhase = "Failed"
+)
+
+// MigrationTarget identifies the cluster a tenant is moved to.
+type MigrationTarget struct {
+	// KubeconfigSecret is the name of a Secret in the operator namespace holding a
+	// kubeconfig for the target cluster, which must run tenant-master.
+	// +kubebuilder:validation:MinLength=1
+	KubeconfigSecret string `json:"kubeconfigSecret"`
+
+	// KubeconfigKey is the Secret key holding the kubeconfig. Default: "kubeconfig".
+	// +kubebuilder:default=kubeconfig
+	KubeconfigKey string `json:"kubeconfigKey,omitempty"`
+}
+
+// TenantMigrationSpec defines a move of a tenant to another cluster.
+type TenantMigrationSpec struct {
+	// TenantName is the name of the Tenant to migrate.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tenantName is immutable"
+	TenantName string `json:"tenantName"`
+
+	// Target is the cluster the tenant is moved to.
+	Target MigrationTarget `json:"target"`
+
+	// VerifyTimeout is how long the target Tenant may take to get its namespace, and then
+	// to become Ready, before the migration is rolled back. Default: 15m.
+	VerifyTimeout *metav1.Duration `json:"verifyTimeout,omitempty"`
+
+	// RequestedBy identifies the admin who started the migration.
+	RequestedBy string `json:"requestedBy,omitempty"`
+}
+
+// MigrationStep records when a migration phase started and finished.
+type MigrationStep struct {
+	// Phase is the migration phase of this step.
+	Phase MigrationPhase `json:"phase"`
+
+	// StartedAt is when the step started.
+	StartedAt metav1.Time `json:"startedAt"`
+
+	// CompletedAt is when the step finished. Unset while it runs.
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// Message gives details on the outcome of the step.
+	Message string `json:"message,omitempty"`
+}
+
+// TenantMigrationStatus defines the observed state of a TenantMigration.
+type TenantMigrationStatus struct {
+	// Phase is the current lifecycle phase.
+	Phase MigrationPhase `json:"phase,omitempty"`
+
+	// Steps lists the phases the migration went through, in order.
+	Steps []MigrationStep `json:"steps,omitempty"`
+
+	// Snapshot is the name of the snapshot taken of the source tenant.
+	Snapshot string `json:"snapshot,omitempty"`
+
+	// TargetNamespace is the tenant namespace on the target cluster.
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
+	// RestoredConfigMaps is the number of ConfigMaps copied to the target namespace.
+	RestoredConfigMaps int32 `json:"restoredConfigMaps,omitempty"`
+
+	// StartedAt is when the migration started.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// CompletedAt is when the migration completed, was rolled back, or failed.
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// Message gives details for RolledBack and Failed migrations.
+	Message string `json:"message,omitempty"`
+}
+
+// TenantMigration is the Schema for the tenantmigrations API.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=tm;plural=tenantmigrations;categories=tenant-master
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantName`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.target.kubeconfigSecret`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type TenantMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantMigrationSpec   `json:"spec,omitempty"`
+	Status TenantMigrationStatus `json:"status,omitempty"`
+}
+
+// TenantMigrationList contains a list of TenantMigration objects.
+// +kubebuilder:object:root=true
+type TenantMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantMigration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantMigration{}, &TenantMigrationList{})
+}
+
+// DeepCopyInto for nested TenantMigration types.
+func (in *TenantMigrationSpec) DeepCopyInto(out *TenantMigrationSpec) {
+	*out = *in
+	if in.VerifyTimeout != nil {
+		out.VerifyTimeout = new(metav1.Duration)
+		*out.VerifyTimeout = *in.VerifyTimeout
+	}
+}
+
+func (in *TenantMigrationSpec) DeepCopy() *TenantMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *MigrationStep) DeepCopyInto(out *MigrationStep) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.CompletedAt != nil {
+		out.CompletedAt = in.CompletedAt.DeepCopy()
+	}
+}
+
+func (in *MigrationStep) DeepCopy() *MigrationStep {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *TenantMigrationStatus) DeepCopyInto(out *TenantMigrationStatus) {
+	*out = *in
+	if in.Steps != nil {
+		out.Steps = make([]MigrationStep, len(in.Steps))
+		for i := range in.Steps {
+			in.Steps[i].DeepCopyInto(&out.Steps[i])
+		}
+	}
+	if in.StartedAt != nil {
+		out.StartedAt = in.StartedAt.DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		out.CompletedAt = in.CompletedAt.DeepCopy()
+	}
+}
+
+func (in *TenantMigrationStatus) DeepCopy() *TenantMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantTemplateSpec describes the Tenants that will be created from a TenantSet.
+type TenantTemplateSpec struct {
+	// Labels are applied to every Tenant created from this template.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are applied to every Tenant created from this template.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Spec is the TenantSpec shared by all members. The owner is overridden per member.
+	Spec TenantSpec `json:"spec"`
+}
+
+// TenantSetMember identifies a single Tenant fanned out by a TenantSet.
+type TenantSetMember struct {
+	// Name is the name of the Tenant to create.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Owner overrides the template owner for this member.
+	Owner string `json:"owner,omitempty"`
+}
+
+// TenantSetSpec defines the desired state of a TenantSet.
+type TenantSetSpec struct {
+	// Template is the Tenant template used for every member.
+	Template TenantTemplateSpec `json:"template"`
+
+	// Tenants lists the names (and optional owners) of the Tenants to create.
+	Tenants []TenantSetMember `json:"tenants,omitempty"`
+}
+
+// TenantSetStatus defines the observed state of a TenantSet.
+type TenantSetStatus struct {
+	// Replicas is the number of Tenants currently owned by this set.
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReadyReplicas is the number of owned Tenants in the Ready state.
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// FailedReplicas is the number of owned Tenants in the Failed state.
+	FailedReplicas int32 `json:"failedReplicas,omitempty"`
+
+	// FailedTenants lists the names of members in the Failed state.
+	FailedTenants []string `json:"failedTenants,omitempty"`
+
+	// ConflictingTenants lists members whose name is taken by a Tenant this set does not
+	// control. Such Tenants are left untouched until they are deleted or renamed.
+	ConflictingTenants []string `json:"conflictingTenants,omitempty"`
+
+	// ObservedGeneration reflects the generation of the Spec that was last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// TenantSet is the Schema for the tenantsets API.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=tset;plural=tenantsets;categories=tenant-master
+// +kubebuilder:printcolumn:name="Tier",type=string,JSONPath=`.spec.template.spec.tier`
+// +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.status.replicas`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyReplicas`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failedReplicas`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type TenantSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantSetSpec   `json:"spec,omitempty"`
+	Status TenantSetStatus `json:"status,omitempty"`
+}
+
+// TenantSetList contains a list of TenantSet objects.
+// +kubebuilder:object:root=true
+type TenantSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantSet{}, &TenantSetList{})
+}
+
+// DeepCopyInto for nested TenantSet types.
+func (in *TenantTemplateSpec) DeepCopyInto(out *TenantTemplateSpec) {
+	*out = *in
+	if in.Labels != nil {
+		out.Labels = make(map[string]string, len(in.Labels))
+		for k, v := range in.Labels {
+			out.Labels[k] = v
+		}
+	}
+	if in.Annotations != nil {
+		out.Annotations = make(map[string]string, len(in.Annotations))
+		for k, v := range in.Annotations {
+			out.Annotations[k] = v
+		}
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+func (in *TenantTemplateSpec) DeepCopy() *TenantTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *TenantSetSpec) DeepCopyInto(out *TenantSetSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Tenants != nil {
+		out.Tenants = make([]TenantSetMember, len(in.Tenants))
+		copy(out.Tenants, in.Tenants)
+	}
+}
+
+func (in *TenantSetSpec) DeepCopy() *TenantSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *TenantSetStatus) DeepCopyInto(out *TenantSetStatus) {
+	*out = *in
+	if in.FailedTenants != nil {
+		out.FailedTenants = make([]string, len(in.FailedTenants))
+		copy(out.FailedTenants, in.FailedTenants)
+	}
+	if in.ConflictingTenants != nil {
+		out.ConflictingTenants = make([]string, len(in.ConflictingTenants))
+		copy(out.ConflictingTenants, in.ConflictingTenants)
+	}
+}
+
+func (in *TenantSetStatus) DeepCopy() *TenantSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
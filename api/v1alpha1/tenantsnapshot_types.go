@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SnapshotTrigger records why a snapshot was taken.
+// +kubebuilder:validation:Enum=Manual;Scheduled;Deletion;Migration
+type SnapshotTrigger string
+
+const (
+	// SnapshotManual: an admin created the TenantSnapshot.
+	SnapshotManual SnapshotTrigger = "Manual"
+
+	// SnapshotScheduled: the operator took the snapshot on the tenant's spec.backup schedule.
+	SnapshotScheduled SnapshotTrigger = "Scheduled"
+
+	// SnapshotDeletion: the operator took the snapshot before deleting the tenant.
+	SnapshotDeletion SnapshotTrigger = "Deletion"
+
+	// SnapshotMigration: a TenantMigration took the snapshot before moving the tenant.
+	SnapshotMigration SnapshotTrigger = "Migration"
+)
+
+// SnapshotPhase represents the lifecycle phase of a TenantSnapshot.
+// +kubebuilder:validation:Enum=Pending;Completed;Failed
+type SnapshotPhase string
+
+const (
+	// SnapshotPending: the snapshot has not been taken yet.
+	SnapshotPending SnapshotPhase = "Pending"
+
+	// SnapshotCompleted: the snapshot was taken.
+	SnapshotCompleted SnapshotPhase = "Completed"
+
+	// SnapshotFailed: the snapshot could not be taken.
+	SnapshotFailed SnapshotPhase = "Failed"
+)
+
+// TenantSnapshotReference names a TenantSnapshot.
+type TenantSnapshotReference struct {
+	// Name of the TenantSnapshot.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// TenantSnapshotSpec defines which tenant a snapshot is of.
+type TenantSnapshotSpec struct {
+	// TenantName is the name of the Tenant the snapshot is of.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tenantName is immutable"
+	TenantName string `json:"tenantName"`
+
+	// Trigger records why the snapshot was taken. Default: Manual.
+	// +kubebuilder:default=Manual
+	Trigger SnapshotTrigger `json:"trigger,omitempty"`
+}
+
+// TenantSnapshotStatus records what a snapshot captured and where it is stored.
+type TenantSnapshotStatus struct {
+	// Phase is the current lifecycle phase.
+	Phase SnapshotPhase `json:"phase,omitempty"`
+
+	// TakenAt is when the snapshot was taken.
+	TakenAt *metav1.Time `json:"takenAt,omitempty"`
+
+	// Tier is the tenant's tier when the snapshot was taken.
+	Tier TenantTier `json:"tier,omitempty"`
+
+	// Owner is the tenant's owner when the snapshot was taken.
+	Owner string `json:"owner,omitempty"`
+
+	// SourceNamespace is the tenant namespace the contents were captured from.
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+
+	// Location is the archive key holding the tenant spec and the captured objects.
+	// Empty when the operator has no archive storage, in which case only this record
+	// exists and the snapshot cannot be restored.
+	Location string `json:"location,omitempty"`
+
+	// Contents lists the namespace objects captured. Secrets are never captured.
+	Contents []ManagedResource `json:"contents,omitempty"`
+
+	// Message gives details for Failed snapshots.
+	Message string `json:"message,omitempty"`
+}
+
+// TenantSnapshot is the Schema for the tenantsnapshots API: a record of the spec and
+// namespace contents of a tenant at one point in time, which a new Tenant can be
+// restored from through spec.restoreFrom.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=tsnap;plural=tenantsnapshots;categories=tenant-master
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantName`
+// +kubebuilder:printcolumn:name="Trigger",type=string,JSONPath=`.spec.trigger`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Taken",type="date",JSONPath=".status.takenAt"
+// +kubebuilder:printcolumn:name="Location",type=string,JSONPath=`.status.location`,priority=1
+type TenantSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantSnapshotSpec   `json:"spec,omitempty"`
+	Status TenantSnapshotStatus `json:"status,omitempty"`
+}
+
+// Restorable reports whether a new tenant can be restored from the snapshot.
+func (s *TenantSnapshot) Restorable() bool {
+	return s.Status.Phase == SnapshotCompleted && s.Status.Location != ""
+}
+
+// TenantSnapshotList contains a list of TenantSnapshot objects.
+// +kubebuilder:object:root=true
+type TenantSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantSnapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantSnapshot{}, &TenantSnapshotList{})
+}
+
+// DeepCopyInto for nested TenantSnapshot types.
+func (in *TenantSnapshotStatus) DeepCopyInto(out *TenantSnapshotStatus) {
+	*out = *in
+	if in.TakenAt != nil {
+		out.TakenAt = in.TakenAt.DeepCopy()
+	}
+	if in.Contents != nil {
+		out.Contents = make([]ManagedResource, len(in.Contents))
+		copy(out.Contents, in.Contents)
+	}
+}
+
+func (in *TenantSnapshotStatus) DeepCopy() *TenantSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
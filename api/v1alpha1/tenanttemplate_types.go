@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantPresetSpec defines the defaults a TenantTemplate applies to the Tenants that
+// reference it. Values the Tenant sets itself take precedence.
+type TenantPresetSpec struct {
+	// Description tells users what the preset is for.
+	Description string `json:"description,omitempty"`
+
+	// ProfileRef names a parent TenantTemplate this one inherits from. The values set
+	// here override the parent's, which in turn override its own profileRef.
+	ProfileRef *TenantTemplateReference `json:"profileRef,omitempty"`
+
+	// Tier of Tenants created from this template. It replaces the Tenant's tier only
+	// when that is the Silver default; Tenants that ask for Bronze or Gold keep it.
+	Tier TenantTier `json:"tier,omitempty"`
+
+	// Resources fills the CPU, memory, storage, and burst settings the Tenant leaves empty.
+	Resources ResourceRequirements `json:"resources,omitempty"`
+
+	// Network fills the network settings the Tenant leaves empty. Internet and external
+	// service access are allowed when either the Tenant or the template allows them.
+	Network NetworkConfig `json:"network,omitempty"`
+
+	// Labels are added to the Tenant, without overriding labels it already has.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// TenantTemplateReference names the TenantTemplate a Tenant is created from.
+type TenantTemplateReference struct {
+	// Name of the TenantTemplate.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// TenantTemplate is the Schema for the tenanttemplates API: a reusable preset of
+// tenant defaults, merged into Tenants that set spec.templateRef when they are created.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=ttpl;plural=tenanttemplates;categories=tenant-master
+// +kubebuilder:printcolumn:name="Tier",type=string,JSONPath=`.spec.tier`
+// +kubebuilder:printcolumn:name="CPU",type=string,JSONPath=`.spec.resources.cpu`
+// +kubebuilder:printcolumn:name="Memory",type=string,JSONPath=`.spec.resources.memory`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type TenantTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TenantPresetSpec `json:"spec,omitempty"`
+}
+
+// TenantTemplateList contains a list of TenantTemplate objects.
+// +kubebuilder:object:root=true
+type TenantTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantTemplate{}, &TenantTemplateList{})
+}
+
+// DeepCopyInto for nested TenantTemplate types.
+func (in *TenantPresetSpec) DeepCopyInto(out *TenantPresetSpec) {
+	*out = *in
+	if in.ProfileRef != nil {
+		out.ProfileRef = new(TenantTemplateReference)
+		*out.ProfileRef = *in.ProfileRef
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	in.Network.DeepCopyInto(&out.Network)
+	if in.Labels != nil {
+		out.Labels = make(map[string]string, len(in.Labels))
+		for k, v := range in.Labels {
+			out.Labels[k] = v
+		}
+	}
+}
+
+func (in *TenantPresetSpec) DeepCopy() *TenantPresetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantPresetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// accessRequestLabelKey links a break-glass RoleBinding to its TenantAccessRequest
+const accessRequestLabelKey = "tenant.platform.io/access-request"
+
+// Sources of a tenant access grant
+const (
+	accessSourceOperator   = "operator"   // RoleBinding generated by the operator
+	accessSourceBreakGlass = "breakGlass" // RoleBinding of a TenantAccessRequest
+	accessSourceTenant     = "tenant"     // RoleBinding created inside the tenant namespace
+)
+
+// TenantAccess lists who currently has access to a tenant's namespaces
+type TenantAccess struct {
+	Tenant  string        `json:"tenant"`
+	Tier    string        `json:"tier,omitempty"`
+	Entries []AccessEntry `json:"entries"`
+}
+
+// AccessEntry is one subject of one RoleBinding
+type AccessEntry struct {
+	SubjectKind      string `json:"subjectKind"`
+	Subject          string `json:"subject"`
+	SubjectNamespace string `json:"subjectNamespace,omitempty"`
+	Namespace        string `json:"namespace"`
+	RoleKind         string `json:"roleKind"`
+	Role             string `json:"role"`
+	Binding          string `json:"binding"`
+	Source           string `json:"source"`
+	// Break-glass grants only
+	AccessRequest string `json:"accessRequest,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+	RequestedBy   string `json:"requestedBy,omitempty"`
+	ExpiresAt     string `json:"expiresAt,omitempty"`
+}
+
+// GetTenantAccessHandler lists the ServiceAccounts, users, and groups bound to roles in
+// the tenant's namespaces, including break-glass grants and when they expire
+func GetTenantAccessHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "access review not supported in mock mode"})
+			return
+		}
+
+		name := c.Param("name")
+		ctx, cancel := k8sContext(opRead)
+		defer cancel()
+
+		tenant := &unstructured.Unstructured{}
+		tenant.SetGroupVersionKind(schema.GroupVersionKind{Group: "platform.io", Version: "v1alpha1", Kind: "Tenant"})
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, tenant); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+			return
+		}
+
+		access, err := buildTenantAccess(ctx, tenant)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, access)
+	}
+}
+
+func buildTenantAccess(ctx context.Context, tenant *unstructured.Unstructured) (*TenantAccess, error) {
+	name := tenant.GetName()
+	access := &TenantAccess{Tenant: name, Entries: []AccessEntry{}}
+	access.Tier, _, _ = unstructured.NestedString(tenant.Object, "spec", "tier")
+
+	// Bindings labeled for the tenant, e.g. in the shared Bronze namespace, plus every
+	// binding in the tenant's own namespaces, including those its admins created
+	bindings := map[string]rbacv1.RoleBinding{}
+	labeled := &rbacv1.RoleBindingList{}
+	if err := listTyped(ctx, labeled, "RoleBindingList", rbacv1.SchemeGroupVersion, client.MatchingLabels{tenantNameLabelKey: name}); err != nil {
+		return nil, fmt.Errorf("failed to list tenant RoleBindings: %w", err)
+	}
+	for _, rb := range labeled.Items {
+		bindings[rb.Namespace+"/"+rb.Name] = rb
+	}
+
+	namespaces := &unstructured.UnstructuredList{}
+	namespaces.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "NamespaceList"})
+	if err := k8sClient.List(ctx, namespaces, client.MatchingLabels{tenantNameLabelKey: name}); err != nil {
+		return nil, fmt.Errorf("failed to list tenant namespaces: %w", err)
+	}
+	for _, ns := range namespaces.Items {
+		list := &rbacv1.RoleBindingList{}
+		if err := listTyped(ctx, list, "RoleBindingList", rbacv1.SchemeGroupVersion, client.InNamespace(ns.GetName())); err != nil {
+			return nil, fmt.Errorf("failed to list RoleBindings in %s: %w", ns.GetName(), err)
+		}
+		for _, rb := range list.Items {
+			bindings[rb.Namespace+"/"+rb.Name] = rb
+		}
+	}
+
+	for _, rb := range bindings {
+		source := accessSourceTenant
+		requestName := rb.Labels[accessRequestLabelKey]
+		var request AccessRequest
+		switch {
+		case requestName != "":
+			source = accessSourceBreakGlass
+			var err error
+			if request, err = accessRequest(ctx, requestName); err != nil {
+				return nil, err
+			}
+		case rb.Labels[managedByLabelKey] == managedByLabelValue:
+			source = accessSourceOperator
+		}
+		for _, subject := range rb.Subjects {
+			entry := AccessEntry{
+				SubjectKind:      subject.Kind,
+				Subject:          subject.Name,
+				SubjectNamespace: subject.Namespace,
+				Namespace:        rb.Namespace,
+				RoleKind:         rb.RoleRef.Kind,
+				Role:             rb.RoleRef.Name,
+				Binding:          rb.Name,
+				Source:           source,
+			}
+			if source == accessSourceBreakGlass {
+				entry.AccessRequest = requestName
+				entry.Reason, entry.RequestedBy, entry.ExpiresAt = request.Reason, request.RequestedBy, request.ExpiresAt
+			}
+			access.Entries = append(access.Entries, entry)
+		}
+	}
+
+	sort.Slice(access.Entries, func(i, j int) bool {
+		a, b := access.Entries[i], access.Entries[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Binding != b.Binding {
+			return a.Binding < b.Binding
+		}
+		if a.SubjectKind != b.SubjectKind {
+			return a.SubjectKind < b.SubjectKind
+		}
+		return a.Subject < b.Subject
+	})
+	return access, nil
+}
+
+// accessRequest fetches a TenantAccessRequest. A request deleted while its binding is
+// being revoked yields an empty AccessRequest
+func accessRequest(ctx context.Context, name string) (AccessRequest, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(accessRequestGVK)
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return AccessRequest{}, nil
+		}
+		return AccessRequest{}, fmt.Errorf("failed to get access request %s: %w", name, err)
+	}
+	return accessRequestFromObject(obj), nil
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxAccessRequestDuration mirrors the operator's break-glass limit
+const maxAccessRequestDuration = 24 * time.Hour
+
+// AccessRequest is the BFF view of a TenantAccessRequest
+type AccessRequest struct {
+	Name        string `json:"name"`
+	Tenant      string `json:"tenant"`
+	SubjectKind string `json:"subjectKind"`
+	Subject     string `json:"subject"`
+	ClusterRole string `json:"clusterRole,omitempty"`
+	Duration    string `json:"duration"`
+	Reason      string `json:"reason"`
+	RequestedBy string `json:"requestedBy,omitempty"`
+	Phase       string `json:"phase,omitempty"`
+	ExpiresAt   string `json:"expiresAt,omitempty"`
+	Message     string `json:"message,omitempty"`
+}
+
+var accessRequestGVK = schema.GroupVersionKind{
+	Group:   "platform.io",
+	Version: "v1alpha1",
+	Kind:    "TenantAccessRequest",
+}
+
+// CreateAccessRequestHandler grants time-limited elevated access to a tenant namespace
+func CreateAccessRequestHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "access requests not supported in mock mode"})
+			return
+		}
+
+		name := c.Param("name")
+		var req struct {
+			Subject struct {
+				Kind      string `json:"kind"`
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"subject"`
+			ClusterRole string `json:"clusterRole"`
+			Duration    string `json:"duration"`
+			Reason      string `json:"reason"`
+			RequestedBy string `json:"requestedBy"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+			return
+		}
+		if req.Subject.Name == "" || req.Reason == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "subject.name and reason are required"})
+			return
+		}
+		if req.Subject.Kind == "" {
+			req.Subject.Kind = "User"
+		}
+		d, err := time.ParseDuration(req.Duration)
+		if err != nil || d <= 0 || d > maxAccessRequestDuration {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("duration must be between 0 and %s", maxAccessRequestDuration)})
+			return
+		}
+
+		subject := map[string]any{"kind": req.Subject.Kind, "name": req.Subject.Name}
+		if req.Subject.Namespace != "" {
+			subject["namespace"] = req.Subject.Namespace
+		}
+		spec := map[string]any{
+			"tenantRef": name,
+			"subject":   subject,
+			"duration":  d.String(),
+			"reason":    req.Reason,
+		}
+		if req.ClusterRole != "" {
+			spec["clusterRole"] = req.ClusterRole
+		}
+		if req.RequestedBy != "" {
+			spec["requestedBy"] = req.RequestedBy
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(accessRequestGVK)
+		obj.SetGenerateName(fmt.Sprintf("%s-breakglass-", name))
+		obj.SetLabels(map[string]string{"tenant.platform.io/name": name})
+		obj.Object["spec"] = spec
+
+		ctx, cancel := k8sContext(opWrite)
+		defer cancel()
+		if err := k8sClient.Create(ctx, obj); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to create access request: %v", err)})
+			return
+		}
+
+		c.JSON(http.StatusCreated, accessRequestFromObject(obj))
+	}
+}
+
+// ListAccessRequestsHandler lists break-glass access requests for a tenant
+func ListAccessRequestsHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusOK, []AccessRequest{})
+			return
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   accessRequestGVK.Group,
+			Version: accessRequestGVK.Version,
+			Kind:    accessRequestGVK.Kind + "List",
+		})
+
+		ctx, cancel := k8sContext(opRead)
+		defer cancel()
+		if err := k8sClient.List(ctx, list, client.MatchingLabels{"tenant.platform.io/name": c.Param("name")}); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		requests := make([]AccessRequest, 0, len(list.Items))
+		for i := range list.Items {
+			requests = append(requests, accessRequestFromObject(&list.Items[i]))
+		}
+		c.JSON(http.StatusOK, requests)
+	}
+}
+
+// RevokeAccessRequestHandler revokes break-glass access before it expires
+func RevokeAccessRequestHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "access requests not supported in mock mode"})
+			return
+		}
+
+		ctx, cancel := k8sContext(opWrite)
+		defer cancel()
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(accessRequestGVK)
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: c.Param("id")}, obj); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "access request not found"})
+			return
+		}
+		if tenant, _, _ := unstructured.NestedString(obj.Object, "spec", "tenantRef"); tenant != c.Param("name") {
+			c.JSON(http.StatusNotFound, gin.H{"error": "access request not found"})
+			return
+		}
+
+		// Deleting the request makes the operator revoke the RoleBinding and audit it
+		if err := k8sClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to revoke access request: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"revoked": obj.GetName()})
+	}
+}
+
+func accessRequestFromObject(obj *unstructured.Unstructured) AccessRequest {
+	ar := AccessRequest{Name: obj.GetName()}
+	ar.Tenant, _, _ = unstructured.NestedString(obj.Object, "spec", "tenantRef")
+	ar.SubjectKind, _, _ = unstructured.NestedString(obj.Object, "spec", "subject", "kind")
+	ar.Subject, _, _ = unstructured.NestedString(obj.Object, "spec", "subject", "name")
+	ar.ClusterRole, _, _ = unstructured.NestedString(obj.Object, "spec", "clusterRole")
+	ar.Duration, _, _ = unstructured.NestedString(obj.Object, "spec", "duration")
+	ar.Reason, _, _ = unstructured.NestedString(obj.Object, "spec", "reason")
+	ar.RequestedBy, _, _ = unstructured.NestedString(obj.Object, "spec", "requestedBy")
+	ar.Phase, _, _ = unstructured.NestedString(obj.Object, "status", "phase")
+	ar.ExpiresAt, _, _ = unstructured.NestedString(obj.Object, "status", "expiresAt")
+	ar.Message, _, _ = unstructured.NestedString(obj.Object, "status", "message")
+	return ar
+}
@@ -0,0 +1,408 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// apiKeyPrefix marks BFF-issued API keys: "tmk_<id>_<secret>".
+	apiKeyPrefix = "tmk"
+
+	// apiKeyHeader carries an API key on scraper requests.
+	apiKeyHeader = "X-API-Key"
+
+	// Defaults and caps for API key lifetime.
+	defaultAPIKeyTTL = 30 * 24 * time.Hour
+	maxAPIKeyTTL     = 365 * 24 * time.Hour
+
+	apiKeyTenantLabel    = "tenant.platform.io/name"
+	apiKeyComponentLabel = "app.kubernetes.io/component"
+	apiKeyComponent      = "bff-apikey"
+	apiKeyHashAnnotation = "tenant.platform.io/apikey-hash"
+	apiKeyExpiryAnno     = "tenant.platform.io/apikey-expires-at"
+	apiKeyDescAnno       = "tenant.platform.io/apikey-description"
+)
+
+// apiKeyReadRoutes are the only routes an API key may call (GET only).
+var apiKeyReadRoutes = map[string]bool{
+	"/api/v1/tenants/:name/metrics": true,
+}
+
+var errAPIKeyNotFound = errors.New("api key not found")
+
+// APIKey is the public (secret-free) view of an issued API key
+type APIKey struct {
+	ID          string    `json:"id"`
+	Tenant      string    `json:"tenant"`
+	Description string    `json:"description,omitempty"`
+	Scope       string    `json:"scope"`
+	CreatedAt   time.Time `json:"createdAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	Expired     bool      `json:"expired"`
+}
+
+// storedAPIKey is an APIKey plus the hash of its secret
+type storedAPIKey struct {
+	APIKey
+	Hash string
+}
+
+// apiKeyStore persists issued API keys
+type apiKeyStore interface {
+	Save(ctx context.Context, key storedAPIKey) error
+	Get(ctx context.Context, id string) (storedAPIKey, error)
+	List(ctx context.Context, tenant string) ([]storedAPIKey, error)
+	Delete(ctx context.Context, tenant, id string) error
+}
+
+var apiKeys apiKeyStore
+
+// initAPIKeyStore selects the API key store for the BFF mode
+func initAPIKeyStore(mode string) {
+	if mode == "k8s" {
+		apiKeys = &secretAPIKeyStore{namespace: bffNamespace()}
+		return
+	}
+	apiKeys = &memoryAPIKeyStore{keys: map[string]storedAPIKey{}}
+}
+
+// bffNamespace returns the namespace the BFF stores its own objects in
+func bffNamespace() string {
+	if ns := os.Getenv("BFF_NAMESPACE"); ns != "" {
+		return ns
+	}
+	return "tenant-master-system"
+}
+
+// memoryAPIKeyStore keeps API keys in memory (mock mode)
+type memoryAPIKeyStore struct {
+	mu   sync.RWMutex
+	keys map[string]storedAPIKey
+}
+
+func (s *memoryAPIKeyStore) Save(_ context.Context, key storedAPIKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[key.ID] = key
+	return nil
+}
+
+func (s *memoryAPIKeyStore) Get(_ context.Context, id string) (storedAPIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.keys[id]
+	if !ok {
+		return storedAPIKey{}, errAPIKeyNotFound
+	}
+	return key, nil
+}
+
+func (s *memoryAPIKeyStore) List(_ context.Context, tenant string) ([]storedAPIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var keys []storedAPIKey
+	for _, key := range s.keys {
+		if key.Tenant == tenant {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (s *memoryAPIKeyStore) Delete(_ context.Context, tenant, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key, ok := s.keys[id]
+	if !ok || key.Tenant != tenant {
+		return errAPIKeyNotFound
+	}
+	delete(s.keys, id)
+	return nil
+}
+
+// secretAPIKeyStore keeps API keys as Secrets in the BFF namespace (k8s mode)
+type secretAPIKeyStore struct {
+	namespace string
+}
+
+func apiKeySecretName(id string) string {
+	return "bff-apikey-" + id
+}
+
+func newSecretObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Secret"})
+	return obj
+}
+
+func (s *secretAPIKeyStore) Save(ctx context.Context, key storedAPIKey) error {
+	obj := newSecretObject()
+	obj.SetName(apiKeySecretName(key.ID))
+	obj.SetNamespace(s.namespace)
+	obj.SetLabels(map[string]string{
+		apiKeyTenantLabel:    key.Tenant,
+		apiKeyComponentLabel: apiKeyComponent,
+	})
+	obj.SetAnnotations(map[string]string{
+		apiKeyHashAnnotation: key.Hash,
+		apiKeyExpiryAnno:     key.ExpiresAt.Format(time.RFC3339),
+		apiKeyDescAnno:       key.Description,
+	})
+	_ = unstructured.SetNestedField(obj.Object, "Opaque", "type")
+	return k8sClient.Create(ctx, obj)
+}
+
+func (s *secretAPIKeyStore) Get(ctx context.Context, id string) (storedAPIKey, error) {
+	obj := newSecretObject()
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: s.namespace, Name: apiKeySecretName(id)}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return storedAPIKey{}, errAPIKeyNotFound
+		}
+		return storedAPIKey{}, err
+	}
+	return storedAPIKeyFromSecret(id, obj), nil
+}
+
+func (s *secretAPIKeyStore) List(ctx context.Context, tenant string) ([]storedAPIKey, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "SecretList"})
+	if err := k8sClient.List(ctx, list, client.InNamespace(s.namespace), client.MatchingLabels{
+		apiKeyTenantLabel:    tenant,
+		apiKeyComponentLabel: apiKeyComponent,
+	}); err != nil {
+		return nil, err
+	}
+	var keys []storedAPIKey
+	for i := range list.Items {
+		id := strings.TrimPrefix(list.Items[i].GetName(), "bff-apikey-")
+		keys = append(keys, storedAPIKeyFromSecret(id, &list.Items[i]))
+	}
+	return keys, nil
+}
+
+func (s *secretAPIKeyStore) Delete(ctx context.Context, tenant, id string) error {
+	key, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if key.Tenant != tenant {
+		return errAPIKeyNotFound
+	}
+	obj := newSecretObject()
+	obj.SetName(apiKeySecretName(id))
+	obj.SetNamespace(s.namespace)
+	if err := k8sClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func storedAPIKeyFromSecret(id string, obj *unstructured.Unstructured) storedAPIKey {
+	annotations := obj.GetAnnotations()
+	expiresAt, _ := time.Parse(time.RFC3339, annotations[apiKeyExpiryAnno])
+	return storedAPIKey{
+		APIKey: APIKey{
+			ID:          id,
+			Tenant:      obj.GetLabels()[apiKeyTenantLabel],
+			Description: annotations[apiKeyDescAnno],
+			Scope:       "read",
+			CreatedAt:   obj.GetCreationTimestamp().Time,
+			ExpiresAt:   expiresAt,
+		},
+		Hash: annotations[apiKeyHashAnnotation],
+	}
+}
+
+// hashAPIKeySecret hashes the secret part of an API key for storage
+func hashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// parseAPIKey splits "tmk_<id>_<secret>" into its id and secret
+func parseAPIKey(raw string) (string, string, bool) {
+	parts := strings.SplitN(raw, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// authenticateAPIKey validates a raw API key and returns the stored key on success
+func authenticateAPIKey(ctx context.Context, raw string) (storedAPIKey, error) {
+	id, secret, ok := parseAPIKey(raw)
+	if !ok {
+		return storedAPIKey{}, errAPIKeyNotFound
+	}
+	key, err := apiKeys.Get(ctx, id)
+	if err != nil {
+		return storedAPIKey{}, err
+	}
+	if subtle.ConstantTimeCompare([]byte(key.Hash), []byte(hashAPIKeySecret(secret))) != 1 {
+		return storedAPIKey{}, errAPIKeyNotFound
+	}
+	if time.Now().After(key.ExpiresAt) {
+		return storedAPIKey{}, fmt.Errorf("api key %s expired at %s", id, key.ExpiresAt.Format(time.RFC3339))
+	}
+	return key, nil
+}
+
+// apiKeyAuth authorizes a request carrying an API key. It only allows GET requests
+// to read routes of the tenant the key was issued for.
+func apiKeyAuth(c *gin.Context, raw string) {
+	if c.Request.Method != http.MethodGet || !apiKeyReadRoutes[c.FullPath()] {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "api keys are limited to read-only metrics endpoints"})
+		return
+	}
+
+	ctx, cancel := k8sContext(opRead)
+	defer cancel()
+
+	key, err := authenticateAPIKey(ctx, raw)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired api key"})
+		return
+	}
+	if key.Tenant != c.Param("name") {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "api key is not valid for this tenant"})
+		return
+	}
+	c.Next()
+}
+
+// CreateAPIKeyHandler issues a read-only API key scoped to one tenant
+func CreateAPIKeyHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Param("name")
+		var req struct {
+			Description string `json:"description"`
+			TTL         string `json:"ttl"`
+		}
+		if c.Request.ContentLength > 0 {
+			if err := c.BindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+				return
+			}
+		}
+
+		ttl := defaultAPIKeyTTL
+		if req.TTL != "" {
+			parsed, err := time.ParseDuration(req.TTL)
+			if err != nil || parsed <= 0 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid ttl"})
+				return
+			}
+			ttl = parsed
+		}
+		if ttl > maxAPIKeyTTL {
+			ttl = maxAPIKeyTTL
+		}
+
+		id, err := randomHex(6)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate key"})
+			return
+		}
+		secret, err := randomHex(24)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate key"})
+			return
+		}
+
+		now := time.Now().UTC()
+		key := storedAPIKey{
+			APIKey: APIKey{
+				ID:          id,
+				Tenant:      name,
+				Description: req.Description,
+				Scope:       "read",
+				CreatedAt:   now,
+				ExpiresAt:   now.Add(ttl),
+			},
+			Hash: hashAPIKeySecret(secret),
+		}
+
+		ctx, cancel := k8sContext(opWrite)
+		defer cancel()
+		if err := apiKeys.Save(ctx, key); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to store api key: %v", err)})
+			return
+		}
+
+		// The raw key is only returned once
+		c.JSON(http.StatusCreated, gin.H{
+			"apiKey": key.APIKey,
+			"key":    fmt.Sprintf("%s_%s_%s", apiKeyPrefix, id, secret),
+		})
+	}
+}
+
+// ListAPIKeysHandler lists the API keys issued for a tenant (without secrets)
+func ListAPIKeysHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := k8sContext(opRead)
+		defer cancel()
+
+		stored, err := apiKeys.List(ctx, c.Param("name"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		now := time.Now()
+		keys := make([]APIKey, 0, len(stored))
+		for _, k := range stored {
+			k.Expired = now.After(k.ExpiresAt)
+			keys = append(keys, k.APIKey)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+		c.JSON(http.StatusOK, keys)
+	}
+}
+
+// RevokeAPIKeyHandler revokes an API key immediately
+func RevokeAPIKeyHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := k8sContext(opWrite)
+		defer cancel()
+
+		name := c.Param("name")
+		id := c.Param("id")
+		if err := apiKeys.Delete(ctx, name, id); err != nil {
+			if errors.Is(err, errAPIKeyNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "api key not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"revoked": id})
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Audit entry labels written by the operator (see internal/audit)
+const (
+	auditTenantLabelKey = "tenant.platform.io/name"
+	auditActionLabelKey = "tenant.platform.io/audit-action"
+	auditTypeLabelKey   = "type"
+	auditTypeLabelValue = "audit"
+	auditEntryDataKey   = "entry.json"
+)
+
+// Actions audited by the BFF itself
+const (
+	auditActionTokenIssued     = "token-issued"
+	auditActionSecretsExported = "secrets-exported"
+)
+
+// operatorNamespace returns the namespace the operator stores audit entries in
+func operatorNamespace() string {
+	if ns := os.Getenv("OPERATOR_NAMESPACE"); ns != "" {
+		return ns
+	}
+	return "tenant-master-system"
+}
+
+// latestAuditEntry returns the newest audit entry for a tenant and action recorded
+// at or after since, or nil if none exists
+func latestAuditEntry(ctx context.Context, tenant, action string, since time.Time) (map[string]any, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMapList"})
+
+	if err := k8sClient.List(ctx, list,
+		client.InNamespace(operatorNamespace()),
+		client.MatchingLabels{
+			auditTenantLabelKey: tenant,
+			auditActionLabelKey: action,
+			auditTypeLabelKey:   auditTypeLabelValue,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	var newest *unstructured.Unstructured
+	for i := range list.Items {
+		item := &list.Items[i]
+		created := item.GetCreationTimestamp().Time
+		if created.Before(since.Truncate(time.Second)) {
+			continue
+		}
+		if newest == nil || created.After(newest.GetCreationTimestamp().Time) ||
+			(created.Equal(newest.GetCreationTimestamp().Time) && item.GetName() > newest.GetName()) {
+			newest = item
+		}
+	}
+	if newest == nil {
+		return nil, nil
+	}
+
+	raw, _, _ := unstructured.NestedString(newest.Object, "data", auditEntryDataKey)
+	entry := map[string]any{}
+	if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+		return nil, fmt.Errorf("failed to decode audit entry %s: %w", newest.GetName(), err)
+	}
+	return entry, nil
+}
+
+// recordAuditEntry appends an entry to the operator audit trail, in the same format
+// the operator uses, so BFF actions show up alongside reconciler actions
+func recordAuditEntry(ctx context.Context, tenant, action, actor, message string, details any) error {
+	now := time.Now().UTC()
+	entry := map[string]any{
+		"timestamp": now,
+		"tenant":    tenant,
+		"action":    action,
+		"message":   message,
+	}
+	if actor != "" {
+		entry["actor"] = actor
+	}
+	if details != nil {
+		entry["details"] = details
+	}
+	payload, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+
+	cm := &unstructured.Unstructured{}
+	cm.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"})
+	cm.SetName(fmt.Sprintf("audit-%s-%s-%d", tenant, strings.ToLower(action), now.UnixNano()))
+	cm.SetNamespace(operatorNamespace())
+	cm.SetLabels(map[string]string{
+		auditTenantLabelKey: tenant,
+		auditActionLabelKey: action,
+		auditTypeLabelKey:   auditTypeLabelValue,
+	})
+	cm.Object["data"] = map[string]any{auditEntryDataKey: string(payload)}
+
+	if err := k8sClient.Create(ctx, cm); err != nil {
+		return fmt.Errorf("failed to store audit entry: %w", err)
+	}
+	return nil
+}
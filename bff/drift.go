@@ -0,0 +1,563 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Drift actions: what the operator's next reconcile does about a difference
+const (
+	driftCreate = "create"
+	driftUpdate = "update"
+	driftRemove = "remove"
+)
+
+// Names and defaults of the child objects rendered by the operator
+const (
+	bronzeNamespace          = "bronze-tenants"
+	defaultNetworkPolicyName = "default-deny-all"
+	tierLabelKey             = "tenant.platform.io/tier"
+	ownerLabelKey            = "tenant.platform.io/owner"
+	defaultTenantPods        = 100
+)
+
+// limitsOvercommitRatio mirrors the operator's --quota-limits-overcommit-ratio
+var limitsOvercommitRatio = envFloat("BFF_LIMITS_OVERCOMMIT_RATIO", 1, 1)
+
+// Default object counts per tier, keyed by the spec.quotas.objects field overriding them
+var tierObjectQuotas = map[string]map[string]int64{
+	"Silver": {"services": 20, "loadBalancers": 1, "nodePorts": 2, "persistentVolumeClaims": 10, "configMaps": 50, "secrets": 50},
+	"Gold":   {"services": 50, "loadBalancers": 3, "nodePorts": 5, "persistentVolumeClaims": 50, "configMaps": 200, "secrets": 200},
+}
+
+// ResourceQuota names of the object counts in spec.quotas.objects
+var objectQuotaResources = map[string]string{
+	"services":               "services",
+	"loadBalancers":          "services.loadbalancers",
+	"nodePorts":              "services.nodeports",
+	"persistentVolumeClaims": "persistentvolumeclaims",
+	"configMaps":             "configmaps",
+	"secrets":                "secrets",
+}
+
+// DriftItem is one difference between a desired child object and the live one
+type DriftItem struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Field is empty when the whole object is missing
+	Field   string `json:"field,omitempty"`
+	Desired string `json:"desired,omitempty"`
+	Live    string `json:"live,omitempty"`
+	Action  string `json:"action"`
+}
+
+// DriftReport lists the child objects compared for a tenant and how they differ
+// from what the operator renders
+type DriftReport struct {
+	Tenant    string      `json:"tenant"`
+	Tier      string      `json:"tier"`
+	Namespace string      `json:"namespace"`
+	InSync    bool        `json:"inSync"`
+	Checked   []string    `json:"checked"`
+	Drift     []DriftItem `json:"drift"`
+	// Report is the drift as one line per item, e.g. for a terminal
+	Report string `json:"report"`
+}
+
+// GetTenantDriftHandler renders the tenant's desired namespace, quota, RBAC, and
+// NetworkPolicy and diffs them against the live objects, showing what the next
+// reconcile will change
+func GetTenantDriftHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "drift reports not supported in mock mode"})
+			return
+		}
+
+		name := c.Param("name")
+		ctx, cancel := k8sContext(opRead)
+		defer cancel()
+
+		tenant := &unstructured.Unstructured{}
+		tenant.SetGroupVersionKind(schema.GroupVersionKind{Group: "platform.io", Version: "v1alpha1", Kind: "Tenant"})
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, tenant); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+			return
+		}
+
+		report, err := buildDriftReport(ctx, tenant)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, report)
+	}
+}
+
+// driftBuilder compares desired and live objects of one tenant
+type driftBuilder struct {
+	ctx    context.Context
+	tenant *unstructured.Unstructured
+	report *DriftReport
+}
+
+func buildDriftReport(ctx context.Context, tenant *unstructured.Unstructured) (*DriftReport, error) {
+	tier, _, _ := unstructured.NestedString(tenant.Object, "spec", "tier")
+	if tier == "" {
+		tier = "Silver"
+	}
+	namespace := "tenant-" + tenant.GetName()
+	if tier == "Bronze" {
+		namespace = bronzeNamespace
+	}
+
+	b := &driftBuilder{
+		ctx:    ctx,
+		tenant: tenant,
+		report: &DriftReport{Tenant: tenant.GetName(), Tier: tier, Namespace: namespace, Checked: []string{}, Drift: []DriftItem{}},
+	}
+	for _, check := range []func() error{b.namespace, b.quota, b.rbac, b.accessBinding, b.networkPolicy} {
+		if err := check(); err != nil {
+			return nil, err
+		}
+	}
+
+	b.report.InSync = len(b.report.Drift) == 0
+	lines := make([]string, 0, len(b.report.Drift))
+	for _, item := range b.report.Drift {
+		lines = append(lines, formatDriftItem(item))
+	}
+	b.report.Report = strings.Join(lines, "\n")
+	return b.report, nil
+}
+
+// get fetches a live object into obj and reports whether it exists, recording a
+// create when it does not
+func (b *driftBuilder) get(gvk schema.GroupVersionKind, namespace, name string, obj any) (bool, error) {
+	ref := gvk.Kind + " " + name
+	if namespace != "" {
+		ref = gvk.Kind + " " + namespace + "/" + name
+	}
+	b.report.Checked = append(b.report.Checked, ref)
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	err := k8sClient.Get(b.ctx, types.NamespacedName{Namespace: namespace, Name: name}, u)
+	if apierrors.IsNotFound(err) {
+		b.add(DriftItem{Kind: gvk.Kind, Namespace: namespace, Name: name, Action: driftCreate})
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get %s: %w", ref, err)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), obj); err != nil {
+		return false, fmt.Errorf("failed to decode %s: %w", ref, err)
+	}
+	return true, nil
+}
+
+func (b *driftBuilder) add(item DriftItem) {
+	b.report.Drift = append(b.report.Drift, item)
+}
+
+// namespace compares the labels the operator owns on the tenant namespace. The shared
+// Bronze namespace only carries the tier and managed-by labels.
+func (b *driftBuilder) namespace() error {
+	ns := &corev1.Namespace{}
+	found, err := b.get(corev1.SchemeGroupVersion.WithKind("Namespace"), "", b.report.Namespace, ns)
+	if err != nil || !found {
+		return err
+	}
+
+	desired := map[string]string{tierLabelKey: b.report.Tier, managedByLabelKey: managedByLabelValue}
+	if b.report.Tier != "Bronze" {
+		owner, _, _ := unstructured.NestedString(b.tenant.Object, "spec", "owner")
+		desired[tenantNameLabelKey] = b.tenant.GetName()
+		desired[ownerLabelKey] = owner
+	}
+	for _, key := range sortedKeys(desired) {
+		if live, ok := ns.Labels[key]; !ok || live != desired[key] {
+			b.add(DriftItem{Kind: "Namespace", Name: ns.Name, Field: "metadata.labels." + key,
+				Desired: desired[key], Live: live, Action: driftUpdate})
+		}
+	}
+	return nil
+}
+
+// quota compares the hard limits of the tenant ResourceQuota with the spec, including
+// the environment share and an active boost
+func (b *driftBuilder) quota() error {
+	name := b.tenant.GetName() + "-quota"
+	rq := &corev1.ResourceQuota{}
+	found, err := b.get(corev1.SchemeGroupVersion.WithKind("ResourceQuota"), b.report.Namespace, name, rq)
+	if err != nil || !found {
+		return err
+	}
+
+	desired := b.desiredQuotaHard()
+	for _, key := range sortedKeys(desired) {
+		want := desired[key]
+		if live, ok := rq.Spec.Hard[corev1.ResourceName(key)]; !ok || live.Cmp(want) != 0 {
+			item := DriftItem{Kind: "ResourceQuota", Namespace: rq.Namespace, Name: name, Field: "spec.hard." + key,
+				Desired: want.String(), Action: driftUpdate}
+			if ok {
+				item.Live = live.String()
+			}
+			b.add(item)
+		}
+	}
+	for _, key := range sortedKeys(rq.Spec.Hard) {
+		if _, ok := desired[key]; !ok {
+			live := rq.Spec.Hard[corev1.ResourceName(key)]
+			b.add(DriftItem{Kind: "ResourceQuota", Namespace: rq.Namespace, Name: name, Field: "spec.hard." + key,
+				Live: live.String(), Action: driftRemove})
+		}
+	}
+	return nil
+}
+
+// desiredQuotaHard mirrors the operator's quota rendering
+func (b *driftBuilder) desiredQuotaHard() map[string]resource.Quantity {
+	cpuValue, memValue := tenantRequests(b.tenant)
+	cpu, err := parseQuantityOr(cpuValue, defaultTenantCPU)
+	if err != nil {
+		cpu = resource.MustParse(defaultTenantCPU)
+	}
+	mem, err := parseQuantityOr(memValue, defaultTenantMemory)
+	if err != nil {
+		mem = resource.MustParse(defaultTenantMemory)
+	}
+
+	// Limits default to the requests times the overcommit ratio
+	limitsCPU, limitsMem := cpu.DeepCopy(), mem.DeepCopy()
+	if limitsOvercommitRatio != 1 {
+		limitsCPU = *resource.NewMilliQuantity(int64(float64(cpu.MilliValue())*limitsOvercommitRatio), resource.DecimalSI)
+		limitsMem = *resource.NewQuantity(int64(float64(mem.Value())*limitsOvercommitRatio), resource.BinarySI)
+	}
+	if v, _, _ := unstructured.NestedString(b.tenant.Object, "spec", "resources", "limits", "cpu"); v != "" {
+		if qty, err := resource.ParseQuantity(v); err == nil {
+			limitsCPU = qty
+		}
+	}
+	if v, _, _ := unstructured.NestedString(b.tenant.Object, "spec", "resources", "limits", "memory"); v != "" {
+		if qty, err := resource.ParseQuantity(v); err == nil {
+			limitsMem = qty
+		}
+	}
+
+	// Active boosts are added before the environment share is taken
+	if active, _, _ := unstructured.NestedBool(b.tenant.Object, "status", "burst", "active"); active {
+		burstCPU, _, _ := unstructured.NestedString(b.tenant.Object, "status", "burst", "cpu")
+		burstMem, _, _ := unstructured.NestedString(b.tenant.Object, "status", "burst", "memory")
+		addQuantity(&cpu, burstCPU)
+		addQuantity(&mem, burstMem)
+		addQuantity(&limitsCPU, burstCPU)
+		addQuantity(&limitsMem, burstMem)
+	}
+
+	hard := map[string]resource.Quantity{
+		"requests.cpu":    cpu,
+		"limits.cpu":      limitsCPU,
+		"requests.memory": mem,
+		"limits.memory":   limitsMem,
+		"pods":            *resource.NewQuantity(defaultTenantPods, resource.DecimalSI),
+	}
+	if b.report.Tier == "Bronze" {
+		return hard
+	}
+
+	storageKeys := []string{"requests.storage"}
+	if storage, _, _ := unstructured.NestedString(b.tenant.Object, "spec", "resources", "storage"); storage != "" {
+		if qty, err := resource.ParseQuantity(storage); err == nil {
+			hard["requests.storage"] = qty
+			if class, _, _ := unstructured.NestedString(b.tenant.Object, "spec", "resources", "storageClass"); class != "" {
+				key := class + ".storageclass.storage.k8s.io/requests.storage"
+				hard[key] = qty
+				storageKeys = append(storageKeys, key)
+			}
+		}
+	}
+	external, _, _ := unstructured.NestedBool(b.tenant.Object, "spec", "network", "allowExternalServices")
+	for field, name := range objectQuotaResources {
+		count := tierObjectQuotas[b.report.Tier][field]
+		if override, found, _ := unstructured.NestedInt64(b.tenant.Object, "spec", "quotas", "objects", field); found {
+			count = override
+		}
+		if !external && (field == "loadBalancers" || field == "nodePorts") {
+			count = 0
+		}
+		hard[name] = *resource.NewQuantity(count, resource.DecimalSI)
+	}
+
+	// The vCluster exposure Service has its own slot; a LoadBalancer also takes a node port
+	exposure, _, _ := unstructured.NestedString(b.tenant.Object, "spec", "exposure", "type")
+	reserved := map[string][]string{
+		"LoadBalancer": {"services", "services.loadbalancers", "services.nodeports"},
+		"NodePort":     {"services", "services.nodeports"},
+	}[exposure]
+	for _, name := range reserved {
+		qty := hard[name]
+		qty.Add(*resource.NewQuantity(1, resource.DecimalSI))
+		hard[name] = qty
+	}
+
+	share := b.baseQuotaShare()
+	if share >= 100 {
+		return hard
+	}
+	for _, key := range []string{"requests.cpu", "limits.cpu"} {
+		qty := hard[key]
+		hard[key] = *resource.NewMilliQuantity(qty.MilliValue()*share/100, resource.DecimalSI)
+	}
+	for _, key := range append([]string{"requests.memory", "limits.memory"}, storageKeys...) {
+		if qty, ok := hard[key]; ok {
+			hard[key] = *resource.NewQuantity(qty.Value()*share/100, resource.BinarySI)
+		}
+	}
+	pods := hard["pods"]
+	hard["pods"] = *resource.NewQuantity(pods.Value()*share/100, resource.DecimalSI)
+	return hard
+}
+
+// baseQuotaShare returns the percentage of the quota left to the base namespace after
+// spec.environments take their quotaPercent; environments without one split the rest
+func (b *driftBuilder) baseQuotaShare() int64 {
+	envs, _, _ := unstructured.NestedSlice(b.tenant.Object, "spec", "environments")
+	remaining, unset := int64(100), int64(0)
+	for _, e := range envs {
+		env, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+		if percent, found, _ := unstructured.NestedInt64(env, "quotaPercent"); found {
+			remaining -= percent
+		} else {
+			unset++
+		}
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	if unset > 0 {
+		remaining -= remaining / unset * unset
+	}
+	return remaining
+}
+
+// rbac compares the tenant ServiceAccount, Roles, and RoleBinding. The admin, edit, and
+// view Roles are checked for rules that would let the tenant change the resources
+// enforcing its tier; Bronze Roles are only checked for existence.
+func (b *driftBuilder) rbac() error {
+	name := b.tenant.GetName()
+	namespace := b.report.Namespace
+	saName := name + "-sa"
+	roleName, bindingName := name+"-admin", name+"-admin-binding"
+	roleNames := []string{roleName, name + "-edit", name + "-view"}
+	if b.report.Tier == "Bronze" {
+		roleName, bindingName = name+"-bronze", name+"-bronze-binding"
+		roleNames = []string{roleName}
+	}
+
+	if _, err := b.get(corev1.SchemeGroupVersion.WithKind("ServiceAccount"), namespace, saName, &corev1.ServiceAccount{}); err != nil {
+		return err
+	}
+
+	for _, n := range roleNames {
+		role := &rbacv1.Role{}
+		found, err := b.get(rbacv1.SchemeGroupVersion.WithKind("Role"), namespace, n, role)
+		if err != nil {
+			return err
+		}
+		if found && b.report.Tier != "Bronze" && grantsProtectedWrites(role.Rules) {
+			b.add(DriftItem{Kind: "Role", Namespace: namespace, Name: n, Field: "rules",
+				Desired: "no wildcards or writes to resourcequotas, limitranges, networkpolicies",
+				Live:    formatPolicyRules(role.Rules), Action: driftUpdate})
+		}
+	}
+
+	binding := &rbacv1.RoleBinding{}
+	found, err := b.get(rbacv1.SchemeGroupVersion.WithKind("RoleBinding"), namespace, bindingName, binding)
+	if err != nil || !found {
+		return err
+	}
+	if binding.RoleRef.Kind != "Role" || binding.RoleRef.Name != roleName {
+		b.add(DriftItem{Kind: "RoleBinding", Namespace: namespace, Name: bindingName, Field: "roleRef",
+			Desired: "Role/" + roleName, Live: binding.RoleRef.Kind + "/" + binding.RoleRef.Name, Action: driftUpdate})
+	}
+	subject := rbacv1.ServiceAccountKind + "/" + namespace + "/" + saName
+	var live []string
+	for _, s := range binding.Subjects {
+		live = append(live, s.Kind+"/"+s.Namespace+"/"+s.Name)
+	}
+	if len(live) != 1 || live[0] != subject {
+		b.add(DriftItem{Kind: "RoleBinding", Namespace: namespace, Name: bindingName, Field: "subjects",
+			Desired: subject, Live: strings.Join(live, ", "), Action: driftUpdate})
+	}
+	return nil
+}
+
+// accessBinding compares the RoleBinding of the spec.accessControl users and groups:
+// its Role and the number of subjects.
+func (b *driftBuilder) accessBinding() error {
+	if b.report.Tier == "Bronze" {
+		return nil
+	}
+	users, _, _ := unstructured.NestedStringSlice(b.tenant.Object, "spec", "accessControl", "users")
+	groups, _, _ := unstructured.NestedStringSlice(b.tenant.Object, "spec", "accessControl", "groups")
+	if len(users)+len(groups) == 0 {
+		return nil
+	}
+
+	name := b.tenant.GetName()
+	bindingName := name + "-access-binding"
+	binding := &rbacv1.RoleBinding{}
+	found, err := b.get(rbacv1.SchemeGroupVersion.WithKind("RoleBinding"), b.report.Namespace, bindingName, binding)
+	if err != nil || !found {
+		return err
+	}
+
+	role, _, _ := unstructured.NestedString(b.tenant.Object, "spec", "accessControl", "role")
+	roleName := name + "-" + strings.ToLower(role)
+	if role == "" {
+		roleName = name + "-admin"
+	}
+	if binding.RoleRef.Name != roleName {
+		b.add(DriftItem{Kind: "RoleBinding", Namespace: b.report.Namespace, Name: bindingName, Field: "roleRef",
+			Desired: "Role/" + roleName, Live: binding.RoleRef.Kind + "/" + binding.RoleRef.Name, Action: driftUpdate})
+	}
+	if want := len(users) + len(groups); len(binding.Subjects) != want {
+		b.add(DriftItem{Kind: "RoleBinding", Namespace: b.report.Namespace, Name: bindingName, Field: "subjects",
+			Desired: fmt.Sprintf("%d subjects", want), Live: fmt.Sprintf("%d subjects", len(binding.Subjects)), Action: driftUpdate})
+	}
+	return nil
+}
+
+// networkPolicy compares the default-deny NetworkPolicy rule counts, as the operator's
+// drift correction does, and the namespaces of whitelisted services. Bronze tenants
+// have no NetworkPolicy of their own.
+func (b *driftBuilder) networkPolicy() error {
+	if b.report.Tier == "Bronze" {
+		return nil
+	}
+	policy := &netv1.NetworkPolicy{}
+	found, err := b.get(netv1.SchemeGroupVersion.WithKind("NetworkPolicy"), b.report.Namespace, defaultNetworkPolicyName, policy)
+	if err != nil || !found {
+		return err
+	}
+
+	// DNS, one rule per whitelisted service, custom nameservers, and the internet
+	whitelisted, _, _ := unstructured.NestedStringSlice(b.tenant.Object, "spec", "network", "whitelistedServices")
+	wantEgress := 1 + len(whitelisted)
+	if nameservers, _, _ := unstructured.NestedStringSlice(b.tenant.Object, "spec", "network", "dnsConfig", "nameservers"); len(nameservers) > 0 {
+		wantEgress++
+	}
+	if internet, _, _ := unstructured.NestedBool(b.tenant.Object, "spec", "network", "allowInternetAccess"); internet {
+		wantEgress++
+	}
+
+	if len(policy.Spec.Ingress) != 1 {
+		b.add(DriftItem{Kind: "NetworkPolicy", Namespace: policy.Namespace, Name: policy.Name, Field: "spec.ingress",
+			Desired: "1 rule", Live: fmt.Sprintf("%d rules", len(policy.Spec.Ingress)), Action: driftUpdate})
+	}
+	if len(policy.Spec.Egress) != wantEgress {
+		b.add(DriftItem{Kind: "NetworkPolicy", Namespace: policy.Namespace, Name: policy.Name, Field: "spec.egress",
+			Desired: fmt.Sprintf("%d rules", wantEgress), Live: fmt.Sprintf("%d rules", len(policy.Spec.Egress)), Action: driftUpdate})
+	}
+
+	allowed := map[string]bool{}
+	for _, rule := range policy.Spec.Egress {
+		for _, peer := range rule.To {
+			if peer.NamespaceSelector != nil {
+				allowed[peer.NamespaceSelector.MatchLabels["name"]] = true
+			}
+		}
+	}
+	for _, ref := range whitelisted {
+		namespace := "default"
+		if i := strings.Index(ref, "/"); i >= 0 {
+			namespace = ref[:i]
+		}
+		if !allowed[namespace] {
+			b.add(DriftItem{Kind: "NetworkPolicy", Namespace: policy.Namespace, Name: policy.Name, Field: "spec.egress",
+				Desired: "egress to namespace " + namespace + " (" + ref + ")", Action: driftUpdate})
+		}
+	}
+	return nil
+}
+
+// formatDriftItem renders an item as "+ Kind ns/name", "~ Kind ns/name field: live -> desired",
+// or "- Kind ns/name field: live"
+func formatDriftItem(item DriftItem) string {
+	ref := item.Kind + " " + item.Name
+	if item.Namespace != "" {
+		ref = item.Kind + " " + item.Namespace + "/" + item.Name
+	}
+	switch {
+	case item.Action == driftCreate:
+		return "+ " + ref + " is missing and will be created"
+	case item.Action == driftRemove:
+		return fmt.Sprintf("- %s %s: %s will be removed", ref, item.Field, item.Live)
+	case item.Live == "":
+		return fmt.Sprintf("~ %s %s: (unset) -> %s", ref, item.Field, item.Desired)
+	default:
+		return fmt.Sprintf("~ %s %s: %s -> %s", ref, item.Field, item.Live, item.Desired)
+	}
+}
+
+func formatPolicyRules(rules []rbacv1.PolicyRule) string {
+	parts := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		parts = append(parts, fmt.Sprintf("%s/%s: %s",
+			strings.Join(rule.APIGroups, ","), strings.Join(rule.Resources, ","), strings.Join(rule.Verbs, ",")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// protectedResources enforce the tenant's tier and may only be changed by the operator.
+var protectedResources = map[string]bool{"resourcequotas": true, "limitranges": true, "networkpolicies": true}
+
+// grantsProtectedWrites reports whether rules contain a wildcard or allow anything but
+// reads of the protected resources.
+func grantsProtectedWrites(rules []rbacv1.PolicyRule) bool {
+	for _, rule := range rules {
+		if slices.Contains(rule.APIGroups, "*") || slices.Contains(rule.Resources, "*") || slices.Contains(rule.Verbs, "*") {
+			return true
+		}
+		for _, resource := range rule.Resources {
+			if !protectedResources[resource] {
+				continue
+			}
+			for _, verb := range rule.Verbs {
+				if verb != "get" && verb != "list" && verb != "watch" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func sortedKeys[K ~string, V any](m map[K]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, string(k))
+	}
+	sort.Strings(keys)
+	return keys
+}
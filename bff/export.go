@@ -0,0 +1,211 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Parts of a tenant export bundle selectable with ?include=
+const (
+	exportManifests = "manifests" // child objects listed in status.managedResources
+	exportSecrets   = "secrets"   // child Secrets, which are left out otherwise
+	exportSnapshot  = "snapshot"  // the latest TenantSnapshot
+)
+
+// ExportTenantHandler streams a tar.gz with the Tenant CR and, depending on ?include=,
+// its generated child manifests, their Secrets, and its latest TenantSnapshot, for
+// migrations to other clusters and offline audits
+func ExportTenantHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "export not supported in mock mode"})
+			return
+		}
+
+		name := c.Param("name")
+		include := map[string]bool{}
+		for _, value := range c.QueryArray("include") {
+			for _, part := range strings.Split(value, ",") {
+				part = strings.TrimSpace(part)
+				if part == "" {
+					continue
+				}
+				if part != exportManifests && part != exportSecrets && part != exportSnapshot {
+					c.JSON(http.StatusBadRequest, gin.H{"error": "include must list manifests, secrets, or snapshot"})
+					return
+				}
+				include[part] = true
+			}
+		}
+		if len(include) == 0 {
+			include[exportManifests] = true
+		}
+
+		ctx, cancel := k8sContext(opRead)
+		defer cancel()
+
+		tenant := &unstructured.Unstructured{}
+		tenant.SetGroupVersionKind(schema.GroupVersionKind{Group: "platform.io", Version: "v1alpha1", Kind: "Tenant"})
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, tenant); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+			return
+		}
+
+		bundle, files, err := buildExportBundle(ctx, tenant, include)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		// Never hand out Secrets that are missing from the audit trail
+		if include[exportSecrets] {
+			details := gin.H{"include": sortedKeys(include), "files": files}
+			message := fmt.Sprintf("exported tenant bundle with %d files including Secrets", len(files))
+			if err := recordAuditEntry(ctx, name, auditActionSecretsExported, c.Query("requestedBy"), message, details); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to audit export: %v", err)})
+				return
+			}
+		}
+
+		filename := fmt.Sprintf("%s-export-%s.tar.gz", name, time.Now().UTC().Format("20060102T150405Z"))
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		c.Data(http.StatusOK, "application/gzip", bundle)
+	}
+}
+
+// buildExportBundle writes the export files into a tar.gz and returns it with the
+// file names. Objects are written as YAML without server-populated metadata, so they
+// can be applied to another cluster.
+func buildExportBundle(ctx context.Context, tenant *unstructured.Unstructured, include map[string]bool) ([]byte, []string, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	var files []string
+
+	add := func(name string, obj *unstructured.Unstructured, mode int64) error {
+		content, err := exportYAML(obj)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", name, err)
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: mode, Size: int64(len(content)), ModTime: now}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(content); err != nil {
+			return err
+		}
+		files = append(files, name)
+		return nil
+	}
+
+	if err := add("tenant.yaml", tenant, 0o644); err != nil {
+		return nil, nil, err
+	}
+
+	if include[exportManifests] || include[exportSecrets] {
+		for _, ref := range tenantManagedResources(tenant) {
+			// Helm releases and other non-Kubernetes artifacts have no manifest
+			if ref.APIVersion == "" {
+				continue
+			}
+			isSecret := ref.APIVersion == "v1" && ref.Kind == "Secret"
+			if (isSecret && !include[exportSecrets]) || (!isSecret && !include[exportManifests]) {
+				continue
+			}
+
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, obj); err != nil {
+				// The inventory lags behind deletions
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, nil, fmt.Errorf("failed to get %s %s/%s: %w", ref.Kind, ref.Namespace, ref.Name, err)
+			}
+
+			dir, mode := "manifests", int64(0o644)
+			if isSecret {
+				dir, mode = "secrets", 0o600
+			}
+			namespace := ref.Namespace
+			if namespace == "" {
+				namespace = "_cluster"
+			}
+			name := path.Join(dir, namespace, strings.ToLower(ref.Kind)+"-"+ref.Name+".yaml")
+			if err := add(name, obj, mode); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if include[exportSnapshot] {
+		snapshot, err := latestSnapshot(ctx, tenant.GetName())
+		if err != nil {
+			return nil, nil, err
+		}
+		if snapshot != nil {
+			if err := add(path.Join("snapshot", snapshot.GetName()+".yaml"), snapshot, 0o644); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), files, nil
+}
+
+// exportYAML encodes obj without the metadata the API server populates
+func exportYAML(obj *unstructured.Unstructured) ([]byte, error) {
+	out := obj.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp", "selfLink", "ownerReferences"} {
+		unstructured.RemoveNestedField(out.Object, "metadata", field)
+	}
+	return yaml.Marshal(out.Object)
+}
+
+// latestSnapshot returns the newest TenantSnapshot of a tenant, or nil if there is none
+func latestSnapshot(ctx context.Context, tenant string) (*unstructured.Unstructured, error) {
+	items, err := tenantSnapshots(ctx, tenant)
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+// tenantManagedResources returns the child objects listed in status.managedResources
+func tenantManagedResources(tenant *unstructured.Unstructured) []ManagedResource {
+	refs, _, _ := unstructured.NestedSlice(tenant.Object, "status", "managedResources")
+	var out []ManagedResource
+	for _, ref := range refs {
+		m, ok := ref.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mr := ManagedResource{}
+		mr.APIVersion, _ = m["apiVersion"].(string)
+		mr.Kind, _ = m["kind"].(string)
+		mr.Namespace, _ = m["namespace"].(string)
+		mr.Name, _ = m["name"].(string)
+		out = append(out, mr)
+	}
+	return out
+}
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/fs"
 	"net/http"
@@ -12,9 +16,11 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // TenantSummary is a simplified representation returned by the BFF
@@ -31,11 +37,22 @@ type TenantSummary struct {
 	KubeconfigSecret string    `json:"kubeconfigSecret,omitempty"`
 }
 
+// ManagedResource references a child object created by the operator for a tenant
+type ManagedResource struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
 // TenantDetail extends TenantSummary with more details
 type TenantDetail struct {
 	TenantSummary
-	NetworkPolicy map[string]interface{} `json:"networkPolicy,omitempty"`
-	Events        []string               `json:"events,omitempty"`
+	NetworkPolicy    map[string]interface{} `json:"networkPolicy,omitempty"`
+	Events           []string               `json:"events,omitempty"`
+	ManagedResources []ManagedResource      `json:"managedResources,omitempty"`
+	ResourceUsage    map[string]interface{} `json:"resourceUsage,omitempty"`
+	Billing          map[string]interface{} `json:"billing,omitempty"`
 }
 
 // GetTenantsHandler returns a handler function for listing tenants
@@ -50,6 +67,10 @@ func GetTenantsHandler(mode string) gin.HandlerFunc {
 }
 
 func getTenantsMock(c *gin.Context) {
+	scenario, ok := mockScenario(c)
+	if !ok {
+		return
+	}
 	examplesDir := filepath.Join("..", "examples", "tenants")
 	var tenants []TenantSummary
 	_ = filepath.WalkDir(examplesDir, func(path string, d fs.DirEntry, err error) error {
@@ -66,6 +87,10 @@ func getTenantsMock(c *gin.Context) {
 		if err != nil {
 			return nil
 		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
 		docs := strings.Split(string(b), "---")
 		for _, doc := range docs {
 			doc = strings.TrimSpace(doc)
@@ -116,7 +141,7 @@ func getTenantsMock(c *gin.Context) {
 				}
 			}
 			if name != "" {
-				tenants = append(tenants, TenantSummary{
+				tenant := TenantSummary{
 					Name:      name,
 					Tier:      tier,
 					Owner:     owner,
@@ -124,7 +149,9 @@ func getTenantsMock(c *gin.Context) {
 					Namespace: namespace,
 					CPU:       cpu,
 					Memory:    memory,
-				})
+				}
+				applyMockScenario(scenario, &tenant, info.ModTime())
+				tenants = append(tenants, tenant)
 			}
 		}
 		return nil
@@ -133,7 +160,7 @@ func getTenantsMock(c *gin.Context) {
 }
 
 func getTenantsK8s(c *gin.Context) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := k8sContext(opRead)
 	defer cancel()
 
 	list := &unstructured.UnstructuredList{}
@@ -205,6 +232,10 @@ func GetTenantDetailHandler(mode string) gin.HandlerFunc {
 }
 
 func getTenantDetailMock(c *gin.Context, name string) {
+	scenario, ok := mockScenario(c)
+	if !ok {
+		return
+	}
 	examplesDir := filepath.Join("..", "examples", "tenants")
 	path := filepath.Join(examplesDir, name+".yaml")
 	b, err := os.ReadFile(path)
@@ -212,6 +243,11 @@ func getTenantDetailMock(c *gin.Context, name string) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
 		return
 	}
+	info, err := os.Stat(path)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+		return
+	}
 	var m map[string]any
 	if err := yaml.Unmarshal(b, &m); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "invalid yaml"})
@@ -237,11 +273,12 @@ func getTenantDetailMock(c *gin.Context, name string) {
 			detail.State = state
 		}
 	}
+	detail.Events = applyMockScenario(scenario, &detail.TenantSummary, info.ModTime())
 	c.JSON(http.StatusOK, detail)
 }
 
 func getTenantDetailK8s(c *gin.Context, name string) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := k8sContext(opRead)
 	defer cancel()
 
 	obj := &unstructured.Unstructured{}
@@ -276,6 +313,13 @@ func getTenantDetailK8s(c *gin.Context, name string) {
 	if state, ok := status["state"].(string); ok {
 		detail.State = state
 	}
+	detail.ManagedResources = tenantManagedResources(obj)
+	if usage, ok := status["resourceUsage"].(map[string]interface{}); ok {
+		detail.ResourceUsage = usage
+	}
+	if billing, ok := status["billing"].(map[string]interface{}); ok {
+		detail.Billing = billing
+	}
 
 	c.JSON(http.StatusOK, detail)
 }
@@ -326,7 +370,7 @@ func createTenantMock(c *gin.Context, name string, spec map[string]any) {
 }
 
 func createTenantK8s(c *gin.Context, name string, spec map[string]any) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := k8sContext(opWrite)
 	defer cancel()
 
 	obj := &unstructured.Unstructured{}
@@ -367,7 +411,7 @@ func UpdateTenantHandler(mode string) gin.HandlerFunc {
 }
 
 func updateTenantK8s(c *gin.Context, name string, updates map[string]any) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := k8sContext(opWrite)
 	defer cancel()
 
 	obj := &unstructured.Unstructured{}
@@ -396,6 +440,157 @@ func updateTenantK8s(c *gin.Context, name string, updates map[string]any) {
 	c.JSON(http.StatusOK, gin.H{"updated": name})
 }
 
+// TenantResource is the stable representation returned by PUT, for infrastructure-as-code
+// tools. ID is derived from the name only, so it survives re-creation of the tenant.
+type TenantResource struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	Spec            map[string]any `json:"spec"`
+	State           string         `json:"state"`
+	Namespace       string         `json:"namespace"`
+	Generation      int64          `json:"generation"`
+	ResourceVersion string         `json:"resourceVersion"`
+	CreatedAt       time.Time      `json:"createdAt"`
+}
+
+// PutTenantHandler creates or fully replaces a tenant. The body is the complete
+// spec; fields omitted from it are removed from the tenant. Repeating the same
+// request is a no-op and returns the same response.
+func PutTenantHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Param("name")
+		var spec map[string]any
+		if err := c.BindJSON(&spec); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+			return
+		}
+		// The name comes from the path; a matching name in the body is tolerated
+		if bodyName, ok := spec["name"]; ok {
+			if bodyName != name {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "name in body does not match the URL"})
+				return
+			}
+			delete(spec, "name")
+		}
+
+		if mode == "k8s" {
+			putTenantK8s(c, name, spec)
+		} else {
+			putTenantMock(c, name, spec)
+		}
+	}
+}
+
+func putTenantMock(c *gin.Context, name string, spec map[string]any) {
+	path := filepath.Join("..", "examples", "tenants", name+".yaml")
+	status := http.StatusOK
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		status = http.StatusCreated
+	}
+
+	out, err := yaml.Marshal(map[string]any{
+		"apiVersion": "platform.io/v1alpha1",
+		"kind":       "Tenant",
+		"metadata":   map[string]any{"name": name},
+		"spec":       spec,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to marshal"})
+		return
+	}
+	_ = os.MkdirAll(filepath.Dir(path), 0755)
+	if err := os.WriteFile(path, out, 0644); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to write file"})
+		return
+	}
+	c.JSON(status, TenantResource{ID: tenantResourceID(name), Name: name, Spec: spec})
+}
+
+func putTenantK8s(c *gin.Context, name string, spec map[string]any) {
+	ctx, cancel := k8sContext(opWrite)
+	defer cancel()
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "platform.io",
+		Version: "v1alpha1",
+		Kind:    "Tenant",
+	})
+
+	status := http.StatusOK
+	err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, obj)
+	switch {
+	case apierrors.IsNotFound(err):
+		obj.SetName(name)
+		obj.Object["spec"] = spec
+		if err := k8sClient.Create(ctx, obj); err != nil {
+			c.JSON(tenantWriteErrorStatus(err), gin.H{"error": fmt.Sprintf("failed to create tenant: %v", err)})
+			return
+		}
+		status = http.StatusCreated
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to get tenant: %v", err)})
+		return
+	case obj.GetDeletionTimestamp() != nil:
+		c.JSON(http.StatusConflict, gin.H{"error": "tenant is being deleted"})
+		return
+	default:
+		// Skip the write when nothing changed, so repeated applies do not bump the
+		// generation and trigger a reconcile
+		current, _, _ := unstructured.NestedMap(obj.Object, "spec")
+		if !sameJSON(current, spec) {
+			obj.Object["spec"] = spec
+			if err := k8sClient.Update(ctx, obj); err != nil {
+				c.JSON(tenantWriteErrorStatus(err), gin.H{"error": fmt.Sprintf("failed to replace tenant: %v", err)})
+				return
+			}
+		}
+	}
+
+	c.JSON(status, tenantResourceFromObject(obj))
+}
+
+// sameJSON reports whether a and b encode to the same JSON; unlike reflect.DeepEqual
+// it treats the int64 numbers of API objects and the float64 numbers of request
+// bodies alike
+func sameJSON(a, b any) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ja, jb)
+}
+
+// tenantResourceID returns the deterministic ID of a tenant
+func tenantResourceID(name string) string {
+	return "platform.io/v1alpha1/tenants/" + name
+}
+
+func tenantResourceFromObject(obj *unstructured.Unstructured) TenantResource {
+	res := TenantResource{
+		ID:              tenantResourceID(obj.GetName()),
+		Name:            obj.GetName(),
+		Generation:      obj.GetGeneration(),
+		ResourceVersion: obj.GetResourceVersion(),
+		CreatedAt:       obj.GetCreationTimestamp().Time,
+	}
+	res.Spec, _, _ = unstructured.NestedMap(obj.Object, "spec")
+	res.State, _, _ = unstructured.NestedString(obj.Object, "status", "state")
+	res.Namespace, _, _ = unstructured.NestedString(obj.Object, "status", "namespace")
+	return res
+}
+
+// tenantWriteErrorStatus maps a create/update error to an HTTP status, so clients
+// can tell rejected specs and concurrent edits from server failures
+func tenantWriteErrorStatus(err error) int {
+	switch {
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err), apierrors.IsForbidden(err):
+		return http.StatusUnprocessableEntity
+	case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // DeleteTenantHandler deletes a tenant
 func DeleteTenantHandler(mode string) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -409,7 +604,7 @@ func DeleteTenantHandler(mode string) gin.HandlerFunc {
 }
 
 func deleteTenantK8s(c *gin.Context, name string) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := k8sContext(opWrite)
 	defer cancel()
 
 	obj := &unstructured.Unstructured{}
@@ -420,18 +615,86 @@ func deleteTenantK8s(c *gin.Context, name string) {
 	})
 	obj.SetName(name)
 
+	requestedAt := time.Now()
 	if err := k8sClient.Delete(ctx, obj); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to delete tenant: %v", err)})
+		status := http.StatusInternalServerError
+		// Rejected by the webhook, e.g. for a tenant with spec.deletionProtection
+		if apierrors.IsForbidden(err) {
+			status = http.StatusForbidden
+		}
+		c.JSON(status, gin.H{"error": fmt.Sprintf("failed to delete tenant: %v", err)})
+		return
+	}
+
+	if c.Query("wait") != "true" {
+		c.JSON(http.StatusOK, gin.H{"deleted": name})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"deleted": name})
+	timeout := defaultDeleteWaitTimeout
+	if t := c.Query("timeout"); t != "" {
+		d, err := time.ParseDuration(t)
+		if err != nil || d <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "timeout must be a positive duration (e.g. 90s)"})
+			return
+		}
+		timeout = d
+	}
+
+	summary, err := waitForTenantDeletion(name, requestedAt, timeout)
+	if err != nil {
+		c.JSON(http.StatusGatewayTimeout, gin.H{"deleted": name, "error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deleted": name, "summary": summary})
+}
+
+// defaultDeleteWaitTimeout bounds how long DELETE ?wait=true blocks
+const defaultDeleteWaitTimeout = 2 * time.Minute
+
+// waitForTenantDeletion polls until the tenant is gone and returns its deletion audit entry
+func waitForTenantDeletion(name string, requestedAt time.Time, timeout time.Duration) (map[string]any, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   "platform.io",
+			Version: "v1alpha1",
+			Kind:    "Tenant",
+		})
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, obj)
+		if apierrors.IsNotFound(err) {
+			entry, err := latestAuditEntry(ctx, name, "deletion", requestedAt)
+			if err != nil {
+				return nil, fmt.Errorf("tenant deleted but summary unavailable: %v", err)
+			}
+			if entry != nil {
+				return entry, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out after %s waiting for tenant deletion to complete", timeout)
+		case <-ticker.C:
+		}
+	}
 }
 
 // GetTenantMetricsHandler retrieves metrics for a tenant
 func GetTenantMetricsHandler(mode string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		name := c.Param("name")
+		if mode == "k8s" {
+			getTenantMetricsK8s(c, name)
+			return
+		}
 		// Mocked metrics response
 		c.JSON(http.StatusOK, gin.H{
 			"tenant": name,
@@ -445,20 +708,84 @@ func GetTenantMetricsHandler(mode string) gin.HandlerFunc {
 	}
 }
 
-// GetTenantKubeconfigHandler retrieves kubeconfig for a tenant
+// getTenantMetricsK8s reports the utilization the operator samples from metrics-server
+// and the quota consumption, both from status.resourceUsage
+func getTenantMetricsK8s(c *gin.Context, name string) {
+	ctx, cancel := k8sContext(opRead)
+	defer cancel()
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "platform.io",
+		Version: "v1alpha1",
+		Kind:    "Tenant",
+	})
+
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, obj); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+		return
+	}
+
+	usage, found, _ := unstructured.NestedMap(obj.Object, "status", "resourceUsage")
+	if !found {
+		c.JSON(http.StatusNotFound, gin.H{"error": "resource usage not reported yet"})
+		return
+	}
+	field := func(fields ...string) string {
+		v, _, _ := unstructured.NestedString(usage, fields...)
+		return v
+	}
+	pods, _, _ := unstructured.NestedInt64(usage, "utilization", "pods")
+	suspended, _, _ := unstructured.NestedBool(usage, "suspended")
+
+	c.JSON(http.StatusOK, gin.H{
+		"tenant": name,
+		"metrics": gin.H{
+			"cpu_usage":         field("utilization", "cpu"),
+			"memory_usage":      field("utilization", "memory"),
+			"pods":              pods,
+			"sampled_at":        field("utilization", "sampleTime"),
+			"cpu_quota_used":    field("cpu", "used"),
+			"cpu_quota_hard":    field("cpu", "hard"),
+			"memory_quota_used": field("memory", "used"),
+			"memory_quota_hard": field("memory", "hard"),
+			"active":            !suspended,
+		},
+	})
+}
+
+// Kubeconfig formats: the tenant kubeconfig (vCluster admin certificates for Gold, a
+// ServiceAccount token for Silver), or the kubeconfig that logs in through OIDC
+// (spec.vcluster.oidc)
+const (
+	kubeconfigFormatCert = "cert"
+	kubeconfigFormatOIDC = "oidc"
+)
+
+// GetTenantKubeconfigHandler retrieves kubeconfig for a tenant; ?format=oidc selects
+// the OIDC exec plugin kubeconfig
 func GetTenantKubeconfigHandler(mode string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		name := c.Param("name")
+		format := c.DefaultQuery("format", kubeconfigFormatCert)
+		if format != kubeconfigFormatCert && format != kubeconfigFormatOIDC {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "format must be cert or oidc"})
+			return
+		}
 		if mode == "k8s" {
-			getTenantKubeconfigK8s(c, name)
+			getTenantKubeconfigK8s(c, name, format)
 		} else {
-			getTenantKubeconfigMock(c, name)
+			getTenantKubeconfigMock(c, name, format)
 		}
 	}
 }
 
-func getTenantKubeconfigMock(c *gin.Context, name string) {
-	secretPath := filepath.Join("..", "examples", "tenants", name+"-kubeconfig.secret")
+func getTenantKubeconfigMock(c *gin.Context, name, format string) {
+	file := name + "-kubeconfig.secret"
+	if format == kubeconfigFormatOIDC {
+		file = name + "-kubeconfig-oidc.secret"
+	}
+	secretPath := filepath.Join("..", "examples", "tenants", file)
 	b, err := os.ReadFile(secretPath)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "kubeconfig not found"})
@@ -467,8 +794,8 @@ func getTenantKubeconfigMock(c *gin.Context, name string) {
 	c.Data(http.StatusOK, "text/plain", b)
 }
 
-func getTenantKubeconfigK8s(c *gin.Context, name string) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func getTenantKubeconfigK8s(c *gin.Context, name, format string) {
+	ctx, cancel := k8sContext(opRead)
 	defer cancel()
 
 	obj := &unstructured.Unstructured{}
@@ -490,6 +817,100 @@ func getTenantKubeconfigK8s(c *gin.Context, name string) {
 		return
 	}
 
-	// TODO: Fetch secret from Kubernetes
-	c.JSON(http.StatusOK, gin.H{"secret": secretName})
+	key := "kubeconfig"
+	if format == kubeconfigFormatOIDC {
+		if _, found, _ := unstructured.NestedMap(obj.Object, "spec", "vcluster", "oidc"); !found {
+			c.JSON(http.StatusNotFound, gin.H{"error": "tenant has no spec.vcluster.oidc"})
+			return
+		}
+		key = "kubeconfig-oidc"
+	}
+
+	namespace, _ := status["namespace"].(string)
+	secret := &unstructured.Unstructured{}
+	secret.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Secret"})
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: secretName}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "kubeconfig secret not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	encoded, _, _ := unstructured.NestedString(secret.Object, "data", key)
+	kubeconfig, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(kubeconfig) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("kubeconfig secret has no %s key", key)})
+		return
+	}
+	c.Data(http.StatusOK, "text/plain", kubeconfig)
+}
+
+// Annotations the operator watches to rotate tenant credentials
+const (
+	rotateCredentialsAnnotation            = "tenant.platform.io/rotate-credentials-at"
+	rotateCredentialsRequestedByAnnotation = "tenant.platform.io/rotate-credentials-requested-by"
+)
+
+// RevokeTenantKubeconfigHandler asks the operator to rotate the tenant credentials,
+// invalidating every previously downloaded kubeconfig and ServiceAccount token
+func RevokeTenantKubeconfigHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "kubeconfig revocation not supported in mock mode"})
+			return
+		}
+
+		name := c.Param("name")
+		var req struct {
+			RequestedBy string `json:"requestedBy"`
+		}
+		if c.Request.ContentLength > 0 {
+			if err := c.BindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+				return
+			}
+		}
+
+		ctx, cancel := k8sContext(opWrite)
+		defer cancel()
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   "platform.io",
+			Version: "v1alpha1",
+			Kind:    "Tenant",
+		})
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, obj); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+			return
+		}
+		if tier, _, _ := unstructured.NestedString(obj.Object, "spec", "tier"); tier == "Bronze" {
+			c.JSON(http.StatusConflict, gin.H{"error": "Bronze tier tenants have no credentials to revoke"})
+			return
+		}
+
+		requestedAt := time.Now().UTC().Truncate(time.Second)
+		patch := client.MergeFrom(obj.DeepCopy())
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[rotateCredentialsAnnotation] = requestedAt.Format(time.RFC3339)
+		if req.RequestedBy != "" {
+			annotations[rotateCredentialsRequestedByAnnotation] = req.RequestedBy
+		} else {
+			delete(annotations, rotateCredentialsRequestedByAnnotation)
+		}
+		obj.SetAnnotations(annotations)
+
+		if err := k8sClient.Patch(ctx, obj, patch); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to request credential rotation: %v", err)})
+			return
+		}
+
+		// The operator rotates asynchronously and records the rotation in the audit trail
+		c.JSON(http.StatusAccepted, gin.H{"tenant": name, "rotationRequestedAt": requestedAt})
+	}
 }
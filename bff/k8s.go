@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Kubernetes call classes with separately configurable timeouts
+const (
+	opRead  = "read"
+	opWrite = "write"
+)
+
+// k8sPolicy controls timeouts and retries for Kubernetes API calls
+type k8sPolicy struct {
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	MaxRetries   int
+	RetryBackoff time.Duration
+}
+
+// k8sCallPolicy is loaded once from the environment at startup
+var k8sCallPolicy = loadK8sPolicy()
+
+// loadK8sPolicy reads the call policy from the environment, falling back to defaults
+// for unset or invalid values:
+//
+//	BFF_K8S_READ_TIMEOUT   timeout for get/list handlers (default 10s)
+//	BFF_K8S_WRITE_TIMEOUT  timeout for create/update/patch/delete handlers (default 10s)
+//	BFF_K8S_MAX_RETRIES    retries for 429/5xx responses to calls safe to replay (default 3, 0 disables)
+//	BFF_K8S_RETRY_BACKOFF  initial retry delay, doubled per attempt (default 200ms)
+func loadK8sPolicy() k8sPolicy {
+	return k8sPolicy{
+		ReadTimeout:  envDuration("BFF_K8S_READ_TIMEOUT", 10*time.Second),
+		WriteTimeout: envDuration("BFF_K8S_WRITE_TIMEOUT", 10*time.Second),
+		MaxRetries:   envInt("BFF_K8S_MAX_RETRIES", 3),
+		RetryBackoff: envDuration("BFF_K8S_RETRY_BACKOFF", 200*time.Millisecond),
+	}
+}
+
+// k8sContext returns a context bounded by the configured timeout for op
+func k8sContext(op string) (context.Context, context.CancelFunc) {
+	timeout := k8sCallPolicy.ReadTimeout
+	if op == opWrite {
+		timeout = k8sCallPolicy.WriteTimeout
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+func envDuration(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		log.Printf("Warning: invalid %s=%q, using %s", key, v, def)
+		return def
+	}
+	return d
+}
+
+func envInt(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		log.Printf("Warning: invalid %s=%q, using %d", key, v, def)
+		return def
+	}
+	return n
+}
+
+func envFloat(key string, def, min float64) float64 {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < min {
+		log.Printf("Warning: invalid %s=%q, using %g", key, v, def)
+		return def
+	}
+	return f
+}
+
+// retryingClient retries Kubernetes calls that failed with a transient API error, but
+// only calls that are safe to replay: the server may have applied an attempt that timed
+// out. Gets and Lists are always retried, Updates only with a resourceVersion, so a
+// replay fails with a conflict instead of overwriting, and Deletes treat NotFound on a
+// retry as success. Creates and Patches, which would create or apply twice, and
+// subresource calls such as TokenRequests are not retried.
+type retryingClient struct {
+	client.Client
+	policy k8sPolicy
+}
+
+func newRetryingClient(cl client.Client, policy k8sPolicy) client.Client {
+	return &retryingClient{Client: cl, policy: policy}
+}
+
+func (r *retryingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return r.retry(ctx, func(int) error { return r.Client.Get(ctx, key, obj, opts...) })
+}
+
+func (r *retryingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return r.retry(ctx, func(int) error { return r.Client.List(ctx, list, opts...) })
+}
+
+func (r *retryingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if obj.GetResourceVersion() == "" {
+		return r.Client.Update(ctx, obj, opts...)
+	}
+	return r.retry(ctx, func(int) error { return r.Client.Update(ctx, obj, opts...) })
+}
+
+func (r *retryingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return r.retry(ctx, func(attempt int) error {
+		err := r.Client.Delete(ctx, obj, opts...)
+		if attempt > 0 && apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+}
+
+// retry runs call until it succeeds, fails permanently, runs out of retries, or ctx
+// expires. The delay doubles per attempt (with jitter) unless the API server suggests one.
+func (r *retryingClient) retry(ctx context.Context, call func(attempt int) error) error {
+	delay := r.policy.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := call(attempt)
+		if err == nil || attempt >= r.policy.MaxRetries || !isRetryable(err) {
+			return err
+		}
+
+		pause := wait.Jitter(delay, 0.2)
+		if seconds, ok := apierrors.SuggestsClientDelay(err); ok && seconds > 0 {
+			pause = time.Duration(seconds) * time.Second
+		}
+		log.Printf("Transient Kubernetes API error (attempt %d/%d), retrying in %s: %v",
+			attempt+1, r.policy.MaxRetries+1, pause, err)
+
+		timer := time.NewTimer(pause)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+// isRetryable reports whether err is a throttling or server-side API error
+func isRetryable(err error) bool {
+	return apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err) ||
+		apierrors.IsUnexpectedServerError(err)
+}
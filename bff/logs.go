@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	defaultLogQueryLimit = 100
+	maxLogQueryLimit     = 5000
+	defaultLogQueryRange = time.Hour
+)
+
+// logBackendClient queries Loki and Elasticsearch on behalf of tenants
+var logBackendClient = &http.Client{Timeout: 30 * time.Second}
+
+// QueryTenantLogsHandler proxies a log query to the tenant's log backend
+// (spec.logging). Loki queries run under the tenant's X-Scope-OrgID and
+// Elasticsearch queries against the tenant's own index, so a tenant can only
+// read its own logs.
+//
+// Query parameters: query (LogQL or Lucene query string), start and end
+// (RFC3339, default: the last hour), limit (default 100, max 5000).
+func QueryTenantLogsHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "log queries not supported in mock mode"})
+			return
+		}
+
+		name := c.Param("name")
+		end := time.Now()
+		start := end.Add(-defaultLogQueryRange)
+		if v := c.Query("start"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "start must be RFC3339"})
+				return
+			}
+			start = t
+		}
+		if v := c.Query("end"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "end must be RFC3339"})
+				return
+			}
+			end = t
+		}
+		if !start.Before(end) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "start must be before end"})
+			return
+		}
+		limit := defaultLogQueryLimit
+		if v := c.Query("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 || n > maxLogQueryLimit {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("limit must be between 1 and %d", maxLogQueryLimit)})
+				return
+			}
+			limit = n
+		}
+
+		ctx, cancel := k8sContext(opRead)
+		tenant := &unstructured.Unstructured{}
+		tenant.SetGroupVersionKind(schema.GroupVersionKind{Group: "platform.io", Version: "v1alpha1", Kind: "Tenant"})
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, tenant)
+		cancel()
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+			return
+		}
+		backend, _, _ := unstructured.NestedString(tenant.Object, "spec", "logging", "backend")
+		if backend == "" {
+			c.JSON(http.StatusConflict, gin.H{"error": "tenant has no spec.logging configured"})
+			return
+		}
+		tenantID, _, _ := unstructured.NestedString(tenant.Object, "spec", "logging", "tenantID")
+		if tenantID == "" {
+			tenantID = name
+		}
+
+		var req *http.Request
+		switch backend {
+		case "Loki":
+			req, err = lokiQueryRequest(name, tenantID, c.Query("query"), start, end, limit)
+		case "Elasticsearch":
+			req, err = elasticsearchQueryRequest(tenantID, c.Query("query"), start, end, limit)
+		default:
+			err = fmt.Errorf("unsupported logging backend %q", backend)
+		}
+		if err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+			return
+		}
+		req = req.WithContext(c.Request.Context())
+
+		resp, err := logBackendClient.Do(req)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": fmt.Sprintf("log backend unreachable: %v", err)})
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": fmt.Sprintf("failed to read log backend response: %v", err)})
+			return
+		}
+		if resp.StatusCode >= 500 {
+			c.JSON(http.StatusBadGateway, gin.H{"error": fmt.Sprintf("log backend returned %d", resp.StatusCode)})
+			return
+		}
+		c.Data(resp.StatusCode, "application/json", body)
+	}
+}
+
+// lokiQueryRequest builds a query_range request scoped to the tenant's Loki org
+func lokiQueryRequest(name, tenantID, query string, start, end time.Time, limit int) (*http.Request, error) {
+	base := os.Getenv("BFF_LOKI_URL")
+	if base == "" {
+		return nil, fmt.Errorf("log queries are not configured (BFF_LOKI_URL unset)")
+	}
+	if query == "" {
+		query = fmt.Sprintf(`{tenant=%q}`, name)
+	}
+
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", strconv.FormatInt(start.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(end.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(limit))
+	params.Set("direction", "backward")
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(base, "/")+"/loki/api/v1/query_range?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Scope-OrgID", tenantID)
+	return req, nil
+}
+
+// elasticsearchQueryRequest builds a _search request against the tenant's index
+func elasticsearchQueryRequest(tenantID, query string, start, end time.Time, limit int) (*http.Request, error) {
+	base := os.Getenv("BFF_ELASTICSEARCH_URL")
+	if base == "" {
+		return nil, fmt.Errorf("log queries are not configured (BFF_ELASTICSEARCH_URL unset)")
+	}
+	if query == "" {
+		query = "*"
+	}
+
+	search := gin.H{
+		"size": limit,
+		"sort": []gin.H{{"@timestamp": gin.H{"order": "desc"}}},
+		"query": gin.H{"bool": gin.H{
+			"must": []gin.H{{"query_string": gin.H{"query": query}}},
+			"filter": []gin.H{{"range": gin.H{"@timestamp": gin.H{
+				"gte": start.UTC().Format(time.RFC3339),
+				"lte": end.UTC().Format(time.RFC3339),
+			}}}},
+		}},
+	}
+	body, err := json.Marshal(search)
+	if err != nil {
+		return nil, err
+	}
+
+	index := url.PathEscape("tenant-" + tenantID)
+	req, err := http.NewRequest(http.MethodPost, strings.TrimRight(base, "/")+"/"+index+"/_search", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
@@ -10,7 +10,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-var k8sClient client.Client
+var (
+	k8sClient client.Client
+	k8sConfig *rest.Config
+)
 
 func main() {
 	mode := os.Getenv("BFF_MODE") // "mock", "k8s", or unset (defaults to mock)
@@ -30,6 +33,7 @@ func main() {
 	} else {
 		log.Println("Running in mock mode")
 	}
+	initAPIKeyStore(mode)
 
 	r := gin.Default()
 
@@ -44,15 +48,60 @@ func main() {
 		c.JSON(200, gin.H{"status": "ok", "mode": mode})
 	})
 
+	// Build and cluster identity for support
+	r.GET("/api/v1/version", VersionHandler(mode))
+
 	// Tenant endpoints
 	r.GET("/api/v1/tenants", GetTenantsHandler(mode))
 	r.POST("/api/v1/tenants", CreateTenantHandler(mode))
 	r.GET("/api/v1/tenants/:name", GetTenantDetailHandler(mode))
 	r.GET("/api/v1/tenants/:name/metrics", GetTenantMetricsHandler(mode))
 	r.GET("/api/v1/tenants/:name/kubeconfig", GetTenantKubeconfigHandler(mode))
+	r.POST("/api/v1/tenants/:name/kubeconfig/revoke", RevokeTenantKubeconfigHandler(mode))
+	r.PUT("/api/v1/tenants/:name", PutTenantHandler(mode))
 	r.PATCH("/api/v1/tenants/:name", UpdateTenantHandler(mode))
 	r.DELETE("/api/v1/tenants/:name", DeleteTenantHandler(mode))
 
+	// Tenant presets for spec.templateRef
+	r.GET("/api/v1/templates", GetTemplatesHandler(mode))
+
+	// Tenant log queries proxied to the tenant's log backend (spec.logging)
+	r.GET("/api/v1/tenants/:name/logs/query", QueryTenantLogsHandler(mode))
+
+	// On-demand provisioning checks, optionally for another tier (?tier=Gold)
+	r.GET("/api/v1/tenants/:name/preflight", GetTenantPreflightHandler(mode))
+
+	// Effective network boundary (NetworkPolicies, whitelisted services) as a graph
+	r.GET("/api/v1/tenants/:name/network", GetTenantNetworkHandler(mode))
+
+	// Desired vs live child objects (what the next reconcile will change)
+	r.GET("/api/v1/tenants/:name/drift", GetTenantDriftHandler(mode))
+
+	// Snapshots a tenant can be restored from (spec.restoreFrom), newest first
+	r.GET("/api/v1/tenants/:name/snapshots", ListSnapshotsHandler(mode))
+
+	// Export bundle: Tenant CR, child manifests, and latest TenantSnapshot
+	r.GET("/api/v1/tenants/:name/export", ExportTenantHandler(mode))
+
+	// Chargeback usage report of a closed day or month (?period=, ?format=json|csv)
+	r.GET("/api/v1/tenants/:name/usage-report", GetUsageReportHandler(mode))
+
+	// Short-lived tenant ServiceAccount tokens (TokenRequest API)
+	r.POST("/api/v1/tenants/:name/token", CreateTenantTokenHandler(mode))
+
+	// Scoped read-only API keys for metrics scrapers
+	r.POST("/api/v1/tenants/:name/apikeys", CreateAPIKeyHandler(mode))
+	r.GET("/api/v1/tenants/:name/apikeys", ListAPIKeysHandler(mode))
+	r.DELETE("/api/v1/tenants/:name/apikeys/:id", RevokeAPIKeyHandler(mode))
+
+	// Who has access: generated, tenant-created, and break-glass RoleBindings
+	r.GET("/api/v1/tenants/:name/access", GetTenantAccessHandler(mode))
+
+	// Break-glass access requests (time-limited elevated RBAC)
+	r.POST("/api/v1/tenants/:name/access-requests", CreateAccessRequestHandler(mode))
+	r.GET("/api/v1/tenants/:name/access-requests", ListAccessRequestsHandler(mode))
+	r.DELETE("/api/v1/tenants/:name/access-requests/:id", RevokeAccessRequestHandler(mode))
+
 	port := os.Getenv("BFF_PORT")
 	if port == "" {
 		port = "8080"
@@ -74,7 +123,8 @@ func initK8sClient() error {
 	if err != nil {
 		return err
 	}
-	k8sClient = cl
+	k8sClient = newRetryingClient(cl, k8sCallPolicy)
+	k8sConfig = cfg
 	return nil
 }
 
@@ -82,8 +132,8 @@ func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, Authorization")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PATCH, DELETE, OPTIONS")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, Authorization, X-API-Key")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
 			return
@@ -99,6 +149,11 @@ func authMiddleware() gin.HandlerFunc {
 			c.Next()
 			return
 		}
+		// Scoped API keys bypass JWT auth but are restricted to read routes
+		if key := c.GetHeader(apiKeyHeader); key != "" {
+			apiKeyAuth(c, key)
+			return
+		}
 		// Extract JWT from Authorization header
 		auth := c.GetHeader("Authorization")
 		if auth == "" {
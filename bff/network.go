@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	netv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Node kinds in a tenant network graph
+const (
+	netNodeTenantNamespace = "tenantNamespace" // namespace owned by the tenant
+	netNodePeer            = "peer"            // namespace owned by another tenant
+	netNodeNamespace       = "namespace"       // any other namespace
+	netNodeService         = "service"         // whitelisted Service
+	netNodeInternet        = "internet"        // 0.0.0.0/0
+	netNodeCIDR            = "cidr"            // any other IP block
+	netNodeAny             = "any"             // rule without peers: all sources/destinations
+	netNodeSelector        = "selector"        // namespace selector matching no namespace
+)
+
+// NetworkGraph is the effective network boundary of a tenant: one node per
+// namespace, Service, or IP range, and one edge per allowed traffic direction
+type NetworkGraph struct {
+	Tenant              string        `json:"tenant"`
+	AllowInternetAccess bool          `json:"allowInternetAccess"`
+	Nodes               []NetworkNode `json:"nodes"`
+	Edges               []NetworkEdge `json:"edges"`
+}
+
+// NetworkNode is a traffic source or destination
+type NetworkNode struct {
+	ID    string `json:"id"`
+	Kind  string `json:"kind"`
+	Label string `json:"label"`
+	// Tenant owning a peer namespace
+	Tenant string `json:"tenant,omitempty"`
+	// Ports exposed by a whitelisted Service
+	Ports []string `json:"ports,omitempty"`
+	// Resolved is false for whitelisted Services that do not exist
+	Resolved *bool `json:"resolved,omitempty"`
+}
+
+// NetworkEdge allows traffic from one node to another
+type NetworkEdge struct {
+	From      string   `json:"from"`
+	To        string   `json:"to"`
+	Direction string   `json:"direction"`
+	Ports     []string `json:"ports,omitempty"`
+	// Source is the NetworkPolicy ("namespace/name") or spec field that allows the traffic
+	Source string `json:"source"`
+}
+
+// GetTenantNetworkHandler returns the tenant's effective ingress/egress rules as a
+// graph, built from the NetworkPolicies in the tenant namespaces
+func GetTenantNetworkHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "network graph not supported in mock mode"})
+			return
+		}
+
+		name := c.Param("name")
+		ctx, cancel := k8sContext(opRead)
+		defer cancel()
+
+		tenant := &unstructured.Unstructured{}
+		tenant.SetGroupVersionKind(schema.GroupVersionKind{Group: "platform.io", Version: "v1alpha1", Kind: "Tenant"})
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, tenant); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+			return
+		}
+
+		graph, err := buildNetworkGraph(ctx, tenant)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, graph)
+	}
+}
+
+// networkGraphBuilder collects nodes and edges without duplicates
+type networkGraphBuilder struct {
+	ctx    context.Context
+	tenant string
+	graph  *NetworkGraph
+	nodes  map[string]bool
+	edges  map[string]bool
+}
+
+func buildNetworkGraph(ctx context.Context, tenant *unstructured.Unstructured) (*NetworkGraph, error) {
+	b := &networkGraphBuilder{
+		ctx:    ctx,
+		tenant: tenant.GetName(),
+		graph:  &NetworkGraph{Tenant: tenant.GetName(), Nodes: []NetworkNode{}, Edges: []NetworkEdge{}},
+		nodes:  map[string]bool{},
+		edges:  map[string]bool{},
+	}
+	b.graph.AllowInternetAccess, _, _ = unstructured.NestedBool(tenant.Object, "spec", "network", "allowInternetAccess")
+
+	namespaces := &unstructured.UnstructuredList{}
+	namespaces.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "NamespaceList"})
+	if err := k8sClient.List(ctx, namespaces, client.MatchingLabels{"tenant.platform.io/name": b.tenant}); err != nil {
+		return nil, fmt.Errorf("failed to list tenant namespaces: %w", err)
+	}
+	sort.Slice(namespaces.Items, func(i, j int) bool { return namespaces.Items[i].GetName() < namespaces.Items[j].GetName() })
+
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		b.addNamespaceNode(ns)
+
+		policies := &netv1.NetworkPolicyList{}
+		if err := listTyped(ctx, policies, "NetworkPolicyList", netv1.SchemeGroupVersion, client.InNamespace(ns.GetName())); err != nil {
+			return nil, fmt.Errorf("failed to list NetworkPolicies in %s: %w", ns.GetName(), err)
+		}
+		for _, policy := range policies.Items {
+			if err := b.addPolicy(ns.GetName(), &policy); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Whitelisted services are enforced per namespace by the policies above; resolve
+	// them here so the console can show which Service and ports each entry refers to
+	if len(namespaces.Items) > 0 {
+		base := "tenant-" + b.tenant
+		refs, _, _ := unstructured.NestedStringSlice(tenant.Object, "spec", "network", "whitelistedServices")
+		for _, ref := range refs {
+			if err := b.addWhitelistedService(base, ref); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return b.graph, nil
+}
+
+// listTyped lists objects through the unstructured client and converts them to list
+func listTyped(ctx context.Context, list runtime.Object, kind string, gv schema.GroupVersion, opts ...client.ListOption) error {
+	u := &unstructured.UnstructuredList{}
+	u.SetGroupVersionKind(gv.WithKind(kind))
+	if err := k8sClient.List(ctx, u, opts...); err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), list)
+}
+
+func (b *networkGraphBuilder) addNode(node NetworkNode) string {
+	if !b.nodes[node.ID] {
+		b.nodes[node.ID] = true
+		b.graph.Nodes = append(b.graph.Nodes, node)
+	}
+	return node.ID
+}
+
+func (b *networkGraphBuilder) addEdge(edge NetworkEdge) {
+	key := strings.Join([]string{edge.From, edge.To, edge.Direction, strings.Join(edge.Ports, ","), edge.Source}, "|")
+	if !b.edges[key] {
+		b.edges[key] = true
+		b.graph.Edges = append(b.graph.Edges, edge)
+	}
+}
+
+// addNamespaceNode adds a namespace, classified by the tenant that owns it
+func (b *networkGraphBuilder) addNamespaceNode(ns *unstructured.Unstructured) string {
+	node := NetworkNode{ID: "ns:" + ns.GetName(), Kind: netNodeNamespace, Label: ns.GetName()}
+	switch owner := ns.GetLabels()["tenant.platform.io/name"]; {
+	case owner == b.tenant:
+		node.Kind = netNodeTenantNamespace
+	case owner != "":
+		node.Kind = netNodePeer
+		node.Tenant = owner
+	}
+	return b.addNode(node)
+}
+
+// addPolicy adds one edge per rule peer of a NetworkPolicy in namespace
+func (b *networkGraphBuilder) addPolicy(namespace string, policy *netv1.NetworkPolicy) error {
+	self := "ns:" + namespace
+	source := namespace + "/" + policy.Name
+
+	for _, rule := range policy.Spec.Ingress {
+		peers, err := b.resolvePeers(namespace, rule.From)
+		if err != nil {
+			return err
+		}
+		for _, peer := range peers {
+			b.addEdge(NetworkEdge{From: peer, To: self, Direction: "ingress", Ports: formatPolicyPorts(rule.Ports), Source: source})
+		}
+	}
+	for _, rule := range policy.Spec.Egress {
+		peers, err := b.resolvePeers(namespace, rule.To)
+		if err != nil {
+			return err
+		}
+		for _, peer := range peers {
+			b.addEdge(NetworkEdge{From: self, To: peer, Direction: "egress", Ports: formatPolicyPorts(rule.Ports), Source: source})
+		}
+	}
+	return nil
+}
+
+// resolvePeers turns NetworkPolicy peers into node IDs, expanding namespace
+// selectors into the namespaces they currently match
+func (b *networkGraphBuilder) resolvePeers(namespace string, peers []netv1.NetworkPolicyPeer) ([]string, error) {
+	if len(peers) == 0 {
+		return []string{b.addNode(NetworkNode{ID: "any", Kind: netNodeAny, Label: "Anywhere"})}, nil
+	}
+
+	var ids []string
+	for _, peer := range peers {
+		switch {
+		case peer.IPBlock != nil:
+			if peer.IPBlock.CIDR == "0.0.0.0/0" && len(peer.IPBlock.Except) == 0 {
+				ids = append(ids, b.addNode(NetworkNode{ID: "internet", Kind: netNodeInternet, Label: "Internet"}))
+				continue
+			}
+			label := peer.IPBlock.CIDR
+			if len(peer.IPBlock.Except) > 0 {
+				label += " except " + strings.Join(peer.IPBlock.Except, ", ")
+			}
+			ids = append(ids, b.addNode(NetworkNode{ID: "cidr:" + label, Kind: netNodeCIDR, Label: label}))
+
+		case peer.NamespaceSelector == nil:
+			// A pod selector alone selects pods in the policy's own namespace
+			ids = append(ids, "ns:"+namespace)
+
+		default:
+			selector, err := metav1.LabelSelectorAsSelector(peer.NamespaceSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid namespace selector in %s: %w", namespace, err)
+			}
+			matched := &unstructured.UnstructuredList{}
+			matched.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "NamespaceList"})
+			if err := k8sClient.List(b.ctx, matched, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+				return nil, fmt.Errorf("failed to resolve namespace selector %q: %w", selector.String(), err)
+			}
+			if len(matched.Items) == 0 {
+				ids = append(ids, b.addNode(NetworkNode{ID: "selector:" + selector.String(), Kind: netNodeSelector, Label: selector.String()}))
+				continue
+			}
+			for i := range matched.Items {
+				ids = append(ids, b.addNamespaceNode(&matched.Items[i]))
+			}
+		}
+	}
+	return ids, nil
+}
+
+// addWhitelistedService adds a spec.network.whitelistedServices entry
+// ("namespace/service[:port]") with the ports of the Service it refers to
+func (b *networkGraphBuilder) addWhitelistedService(from, ref string) error {
+	namespace, service, port := ref, "", ""
+	if i := strings.Index(ref, "/"); i >= 0 {
+		namespace, service = ref[:i], ref[i+1:]
+	}
+	if i := strings.LastIndex(service, ":"); i >= 0 {
+		service, port = service[:i], service[i+1:]
+	}
+
+	node := NetworkNode{ID: "svc:" + ref, Kind: netNodeService, Label: ref}
+	resolved := true
+	svc := &unstructured.Unstructured{}
+	svc.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Service"})
+	err := k8sClient.Get(b.ctx, types.NamespacedName{Namespace: namespace, Name: service}, svc)
+	switch {
+	case apierrors.IsNotFound(err):
+		resolved = false
+	case err != nil:
+		return fmt.Errorf("failed to resolve whitelisted service %s: %w", ref, err)
+	default:
+		ports, _, _ := unstructured.NestedSlice(svc.Object, "spec", "ports")
+		for _, p := range ports {
+			m, ok := p.(map[string]any)
+			if !ok {
+				continue
+			}
+			protocol, _ := m["protocol"].(string)
+			if protocol == "" {
+				protocol = "TCP"
+			}
+			node.Ports = append(node.Ports, fmt.Sprintf("%s/%v", protocol, m["port"]))
+		}
+	}
+	node.Resolved = &resolved
+	b.addNode(node)
+
+	edge := NetworkEdge{From: "ns:" + from, To: node.ID, Direction: "egress", Source: "spec.network.whitelistedServices"}
+	if port != "" {
+		edge.Ports = []string{"TCP/" + port}
+	}
+	b.addEdge(edge)
+	return nil
+}
+
+// formatPolicyPorts renders NetworkPolicy ports as "PROTOCOL/port[-endPort]"
+func formatPolicyPorts(ports []netv1.NetworkPolicyPort) []string {
+	var out []string
+	for _, p := range ports {
+		protocol := "TCP"
+		if p.Protocol != nil {
+			protocol = string(*p.Protocol)
+		}
+		port := "*"
+		if p.Port != nil {
+			port = p.Port.String()
+		}
+		if p.EndPort != nil {
+			port = fmt.Sprintf("%s-%d", port, *p.EndPort)
+		}
+		out = append(out, protocol+"/"+port)
+	}
+	return out
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Pre-flight check outcomes
+const (
+	checkPass = "pass"
+	checkFail = "fail"
+	checkWarn = "warn"
+	checkSkip = "skip"
+)
+
+// Defaults the operator applies when the tenant spec leaves them unset
+const (
+	defaultTenantCPU               = "1000m"
+	defaultTenantMemory            = "1Gi"
+	defaultVClusterPersistenceSize = "10Gi"
+	defaultVClusterValuesKey       = "values.yaml"
+)
+
+// Labels and annotations read by the pre-flight checks
+const (
+	tenantNameLabelKey            = "tenant.platform.io/name"
+	quotaScopeLabelKey            = "tenant.platform.io/quota-scope"
+	managedByLabelKey             = "app.kubernetes.io/managed-by"
+	managedByLabelValue           = "tenant-master"
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
+// PreflightCheck is the outcome of one pre-flight check
+type PreflightCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// PreflightReport lists the checks run for a tenant at a tier
+type PreflightReport struct {
+	Tenant string           `json:"tenant"`
+	Tier   string           `json:"tier"`
+	Passed bool             `json:"passed"`
+	Checks []PreflightCheck `json:"checks"`
+}
+
+// GetTenantPreflightHandler checks on demand whether the cluster can provision the
+// tenant: namespace ownership, storage classes, spare capacity, and Gold vCluster
+// prerequisites. ?tier= evaluates a tier change before applying it.
+func GetTenantPreflightHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "pre-flight checks not supported in mock mode"})
+			return
+		}
+
+		name := c.Param("name")
+		ctx, cancel := k8sContext(opRead)
+		defer cancel()
+
+		tenant := &unstructured.Unstructured{}
+		tenant.SetGroupVersionKind(schema.GroupVersionKind{Group: "platform.io", Version: "v1alpha1", Kind: "Tenant"})
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, tenant); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+			return
+		}
+
+		tier, _, _ := unstructured.NestedString(tenant.Object, "spec", "tier")
+		if t := c.Query("tier"); t != "" {
+			if t != "Bronze" && t != "Silver" && t != "Gold" && t != "Platinum" {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "tier must be Bronze, Silver, Gold, or Platinum"})
+				return
+			}
+			tier = t
+		}
+
+		report := PreflightReport{Tenant: name, Tier: tier, Passed: true}
+		for _, check := range []func(context.Context, *unstructured.Unstructured, string) PreflightCheck{
+			checkTenantNamespace,
+			checkStorageClasses,
+			checkClusterCapacity,
+			checkVClusterStorage,
+			checkVClusterValues,
+		} {
+			result := check(ctx, tenant, tier)
+			if result.Status == checkFail {
+				report.Passed = false
+			}
+			report.Checks = append(report.Checks, result)
+		}
+
+		c.JSON(http.StatusOK, report)
+	}
+}
+
+// checkTenantNamespace fails when tenant-<name> exists but belongs to something else
+func checkTenantNamespace(ctx context.Context, tenant *unstructured.Unstructured, tier string) PreflightCheck {
+	check := PreflightCheck{Name: "namespace"}
+	if tier == "Bronze" {
+		check.Status, check.Message = checkSkip, "Bronze tenants share the bronze-tenants namespace"
+		return check
+	}
+
+	name := "tenant-" + tenant.GetName()
+	ns := &unstructured.Unstructured{}
+	ns.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"})
+	err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, ns)
+	switch {
+	case apierrors.IsNotFound(err):
+		check.Status, check.Message = checkPass, fmt.Sprintf("namespace %s is available", name)
+	case err != nil:
+		check.Status, check.Message = checkFail, fmt.Sprintf("failed to look up namespace %s: %v", name, err)
+	case ns.GetLabels()[tenantNameLabelKey] != tenant.GetName():
+		check.Status, check.Message = checkFail, fmt.Sprintf("namespace %s exists and is not owned by this tenant", name)
+	default:
+		check.Status, check.Message = checkPass, fmt.Sprintf("namespace %s is owned by this tenant", name)
+	}
+	return check
+}
+
+// checkStorageClasses verifies that every referenced StorageClass exists, and that
+// Gold tenants without one can fall back to a default StorageClass
+func checkStorageClasses(ctx context.Context, tenant *unstructured.Unstructured, tier string) PreflightCheck {
+	check := PreflightCheck{Name: "storage-class"}
+	if tier == "Bronze" {
+		check.Status, check.Message = checkSkip, "Bronze tenants have no persistent storage"
+		return check
+	}
+
+	classes := &unstructured.UnstructuredList{}
+	classes.SetGroupVersionKind(schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "StorageClassList"})
+	if err := k8sClient.List(ctx, classes); err != nil {
+		check.Status, check.Message = checkFail, fmt.Sprintf("failed to list StorageClasses: %v", err)
+		return check
+	}
+	existing := map[string]bool{}
+	defaultClass := ""
+	for _, sc := range classes.Items {
+		existing[sc.GetName()] = true
+		if sc.GetAnnotations()[defaultStorageClassAnnotation] == "true" {
+			defaultClass = sc.GetName()
+		}
+	}
+
+	var referenced []string
+	if sc, _, _ := unstructured.NestedString(tenant.Object, "spec", "resources", "storageClass"); sc != "" {
+		referenced = append(referenced, sc)
+	}
+	if sc, _, _ := unstructured.NestedString(tenant.Object, "spec", "vcluster", "persistence", "storageClass"); sc != "" && tier == "Gold" {
+		referenced = append(referenced, sc)
+	}
+	for _, sc := range referenced {
+		if !existing[sc] {
+			check.Status, check.Message = checkFail, fmt.Sprintf("StorageClass %s does not exist", sc)
+			return check
+		}
+	}
+
+	switch {
+	case len(referenced) > 0:
+		check.Status, check.Message = checkPass, fmt.Sprintf("StorageClass %s exists", referenced[len(referenced)-1])
+	case tier == "Gold" && vclusterPersistenceEnabled(tenant) && defaultClass == "":
+		check.Status, check.Message = checkFail, "no StorageClass is set and the cluster has no default StorageClass for vCluster persistence"
+	case defaultClass == "":
+		check.Status, check.Message = checkWarn, "no StorageClass is set and the cluster has no default StorageClass; PVCs will stay Pending"
+	default:
+		check.Status, check.Message = checkPass, fmt.Sprintf("PVCs use the default StorageClass %s", defaultClass)
+	}
+	return check
+}
+
+// checkClusterCapacity compares the tenant's CPU and memory budget with the
+// allocatable capacity of schedulable nodes not yet committed to other tenants'
+// ResourceQuotas
+func checkClusterCapacity(ctx context.Context, tenant *unstructured.Unstructured, tier string) PreflightCheck {
+	check := PreflightCheck{Name: "capacity"}
+	if tier == "Bronze" {
+		check.Status, check.Message = checkSkip, "Bronze tenants have no reserved capacity"
+		return check
+	}
+
+	cpuValue, memValue := tenantRequests(tenant)
+	wantCPU, errCPU := parseQuantityOr(cpuValue, defaultTenantCPU)
+	wantMem, errMem := parseQuantityOr(memValue, defaultTenantMemory)
+	if errCPU != nil || errMem != nil {
+		check.Status, check.Message = checkFail, "spec.resources has an invalid cpu or memory quantity"
+		return check
+	}
+
+	nodes := &unstructured.UnstructuredList{}
+	nodes.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "NodeList"})
+	if err := k8sClient.List(ctx, nodes); err != nil {
+		check.Status, check.Message = checkFail, fmt.Sprintf("failed to list nodes: %v", err)
+		return check
+	}
+	allocCPU, allocMem := resource.Quantity{}, resource.Quantity{}
+	for _, node := range nodes.Items {
+		if unschedulable, _, _ := unstructured.NestedBool(node.Object, "spec", "unschedulable"); unschedulable {
+			continue
+		}
+		allocatable, _, _ := unstructured.NestedStringMap(node.Object, "status", "allocatable")
+		addQuantity(&allocCPU, allocatable["cpu"])
+		addQuantity(&allocMem, allocatable["memory"])
+	}
+
+	// Priority-class quotas carve budgets out of the tenant quota, so only unscoped
+	// quotas count towards what other tenants have committed
+	quotas := &unstructured.UnstructuredList{}
+	quotas.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ResourceQuotaList"})
+	if err := k8sClient.List(ctx, quotas, client.MatchingLabels{managedByLabelKey: managedByLabelValue}); err != nil {
+		check.Status, check.Message = checkFail, fmt.Sprintf("failed to list tenant ResourceQuotas: %v", err)
+		return check
+	}
+	usedCPU, usedMem := resource.Quantity{}, resource.Quantity{}
+	for _, quota := range quotas.Items {
+		labels := quota.GetLabels()
+		if labels[tenantNameLabelKey] == tenant.GetName() || labels[quotaScopeLabelKey] != "" {
+			continue
+		}
+		hard, _, _ := unstructured.NestedStringMap(quota.Object, "spec", "hard")
+		addQuantity(&usedCPU, hard["requests.cpu"])
+		addQuantity(&usedMem, hard["requests.memory"])
+	}
+
+	freeCPU, freeMem := allocCPU.DeepCopy(), allocMem.DeepCopy()
+	freeCPU.Sub(usedCPU)
+	freeMem.Sub(usedMem)
+
+	summary := fmt.Sprintf("requested %s CPU / %s memory; uncommitted %s CPU / %s memory",
+		wantCPU.String(), wantMem.String(), freeCPU.String(), freeMem.String())
+	if freeCPU.Cmp(wantCPU) < 0 || freeMem.Cmp(wantMem) < 0 {
+		check.Status, check.Message = checkFail, "insufficient capacity: "+summary
+		return check
+	}
+	check.Status, check.Message = checkPass, summary
+	return check
+}
+
+// checkVClusterStorage verifies that the vCluster volumes fit the tenant storage budget
+func checkVClusterStorage(ctx context.Context, tenant *unstructured.Unstructured, tier string) PreflightCheck {
+	check := PreflightCheck{Name: "vcluster-storage"}
+	if tier != "Gold" {
+		check.Status, check.Message = checkSkip, "only Gold tenants run a vCluster"
+		return check
+	}
+	if !vclusterPersistenceEnabled(tenant) {
+		check.Status, check.Message = checkPass, "vCluster persistence is disabled"
+		return check
+	}
+
+	replicas, found, _ := unstructured.NestedInt64(tenant.Object, "spec", "vcluster", "replicas")
+	if !found {
+		replicas = 1
+	}
+	sizeValue, _, _ := unstructured.NestedString(tenant.Object, "spec", "vcluster", "persistence", "size")
+	size, err := parseQuantityOr(sizeValue, defaultVClusterPersistenceSize)
+	if err != nil {
+		check.Status, check.Message = checkFail, fmt.Sprintf("invalid spec.vcluster.persistence.size: %v", err)
+		return check
+	}
+	total := resource.NewQuantity(size.Value()*replicas, resource.BinarySI)
+
+	budget, _, _ := unstructured.NestedString(tenant.Object, "spec", "resources", "storage")
+	if budget == "" {
+		check.Status, check.Message = checkPass, fmt.Sprintf("vCluster needs %s of storage; the tenant has no storage budget", total.String())
+		return check
+	}
+	quota, err := resource.ParseQuantity(budget)
+	if err != nil {
+		check.Status, check.Message = checkFail, fmt.Sprintf("invalid spec.resources.storage: %v", err)
+		return check
+	}
+	if total.Cmp(quota) > 0 {
+		check.Status, check.Message = checkFail, fmt.Sprintf("vCluster needs %s of storage but spec.resources.storage is %s", total.String(), quota.String())
+		return check
+	}
+	check.Status, check.Message = checkPass, fmt.Sprintf("vCluster needs %s of the %s storage budget", total.String(), quota.String())
+	return check
+}
+
+// checkVClusterValues verifies that the ConfigMaps and Secrets in spec.vcluster.valuesFrom
+// exist in the operator namespace and hold the referenced key
+func checkVClusterValues(ctx context.Context, tenant *unstructured.Unstructured, tier string) PreflightCheck {
+	check := PreflightCheck{Name: "vcluster-values"}
+	if tier != "Gold" {
+		check.Status, check.Message = checkSkip, "only Gold tenants run a vCluster"
+		return check
+	}
+
+	refs, _, _ := unstructured.NestedSlice(tenant.Object, "spec", "vcluster", "valuesFrom")
+	if len(refs) == 0 {
+		check.Status, check.Message = checkPass, "no values references"
+		return check
+	}
+
+	for _, r := range refs {
+		ref, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		kind, _ := ref["kind"].(string)
+		name, _ := ref["name"].(string)
+		key, _ := ref["valuesKey"].(string)
+		optional, _ := ref["optional"].(bool)
+		if key == "" {
+			key = defaultVClusterValuesKey
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: kind})
+		err := k8sClient.Get(ctx, types.NamespacedName{Namespace: operatorNamespace(), Name: name}, obj)
+		if apierrors.IsNotFound(err) && optional {
+			continue
+		}
+		if err != nil {
+			check.Status, check.Message = checkFail, fmt.Sprintf("%s %s/%s: %v", kind, operatorNamespace(), name, err)
+			return check
+		}
+
+		if _, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "data", key); !found && !optional {
+			check.Status, check.Message = checkFail, fmt.Sprintf("%s %s/%s has no key %s", kind, operatorNamespace(), name, key)
+			return check
+		}
+	}
+
+	check.Status, check.Message = checkPass, fmt.Sprintf("%d values reference(s) resolved", len(refs))
+	return check
+}
+
+// vclusterPersistenceEnabled reports whether the tenant's vCluster uses a volume
+func vclusterPersistenceEnabled(tenant *unstructured.Unstructured) bool {
+	enabled, found, _ := unstructured.NestedBool(tenant.Object, "spec", "vcluster", "persistence", "enabled")
+	return !found || enabled
+}
+
+// tenantRequests returns the tenant's CPU and memory requests: spec.resources.requests,
+// falling back to spec.resources.cpu and memory
+func tenantRequests(tenant *unstructured.Unstructured) (string, string) {
+	cpu, _, _ := unstructured.NestedString(tenant.Object, "spec", "resources", "cpu")
+	mem, _, _ := unstructured.NestedString(tenant.Object, "spec", "resources", "memory")
+	if v, _, _ := unstructured.NestedString(tenant.Object, "spec", "resources", "requests", "cpu"); v != "" {
+		cpu = v
+	}
+	if v, _, _ := unstructured.NestedString(tenant.Object, "spec", "resources", "requests", "memory"); v != "" {
+		mem = v
+	}
+	return cpu, mem
+}
+
+func parseQuantityOr(value, def string) (resource.Quantity, error) {
+	if value == "" {
+		value = def
+	}
+	return resource.ParseQuantity(value)
+}
+
+func addQuantity(sum *resource.Quantity, value string) {
+	if qty, err := resource.ParseQuantity(value); err == nil {
+		sum.Add(qty)
+	}
+}
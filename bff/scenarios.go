@@ -0,0 +1,115 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+// mockScenarioEnv selects the mock scenario used when a request does not pass ?scenario=
+const mockScenarioEnv = "BFF_MOCK_SCENARIO"
+
+// mockScenarioName restricts scenario names to files in the scenarios directory
+var mockScenarioName = regexp.MustCompile(`^[a-z0-9][-a-z0-9]*$`)
+
+// MockScenario drives the state of mock tenants over time, so frontends can exercise
+// provisioning failures and slow tenants without a cluster. Scenarios are read from
+// examples/scenarios/<name>.yaml.
+type MockScenario struct {
+	Description string `yaml:"description"`
+	// Transitions apply to every tier without an entry in Tiers
+	Transitions []MockTransition `yaml:"transitions"`
+	// Tiers overrides Transitions for individual tiers
+	Tiers map[string][]MockTransition `yaml:"tiers"`
+}
+
+// MockTransition moves a mock tenant to State once After has passed since the tenant
+// was created or last replaced
+type MockTransition struct {
+	After   time.Duration `yaml:"after"`
+	State   string        `yaml:"state"`
+	Message string        `yaml:"message"`
+}
+
+// mockScenario returns the scenario selected by ?scenario= or BFF_MOCK_SCENARIO, or nil
+// for the default behaviour. It writes a 400 response and returns false for unknown
+// scenarios.
+func mockScenario(c *gin.Context) (*MockScenario, bool) {
+	name := c.Query("scenario")
+	if name == "" {
+		name = os.Getenv(mockScenarioEnv)
+	}
+	if name == "" || name == "default" {
+		return nil, true
+	}
+
+	scenario, err := loadMockScenario(name)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return scenario, true
+}
+
+func loadMockScenario(name string) (*MockScenario, error) {
+	if !mockScenarioName.MatchString(name) {
+		return nil, fmt.Errorf("invalid scenario name %q", name)
+	}
+	b, err := os.ReadFile(filepath.Join("..", "examples", "scenarios", name+".yaml"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("unknown scenario %q", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	scenario := &MockScenario{}
+	if err := yaml.Unmarshal(b, scenario); err != nil {
+		return nil, fmt.Errorf("invalid scenario %q: %v", name, err)
+	}
+	return scenario, nil
+}
+
+// stateAt returns the state of a tenant of tier elapsed after its creation, and the
+// events for every transition reached so far. An empty state leaves the tenant as is.
+func (s *MockScenario) stateAt(tier string, elapsed time.Duration) (string, []string) {
+	transitions, ok := s.Tiers[tier]
+	if !ok {
+		transitions = s.Transitions
+	}
+
+	state := ""
+	var events []string
+	for _, t := range transitions {
+		if elapsed < t.After {
+			break
+		}
+		state = t.State
+		event := t.State
+		if t.Message != "" {
+			event += ": " + t.Message
+		}
+		events = append(events, event)
+	}
+	return state, events
+}
+
+// applyMockScenario overrides the state of a mock tenant whose file was last written
+// at modified, and returns the scenario events.
+func applyMockScenario(s *MockScenario, t *TenantSummary, modified time.Time) []string {
+	if s == nil {
+		return nil
+	}
+	state, events := s.stateAt(t.Tier, time.Since(modified))
+	if state != "" {
+		t.State = state
+	}
+	return events
+}
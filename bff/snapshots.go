@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Snapshot is the BFF view of a TenantSnapshot
+type Snapshot struct {
+	Name            string `json:"name"`
+	Tenant          string `json:"tenant"`
+	Trigger         string `json:"trigger,omitempty"`
+	Phase           string `json:"phase,omitempty"`
+	TakenAt         string `json:"takenAt,omitempty"`
+	Tier            string `json:"tier,omitempty"`
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+	Location        string `json:"location,omitempty"`
+	Objects         int    `json:"objects"`
+	Restorable      bool   `json:"restorable"`
+	Message         string `json:"message,omitempty"`
+}
+
+var snapshotGVK = schema.GroupVersionKind{
+	Group:   "platform.io",
+	Version: "v1alpha1",
+	Kind:    "TenantSnapshot",
+}
+
+// ListSnapshotsHandler lists the snapshots of a tenant, newest first. Snapshots outlive
+// their tenant, so a deleted tenant's snapshots can still be found to restore from.
+func ListSnapshotsHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusOK, []Snapshot{})
+			return
+		}
+
+		ctx, cancel := k8sContext(opRead)
+		defer cancel()
+		items, err := tenantSnapshots(ctx, c.Param("name"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		snapshots := make([]Snapshot, 0, len(items))
+		for i := range items {
+			snapshots = append(snapshots, snapshotFromObject(&items[i]))
+		}
+		c.JSON(http.StatusOK, snapshots)
+	}
+}
+
+// tenantSnapshots returns the TenantSnapshots of a tenant, newest first. Snapshots created
+// by hand need not carry the tenant label, so they are matched on spec.tenantName.
+func tenantSnapshots(ctx context.Context, tenant string) ([]unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   snapshotGVK.Group,
+		Version: snapshotGVK.Version,
+		Kind:    snapshotGVK.Kind + "List",
+	})
+	if err := k8sClient.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	var items []unstructured.Unstructured
+	for _, item := range list.Items {
+		if name, _, _ := unstructured.NestedString(item.Object, "spec", "tenantName"); name == tenant {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i].GetCreationTimestamp(), items[j].GetCreationTimestamp()
+		if !a.Equal(&b) {
+			return b.Before(&a)
+		}
+		return strings.Compare(items[i].GetName(), items[j].GetName()) > 0
+	})
+	return items, nil
+}
+
+func snapshotFromObject(obj *unstructured.Unstructured) Snapshot {
+	s := Snapshot{Name: obj.GetName()}
+	s.Tenant, _, _ = unstructured.NestedString(obj.Object, "spec", "tenantName")
+	s.Trigger, _, _ = unstructured.NestedString(obj.Object, "spec", "trigger")
+	s.Phase, _, _ = unstructured.NestedString(obj.Object, "status", "phase")
+	s.TakenAt, _, _ = unstructured.NestedString(obj.Object, "status", "takenAt")
+	s.Tier, _, _ = unstructured.NestedString(obj.Object, "status", "tier")
+	s.SourceNamespace, _, _ = unstructured.NestedString(obj.Object, "status", "sourceNamespace")
+	s.Location, _, _ = unstructured.NestedString(obj.Object, "status", "location")
+	s.Message, _, _ = unstructured.NestedString(obj.Object, "status", "message")
+	contents, _, _ := unstructured.NestedSlice(obj.Object, "status", "contents")
+	s.Objects = len(contents)
+	// Mirrors TenantSnapshot.Restorable in the operator
+	s.Restorable = s.Phase == "Completed" && s.Location != ""
+	return s
+}
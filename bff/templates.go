@@ -0,0 +1,105 @@
+package main
+
+import (
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TenantTemplate is the BFF view of a TenantTemplate preset
+type TenantTemplate struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Tier        string         `json:"tier,omitempty"`
+	Profile     string         `json:"profile,omitempty"`
+	Spec        map[string]any `json:"spec"`
+	CreatedAt   time.Time      `json:"createdAt,omitempty"`
+}
+
+// GetTemplatesHandler lists the TenantTemplates users can reference in spec.templateRef
+func GetTemplatesHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode == "k8s" {
+			getTemplatesK8s(c)
+		} else {
+			getTemplatesMock(c)
+		}
+	}
+}
+
+func getTemplatesMock(c *gin.Context) {
+	examplesDir := filepath.Join("..", "examples", "templates")
+	templates := []TenantTemplate{}
+	_ = filepath.WalkDir(examplesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if !strings.HasSuffix(d.Name(), ".yaml") && !strings.HasSuffix(d.Name(), ".yml") {
+			return nil
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		for _, doc := range strings.Split(string(b), "---") {
+			var m map[string]any
+			if err := yaml.Unmarshal([]byte(doc), &m); err != nil || m["kind"] != "TenantTemplate" {
+				continue
+			}
+			meta, _ := m["metadata"].(map[string]any)
+			spec, _ := m["spec"].(map[string]any)
+			name, _ := meta["name"].(string)
+			if name != "" {
+				templates = append(templates, templateFromSpec(name, spec))
+			}
+		}
+		return nil
+	})
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	c.JSON(http.StatusOK, templates)
+}
+
+func getTemplatesK8s(c *gin.Context) {
+	ctx, cancel := k8sContext(opRead)
+	defer cancel()
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "platform.io",
+		Version: "v1alpha1",
+		Kind:    "TenantTemplateList",
+	})
+	if err := k8sClient.List(ctx, list); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	templates := make([]TenantTemplate, 0, len(list.Items))
+	for _, item := range list.Items {
+		spec, _, _ := unstructured.NestedMap(item.Object, "spec")
+		t := templateFromSpec(item.GetName(), spec)
+		t.CreatedAt = item.GetCreationTimestamp().Time
+		templates = append(templates, t)
+	}
+	c.JSON(http.StatusOK, templates)
+}
+
+func templateFromSpec(name string, spec map[string]any) TenantTemplate {
+	if spec == nil {
+		spec = map[string]any{}
+	}
+	t := TenantTemplate{Name: name, Spec: spec}
+	t.Description, _ = spec["description"].(string)
+	t.Tier, _ = spec["tier"].(string)
+	t.Profile, _, _ = unstructured.NestedString(spec, "profileRef", "name")
+	return t
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Lifetime bounds for minted ServiceAccount tokens. The API server rejects
+// TokenRequests shorter than 10 minutes.
+const (
+	defaultTokenTTL = 15 * time.Minute
+	minTokenTTL     = 10 * time.Minute
+	maxTokenTTL     = time.Hour
+)
+
+// TenantToken is a short-lived token for the tenant ServiceAccount
+type TenantToken struct {
+	Token          string    `json:"token"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	ServiceAccount string    `json:"serviceAccount"`
+	Namespace      string    `json:"namespace"`
+}
+
+// CreateTenantTokenHandler mints a short-lived token for the tenant ServiceAccount
+// via the TokenRequest API. Every issued token is recorded in the audit trail.
+func CreateTenantTokenHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode != "k8s" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "token exchange not supported in mock mode"})
+			return
+		}
+
+		name := c.Param("name")
+		var req struct {
+			TTL         string `json:"ttl"`
+			RequestedBy string `json:"requestedBy"`
+		}
+		// An empty body requests a token with the default TTL
+		if c.Request.ContentLength > 0 {
+			if err := c.BindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+				return
+			}
+		}
+
+		ttl := defaultTokenTTL
+		if req.TTL != "" {
+			d, err := time.ParseDuration(req.TTL)
+			if err != nil || d < minTokenTTL || d > maxTokenTTL {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("ttl must be between %s and %s", minTokenTTL, maxTokenTTL)})
+				return
+			}
+			ttl = d
+		}
+
+		ctx, cancel := k8sContext(opWrite)
+		defer cancel()
+
+		tenant := &unstructured.Unstructured{}
+		tenant.SetGroupVersionKind(schema.GroupVersionKind{Group: "platform.io", Version: "v1alpha1", Kind: "Tenant"})
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, tenant); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "tenant not found"})
+			return
+		}
+		namespace, _, _ := unstructured.NestedString(tenant.Object, "status", "namespace")
+		if namespace == "" {
+			c.JSON(http.StatusConflict, gin.H{"error": "tenant has no namespace (not yet provisioned)"})
+			return
+		}
+
+		sa := &unstructured.Unstructured{}
+		sa.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ServiceAccount"})
+		sa.SetName(fmt.Sprintf("%s-sa", name))
+		sa.SetNamespace(namespace)
+
+		tokenRequest := &unstructured.Unstructured{}
+		tokenRequest.SetGroupVersionKind(schema.GroupVersionKind{Group: "authentication.k8s.io", Version: "v1", Kind: "TokenRequest"})
+		tokenRequest.Object["spec"] = map[string]any{
+			"expirationSeconds": int64(ttl.Seconds()),
+		}
+
+		if err := k8sClient.SubResource("token").Create(ctx, sa, tokenRequest); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to mint token: %v", err)})
+			return
+		}
+
+		token := TenantToken{
+			ServiceAccount: sa.GetName(),
+			Namespace:      namespace,
+			ExpiresAt:      time.Now().Add(ttl).UTC(),
+		}
+		token.Token, _, _ = unstructured.NestedString(tokenRequest.Object, "status", "token")
+		if ts, _, _ := unstructured.NestedString(tokenRequest.Object, "status", "expirationTimestamp"); ts != "" {
+			if parsed, err := time.Parse(time.RFC3339, ts); err == nil {
+				token.ExpiresAt = parsed
+			}
+		}
+
+		// Never hand out a token that is missing from the audit trail
+		details := gin.H{"serviceAccount": token.ServiceAccount, "namespace": namespace, "expiresAt": token.ExpiresAt}
+		message := fmt.Sprintf("issued %s token for ServiceAccount %s/%s", ttl, namespace, token.ServiceAccount)
+		if err := recordAuditEntry(ctx, name, auditActionTokenIssued, req.RequestedBy, message, details); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to audit token: %v", err)})
+			return
+		}
+
+		c.JSON(http.StatusCreated, token)
+	}
+}
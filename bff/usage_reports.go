@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Usage report data keys written by the operator's meter (see internal/controller)
+const (
+	usageReportJSONKey = "report.json"
+	usageReportCSVKey  = "report.csv"
+)
+
+// GetUsageReportHandler downloads a tenant's usage report for a closed billing period,
+// a day (?period=2026-10-16) or a month (?period=2026-09), as JSON or CSV (?format=csv).
+// Reports are kept after the tenant is deleted, so the tenant is not looked up.
+func GetUsageReportHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Param("name")
+		period := c.Query("period")
+		if _, dayErr := time.Parse("2006-01-02", period); dayErr != nil {
+			if _, monthErr := time.Parse("2006-01", period); monthErr != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "period must be a day (YYYY-MM-DD) or a month (YYYY-MM)"})
+				return
+			}
+		}
+		format := c.DefaultQuery("format", "json")
+		if format != "json" && format != "csv" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or csv"})
+			return
+		}
+
+		if mode != "k8s" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "usage report not found"})
+			return
+		}
+
+		ctx, cancel := k8sContext(opRead)
+		defer cancel()
+
+		cm := &unstructured.Unstructured{}
+		cm.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"})
+		key := types.NamespacedName{Namespace: operatorNamespace(), Name: fmt.Sprintf("usage-report-%s-%s", name, period)}
+		if err := k8sClient.Get(ctx, key, cm); err != nil {
+			if apierrors.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "usage report not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		dataKey, contentType := usageReportJSONKey, "application/json"
+		if format == "csv" {
+			dataKey, contentType = usageReportCSVKey, "text/csv"
+		}
+		data, _, _ := unstructured.NestedString(cm.Object, "data", dataKey)
+		filename := fmt.Sprintf("%s-usage-%s.%s", name, period, format)
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		c.Data(http.StatusOK, contentType, []byte(data))
+	}
+}
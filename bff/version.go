@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+)
+
+// Build metadata, set at link time:
+//
+//	go build -ldflags "-X main.gitSHA=$(git rev-parse HEAD) -X main.buildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// When unset, the VCS stamp embedded by the Go toolchain is used instead.
+var (
+	gitSHA    = ""
+	buildDate = ""
+)
+
+// VersionInfo describes the running BFF build and the cluster it talks to
+type VersionInfo struct {
+	GitSHA    string       `json:"gitSha"`
+	BuildDate string       `json:"buildDate"`
+	GoVersion string       `json:"goVersion"`
+	Mode      string       `json:"mode"`
+	Cluster   *ClusterInfo `json:"cluster,omitempty"`
+}
+
+// ClusterInfo identifies the connected Kubernetes cluster
+type ClusterInfo struct {
+	// Name is the operator-assigned cluster name from CLUSTER_NAME, if set
+	Name string `json:"name,omitempty"`
+	// ID is the UID of the kube-system namespace, stable for the cluster's lifetime
+	ID            string `json:"id,omitempty"`
+	APIServer     string `json:"apiServer,omitempty"`
+	ServerVersion string `json:"serverVersion,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// VersionHandler returns build info, BFF mode, and connected cluster identity
+func VersionHandler(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		info := VersionInfo{Mode: mode}
+		info.GitSHA, info.BuildDate, info.GoVersion = buildInfo()
+
+		if mode == "k8s" {
+			ctx, cancel := k8sContext(opRead)
+			defer cancel()
+			info.Cluster = clusterInfo(ctx)
+		}
+
+		c.JSON(http.StatusOK, info)
+	}
+}
+
+// buildInfo returns the git SHA, build date, and Go version of this binary
+func buildInfo() (sha, date, goVersion string) {
+	sha, date = gitSHA, buildDate
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		goVersion = bi.GoVersion
+		for _, s := range bi.Settings {
+			switch {
+			case s.Key == "vcs.revision" && sha == "":
+				sha = s.Value
+			case s.Key == "vcs.time" && date == "":
+				date = s.Value
+			}
+		}
+	}
+	if sha == "" {
+		sha = "unknown"
+	}
+	if date == "" {
+		date = "unknown"
+	}
+	return sha, date, goVersion
+}
+
+// clusterInfo identifies the cluster; lookup failures are reported in the Error field
+func clusterInfo(ctx context.Context) *ClusterInfo {
+	info := &ClusterInfo{Name: os.Getenv("CLUSTER_NAME")}
+	if k8sConfig != nil {
+		info.APIServer = k8sConfig.Host
+		if dc, err := discovery.NewDiscoveryClientForConfig(k8sConfig); err == nil {
+			if v, err := dc.ServerVersion(); err == nil {
+				info.ServerVersion = v.GitVersion
+			} else {
+				info.Error = err.Error()
+			}
+		}
+	}
+
+	ns := &unstructured.Unstructured{}
+	ns.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"})
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: "kube-system"}, ns); err != nil {
+		info.Error = err.Error()
+	} else {
+		info.ID = string(ns.GetUID())
+	}
+	return info
+}
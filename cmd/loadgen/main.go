@@ -0,0 +1,356 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command loadgen benchmarks tenant provisioning end to end. It creates synthetic
+// Tenants against a test cluster at a fixed rate, waits for them to become Ready,
+// and reports the time-to-Ready distribution per tier, the API server request rate,
+// and the operator's client request rate and memory.
+//
+// Run it against a disposable cluster: every Tenant it creates is labeled with the
+// run ID and, unless -cleanup=false, deleted when the run ends.
+//
+//	kubectl -n tenant-system port-forward svc/tenant-master-metrics 8080 &
+//	go run ./cmd/loadgen -tenants 1000 -tiers Bronze=70,Silver=25,Gold=5 \
+//	    -operator-metrics-url http://localhost:8080/metrics -output json
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	platformclient "github.com/amartyaa/tenant-master/operator/pkg/client"
+)
+
+// RunLabelKey labels every Tenant created by a loadgen run with the run ID
+const RunLabelKey = "loadgen.platform.io/run"
+
+type options struct {
+	tenants            int
+	tiers              string
+	namePrefix         string
+	owner              string
+	createQPS          float64
+	timeout            time.Duration
+	operatorMetricsURL string
+	apiServerMetrics   bool
+	sampleInterval     time.Duration
+	output             string
+	cleanup            bool
+	seed               int64
+}
+
+// tracked is the provisioning timeline of one synthetic Tenant
+type tracked struct {
+	tier      platformv1alpha1.TenantTier
+	createdAt time.Time
+	readyAt   time.Time
+	state     platformv1alpha1.TenantState
+}
+
+// tracker records when each Tenant of the run is first seen Ready
+type tracker struct {
+	mu       sync.Mutex
+	tenants  map[string]*tracked
+	pending  int
+	creating bool
+	done     chan struct{}
+}
+
+func main() {
+	var o options
+	flag.IntVar(&o.tenants, "tenants", 100, "Number of synthetic Tenants to create.")
+	flag.StringVar(&o.tiers, "tiers", "Bronze=70,Silver=25,Gold=5", "Tier mix as comma-separated Tier=weight pairs.")
+	flag.StringVar(&o.namePrefix, "name-prefix", "loadgen", "Prefix of the synthetic Tenant names.")
+	flag.StringVar(&o.owner, "owner", "loadgen@example.com", "spec.owner of the synthetic Tenants.")
+	flag.Float64Var(&o.createQPS, "create-qps", 10, "Tenants created per second.")
+	flag.DurationVar(&o.timeout, "timeout", 30*time.Minute, "How long to wait for all Tenants to become Ready after the last is created.")
+	flag.StringVar(&o.operatorMetricsURL, "operator-metrics-url", "",
+		"Operator metrics endpoint (e.g. a port-forwarded http://localhost:8080/metrics) to sample memory and client request rate; empty skips it.")
+	flag.BoolVar(&o.apiServerMetrics, "apiserver-metrics", true, "Measure the API server request rate from its /metrics endpoint.")
+	flag.DurationVar(&o.sampleInterval, "sample-interval", 5*time.Second, "How often to sample operator memory.")
+	flag.StringVar(&o.output, "output", "text", "Report format: text or json.")
+	flag.BoolVar(&o.cleanup, "cleanup", true, "Delete the synthetic Tenants when the run ends.")
+	flag.Int64Var(&o.seed, "seed", 1, "Seed for the order in which tiers are created.")
+	flag.Parse()
+
+	if err := run(ctrl.SetupSignalHandler(), o); err != nil {
+		fmt.Fprintf(os.Stderr, "loadgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, o options) error {
+	if o.tenants <= 0 {
+		return fmt.Errorf("-tenants must be positive")
+	}
+	if o.createQPS <= 0 {
+		return fmt.Errorf("-create-qps must be positive")
+	}
+	if o.output != "text" && o.output != "json" {
+		return fmt.Errorf("-output must be text or json, not %q", o.output)
+	}
+	tiers, err := tierPlan(o.tiers, o.tenants, o.seed)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	// Keep the generator's own client from throttling the creation rate
+	cfg.QPS, cfg.Burst = float32(o.createQPS)*2+10, int(o.createQPS)*2+20
+	cs, err := platformclient.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	runID := strconv.FormatInt(time.Now().Unix(), 36)
+	t := &tracker{tenants: map[string]*tracked{}, creating: true, done: make(chan struct{})}
+
+	// One watch for all Tenants keeps the generator's own API load out of the numbers
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory := platformclient.NewInformerFactory(cs, 0)
+	informer := factory.Tenants().Informer()
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { t.observe(obj, runID) },
+		UpdateFunc: func(_, obj interface{}) { t.observe(obj, runID) },
+	}); err != nil {
+		return fmt.Errorf("failed to watch Tenants: %w", err)
+	}
+	factory.Start(stopCh)
+	for resource, synced := range factory.WaitForCacheSync(stopCh) {
+		if !synced {
+			return fmt.Errorf("failed to sync %s informer", resource)
+		}
+	}
+
+	sampler := newSampler(cs, o)
+	if err := sampler.start(ctx); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "loadgen: run %s creating %d tenants at %.1f/s\n", runID, o.tenants, o.createQPS)
+	start := time.Now()
+	createErrors := createTenants(ctx, cs, t, o, runID, tiers)
+
+	waitCtx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+	select {
+	case <-t.done:
+	case <-waitCtx.Done():
+		fmt.Fprintf(os.Stderr, "loadgen: stopped waiting with %d tenants not Ready\n", t.remaining())
+	}
+	elapsed := time.Since(start)
+
+	r := t.report(runID, o.tenants, createErrors, elapsed)
+	sampler.stop(context.Background(), r, elapsed)
+	if err := r.write(os.Stdout, o.output); err != nil {
+		return err
+	}
+
+	if o.cleanup {
+		deleteTenants(context.Background(), cs, t)
+	}
+	return nil
+}
+
+// tierPlan returns the tier of each of n Tenants, split by the weights in spec and
+// shuffled so the tiers are created interleaved
+func tierPlan(spec string, n int, seed int64) ([]platformv1alpha1.TenantTier, error) {
+	type weight struct {
+		tier   platformv1alpha1.TenantTier
+		weight int
+	}
+	var weights []weight
+	total := 0
+	for _, pair := range strings.Split(spec, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		tier := platformv1alpha1.TenantTier(name)
+		if tier != platformv1alpha1.BronzeTier && tier != platformv1alpha1.SilverTier && tier != platformv1alpha1.GoldTier {
+			return nil, fmt.Errorf("-tiers: unknown tier %q", name)
+		}
+		w, err := strconv.Atoi(value)
+		if !ok || err != nil || w < 0 {
+			return nil, fmt.Errorf("-tiers: %q is not a Tier=weight pair with a non-negative weight", pair)
+		}
+		weights = append(weights, weight{tier, w})
+		total += w
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("-tiers: weights must not all be zero")
+	}
+
+	plan := make([]platformv1alpha1.TenantTier, 0, n)
+	for _, w := range weights {
+		for i := 0; i < n*w.weight/total; i++ {
+			plan = append(plan, w.tier)
+		}
+	}
+	// Rounding leftovers go to the heaviest tier
+	sort.SliceStable(weights, func(i, j int) bool { return weights[i].weight > weights[j].weight })
+	for len(plan) < n {
+		plan = append(plan, weights[0].tier)
+	}
+	rand.New(rand.NewSource(seed)).Shuffle(len(plan), func(i, j int) { plan[i], plan[j] = plan[j], plan[i] })
+	return plan, nil
+}
+
+// syntheticTenant returns a Tenant sized like a small real tenant of the tier
+func syntheticTenant(name, runID, owner string, tier platformv1alpha1.TenantTier) *platformv1alpha1.Tenant {
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{RunLabelKey: runID},
+		},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  tier,
+			Owner: owner,
+		},
+	}
+	switch tier {
+	case platformv1alpha1.BronzeTier:
+		tenant.Spec.Resources = platformv1alpha1.ResourceRequirements{CPU: "100m", Memory: "128Mi"}
+	case platformv1alpha1.SilverTier:
+		tenant.Spec.Resources = platformv1alpha1.ResourceRequirements{CPU: "500m", Memory: "512Mi", Storage: "5Gi"}
+	case platformv1alpha1.GoldTier:
+		// Room for the default vCluster persistence volume
+		tenant.Spec.Resources = platformv1alpha1.ResourceRequirements{CPU: "2000m", Memory: "4Gi", Storage: "20Gi"}
+	}
+	return tenant
+}
+
+// createTenants creates the run's Tenants at o.createQPS and returns how many failed
+func createTenants(ctx context.Context, cs platformclient.Interface, t *tracker, o options, runID string, tiers []platformv1alpha1.TenantTier) int {
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / o.createQPS))
+	defer ticker.Stop()
+
+	errors := 0
+	for i, tier := range tiers {
+		select {
+		case <-ctx.Done():
+			t.close()
+			return errors + len(tiers) - i
+		case <-ticker.C:
+		}
+
+		name := fmt.Sprintf("%s-%s-%04d", o.namePrefix, runID, i)
+		t.add(name, tier, time.Now())
+		if _, err := cs.Tenants().Create(ctx, syntheticTenant(name, runID, o.owner, tier), metav1.CreateOptions{}); err != nil {
+			fmt.Fprintf(os.Stderr, "loadgen: failed to create tenant %s: %v\n", name, err)
+			t.remove(name)
+			errors++
+		}
+	}
+	t.close()
+	return errors
+}
+
+// deleteTenants deletes every Tenant the run created; the operator finalizes them
+// asynchronously
+func deleteTenants(ctx context.Context, cs platformclient.Interface, t *tracker) {
+	t.mu.Lock()
+	names := make([]string, 0, len(t.tenants))
+	for name := range t.tenants {
+		names = append(names, name)
+	}
+	t.mu.Unlock()
+
+	for _, name := range names {
+		if err := cs.Tenants().Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+			fmt.Fprintf(os.Stderr, "loadgen: failed to delete tenant %s: %v\n", name, err)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "loadgen: deleted %d tenants\n", len(names))
+}
+
+// add starts tracking a Tenant before it is created, so its first watch event is not missed
+func (t *tracker) add(name string, tier platformv1alpha1.TenantTier, createdAt time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tenants[name] = &tracked{tier: tier, createdAt: createdAt}
+	t.pending++
+}
+
+func (t *tracker) remove(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if tt, ok := t.tenants[name]; ok && tt.readyAt.IsZero() {
+		t.pending--
+	}
+	delete(t.tenants, name)
+}
+
+// close marks the creation phase finished; done closes once every Tenant is Ready
+func (t *tracker) close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.creating = false
+	t.checkDone()
+}
+
+func (t *tracker) observe(obj interface{}, runID string) {
+	tenant, ok := obj.(*platformv1alpha1.Tenant)
+	if !ok || tenant.Labels[RunLabelKey] != runID {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tt, ok := t.tenants[tenant.Name]
+	if !ok {
+		return
+	}
+	tt.state = tenant.Status.State
+	if tt.state == platformv1alpha1.StateReady && tt.readyAt.IsZero() {
+		tt.readyAt = time.Now()
+		t.pending--
+		t.checkDone()
+	}
+}
+
+func (t *tracker) checkDone() {
+	if !t.creating && t.pending == 0 {
+		select {
+		case <-t.done:
+		default:
+			close(t.done)
+		}
+	}
+}
+
+func (t *tracker) remaining() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.pending
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// report is the outcome of a loadgen run
+type report struct {
+	RunID          string           `json:"runID"`
+	Tenants        int              `json:"tenants"`
+	CreateErrors   int              `json:"createErrors"`
+	ElapsedSeconds float64          `json:"elapsedSeconds"`
+	Tiers          []tierReport     `json:"tiers"`
+	APIServer      *apiServerReport `json:"apiServer,omitempty"`
+	APIServerError string           `json:"apiServerError,omitempty"`
+	Operator       *operatorReport  `json:"operator,omitempty"`
+	OperatorError  string           `json:"operatorError,omitempty"`
+}
+
+// tierReport is the time-to-Ready distribution of one tier, in seconds
+type tierReport struct {
+	Tier     platformv1alpha1.TenantTier `json:"tier"`
+	Created  int                         `json:"created"`
+	Ready    int                         `json:"ready"`
+	Failed   int                         `json:"failed"`
+	NotReady int                         `json:"notReady"`
+	Min      float64                     `json:"minSeconds"`
+	P50      float64                     `json:"p50Seconds"`
+	P90      float64                     `json:"p90Seconds"`
+	P99      float64                     `json:"p99Seconds"`
+	Max      float64                     `json:"maxSeconds"`
+}
+
+// apiServerReport is the request rate the API server served from all clients
+// during the run
+type apiServerReport struct {
+	Requests float64 `json:"requests"`
+	QPS      float64 `json:"qps"`
+}
+
+// operatorReport is the operator's own request rate and memory during the run
+type operatorReport struct {
+	ClientRequests   float64 `json:"clientRequests"`
+	ClientQPS        float64 `json:"clientQPS"`
+	MemoryStartBytes float64 `json:"memoryStartBytes"`
+	MemoryPeakBytes  float64 `json:"memoryPeakBytes"`
+	MemoryEndBytes   float64 `json:"memoryEndBytes"`
+}
+
+// report summarizes the tracked Tenants per tier, in Bronze, Silver, Gold order
+func (t *tracker) report(runID string, tenants, createErrors int, elapsed time.Duration) *report {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	byTier := map[platformv1alpha1.TenantTier]*tierReport{}
+	durations := map[platformv1alpha1.TenantTier][]float64{}
+	for _, tt := range t.tenants {
+		tr, ok := byTier[tt.tier]
+		if !ok {
+			tr = &tierReport{Tier: tt.tier}
+			byTier[tt.tier] = tr
+		}
+		tr.Created++
+		switch {
+		case !tt.readyAt.IsZero():
+			tr.Ready++
+			durations[tt.tier] = append(durations[tt.tier], tt.readyAt.Sub(tt.createdAt).Seconds())
+		case tt.state == platformv1alpha1.StateFailed:
+			tr.Failed++
+		default:
+			tr.NotReady++
+		}
+	}
+
+	r := &report{
+		RunID:          runID,
+		Tenants:        tenants,
+		CreateErrors:   createErrors,
+		ElapsedSeconds: elapsed.Seconds(),
+	}
+	for _, tier := range []platformv1alpha1.TenantTier{platformv1alpha1.BronzeTier, platformv1alpha1.SilverTier, platformv1alpha1.GoldTier} {
+		tr, ok := byTier[tier]
+		if !ok {
+			continue
+		}
+		if d := durations[tier]; len(d) > 0 {
+			sort.Float64s(d)
+			tr.Min, tr.Max = d[0], d[len(d)-1]
+			tr.P50, tr.P90, tr.P99 = percentile(d, 50), percentile(d, 90), percentile(d, 99)
+		}
+		r.Tiers = append(r.Tiers, *tr)
+	}
+	return r
+}
+
+// percentile returns the nearest-rank percentile p of the sorted values
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func (r *report) write(w io.Writer, format string) error {
+	if format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	}
+
+	fmt.Fprintf(w, "run %s: %d tenants, %d create errors, %.1fs elapsed\n\n", r.RunID, r.Tenants, r.CreateErrors, r.ElapsedSeconds)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIER\tCREATED\tREADY\tFAILED\tNOT READY\tMIN\tP50\tP90\tP99\tMAX")
+	for _, tr := range r.Tiers {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%.1fs\t%.1fs\t%.1fs\t%.1fs\t%.1fs\n",
+			tr.Tier, tr.Created, tr.Ready, tr.Failed, tr.NotReady, tr.Min, tr.P50, tr.P90, tr.P99, tr.Max)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+
+	switch {
+	case r.APIServer != nil:
+		fmt.Fprintf(w, "API server: %.0f requests, %.1f QPS\n", r.APIServer.Requests, r.APIServer.QPS)
+	case r.APIServerError != "":
+		fmt.Fprintf(w, "API server: %s\n", r.APIServerError)
+	}
+	switch {
+	case r.Operator != nil:
+		fmt.Fprintf(w, "operator: %.0f client requests, %.1f QPS; memory %s start, %s peak, %s end\n",
+			r.Operator.ClientRequests, r.Operator.ClientQPS,
+			mebibytes(r.Operator.MemoryStartBytes), mebibytes(r.Operator.MemoryPeakBytes), mebibytes(r.Operator.MemoryEndBytes))
+	case r.OperatorError != "":
+		fmt.Fprintf(w, "operator: %s\n", r.OperatorError)
+	}
+	return nil
+}
+
+func mebibytes(bytes float64) string {
+	return fmt.Sprintf("%.0fMi", bytes/(1<<20))
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	platformclient "github.com/amartyaa/tenant-master/operator/pkg/client"
+)
+
+// Metrics read from the API server and operator endpoints
+const (
+	apiServerRequestsMetric = "apiserver_request_total"
+	clientRequestsMetric    = "rest_client_requests_total"
+	residentMemoryMetric    = "process_resident_memory_bytes"
+)
+
+// sampler measures request counters at the start and end of a run and samples
+// operator memory in between
+type sampler struct {
+	cs         platformclient.Interface
+	o          options
+	httpClient *http.Client
+
+	apiServerStart float64
+	apiServerErr   error
+	operatorStart  float64
+
+	mu          sync.Mutex
+	memoryStart float64
+	memoryPeak  float64
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newSampler(cs platformclient.Interface, o options) *sampler {
+	return &sampler{cs: cs, o: o, httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// start takes the baseline counters and starts sampling operator memory. An
+// unreachable API server metrics endpoint is reported, not fatal; an unreachable
+// operator endpoint is an error, since it was asked for explicitly.
+func (s *sampler) start(ctx context.Context) error {
+	if s.o.apiServerMetrics {
+		s.apiServerStart, s.apiServerErr = s.apiServer(ctx)
+	}
+	if s.o.operatorMetricsURL == "" {
+		return nil
+	}
+
+	metrics, err := s.operator(ctx)
+	if err != nil {
+		return err
+	}
+	s.operatorStart = metrics[clientRequestsMetric]
+	s.memoryStart = metrics[residentMemoryMetric]
+	s.memoryPeak = s.memoryStart
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.o.sampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			// A missed sample only lowers the accuracy of the peak
+			if metrics, err := s.operator(ctx); err == nil {
+				s.recordMemory(metrics[residentMemoryMetric])
+			}
+		}
+	}()
+	return nil
+}
+
+// stop ends sampling and adds the request rates and memory figures to r
+func (s *sampler) stop(ctx context.Context, r *report, elapsed time.Duration) {
+	if s.cancel != nil {
+		s.cancel()
+		s.wg.Wait()
+	}
+	seconds := elapsed.Seconds()
+
+	if s.o.apiServerMetrics {
+		end, err := s.apiServer(ctx)
+		if s.apiServerErr == nil {
+			s.apiServerErr = err
+		}
+		if s.apiServerErr != nil {
+			r.APIServerError = s.apiServerErr.Error()
+		} else {
+			requests := end - s.apiServerStart
+			r.APIServer = &apiServerReport{Requests: requests, QPS: requests / seconds}
+		}
+	}
+
+	if s.o.operatorMetricsURL != "" {
+		metrics, err := s.operator(ctx)
+		if err != nil {
+			r.OperatorError = err.Error()
+			return
+		}
+		s.recordMemory(metrics[residentMemoryMetric])
+		requests := metrics[clientRequestsMetric] - s.operatorStart
+		r.Operator = &operatorReport{
+			ClientRequests:   requests,
+			ClientQPS:        requests / seconds,
+			MemoryStartBytes: s.memoryStart,
+			MemoryPeakBytes:  s.memoryPeak,
+			MemoryEndBytes:   metrics[residentMemoryMetric],
+		}
+	}
+}
+
+func (s *sampler) recordMemory(bytes float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if bytes > s.memoryPeak {
+		s.memoryPeak = bytes
+	}
+}
+
+// apiServer returns the API server's total request count across all clients
+func (s *sampler) apiServer(ctx context.Context) (float64, error) {
+	body, err := s.cs.RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read API server metrics: %w", err)
+	}
+	return sumMetrics(bytes.NewReader(body), apiServerRequestsMetric)[apiServerRequestsMetric], nil
+}
+
+// operator returns the operator's client request count and resident memory
+func (s *sampler) operator(ctx context.Context) (map[string]float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.o.operatorMetricsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -operator-metrics-url: %w", err)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operator metrics: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to read operator metrics: %s", resp.Status)
+	}
+	return sumMetrics(resp.Body, clientRequestsMetric, residentMemoryMetric), nil
+}
+
+// sumMetrics sums the samples of each named metric across all label sets in a
+// Prometheus text exposition
+func sumMetrics(r io.Reader, names ...string) map[string]float64 {
+	sums := make(map[string]float64, len(names))
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		// name{labels} value [timestamp]
+		name, rest := line, ""
+		if i := strings.IndexAny(line, "{ "); i >= 0 {
+			name, rest = line[:i], line[i:]
+		}
+		if strings.HasPrefix(rest, "{") {
+			end := strings.LastIndex(rest, "}")
+			if end < 0 {
+				continue
+			}
+			rest = rest[end+1:]
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		for _, want := range names {
+			if name != want {
+				continue
+			}
+			if value, err := strconv.ParseFloat(fields[0], 64); err == nil {
+				sums[name] += value
+			}
+		}
+	}
+	return sums
+}
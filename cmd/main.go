@@ -33,7 +33,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
 	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/notify"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
 	"github.com/amartyaa/tenant-master/operator/internal/webhook/mutating"
 	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
 )
@@ -53,6 +57,7 @@ func main() {
 	var enableLeaderElection bool
 	var webhookPort int
 	var certDir string
+	var operatorServiceAccount string
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -60,6 +65,11 @@ func main() {
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.IntVar(&webhookPort, "webhook-port", 9443, "The port the webhook server binds to.")
 	flag.StringVar(&certDir, "cert-dir", "/tmp/k8s-webhook-server/serving-certs", "The directory containing webhook server certs.")
+	flag.StringVar(&operatorServiceAccount, "operator-service-account", "system:serviceaccount:tenant-system:tenant-master",
+		"The username of the operator ServiceAccount, exempted from tenant RBAC admission checks.")
+
+	operatorConfig := config.Default()
+	operatorConfig.BindFlags(flag.CommandLine)
 
 	opts := zap.Options{
 		Development: true,
@@ -87,29 +97,298 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Optional archive for tenant snapshots and the audit trail outside the cluster
+	archive, err := storage.New(operatorConfig.Storage)
+	if err != nil {
+		setupLog.Error(err, "invalid storage backend configuration")
+		os.Exit(1)
+	}
+
+	if err := operatorConfig.Chart.Validate(); err != nil {
+		setupLog.Error(err, "invalid vCluster chart configuration")
+		os.Exit(1)
+	}
+	if err := operatorConfig.Kubeconfig.Validate(); err != nil {
+		setupLog.Error(err, "invalid Silver tier kubeconfig configuration")
+		os.Exit(1)
+	}
+	if err := operatorConfig.Quota.Validate(); err != nil {
+		setupLog.Error(err, "invalid quota configuration")
+		os.Exit(1)
+	}
+
 	// Register Tenant controller
 	if err = (&controller.TenantReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
 		Log:    ctrl.Log.WithName("controllers").WithName("Tenant"),
+		Audit: &audit.Recorder{
+			Client:    mgr.GetClient(),
+			Namespace: controller.OperatorNamespace,
+			Archive:   archive,
+		},
+		Archive:  archive,
+		Config:   operatorConfig,
+		Recorder: mgr.GetEventRecorderFor("tenant-master"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Tenant")
 		os.Exit(1)
 	}
 
+	// Register TenantSet controller
+	if err = (&controller.TenantSetReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Log:    ctrl.Log.WithName("controllers").WithName("TenantSet"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantSet")
+		os.Exit(1)
+	}
+
+	// Register TenantAccessRequest (break-glass) controller
+	if err = (&controller.TenantAccessRequestReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Log:    ctrl.Log.WithName("controllers").WithName("TenantAccessRequest"),
+		Audit: &audit.Recorder{
+			Client:    mgr.GetClient(),
+			Namespace: controller.OperatorNamespace,
+			Archive:   archive,
+		},
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantAccessRequest")
+		os.Exit(1)
+	}
+
+	// Register TenantMigration (cross-cluster move) controller
+	if err = (&controller.TenantMigrationReconciler{
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Log:     ctrl.Log.WithName("controllers").WithName("TenantMigration"),
+		Archive: archive,
+		Audit: &audit.Recorder{
+			Client:    mgr.GetClient(),
+			Namespace: controller.OperatorNamespace,
+			Archive:   archive,
+		},
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantMigration")
+		os.Exit(1)
+	}
+
+	// Register TenantSnapshot controller (snapshots requested by admins)
+	if err = (&controller.TenantSnapshotReconciler{
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Log:     ctrl.Log.WithName("controllers").WithName("TenantSnapshot"),
+		Archive: archive,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantSnapshot")
+		os.Exit(1)
+	}
+
+	// Register Event mirroring from tenant namespaces onto Tenants
+	if err = (&controller.EventMirrorReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("EventMirror"),
+		Recorder: mgr.GetEventRecorderFor("tenant-master"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "EventMirror")
+		os.Exit(1)
+	}
+
+	// Notifications for usage digests, stuck tenant escalations, and cleanup and expiry notices
+	var notifier notify.Notifier = &notify.LogNotifier{Log: ctrl.Log.WithName("notify")}
+	if operatorConfig.Notify.SMTPAddr != "" {
+		notifier = &notify.SMTPNotifier{
+			Addr:     operatorConfig.Notify.SMTPAddr,
+			From:     operatorConfig.Notify.SMTPFrom,
+			Username: operatorConfig.Notify.SMTPUsername,
+			Password: os.Getenv("SMTP_PASSWORD"),
+		}
+	}
+
+	// Scheduled usage digests for tenant owners
+	if err = mgr.Add(&controller.DigestSender{
+		Client:   mgr.GetClient(),
+		Notifier: notifier,
+		Config:   operatorConfig,
+		Log:      ctrl.Log.WithName("digest"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add digest sender")
+		os.Exit(1)
+	}
+
+	// Alerting on tenants that exceed their tier's provisioning SLA
+	if err = mgr.Add(&controller.StuckTenantDetector{
+		Client:   mgr.GetClient(),
+		Recorder: mgr.GetEventRecorderFor("tenant-master"),
+		Notifier: notifier,
+		Config:   operatorConfig,
+		Log:      ctrl.Log.WithName("stuck"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add stuck tenant detector")
+		os.Exit(1)
+	}
+
+	// Renewal of tenant kubeconfig credentials before they expire
+	if err = mgr.Add(&controller.KubeconfigRotator{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("tenant-master"),
+		Config:   operatorConfig,
+		Log:      ctrl.Log.WithName("kubeconfig-rotation"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add kubeconfig rotator")
+		os.Exit(1)
+	}
+
+	// Scheduled tenant snapshots (spec.backup) and their retention
+	if err = mgr.Add(&controller.BackupScheduler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("tenant-master"),
+		Archive:  archive,
+		Log:      ctrl.Log.WithName("backup"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add backup scheduler")
+		os.Exit(1)
+	}
+
+	// Per-zone placement of tenant pods for capacity planning and charging
+	if err = mgr.Add(&controller.ZoneUsageReporter{
+		Client: mgr.GetClient(),
+		Config: operatorConfig,
+		Log:    ctrl.Log.WithName("zone-usage"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add zone usage reporter")
+		os.Exit(1)
+	}
+
+	// Live ResourceQuota consumption in status.resourceUsage between reconciles
+	if err = mgr.Add(&controller.ResourceUsageReporter{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("resource-usage"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add resource usage reporter")
+		os.Exit(1)
+	}
+
+	// Actual CPU and memory use of tenant pods from metrics-server
+	if err = mgr.Add(&controller.UtilizationCollector{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("utilization"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add utilization collector")
+		os.Exit(1)
+	}
+
+	// Chargeback metering of requested resources at per-tier unit prices
+	if operatorConfig.Metering.Interval > 0 {
+		if err = mgr.Add(&controller.Meter{
+			Client:  mgr.GetClient(),
+			Config:  operatorConfig,
+			Archive: archive,
+			Log:     ctrl.Log.WithName("metering"),
+		}); err != nil {
+			setupLog.Error(err, "unable to add usage meter")
+			os.Exit(1)
+		}
+	}
+
+	// Optional cleanup of tenants that stay Failed beyond their retention
+	if err = mgr.Add(&controller.FailedTenantCleaner{
+		Client:   mgr.GetClient(),
+		Recorder: mgr.GetEventRecorderFor("tenant-master"),
+		Notifier: notifier,
+		Audit: &audit.Recorder{
+			Client:    mgr.GetClient(),
+			Namespace: controller.OperatorNamespace,
+			Archive:   archive,
+		},
+		Config: operatorConfig,
+		Log:    ctrl.Log.WithName("failed-cleanup"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add failed tenant cleaner")
+		os.Exit(1)
+	}
+
+	// Deletion of ephemeral tenants whose spec.ttl ran out
+	if err = mgr.Add(&controller.TenantExpirer{
+		Client:   mgr.GetClient(),
+		Recorder: mgr.GetEventRecorderFor("tenant-master"),
+		Notifier: notifier,
+		Audit: &audit.Recorder{
+			Client:    mgr.GetClient(),
+			Namespace: controller.OperatorNamespace,
+			Archive:   archive,
+		},
+		Config: operatorConfig,
+		Log:    ctrl.Log.WithName("expiry"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add tenant expirer")
+		os.Exit(1)
+	}
+
 	// Register webhooks (only if webhooks are enabled)
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
 		// Mutating webhook
-		if err = (&mutating.TenantMutatingWebhook{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&mutating.TenantMutatingWebhook{Client: mgr.GetClient()}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Tenant mutating")
 			os.Exit(1)
 		}
 
 		// Validating webhook
-		if err = (&validating.TenantValidatingWebhook{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&validating.TenantValidatingWebhook{
+			Client:                mgr.GetClient(),
+			LimitsOvercommitRatio: operatorConfig.Quota.LimitsOvercommitRatio,
+		}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Tenant validating")
 			os.Exit(1)
 		}
+
+		// Service exposure webhook (tenant namespaces only)
+		if err = (&validating.ServiceValidatingWebhook{Client: mgr.GetClient()}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Service validating")
+			os.Exit(1)
+		}
+
+		// Privileged workload webhook (tenant namespaces only)
+		if err = (&validating.PodValidatingWebhook{Client: mgr.GetClient()}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Pod validating")
+			os.Exit(1)
+		}
+
+		// Tenant and tier labels on workloads (tenant namespaces only)
+		if err = (&mutating.PodMutatingWebhook{Client: mgr.GetClient()}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Pod mutating")
+			os.Exit(1)
+		}
+
+		// RBAC escalation webhook (tenant namespaces only)
+		if err = (&validating.RBACValidatingWebhook{
+			Client:      mgr.GetClient(),
+			ExemptUsers: []string{operatorServiceAccount},
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "RBAC validating")
+			os.Exit(1)
+		}
+
+		// Bronze ownership webhook (shared Bronze namespace only)
+		if err = (&validating.BronzeOwnershipValidatingWebhook{Client: mgr.GetClient()}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Bronze ownership validating")
+			os.Exit(1)
+		}
+
+		// Managed object protection webhook (tenant namespaces only)
+		if err = (&validating.ManagedObjectValidatingWebhook{
+			ExemptUsers: []string{operatorServiceAccount},
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "managed object validating")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("webhooks disabled; only the CRD validation rules check Tenants")
 	}
 
 	// Setup health check
@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records tenant lifecycle events as an append-only audit trail.
+// Entries are stored as ConfigMaps in the operator namespace so they outlive the
+// tenant they describe, and optionally archived to external storage.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+)
+
+const (
+	// TenantLabelKey links an audit entry to its tenant.
+	TenantLabelKey = "tenant.platform.io/name"
+
+	// ActionLabelKey records the audited action on the entry.
+	ActionLabelKey = "tenant.platform.io/audit-action"
+
+	// TypeLabelKey/TypeLabelValue mark ConfigMaps as audit entries.
+	TypeLabelKey   = "type"
+	TypeLabelValue = "audit"
+
+	// EntryDataKey is the ConfigMap key holding the JSON-encoded entry.
+	EntryDataKey = "entry.json"
+)
+
+// Audited actions.
+const (
+	ActionDeletion   = "deletion"
+	ActionBurstStart = "burst-start"
+	ActionBurstEnd   = "burst-end"
+
+	ActionAccessGranted = "access-granted"
+	ActionAccessRevoked = "access-revoked"
+	ActionAccessDenied  = "access-denied"
+
+	ActionCredentialsRotated = "credentials-rotated"
+
+	ActionFailedCleanup = "failed-cleanup"
+	ActionExpired       = "expired"
+
+	ActionSuspended = "suspended"
+	ActionResumed   = "resumed"
+
+	ActionMigrated            = "migrated"
+	ActionMigrationRolledBack = "migration-rolled-back"
+)
+
+// Entry is a single audit record.
+type Entry struct {
+	// Timestamp is when the audited action happened.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Tenant is the name of the tenant the action applies to.
+	Tenant string `json:"tenant"`
+
+	// Action is a short machine-readable action name (e.g. "deletion").
+	Action string `json:"action"`
+
+	// Actor identifies who triggered the action, if known.
+	Actor string `json:"actor,omitempty"`
+
+	// Message is a human-readable summary.
+	Message string `json:"message,omitempty"`
+
+	// Details carries an action-specific payload.
+	Details interface{} `json:"details,omitempty"`
+}
+
+// Recorder writes audit entries to the operator namespace.
+type Recorder struct {
+	Client    client.Client
+	Namespace string
+
+	// Archive additionally stores each entry as "audit/<tenant>/<name>.json", so the
+	// trail survives the cluster. Optional.
+	Archive storage.Storage
+}
+
+// Record persists an entry and returns the name of the stored object.
+func (r *Recorder) Record(ctx context.Context, entry Entry) (string, error) {
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now().UTC()
+	}
+
+	payload, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+
+	name := fmt.Sprintf("audit-%s-%s-%d", entry.Tenant, strings.ToLower(entry.Action), entry.Timestamp.UnixNano())
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: r.Namespace,
+			Labels: map[string]string{
+				TenantLabelKey: entry.Tenant,
+				ActionLabelKey: entry.Action,
+				TypeLabelKey:   TypeLabelValue,
+			},
+		},
+		Data: map[string]string{
+			EntryDataKey: string(payload),
+		},
+	}
+
+	if err := r.Client.Create(ctx, cm); err != nil {
+		return "", fmt.Errorf("failed to store audit entry: %w", err)
+	}
+	if r.Archive != nil {
+		if err := r.Archive.Put(ctx, "audit/"+entry.Tenant+"/"+name+".json", payload); err != nil {
+			return name, fmt.Errorf("failed to archive audit entry %s: %w", name, err)
+		}
+	}
+	return name, nil
+}
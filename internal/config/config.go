@@ -0,0 +1,743 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config holds operator-wide settings that tune controller behaviour.
+// Values are populated from command-line flags in cmd/main.go.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RequeuePolicy controls how long the controller waits before retrying a failed
+// reconciliation, per class of error. A zero duration means "do not retry" —
+// the tenant is only reconciled again when it changes.
+type RequeuePolicy struct {
+	// Capacity applies when the cluster or quota cannot fit the tenant.
+	Capacity time.Duration
+
+	// Transient applies to API server errors expected to clear on their own
+	// (timeouts, conflicts, throttling, unavailability).
+	Transient time.Duration
+
+	// Validation applies when the tenant spec itself is invalid.
+	Validation time.Duration
+
+	// Default applies to any error that does not fit a more specific class.
+	Default time.Duration
+
+	// Pending is how often a tenant is checked again while it waits for a child
+	// resource to become ready, such as the Gold tier vCluster, without failing.
+	Pending time.Duration
+
+	// Resync is how often a reconciled tenant is reconciled again without a change,
+	// reverting drift in child objects whose watch events were missed.
+	Resync time.Duration
+}
+
+// NotifyConfig configures the SMTP relay used for notifications. Without an
+// address, notifications are only logged. The SMTP password is read from the
+// SMTP_PASSWORD environment variable.
+type NotifyConfig struct {
+	SMTPAddr     string
+	SMTPFrom     string
+	SMTPUsername string
+}
+
+// DigestConfig controls the scheduled usage digests sent to tenant owners.
+type DigestConfig struct {
+	// DefaultEnabled sends digests to tenants that do not set
+	// spec.notifications.digest themselves.
+	DefaultEnabled bool
+
+	// Interval is the time between two digests for the same tenant.
+	Interval time.Duration
+
+	// CPUCoreHourCost and MemoryGiBHourCost price the cost estimate.
+	CPUCoreHourCost   float64
+	MemoryGiBHourCost float64
+}
+
+// LoggingConfig holds the log backends that spec.logging routes tenant logs to.
+type LoggingConfig struct {
+	// LokiURL is the Loki push endpoint (e.g., http://loki.logging:3100/loki/api/v1/push).
+	LokiURL string
+
+	// ElasticsearchURL is the Elasticsearch endpoint (e.g., https://es.logging:9200).
+	ElasticsearchURL string
+}
+
+// StuckConfig controls alerting on tenants that do not finish provisioning.
+type StuckConfig struct {
+	// BronzeSLA, SilverSLA, GoldSLA, and PlatinumSLA are the longest a tenant of each
+	// tier may spend provisioning before it is flagged as stuck. Zero disables the check.
+	BronzeSLA   time.Duration
+	SilverSLA   time.Duration
+	GoldSLA     time.Duration
+	PlatinumSLA time.Duration
+
+	// EscalationRecipients are emailed when a tenant is first flagged as stuck.
+	// Without recipients, stuck tenants only get an Event and a metric.
+	EscalationRecipients []string
+}
+
+// Pod Security Admission levels the PodSecurityConfig can enforce.
+const (
+	PodSecurityPrivileged = "privileged"
+	PodSecurityBaseline   = "baseline"
+	PodSecurityRestricted = "restricted"
+)
+
+// PodSecurityConfig maps each tier to the Pod Security Admission level enforced in its
+// namespaces. An empty level leaves the namespaces without an enforce label.
+type PodSecurityConfig struct {
+	Bronze string
+	Silver string
+	// Gold applies to the vCluster host namespace, where the synced pods run.
+	Gold string
+}
+
+// Actions the FailedCleanupConfig can take on tenants left in the Failed state.
+const (
+	FailedCleanupDelete  = "Delete"
+	FailedCleanupSuspend = "Suspend"
+)
+
+// FailedCleanupConfig controls the automatic cleanup of tenants that stay Failed.
+type FailedCleanupConfig struct {
+	// Action is FailedCleanupDelete or FailedCleanupSuspend. Empty disables cleanup.
+	Action string
+
+	// Retention is how long a tenant may stay Failed before the action is taken.
+	Retention time.Duration
+
+	// Notice is how long before the action the owner is notified.
+	Notice time.Duration
+}
+
+// ExpiryConfig controls the deletion of tenants whose spec.ttl has run out.
+type ExpiryConfig struct {
+	// Grace is how long an expired tenant is kept, after its owner is notified, before
+	// it is deleted.
+	Grace time.Duration
+}
+
+// IP families the tenant NetworkPolicies can be generated for.
+const (
+	IPFamilyIPv4 = "IPv4"
+	IPFamilyIPv6 = "IPv6"
+)
+
+// NetworkConfig controls the NetworkPolicies generated for tenant namespaces.
+type NetworkConfig struct {
+	// IPFamilies are the IP families of the cluster. Internet egress and cloud metadata
+	// blocking get a CIDR rule per family, so dual-stack clusters must list both.
+	IPFamilies []string
+
+	// PrivateCIDRs are excluded from internet egress, so allowInternetAccess does not
+	// reopen other tenants' pods or the node network.
+	PrivateCIDRs []string
+
+	// ServiceCIDRs are the cluster's Service CIDRs, likewise excluded from internet
+	// egress. Only needed when they lie outside PrivateCIDRs.
+	ServiceCIDRs []string
+}
+
+// RFC1918CIDRs are the private IPv4 ranges excluded from internet egress by default.
+var RFC1918CIDRs = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
+// ZoneUsageConfig controls the per-zone usage reported for tenants.
+type ZoneUsageConfig struct {
+	// NodePoolLabels are the node labels naming a node's pool, tried in order.
+	NodePoolLabels []string
+}
+
+// UnitPrices are the prices of one hour of each metered resource.
+type UnitPrices struct {
+	CPUCoreHour    float64
+	MemoryGiBHour  float64
+	StorageGiBHour float64
+}
+
+// String renders the prices in the format read by ParseUnitPrices.
+func (p UnitPrices) String() string {
+	return fmt.Sprintf("cpu=%g,memory=%g,storage=%g", p.CPUCoreHour, p.MemoryGiBHour, p.StorageGiBHour)
+}
+
+// ParseUnitPrices parses prices such as "cpu=0.04,memory=0.005,storage=0.00014".
+// Resources left out are free.
+func ParseUnitPrices(v string) (UnitPrices, error) {
+	var p UnitPrices
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(item, "=")
+		if !ok {
+			return p, fmt.Errorf("invalid price %q; must be resource=price", item)
+		}
+		price, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || price < 0 {
+			return p, fmt.Errorf("invalid price %q; must be a non-negative number", value)
+		}
+		switch strings.TrimSpace(name) {
+		case "cpu":
+			p.CPUCoreHour = price
+		case "memory":
+			p.MemoryGiBHour = price
+		case "storage":
+			p.StorageGiBHour = price
+		default:
+			return p, fmt.Errorf("unknown resource %q; must be cpu, memory, or storage", name)
+		}
+	}
+	return p, nil
+}
+
+// MeteringConfig controls the metering of tenant resource usage for chargeback.
+type MeteringConfig struct {
+	// Interval is how often usage is sampled. Zero disables metering.
+	Interval time.Duration
+
+	// Bronze, Silver, and Gold are the unit prices of each tier. Platinum tenants run
+	// on dedicated clusters, which are billed for by their provider, and are not metered.
+	Bronze UnitPrices
+	Silver UnitPrices
+	Gold   UnitPrices
+
+	// ReportRetention is how long daily usage reports are kept in the operator
+	// namespace. Monthly reports and archived reports are kept. Zero keeps them all.
+	ReportRetention time.Duration
+}
+
+// Prices returns the unit prices of a tier, or zero prices for an unmetered tier.
+func (c MeteringConfig) Prices(tier string) UnitPrices {
+	switch tier {
+	case "Bronze":
+		return c.Bronze
+	case "Silver":
+		return c.Silver
+	case "Gold":
+		return c.Gold
+	}
+	return UnitPrices{}
+}
+
+// Backends the StorageConfig can archive snapshots and audit entries to.
+const (
+	StorageFilesystem = "Filesystem"
+	StorageS3         = "S3"
+	StorageGCS        = "GCS"
+	StorageAzureBlob  = "AzureBlob"
+)
+
+// StorageConfig selects where tenant snapshots and audit entries are archived outside
+// the cluster. Credentials are read from the environment: AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY, and AWS_SESSION_TOKEN for S3; GCS_HMAC_ACCESS_ID and
+// GCS_HMAC_SECRET for GCS; AZURE_STORAGE_ACCOUNT and AZURE_STORAGE_KEY for AzureBlob.
+type StorageConfig struct {
+	// Backend is one of the Storage* constants. Empty keeps archives in the cluster only.
+	Backend string
+
+	// Path is the directory of the Filesystem backend, e.g. a mounted PVC.
+	Path string
+
+	// Bucket is the S3 or GCS bucket, or the Azure Blob container.
+	Bucket string
+
+	// Prefix is prepended to every object key.
+	Prefix string
+
+	// Endpoint overrides the service URL, e.g. for MinIO or another S3-compatible store.
+	Endpoint string
+
+	// Region is the S3 bucket region.
+	Region string
+}
+
+// KubeconfigConfig controls the kubeconfigs issued to Silver tier tenants, which
+// authenticate as the tenant ServiceAccount with a token from the TokenRequest API,
+// and the renewal of Gold tier vCluster client certificates.
+type KubeconfigConfig struct {
+	// APIServer is the API server URL written into the kubeconfigs.
+	APIServer string
+
+	// TokenTTL is the lifetime of the ServiceAccount tokens. Kubeconfigs are reissued
+	// when less than a fifth of it remains.
+	TokenTTL time.Duration
+
+	// CertRenewBefore is how long before its client certificate expires a Gold tier
+	// vCluster is restarted to issue a new one.
+	CertRenewBefore time.Duration
+}
+
+// MinKubeconfigTokenTTL is the shortest token lifetime the TokenRequest API accepts.
+const MinKubeconfigTokenTTL = 10 * time.Minute
+
+// Validate checks the API server URL, the token lifetime, and the certificate renewal window.
+func (c KubeconfigConfig) Validate() error {
+	if !strings.HasPrefix(c.APIServer, "https://") {
+		return fmt.Errorf("kubeconfig API server %q must be an https URL", c.APIServer)
+	}
+	if c.TokenTTL < MinKubeconfigTokenTTL {
+		return fmt.Errorf("kubeconfig token TTL %s is shorter than %s", c.TokenTTL, MinKubeconfigTokenTTL)
+	}
+	if c.CertRenewBefore <= 0 {
+		return fmt.Errorf("vCluster certificate renewal window %s must be positive", c.CertRenewBefore)
+	}
+	return nil
+}
+
+// QuotaConfig controls how tenant ResourceQuotas are rendered.
+type QuotaConfig struct {
+	// LimitsOvercommitRatio multiplies a tenant's CPU and memory requests to give the
+	// limits side of its quota when spec.resources.limits leaves it unset. 1 keeps
+	// limits equal to requests.
+	LimitsOvercommitRatio float64
+}
+
+// Validate rejects ratios below 1, which would cap limits below requests.
+func (c QuotaConfig) Validate() error {
+	if c.LimitsOvercommitRatio < 1 {
+		return fmt.Errorf("limits overcommit ratio %g must be at least 1", c.LimitsOvercommitRatio)
+	}
+	return nil
+}
+
+// Sources the vCluster Helm chart can be installed from.
+const (
+	VClusterChartRepository = "Repository"
+	VClusterChartOCI        = "OCI"
+	VClusterChartBundled    = "Bundled"
+)
+
+// VClusterChartConfig selects the Helm chart Gold tier vClusters are installed from.
+// Air-gapped clusters point it at an internal chart repository or OCI registry, or at
+// the chart bundled with the operator, and at a mirror of the vCluster image.
+type VClusterChartConfig struct {
+	// Source is one of the VClusterChart* constants.
+	Source string
+
+	// Repository is the chart repository URL (Repository) or the oci:// reference of
+	// the chart's parent in the registry (OCI).
+	Repository string
+
+	// Name and Version identify the chart. Version is also the vCluster image tag.
+	Name    string
+	Version string
+
+	// BundledPath is the chart archive used by the Bundled source.
+	BundledPath string
+
+	// CredentialsSecret names a Secret in the operator namespace with the username and
+	// password keys for the chart repository or registry, and optionally ca.crt.
+	CredentialsSecret string
+
+	// ImageRepository is the vCluster image, e.g. on an internal registry mirror.
+	ImageRepository string
+}
+
+// Validate checks that the chart source is complete and, for the Bundled source, that
+// the chart archive is readable.
+func (c VClusterChartConfig) Validate() error {
+	if c.Name == "" || c.Version == "" {
+		return fmt.Errorf("vCluster chart name and version are required")
+	}
+	switch c.Source {
+	case VClusterChartRepository:
+		if !strings.HasPrefix(c.Repository, "http://") && !strings.HasPrefix(c.Repository, "https://") {
+			return fmt.Errorf("vCluster chart repository %q must be an http(s) URL", c.Repository)
+		}
+	case VClusterChartOCI:
+		if !strings.HasPrefix(c.Repository, "oci://") {
+			return fmt.Errorf("vCluster chart registry %q must start with oci://", c.Repository)
+		}
+	case VClusterChartBundled:
+		f, err := os.Open(c.BundledPath)
+		if err != nil {
+			return fmt.Errorf("bundled vCluster chart is not readable: %w", err)
+		}
+		return f.Close()
+	default:
+		return fmt.Errorf("unknown vCluster chart source %q", c.Source)
+	}
+	return nil
+}
+
+// ClusterAPIConfig holds the defaults of the dedicated workload clusters of Platinum
+// tier tenants, created from a Cluster API ClusterClass. A tenant's spec.cluster
+// overrides them.
+type ClusterAPIConfig struct {
+	// ClusterClass is the ClusterClass clusters are created from. Platinum tenants
+	// cannot be provisioned without one, from here or from spec.cluster.class.
+	ClusterClass string
+
+	// KubernetesVersion of the control plane and nodes.
+	KubernetesVersion string
+
+	// WorkerClass is the worker machine deployment class of the ClusterClass.
+	WorkerClass string
+}
+
+// GitOpsConfig locates the GitOps controller that spec.gitops hands tenants to.
+type GitOpsConfig struct {
+	// ArgoCDNamespace is the namespace Argo CD watches for AppProjects and Applications.
+	ArgoCDNamespace string
+}
+
+// OperatorConfig is the top-level operator configuration.
+type OperatorConfig struct {
+	Requeue RequeuePolicy
+	Notify  NotifyConfig
+	Digest  DigestConfig
+	Logging LoggingConfig
+	Stuck   StuckConfig
+	Cleanup FailedCleanupConfig
+	Expiry  ExpiryConfig
+
+	PodSecurity PodSecurityConfig
+	Network     NetworkConfig
+	Storage     StorageConfig
+	Chart       VClusterChartConfig
+	ClusterAPI  ClusterAPIConfig
+	GitOps      GitOpsConfig
+
+	Kubeconfig KubeconfigConfig
+	Quota      QuotaConfig
+	ZoneUsage  ZoneUsageConfig
+	Metering   MeteringConfig
+}
+
+// Default returns the configuration used when no flags are set.
+func Default() *OperatorConfig {
+	return &OperatorConfig{
+		Requeue: RequeuePolicy{
+			Capacity:   5 * time.Minute,
+			Transient:  15 * time.Second,
+			Validation: 0,
+			Default:    30 * time.Second,
+			Pending:    10 * time.Second,
+			Resync:     10 * time.Minute,
+		},
+		Notify: NotifyConfig{
+			SMTPFrom: "tenant-master@localhost",
+		},
+		Digest: DigestConfig{
+			DefaultEnabled:    false,
+			Interval:          7 * 24 * time.Hour,
+			CPUCoreHourCost:   0.04,
+			MemoryGiBHourCost: 0.005,
+		},
+		Stuck: StuckConfig{
+			BronzeSLA:   5 * time.Minute,
+			SilverSLA:   10 * time.Minute,
+			GoldSLA:     30 * time.Minute,
+			PlatinumSLA: 60 * time.Minute,
+		},
+		Cleanup: FailedCleanupConfig{
+			Retention: 7 * 24 * time.Hour,
+			Notice:    24 * time.Hour,
+		},
+		Expiry: ExpiryConfig{
+			Grace: 24 * time.Hour,
+		},
+		PodSecurity: PodSecurityConfig{
+			Bronze: PodSecurityRestricted,
+			Silver: PodSecurityRestricted,
+			Gold:   PodSecurityBaseline,
+		},
+		Network: NetworkConfig{
+			IPFamilies:   []string{IPFamilyIPv4},
+			PrivateCIDRs: RFC1918CIDRs,
+		},
+		Storage: StorageConfig{
+			Region: "us-east-1",
+		},
+		Chart: VClusterChartConfig{
+			Source:          VClusterChartRepository,
+			Repository:      "https://charts.loft.sh",
+			Name:            "vcluster",
+			Version:         "0.15.0",
+			BundledPath:     "/charts/vcluster.tgz",
+			ImageRepository: "loftsh/vcluster",
+		},
+		ClusterAPI: ClusterAPIConfig{
+			KubernetesVersion: "v1.29.0",
+			WorkerClass:       "default-worker",
+		},
+		GitOps: GitOpsConfig{
+			ArgoCDNamespace: "argocd",
+		},
+		Kubeconfig: KubeconfigConfig{
+			APIServer:       "https://kubernetes.default.svc",
+			TokenTTL:        24 * time.Hour,
+			CertRenewBefore: 30 * 24 * time.Hour,
+		},
+		Quota: QuotaConfig{
+			LimitsOvercommitRatio: 1,
+		},
+		ZoneUsage: ZoneUsageConfig{
+			NodePoolLabels: []string{
+				"cloud.google.com/gke-nodepool",
+				"eks.amazonaws.com/nodegroup",
+				"kubernetes.azure.com/agentpool",
+				"karpenter.sh/nodepool",
+			},
+		},
+		Metering: MeteringConfig{
+			Interval: 5 * time.Minute,
+			Bronze:   UnitPrices{CPUCoreHour: 0.03, MemoryGiBHour: 0.004, StorageGiBHour: 0.00014},
+			Silver:   UnitPrices{CPUCoreHour: 0.04, MemoryGiBHour: 0.005, StorageGiBHour: 0.00014},
+			Gold:     UnitPrices{CPUCoreHour: 0.05, MemoryGiBHour: 0.006, StorageGiBHour: 0.00014},
+
+			ReportRetention: 90 * 24 * time.Hour,
+		},
+	}
+}
+
+// BindFlags registers the configuration flags on fs, using the current values as defaults.
+func (c *OperatorConfig) BindFlags(fs *flag.FlagSet) {
+	fs.DurationVar(&c.Requeue.Capacity, "requeue-after-capacity", c.Requeue.Capacity,
+		"Retry interval after capacity/quota errors (0 disables retry).")
+	fs.DurationVar(&c.Requeue.Transient, "requeue-after-transient", c.Requeue.Transient,
+		"Retry interval after transient API server errors (0 disables retry).")
+	fs.DurationVar(&c.Requeue.Validation, "requeue-after-validation", c.Requeue.Validation,
+		"Retry interval after tenant spec validation errors (0 disables retry).")
+	fs.DurationVar(&c.Requeue.Default, "requeue-after-default", c.Requeue.Default,
+		"Retry interval after unclassified errors (0 disables retry).")
+	fs.DurationVar(&c.Requeue.Pending, "requeue-after-pending", c.Requeue.Pending,
+		"Interval between readiness checks while a tenant waits for its vCluster to start.")
+	fs.DurationVar(&c.Requeue.Resync, "requeue-after-resync", c.Requeue.Resync,
+		"Interval at which reconciled tenants are reconciled again to revert drift (0 disables).")
+
+	fs.StringVar(&c.Notify.SMTPAddr, "smtp-address", c.Notify.SMTPAddr,
+		"SMTP relay (host:port) for tenant notifications. Notifications are only logged when empty.")
+	fs.StringVar(&c.Notify.SMTPFrom, "smtp-from", c.Notify.SMTPFrom,
+		"Sender address for tenant notifications.")
+	fs.StringVar(&c.Notify.SMTPUsername, "smtp-username", c.Notify.SMTPUsername,
+		"SMTP username; the password is read from SMTP_PASSWORD.")
+
+	fs.BoolVar(&c.Digest.DefaultEnabled, "digest-default-enabled", c.Digest.DefaultEnabled,
+		"Send usage digests to tenants that do not set spec.notifications.digest.")
+	fs.DurationVar(&c.Digest.Interval, "digest-interval", c.Digest.Interval,
+		"Interval between usage digests for the same tenant.")
+	fs.Float64Var(&c.Digest.CPUCoreHourCost, "digest-cpu-core-hour-cost", c.Digest.CPUCoreHourCost,
+		"Price of one CPU core for one hour, used for digest cost estimates.")
+	fs.Float64Var(&c.Digest.MemoryGiBHourCost, "digest-memory-gib-hour-cost", c.Digest.MemoryGiBHourCost,
+		"Price of one GiB of memory for one hour, used for digest cost estimates.")
+
+	fs.StringVar(&c.Logging.LokiURL, "logging-loki-url", c.Logging.LokiURL,
+		"Loki push endpoint for tenants with spec.logging.backend=Loki.")
+	fs.StringVar(&c.Logging.ElasticsearchURL, "logging-elasticsearch-url", c.Logging.ElasticsearchURL,
+		"Elasticsearch endpoint for tenants with spec.logging.backend=Elasticsearch.")
+
+	fs.DurationVar(&c.Stuck.BronzeSLA, "stuck-sla-bronze", c.Stuck.BronzeSLA,
+		"Provisioning time after which a Bronze tenant is flagged as stuck (0 disables).")
+	fs.DurationVar(&c.Stuck.SilverSLA, "stuck-sla-silver", c.Stuck.SilverSLA,
+		"Provisioning time after which a Silver tenant is flagged as stuck (0 disables).")
+	fs.DurationVar(&c.Stuck.GoldSLA, "stuck-sla-gold", c.Stuck.GoldSLA,
+		"Provisioning time after which a Gold tenant is flagged as stuck (0 disables).")
+	fs.DurationVar(&c.Stuck.PlatinumSLA, "stuck-sla-platinum", c.Stuck.PlatinumSLA,
+		"Provisioning time after which a Platinum tenant is flagged as stuck (0 disables).")
+	fs.Func("stuck-escalation-recipients",
+		"Comma-separated email addresses notified when a tenant is flagged as stuck.",
+		func(v string) error {
+			c.Stuck.EscalationRecipients = nil
+			for _, addr := range strings.Split(v, ",") {
+				if addr = strings.TrimSpace(addr); addr != "" {
+					c.Stuck.EscalationRecipients = append(c.Stuck.EscalationRecipients, addr)
+				}
+			}
+			return nil
+		})
+
+	fs.Func("failed-tenant-cleanup",
+		"Action taken on tenants that stay Failed beyond --failed-tenant-retention: Delete or Suspend (default: none).",
+		func(v string) error {
+			switch v {
+			case "", FailedCleanupDelete, FailedCleanupSuspend:
+				c.Cleanup.Action = v
+				return nil
+			}
+			return fmt.Errorf("must be %s or %s", FailedCleanupDelete, FailedCleanupSuspend)
+		})
+	fs.DurationVar(&c.Cleanup.Retention, "failed-tenant-retention", c.Cleanup.Retention,
+		"How long a tenant may stay Failed before --failed-tenant-cleanup applies.")
+	fs.DurationVar(&c.Cleanup.Notice, "failed-tenant-notice", c.Cleanup.Notice,
+		"How long before the cleanup the tenant owner is notified.")
+	fs.DurationVar(&c.Expiry.Grace, "tenant-expiry-grace", c.Expiry.Grace,
+		"How long a tenant whose spec.ttl ran out is kept, after its owner is notified, before it is deleted.")
+
+	for _, tier := range []struct {
+		name  string
+		level *string
+	}{{"bronze", &c.PodSecurity.Bronze}, {"silver", &c.PodSecurity.Silver}, {"gold", &c.PodSecurity.Gold}} {
+		fs.Func("pod-security-"+tier.name,
+			fmt.Sprintf("Pod Security Admission level enforced in %s tier namespaces: privileged, baseline, or restricted (default: %s; empty disables).",
+				tier.name, *tier.level),
+			func(v string) error {
+				switch v {
+				case "", PodSecurityPrivileged, PodSecurityBaseline, PodSecurityRestricted:
+					*tier.level = v
+					return nil
+				}
+				return fmt.Errorf("must be %s, %s, or %s", PodSecurityPrivileged, PodSecurityBaseline, PodSecurityRestricted)
+			})
+	}
+
+	fs.Func("network-ip-families",
+		"Comma-separated IP families of the cluster (IPv4, IPv6, or IPv4,IPv6 for dual-stack) that tenant NetworkPolicy CIDR rules are generated for (default: IPv4).",
+		func(v string) error {
+			var families []string
+			for _, family := range strings.Split(v, ",") {
+				switch family = strings.TrimSpace(family); family {
+				case IPFamilyIPv4, IPFamilyIPv6:
+					families = append(families, family)
+				default:
+					return fmt.Errorf("unknown IP family %q; must be %s or %s", family, IPFamilyIPv4, IPFamilyIPv6)
+				}
+			}
+			c.Network.IPFamilies = families
+			return nil
+		})
+	fs.Func("network-private-cidrs",
+		"Comma-separated CIDRs excluded from tenant internet egress (default: 10.0.0.0/8,172.16.0.0/12,192.168.0.0/16; empty excludes none).",
+		func(v string) (err error) {
+			c.Network.PrivateCIDRs, err = parseCIDRList(v)
+			return err
+		})
+	fs.Func("network-service-cidrs",
+		"Comma-separated cluster Service CIDRs excluded from tenant internet egress, if outside --network-private-cidrs.",
+		func(v string) (err error) {
+			c.Network.ServiceCIDRs, err = parseCIDRList(v)
+			return err
+		})
+
+	fs.Func("storage-backend",
+		"Archive tenant snapshots and audit entries outside the cluster: Filesystem, S3, GCS, or AzureBlob (default: none).",
+		func(v string) error {
+			switch v {
+			case "", StorageFilesystem, StorageS3, StorageGCS, StorageAzureBlob:
+				c.Storage.Backend = v
+				return nil
+			}
+			return fmt.Errorf("must be %s, %s, %s, or %s", StorageFilesystem, StorageS3, StorageGCS, StorageAzureBlob)
+		})
+	fs.StringVar(&c.Storage.Path, "storage-path", c.Storage.Path,
+		"Directory of the Filesystem storage backend, e.g. a mounted PersistentVolume.")
+	fs.StringVar(&c.Storage.Bucket, "storage-bucket", c.Storage.Bucket,
+		"S3 or GCS bucket, or Azure Blob container, of the storage backend.")
+	fs.StringVar(&c.Storage.Prefix, "storage-prefix", c.Storage.Prefix,
+		"Prefix prepended to every object key in the storage backend.")
+	fs.StringVar(&c.Storage.Endpoint, "storage-endpoint", c.Storage.Endpoint,
+		"Service URL of the storage backend, e.g. for MinIO or another S3-compatible store.")
+	fs.StringVar(&c.Storage.Region, "storage-region", c.Storage.Region,
+		"Region of the S3 bucket.")
+
+	fs.Func("vcluster-chart-source",
+		"Where Gold tier vClusters are installed from: Repository, OCI, or Bundled (default: Repository).",
+		func(v string) error {
+			switch v {
+			case VClusterChartRepository, VClusterChartOCI, VClusterChartBundled:
+				c.Chart.Source = v
+				return nil
+			}
+			return fmt.Errorf("must be %s, %s, or %s", VClusterChartRepository, VClusterChartOCI, VClusterChartBundled)
+		})
+	fs.StringVar(&c.Chart.Repository, "vcluster-chart-repository", c.Chart.Repository,
+		"vCluster chart repository URL, or oci:// registry reference for the OCI source.")
+	fs.StringVar(&c.Chart.Name, "vcluster-chart-name", c.Chart.Name,
+		"Name of the vCluster chart.")
+	fs.StringVar(&c.Chart.Version, "vcluster-chart-version", c.Chart.Version,
+		"Version of the vCluster chart, also used as the vCluster image tag.")
+	fs.StringVar(&c.Chart.BundledPath, "vcluster-chart-path", c.Chart.BundledPath,
+		"vCluster chart archive used by the Bundled source.")
+	fs.StringVar(&c.Chart.CredentialsSecret, "vcluster-chart-credentials-secret", c.Chart.CredentialsSecret,
+		"Secret in the operator namespace with username, password, and optionally ca.crt for the chart repository or registry.")
+	fs.StringVar(&c.Chart.ImageRepository, "vcluster-image-repository", c.Chart.ImageRepository,
+		"vCluster image repository, e.g. on an internal registry mirror.")
+
+	fs.StringVar(&c.ClusterAPI.ClusterClass, "platinum-cluster-class", c.ClusterAPI.ClusterClass,
+		"Cluster API ClusterClass of Platinum tier dedicated clusters, unless set in spec.cluster.class.")
+	fs.StringVar(&c.ClusterAPI.KubernetesVersion, "platinum-kubernetes-version", c.ClusterAPI.KubernetesVersion,
+		"Kubernetes version of Platinum tier dedicated clusters, unless set in spec.cluster.kubernetesVersion.")
+	fs.StringVar(&c.ClusterAPI.WorkerClass, "platinum-worker-class", c.ClusterAPI.WorkerClass,
+		"Worker machine deployment class of the ClusterClass used for Platinum tier clusters.")
+
+	fs.StringVar(&c.GitOps.ArgoCDNamespace, "argocd-namespace", c.GitOps.ArgoCDNamespace,
+		"Namespace of the Argo CD installation receiving the AppProjects and Applications of tenants with spec.gitops.argocd.")
+
+	fs.StringVar(&c.Kubeconfig.APIServer, "kubeconfig-api-server", c.Kubeconfig.APIServer,
+		"API server URL written into the kubeconfigs issued to Silver tier tenants.")
+	fs.DurationVar(&c.Kubeconfig.TokenTTL, "kubeconfig-token-ttl", c.Kubeconfig.TokenTTL,
+		"Lifetime of the ServiceAccount tokens in Silver tier kubeconfigs (minimum 10m).")
+	fs.DurationVar(&c.Kubeconfig.CertRenewBefore, "kubeconfig-cert-renew-before", c.Kubeconfig.CertRenewBefore,
+		"How long before expiry Gold tier vCluster client certificates are renewed.")
+
+	fs.Float64Var(&c.Quota.LimitsOvercommitRatio, "quota-limits-overcommit-ratio", c.Quota.LimitsOvercommitRatio,
+		"Ratio of a tenant's quota limits to its requests when spec.resources.limits is unset (minimum 1).")
+
+	fs.Func("zone-usage-node-pool-labels",
+		"Comma-separated node labels naming a node's pool, tried in order, for per-zone tenant usage (default: the GKE, EKS, AKS, and Karpenter labels).",
+		func(v string) error {
+			var labels []string
+			for _, label := range strings.Split(v, ",") {
+				if label = strings.TrimSpace(label); label != "" {
+					labels = append(labels, label)
+				}
+			}
+			c.ZoneUsage.NodePoolLabels = labels
+			return nil
+		})
+
+	fs.DurationVar(&c.Metering.Interval, "metering-interval", c.Metering.Interval,
+		"Interval between samples of tenant resource usage for chargeback (0 disables metering).")
+	for _, tier := range []struct {
+		name   string
+		prices *UnitPrices
+	}{{"bronze", &c.Metering.Bronze}, {"silver", &c.Metering.Silver}, {"gold", &c.Metering.Gold}} {
+		fs.Func("metering-price-"+tier.name,
+			fmt.Sprintf("Hourly prices of one requested CPU core, GiB of memory, and GiB of storage in %s tier tenants, as cpu=,memory=,storage= (default: %s).",
+				tier.name, tier.prices),
+			func(v string) (err error) {
+				*tier.prices, err = ParseUnitPrices(v)
+				return err
+			})
+	}
+	fs.DurationVar(&c.Metering.ReportRetention, "usage-report-retention", c.Metering.ReportRetention,
+		"How long daily usage reports are kept in the operator namespace (0 keeps them all).")
+}
+
+// parseCIDRList parses a comma-separated list of CIDRs; an empty list is valid.
+func parseCIDRList(v string) ([]string, error) {
+	var cidrs []string
+	for _, cidr := range strings.Split(v, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q", cidr)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return cidrs, nil
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+)
+
+// TenantAccessRequestReconciler grants time-limited break-glass RoleBindings in tenant
+// namespaces and revokes them at expiry.
+type TenantAccessRequestReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+
+	// Audit records every grant, denial, and revocation. Optional.
+	Audit *audit.Recorder
+}
+
+// +kubebuilder:rbac:groups=platform.io,resources=tenantaccessrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=platform.io,resources=tenantaccessrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=platform.io,resources=tenantaccessrequests/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=bind
+
+// Reconcile implements the reconciliation loop for a TenantAccessRequest.
+func (r *TenantAccessRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("accessrequest", req.NamespacedName)
+
+	ar := &platformv1alpha1.TenantAccessRequest{}
+	if err := r.Get(ctx, req.NamespacedName, ar); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !ar.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.handleDeletion(ctx, ar, log)
+	}
+
+	if !controllerutil.ContainsFinalizer(ar, AccessRequestFinalizerName) {
+		controllerutil.AddFinalizer(ar, AccessRequestFinalizerName)
+		if err := r.Update(ctx, ar); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	switch ar.Status.Phase {
+	case platformv1alpha1.AccessRequestExpired, platformv1alpha1.AccessRequestRevoked, platformv1alpha1.AccessRequestDenied:
+		// Terminal: a new request must be created for further access
+		return ctrl.Result{}, nil
+	case platformv1alpha1.AccessRequestActive:
+		return r.reconcileActive(ctx, ar, log)
+	default:
+		return r.grant(ctx, ar, log)
+	}
+}
+
+// grant validates a pending request and creates the elevated RoleBinding.
+func (r *TenantAccessRequestReconciler) grant(ctx context.Context, ar *platformv1alpha1.TenantAccessRequest, log logr.Logger) (ctrl.Result, error) {
+	if msg := validateAccessRequest(ar); msg != "" {
+		return ctrl.Result{}, r.deny(ctx, ar, msg, log)
+	}
+
+	tenant := &platformv1alpha1.Tenant{}
+	if err := r.Get(ctx, client.ObjectKey{Name: ar.Spec.TenantRef}, tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, r.deny(ctx, ar, fmt.Sprintf("tenant %q not found", ar.Spec.TenantRef), log)
+		}
+		return ctrl.Result{}, err
+	}
+	if tenant.Spec.Tier == platformv1alpha1.BronzeTier {
+		return ctrl.Result{}, r.deny(ctx, ar, "Bronze tier tenants have no dedicated namespace", log)
+	}
+
+	now := time.Now().UTC()
+	ar.Status.Namespace = buildNamespaceName(tenant)
+	ar.Status.RoleBinding = accessRequestBindingName(ar)
+	if err := r.ensureBinding(ctx, ar); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create break-glass RoleBinding: %w", err)
+	}
+
+	ar.Status.Phase = platformv1alpha1.AccessRequestActive
+	ar.Status.GrantedAt = &metav1.Time{Time: now}
+	ar.Status.ExpiresAt = &metav1.Time{Time: now.Add(ar.Spec.Duration.Duration)}
+	ar.Status.Message = ""
+	if err := r.Status().Update(ctx, ar); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("break-glass access granted", "tenant", ar.Spec.TenantRef, "subject", ar.Spec.Subject.Name,
+		"clusterRole", accessRequestClusterRole(ar), "expiresAt", ar.Status.ExpiresAt.Time)
+	recordAuditEntry(ctx, r.Audit, audit.Entry{
+		Timestamp: now,
+		Tenant:    ar.Spec.TenantRef,
+		Action:    audit.ActionAccessGranted,
+		Actor:     ar.Spec.RequestedBy,
+		Message: fmt.Sprintf("%s %s granted ClusterRole %s in %s until %s: %s",
+			ar.Spec.Subject.Kind, ar.Spec.Subject.Name, accessRequestClusterRole(ar),
+			ar.Status.Namespace, ar.Status.ExpiresAt.Format(time.RFC3339), ar.Spec.Reason),
+		Details: ar.Spec,
+	}, log)
+
+	return ctrl.Result{RequeueAfter: ar.Spec.Duration.Duration}, nil
+}
+
+// reconcileActive keeps the RoleBinding in place until expiry, then revokes it.
+func (r *TenantAccessRequestReconciler) reconcileActive(ctx context.Context, ar *platformv1alpha1.TenantAccessRequest, log logr.Logger) (ctrl.Result, error) {
+	if ar.Status.ExpiresAt == nil || !time.Now().Before(ar.Status.ExpiresAt.Time) {
+		if err := r.revoke(ctx, ar, platformv1alpha1.AccessRequestExpired, log); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.Status().Update(ctx, ar)
+	}
+
+	// Restore the binding if it was removed out-of-band while still valid
+	if err := r.ensureBinding(ctx, ar); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to ensure break-glass RoleBinding: %w", err)
+	}
+	return ctrl.Result{RequeueAfter: time.Until(ar.Status.ExpiresAt.Time)}, nil
+}
+
+// handleDeletion revokes active access before the request is removed.
+func (r *TenantAccessRequestReconciler) handleDeletion(ctx context.Context, ar *platformv1alpha1.TenantAccessRequest, log logr.Logger) error {
+	if !controllerutil.ContainsFinalizer(ar, AccessRequestFinalizerName) {
+		return nil
+	}
+
+	if ar.Status.Phase == platformv1alpha1.AccessRequestActive {
+		if err := r.revoke(ctx, ar, platformv1alpha1.AccessRequestRevoked, log); err != nil {
+			return err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(ar, AccessRequestFinalizerName)
+	return r.Update(ctx, ar)
+}
+
+// revoke deletes the break-glass RoleBinding and records the revocation.
+func (r *TenantAccessRequestReconciler) revoke(ctx context.Context, ar *platformv1alpha1.TenantAccessRequest, phase platformv1alpha1.AccessRequestPhase, log logr.Logger) error {
+	rb := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ar.Status.RoleBinding,
+			Namespace: ar.Status.Namespace,
+		},
+	}
+	if err := r.Delete(ctx, rb); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete break-glass RoleBinding: %w", err)
+	}
+
+	ar.Status.Phase = phase
+	log.Info("break-glass access revoked", "tenant", ar.Spec.TenantRef, "subject", ar.Spec.Subject.Name, "phase", phase)
+	recordAuditEntry(ctx, r.Audit, audit.Entry{
+		Tenant: ar.Spec.TenantRef,
+		Action: audit.ActionAccessRevoked,
+		Actor:  ar.Spec.RequestedBy,
+		Message: fmt.Sprintf("%s %s access to %s revoked (%s)",
+			ar.Spec.Subject.Kind, ar.Spec.Subject.Name, ar.Status.Namespace, phase),
+		Details: ar.Status,
+	}, log)
+	return nil
+}
+
+// deny marks the request as Denied and records why.
+func (r *TenantAccessRequestReconciler) deny(ctx context.Context, ar *platformv1alpha1.TenantAccessRequest, msg string, log logr.Logger) error {
+	ar.Status.Phase = platformv1alpha1.AccessRequestDenied
+	ar.Status.Message = msg
+	if err := r.Status().Update(ctx, ar); err != nil {
+		return err
+	}
+
+	log.Info("break-glass access denied", "tenant", ar.Spec.TenantRef, "reason", msg)
+	recordAuditEntry(ctx, r.Audit, audit.Entry{
+		Tenant:  ar.Spec.TenantRef,
+		Action:  audit.ActionAccessDenied,
+		Actor:   ar.Spec.RequestedBy,
+		Message: msg,
+		Details: ar.Spec,
+	}, log)
+	return nil
+}
+
+// ensureBinding creates or updates the RoleBinding described by the request.
+func (r *TenantAccessRequestReconciler) ensureBinding(ctx context.Context, ar *platformv1alpha1.TenantAccessRequest) error {
+	rb := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ar.Status.RoleBinding,
+			Namespace: ar.Status.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, rb, func() error {
+		rb.Labels = map[string]string{
+			TenantNameLabelKey:    ar.Spec.TenantRef,
+			ManagedByLabelKey:     ManagedByValue,
+			AccessRequestLabelKey: ar.Name,
+		}
+		rb.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     accessRequestClusterRole(ar),
+		}
+		rb.Subjects = []rbacv1.Subject{accessRequestSubject(ar)}
+		return controllerutil.SetControllerReference(ar, rb, r.Scheme)
+	})
+	return err
+}
+
+// validateAccessRequest returns a denial message, or "" if the request is acceptable.
+func validateAccessRequest(ar *platformv1alpha1.TenantAccessRequest) string {
+	switch {
+	case ar.Spec.Duration.Duration <= 0:
+		return "duration must be greater than 0"
+	case ar.Spec.Duration.Duration > MaxAccessRequestDuration:
+		return fmt.Sprintf("duration %s exceeds the maximum of %s", ar.Spec.Duration.Duration, MaxAccessRequestDuration)
+	case accessRequestClusterRole(ar) == "cluster-admin":
+		return "binding ClusterRole cluster-admin is not allowed in tenant namespaces"
+	case ar.Spec.Reason == "":
+		return "reason must be specified"
+	}
+	return ""
+}
+
+// accessRequestClusterRole returns the ClusterRole to bind, applying the default.
+func accessRequestClusterRole(ar *platformv1alpha1.TenantAccessRequest) string {
+	if ar.Spec.ClusterRole == "" {
+		return DefaultBreakGlassClusterRole
+	}
+	return ar.Spec.ClusterRole
+}
+
+// accessRequestSubject converts the request subject into an RBAC subject.
+func accessRequestSubject(ar *platformv1alpha1.TenantAccessRequest) rbacv1.Subject {
+	subject := rbacv1.Subject{Kind: ar.Spec.Subject.Kind, Name: ar.Spec.Subject.Name}
+	if subject.Kind == rbacv1.ServiceAccountKind {
+		subject.Namespace = ar.Spec.Subject.Namespace
+		if subject.Namespace == "" {
+			subject.Namespace = ar.Status.Namespace
+		}
+	} else {
+		subject.APIGroup = rbacv1.GroupName
+	}
+	return subject
+}
+
+// accessRequestBindingName returns the RoleBinding name for a request.
+func accessRequestBindingName(ar *platformv1alpha1.TenantAccessRequest) string {
+	return fmt.Sprintf("breakglass-%s", ar.Name)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TenantAccessRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&platformv1alpha1.TenantAccessRequest{}).
+		Owns(&rbacv1.RoleBinding{}).
+		Complete(r)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+)
+
+// backupCheckInterval is how often the BackupScheduler looks for tenants due a backup.
+const backupCheckInterval = time.Minute
+
+// defaultBackupRetention is how many scheduled snapshots are kept when
+// spec.backup.retention is not set.
+const defaultBackupRetention = 7
+
+// ParseBackupSchedule parses a spec.backup.schedule: five cron fields or a descriptor
+// such as "@daily", in UTC unless prefixed with CRON_TZ=<zone>.
+func ParseBackupSchedule(schedule string) (cron.Schedule, error) {
+	return cron.ParseStandard(schedule)
+}
+
+// BackupScheduler takes the scheduled TenantSnapshots of tenants with spec.backup and
+// deletes those beyond the tenant's retention. It runs as a manager Runnable, so only
+// the elected leader takes backups.
+type BackupScheduler struct {
+	Client   client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Log      logr.Logger
+
+	// Archive stores the snapshot contents; without it only the snapshot records are kept.
+	Archive storage.Storage
+}
+
+// Start checks for due backups until ctx is cancelled.
+func (b *BackupScheduler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(backupCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := b.TakeDue(ctx, time.Now()); err != nil {
+			b.Log.Error(err, "failed to take scheduled tenant backups")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// TakeDue snapshots every tenant whose backup schedule came due since its last backup,
+// or since it was created, and records the outcome in status.backup.
+func (b *BackupScheduler) TakeDue(ctx context.Context, now time.Time) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := b.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	r := &TenantReconciler{Client: b.Client, Scheme: b.Scheme, Archive: b.Archive, Log: b.Log}
+	var errs []string
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		if tenant.Spec.Backup == nil || tenant.Spec.Tier == platformv1alpha1.BronzeTier ||
+			!tenant.DeletionTimestamp.IsZero() || tenant.Status.Namespace == "" {
+			continue
+		}
+		if err := b.backup(ctx, r, tenant, now); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("backup failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// backup takes one tenant's scheduled snapshot if due, prunes old ones, and records
+// the new status.
+func (b *BackupScheduler) backup(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, now time.Time) error {
+	log := b.Log.WithValues("tenant", tenant.Name)
+	before := tenant.DeepCopy()
+	if tenant.Status.Backup == nil {
+		tenant.Status.Backup = &platformv1alpha1.BackupStatus{}
+	}
+	status := tenant.Status.Backup
+
+	var runErr error
+	schedule, err := ParseBackupSchedule(tenant.Spec.Backup.Schedule)
+	if err != nil {
+		// Only reachable with webhooks disabled; nothing to do until the schedule is fixed
+		status.NextBackupTime = nil
+		status.Message = fmt.Sprintf("invalid schedule %q: %v", tenant.Spec.Backup.Schedule, err)
+	} else {
+		last := tenant.CreationTimestamp.Time
+		if status.LastBackupTime != nil {
+			last = status.LastBackupTime.Time
+		}
+		if next := schedule.Next(last); !now.Before(next) {
+			runErr = b.run(ctx, r, tenant, status, now, log)
+		}
+		next := metav1.NewTime(schedule.Next(now))
+		status.NextBackupTime = &next
+		if runErr == nil && status.LastResult != platformv1alpha1.SnapshotFailed {
+			status.Message = ""
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(before.Status, tenant.Status) {
+		if err := b.Client.Status().Patch(ctx, tenant, client.MergeFrom(before)); err != nil {
+			return errors.Join(runErr, fmt.Errorf("failed to record backup status: %w", err))
+		}
+	}
+	return runErr
+}
+
+// run takes a scheduled snapshot and, once it completed, deletes the snapshots beyond
+// the retention.
+func (b *BackupScheduler) run(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, status *platformv1alpha1.BackupStatus, now time.Time, log logr.Logger) error {
+	taken := metav1.NewTime(now)
+	status.LastBackupTime = &taken
+
+	snapshot, err := r.takeSnapshot(ctx, tenant, platformv1alpha1.SnapshotScheduled, log)
+	if err != nil {
+		status.LastResult = platformv1alpha1.SnapshotFailed
+		status.Message = err.Error()
+		b.Recorder.Event(tenant, corev1.EventTypeWarning, "BackupFailed", err.Error())
+		return err
+	}
+	status.LastSnapshot = snapshot.Name
+	status.LastResult = platformv1alpha1.SnapshotCompleted
+	status.Message = ""
+
+	retention := defaultBackupRetention
+	if tenant.Spec.Backup.Retention > 0 {
+		retention = int(tenant.Spec.Backup.Retention)
+	}
+	return b.prune(ctx, tenant, retention, log)
+}
+
+// prune keeps the retention newest completed scheduled snapshots of tenant. Failed
+// ones are deleted once a newer one completed. Manual, deletion, and migration
+// snapshots are never pruned.
+func (b *BackupScheduler) prune(ctx context.Context, tenant *platformv1alpha1.Tenant, retention int, log logr.Logger) error {
+	snapshots := &platformv1alpha1.TenantSnapshotList{}
+	if err := b.Client.List(ctx, snapshots, client.MatchingLabels{TenantNameLabelKey: tenant.Name}); err != nil {
+		return fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	var scheduled []platformv1alpha1.TenantSnapshot
+	for _, snapshot := range snapshots.Items {
+		if snapshot.Spec.TenantName == tenant.Name && snapshot.Spec.Trigger == platformv1alpha1.SnapshotScheduled {
+			scheduled = append(scheduled, snapshot)
+		}
+	}
+	// Newest first
+	sort.Slice(scheduled, func(i, j int) bool {
+		a, b := snapshotTime(&scheduled[i]), snapshotTime(&scheduled[j])
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return scheduled[i].Name > scheduled[j].Name
+	})
+
+	var errs []error
+	completed := 0
+	for i := range scheduled {
+		snapshot := &scheduled[i]
+		if snapshot.Status.Phase == platformv1alpha1.SnapshotCompleted {
+			completed++
+			if completed <= retention {
+				continue
+			}
+		} else if completed == 0 {
+			continue
+		}
+		errs = append(errs, b.deleteSnapshot(ctx, snapshot, log))
+	}
+	return errors.Join(errs...)
+}
+
+// deleteSnapshot deletes a snapshot and its archived copy.
+func (b *BackupScheduler) deleteSnapshot(ctx context.Context, snapshot *platformv1alpha1.TenantSnapshot, log logr.Logger) error {
+	if b.Archive != nil && snapshot.Status.Location != "" {
+		if err := b.Archive.Delete(ctx, snapshot.Status.Location); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("failed to delete archived snapshot %s: %w", snapshot.Status.Location, err)
+		}
+	}
+	if err := b.Client.Delete(ctx, snapshot); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete snapshot %s: %w", snapshot.Name, err)
+	}
+	log.Info("deleted expired scheduled snapshot", "snapshot", snapshot.Name)
+	return nil
+}
+
+// snapshotTime is when a snapshot was taken, or created if it was never taken.
+func snapshotTime(snapshot *platformv1alpha1.TenantSnapshot) time.Time {
+	if snapshot.Status.TakenAt != nil {
+		return snapshot.Status.TakenAt.Time
+	}
+	return snapshot.CreationTimestamp.Time
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// bronzeLimitRangeName is the LimitRange giving containers in the shared Bronze namespace
+// default requests and limits, so every pod counts against its tenant's quota.
+const bronzeLimitRangeName = "bronze-defaults"
+
+// bronzeQuotaResources are the quota dimensions a PriorityClass-scoped quota can track.
+var bronzeQuotaResources = []corev1.ResourceName{
+	corev1.ResourceRequestsCPU,
+	corev1.ResourceRequestsMemory,
+	corev1.ResourceLimitsCPU,
+	corev1.ResourceLimitsMemory,
+	corev1.ResourcePods,
+}
+
+// bronzeRoleRules let a Bronze tenant run workloads in the shared namespace. RBAC cannot
+// match labels, so the Role covers every tenant's objects; the Bronze ownership webhook
+// rejects changes to objects not labeled with the tenant, and the pod webhook tags pods
+// through their ServiceAccount. Reads are not restricted: Bronze isolation is soft.
+var bronzeRoleRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods", "services", "configmaps", "persistentvolumeclaims"},
+		Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods/log"},
+		Verbs:     []string{"get"},
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "statefulsets"},
+		Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"jobs", "cronjobs"},
+		Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+	},
+}
+
+// BronzePriorityClassName returns the PriorityClass that scopes a Bronze tenant's quota.
+// The pod webhook assigns it to the tenant's pods in the shared namespace.
+func BronzePriorityClassName(tenantName string) string {
+	return "bronze-" + tenantName
+}
+
+// reconcileBronzeTier handles the Bronze tier provisioning (soft isolation in the shared
+// namespace): a ServiceAccount, a quota scoped to the tenant's PriorityClass, and a Role.
+func (r *TenantReconciler) reconcileBronzeTier(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	err := r.ensureBronzeNamespace(ctx, tenant, log)
+	setResourceCondition(tenant, platformv1alpha1.ConditionNamespaceReady, "CreateFailed", err)
+	if err != nil {
+		return fmt.Errorf("shared namespace setup failed: %w", err)
+	}
+	tenant.Status.Namespace = BronzeNamespace
+
+	// Apply or revert time-boxed quota boosts before rendering the quota
+	r.reconcileBurst(ctx, tenant, log)
+
+	err = r.ensureBronzeQuota(ctx, tenant, log)
+	setResourceCondition(tenant, platformv1alpha1.ConditionQuotaReady, "CreateFailed", err)
+	if err != nil {
+		return fmt.Errorf("resource quota creation failed: %w", err)
+	}
+
+	err = r.ensureBronzeRBAC(ctx, tenant, log)
+	setResourceCondition(tenant, platformv1alpha1.ConditionRBACReady, "CreateFailed", err)
+	if err != nil {
+		return fmt.Errorf("RBAC creation failed: %w", err)
+	}
+
+	if err := r.completeStep(ctx, tenant, platformv1alpha1.ConditionBaseResourcesProvisioned,
+		fmt.Sprintf("ServiceAccount, scoped quota, and RBAC are provisioned in the shared %s namespace", BronzeNamespace)); err != nil {
+		return err
+	}
+
+	tenant.Status.State = platformv1alpha1.StateReady
+	return nil
+}
+
+// ensureBronzeNamespace creates the namespace shared by all Bronze tenants and its default
+// container limits. It is not owned by any tenant, so it outlives them; drift in the
+// limits is reported against the tenant being reconciled. It enforces the Bronze Pod
+// Security level, which no single tenant can relax.
+func (r *TenantReconciler) ensureBronzeNamespace(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	podSecurity := r.config().PodSecurity.Bronze
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: BronzeNamespace}}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, ns, func() error {
+		if ns.Labels == nil {
+			ns.Labels = map[string]string{}
+		}
+		ns.Labels[TierLabelKey] = string(platformv1alpha1.BronzeTier)
+		ns.Labels[ManagedByLabelKey] = ManagedByValue
+		setPodSecurityLabel(ns, podSecurity)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "failed to create or update shared Bronze namespace", "namespace", BronzeNamespace)
+		return err
+	}
+	log.Info("ensured shared Bronze namespace", "namespace", BronzeNamespace, "podSecurity", podSecurity, "operation", result)
+	tenant.Status.PodSecurityLevel = podSecurity
+
+	lr := &corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: bronzeLimitRangeName, Namespace: BronzeNamespace}}
+	_, err = r.applyManaged(ctx, tenant, lr, func() error {
+		lr.Labels = map[string]string{ManagedByLabelKey: ManagedByValue}
+		lr.Spec.Limits = []corev1.LimitRangeItem{{
+			Type: corev1.LimitTypeContainer,
+			Default: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+			DefaultRequest: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+			},
+		}}
+		return nil
+	}, log)
+	if err != nil {
+		return fmt.Errorf("failed to create or update LimitRange %s: %w", bronzeLimitRangeName, err)
+	}
+	return nil
+}
+
+// ensureBronzeQuota creates the tenant's PriorityClass and a ResourceQuota in the shared
+// namespace scoped to it. ResourceQuotas cannot select pods by label, so the PriorityClass
+// stands in for the tenant's label selector.
+func (r *TenantReconciler) ensureBronzeQuota(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	priorityClassName := BronzePriorityClassName(tenant.Name)
+	pc := &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: priorityClassName}}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, pc, func() error {
+		pc.Labels = map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			ManagedByLabelKey:  ManagedByValue,
+		}
+		// Value and preemption policy are immutable; set them only on create
+		if pc.CreationTimestamp.IsZero() {
+			never := corev1.PreemptNever
+			pc.Value = 0
+			pc.PreemptionPolicy = &never
+		}
+		pc.Description = fmt.Sprintf("Scopes the quota of Bronze tenant %s", tenant.Name)
+		return controllerutil.SetControllerReference(tenant, pc, r.Scheme)
+	})
+	if err != nil {
+		log.Error(err, "failed to create or update PriorityClass", "priorityClass", priorityClassName)
+		return err
+	}
+	log.Info("ensured PriorityClass", "priorityClass", priorityClassName, "operation", result)
+
+	hard := bronzeQuotaHard(tenant, r.config().Quota.LimitsOvercommitRatio)
+	rq := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{
+		Name:      fmt.Sprintf("%s-quota", tenant.Name),
+		Namespace: BronzeNamespace,
+	}}
+	result, err = r.applyManaged(ctx, tenant, rq, func() error {
+		rq.Labels = map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			ManagedByLabelKey:  ManagedByValue,
+			QuotaScopeLabelKey: QuotaScopePriorityClass,
+		}
+		rq.Spec = corev1.ResourceQuotaSpec{
+			Hard: hard,
+			ScopeSelector: &corev1.ScopeSelector{
+				MatchExpressions: []corev1.ScopedResourceSelectorRequirement{{
+					ScopeName: corev1.ResourceQuotaScopePriorityClass,
+					Operator:  corev1.ScopeSelectorOpIn,
+					Values:    []string{priorityClassName},
+				}},
+			},
+		}
+		return controllerutil.SetControllerReference(tenant, rq, r.Scheme)
+	}, log)
+	if err != nil {
+		log.Error(err, "failed to create or update ResourceQuota", "namespace", BronzeNamespace)
+		return err
+	}
+	log.Info("ensured ResourceQuota", "namespace", BronzeNamespace, "operation", result)
+	return nil
+}
+
+// bronzeQuotaHard is the tenant quota restricted to the pod resources a scoped quota tracks.
+func bronzeQuotaHard(tenant *platformv1alpha1.Tenant, limitsRatio float64) corev1.ResourceList {
+	all := buildQuotaHard(tenant, limitsRatio)
+	hard := corev1.ResourceList{}
+	for _, name := range bronzeQuotaResources {
+		if qty, ok := all[name]; ok {
+			hard[name] = qty
+		}
+	}
+	return hard
+}
+
+// ensureBronzeRBAC creates the tenant's ServiceAccount in the shared namespace and binds
+// it to a Role for running workloads there.
+func (r *TenantReconciler) ensureBronzeRBAC(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	saName := fmt.Sprintf("%s-sa", tenant.Name)
+	labels := func() map[string]string {
+		return map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			TierLabelKey:       string(platformv1alpha1.BronzeTier),
+			ManagedByLabelKey:  ManagedByValue,
+		}
+	}
+
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: saName, Namespace: BronzeNamespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, sa, func() error {
+		sa.Labels = labels()
+		return controllerutil.SetControllerReference(tenant, sa, r.Scheme)
+	}); err != nil {
+		log.Error(err, "failed to create or update ServiceAccount", "namespace", BronzeNamespace, "serviceAccount", saName)
+		return err
+	}
+
+	role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-bronze", tenant.Name), Namespace: BronzeNamespace}}
+	if _, err := r.applyManaged(ctx, tenant, role, func() error {
+		role.Labels = labels()
+		role.Rules = bronzeRoleRules
+		return controllerutil.SetControllerReference(tenant, role, r.Scheme)
+	}, log); err != nil {
+		log.Error(err, "failed to create or update Role", "namespace", BronzeNamespace)
+		return err
+	}
+
+	rb := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-bronze-binding", tenant.Name), Namespace: BronzeNamespace}}
+	result, err := r.applyManaged(ctx, tenant, rb, func() error {
+		rb.Labels = labels()
+		rb.RoleRef = rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: role.Name}
+		rb.Subjects = []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: saName, Namespace: BronzeNamespace}}
+		return controllerutil.SetControllerReference(tenant, rb, r.Scheme)
+	}, log)
+	if err != nil {
+		log.Error(err, "failed to create or update RoleBinding", "namespace", BronzeNamespace)
+		return err
+	}
+	log.Info("ensured Bronze RBAC", "namespace", BronzeNamespace, "serviceAccount", saName, "operation", result)
+	return nil
+}
+
+// removeBronzeResources deletes a tenant's objects in the shared namespace and its
+// PriorityClass after it moved to another tier.
+func (r *TenantReconciler) removeBronzeResources(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	objs := []client.Object{
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-sa", tenant.Name), Namespace: BronzeNamespace}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-bronze", tenant.Name), Namespace: BronzeNamespace}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-bronze-binding", tenant.Name), Namespace: BronzeNamespace}},
+		&corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-quota", tenant.Name), Namespace: BronzeNamespace}},
+		&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: BronzePriorityClassName(tenant.Name)}},
+	}
+	for _, obj := range objs {
+		if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete %T %s: %w", obj, obj.GetName(), err)
+		}
+	}
+	log.Info("removed Bronze tier resources", "namespace", BronzeNamespace)
+	return nil
+}
+
+// listBronzeResources inventories a Bronze tenant's objects in the shared namespace and
+// its PriorityClass. The shared namespace itself is not the tenant's.
+func (r *TenantReconciler) listBronzeResources(ctx context.Context, tenant *platformv1alpha1.Tenant) ([]platformv1alpha1.ManagedResource, error) {
+	var resources []platformv1alpha1.ManagedResource
+	if err := r.inventoryObjects(ctx, tenant, BronzeNamespace, &resources); err != nil {
+		return resources, err
+	}
+
+	pc := &schedulingv1.PriorityClass{}
+	err := r.Get(ctx, client.ObjectKey{Name: BronzePriorityClassName(tenant.Name)}, pc)
+	if client.IgnoreNotFound(err) != nil {
+		return resources, fmt.Errorf("failed to fetch PriorityClass: %w", err)
+	}
+	if err == nil {
+		resources = append(resources, platformv1alpha1.ManagedResource{
+			APIVersion: schedulingv1.SchemeGroupVersion.String(),
+			Kind:       "PriorityClass",
+			Name:       pc.Name,
+		})
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].String() < resources[j].String()
+	})
+	return resources, nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// BurstUsage is the audit payload recorded when a quota boost ends.
+type BurstUsage struct {
+	CPU             string    `json:"cpu,omitempty"`
+	Memory          string    `json:"memory,omitempty"`
+	StartedAt       time.Time `json:"startedAt"`
+	EndedAt         time.Time `json:"endedAt"`
+	DurationSeconds float64   `json:"durationSeconds"`
+	CPUCoreSeconds  float64   `json:"cpuCoreSeconds"`
+	MemoryGiBHours  float64   `json:"memoryGiBHours"`
+	Expired         bool      `json:"expired"`
+}
+
+// reconcileBurst starts, keeps, or reverts the time-boxed quota boost requested in
+// spec.resources.burst. It only updates tenant.Status.Burst in memory; the quota
+// itself is rendered from that status by buildQuotaHard.
+//
+// A boost is applied once per request: after it expires it stays reverted until the
+// burst spec is changed or removed and re-added.
+func (r *TenantReconciler) reconcileBurst(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) {
+	now := time.Now().UTC()
+	spec := tenant.Spec.Resources.Burst
+	status := tenant.Status.Burst
+
+	// End an active boost that expired, was removed, or was replaced
+	if status != nil && status.Active {
+		expired := status.ExpiresAt != nil && !now.Before(status.ExpiresAt.Time)
+		if expired || !burstMatches(spec, status) {
+			r.endBurst(ctx, tenant, now, expired, log)
+			status = tenant.Status.Burst
+		}
+	}
+
+	if spec == nil || spec.Duration.Duration <= 0 {
+		return
+	}
+
+	// Already applied (or already consumed) for this request
+	if burstMatches(spec, status) {
+		return
+	}
+
+	tenant.Status.Burst = &platformv1alpha1.BurstStatus{
+		Active:    true,
+		CPU:       spec.CPU,
+		Memory:    spec.Memory,
+		Duration:  spec.Duration,
+		StartedAt: &metav1.Time{Time: now},
+		ExpiresAt: &metav1.Time{Time: now.Add(spec.Duration.Duration)},
+	}
+	log.Info("quota boost applied", "cpu", spec.CPU, "memory", spec.Memory, "expiresAt", tenant.Status.Burst.ExpiresAt.Time)
+	metrics.SetBurstActive(tenant.Name, true)
+
+	r.recordAudit(ctx, audit.Entry{
+		Timestamp: now,
+		Tenant:    tenant.Name,
+		Action:    audit.ActionBurstStart,
+		Message: fmt.Sprintf("quota boost of cpu=%s memory=%s applied for %s",
+			spec.CPU, spec.Memory, spec.Duration.Duration),
+		Details: tenant.Status.Burst,
+	}, log)
+}
+
+// endBurst reverts an active boost and records its usage.
+func (r *TenantReconciler) endBurst(ctx context.Context, tenant *platformv1alpha1.Tenant, now time.Time, expired bool, log logr.Logger) {
+	status := tenant.Status.Burst
+	status.Active = false
+	status.EndedAt = &metav1.Time{Time: now}
+	if expired && status.ExpiresAt != nil {
+		status.EndedAt = status.ExpiresAt.DeepCopy()
+	}
+
+	usage := burstUsage(status, expired)
+	log.Info("quota boost reverted", "expired", expired, "durationSeconds", usage.DurationSeconds)
+	metrics.SetBurstActive(tenant.Name, false)
+	metrics.RecordBurstUsage(tenant.Name, string(tenant.Spec.Tier), usage.CPUCoreSeconds, usage.MemoryGiBHours)
+
+	reason := "expired"
+	if !expired {
+		reason = "cancelled"
+	}
+	r.recordAudit(ctx, audit.Entry{
+		Timestamp: now,
+		Tenant:    tenant.Name,
+		Action:    audit.ActionBurstEnd,
+		Message: fmt.Sprintf("quota boost %s after %.0fs (%.1f CPU core-seconds, %.2f GiB-hours)",
+			reason, usage.DurationSeconds, usage.CPUCoreSeconds, usage.MemoryGiBHours),
+		Details: usage,
+	}, log)
+}
+
+// burstUsage computes the resources granted by a finished boost.
+func burstUsage(status *platformv1alpha1.BurstStatus, expired bool) BurstUsage {
+	usage := BurstUsage{
+		CPU:     status.CPU,
+		Memory:  status.Memory,
+		Expired: expired,
+	}
+	if status.StartedAt != nil {
+		usage.StartedAt = status.StartedAt.Time
+	}
+	if status.EndedAt != nil {
+		usage.EndedAt = status.EndedAt.Time
+	}
+	usage.DurationSeconds = usage.EndedAt.Sub(usage.StartedAt).Seconds()
+	if usage.DurationSeconds < 0 {
+		usage.DurationSeconds = 0
+	}
+
+	if status.CPU != "" {
+		if qty, err := resource.ParseQuantity(status.CPU); err == nil {
+			usage.CPUCoreSeconds = float64(qty.MilliValue()) / 1000 * usage.DurationSeconds
+		}
+	}
+	if status.Memory != "" {
+		if qty, err := resource.ParseQuantity(status.Memory); err == nil {
+			usage.MemoryGiBHours = float64(qty.Value()) / (1 << 30) * usage.DurationSeconds / 3600
+		}
+	}
+	return usage
+}
+
+// burstMatches reports whether the status describes the boost requested by spec.
+// Quantities are compared by value, so normalizing "2" to "2000m" does not restart
+// a consumed boost.
+func burstMatches(spec *platformv1alpha1.BurstConfig, status *platformv1alpha1.BurstStatus) bool {
+	if spec == nil || status == nil {
+		return false
+	}
+	return quantitiesEqual(spec.CPU, status.CPU) && quantitiesEqual(spec.Memory, status.Memory) &&
+		spec.Duration == status.Duration
+}
+
+// quantitiesEqual compares two quantity strings by value, falling back to the strings
+// when either does not parse.
+func quantitiesEqual(a, b string) bool {
+	qa, errA := resource.ParseQuantity(a)
+	qb, errB := resource.ParseQuantity(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return qa.Cmp(qb) == 0
+}
+
+// addBurstToQuota raises the CPU and memory limits in hard by an active boost.
+func addBurstToQuota(hard corev1.ResourceList, burst *platformv1alpha1.BurstStatus) {
+	if burst == nil || !burst.Active {
+		return
+	}
+
+	add := func(names []corev1.ResourceName, value string) {
+		if value == "" {
+			return
+		}
+		extra, err := resource.ParseQuantity(value)
+		if err != nil {
+			return
+		}
+		for _, name := range names {
+			qty := hard[name]
+			qty.Add(extra)
+			hard[name] = qty
+		}
+	}
+
+	add([]corev1.ResourceName{corev1.ResourceRequestsCPU, corev1.ResourceLimitsCPU}, burst.CPU)
+	add([]corev1.ResourceName{corev1.ResourceRequestsMemory, corev1.ResourceLimitsMemory}, burst.Memory)
+}
+
+// burstRequeueAfter returns how long until an active boost must be reverted, or 0.
+func burstRequeueAfter(tenant *platformv1alpha1.Tenant) time.Duration {
+	burst := tenant.Status.Burst
+	if burst == nil || !burst.Active || burst.ExpiresAt == nil {
+		return 0
+	}
+	after := time.Until(burst.ExpiresAt.Time)
+	if after <= 0 {
+		return time.Second
+	}
+	return after
+}
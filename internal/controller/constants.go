@@ -16,10 +16,19 @@ limitations under the License.
 
 package controller
 
+import "time"
+
 const (
 	// TenantFinalizerName is the finalizer used for cleanup on Tenant deletion.
 	TenantFinalizerName = "tenant.platform.io/finalizer"
 
+	// OperatorNamespace is the namespace holding operator-owned shared objects
+	// (propagated secrets, snapshots, audit entries).
+	OperatorNamespace = "tenant-master-system"
+
+	// BronzeNamespace is the namespace shared by all Bronze tier tenants.
+	BronzeNamespace = "bronze-tenants"
+
 	// NamespacePrefix is the prefix for tenant namespaces.
 	NamespacePrefix = "tenant"
 
@@ -32,6 +41,14 @@ const (
 	// TenantNameLabelKey is the label key for tenant name.
 	TenantNameLabelKey = "tenant.platform.io/name"
 
+	// TenantUIDLabelKey is the label key for the UID of the tenant a snapshot was taken
+	// of, which tells a deleted tenant's snapshots from those of a recreated one.
+	TenantUIDLabelKey = "tenant.platform.io/uid"
+
+	// ClusterNameLabelKey labels a kubeconfig Secret in the operator namespace that
+	// registers a remote cluster for spec.placement, with the cluster name as value.
+	ClusterNameLabelKey = "tenant.platform.io/cluster-name"
+
 	// ManagedByLabelKey indicates the resource is managed by Tenant-Master.
 	ManagedByLabelKey = "app.kubernetes.io/managed-by"
 	ManagedByValue    = "tenant-master"
@@ -44,6 +61,127 @@ const (
 
 	// KubeconfigSecretSuffix is the suffix for kubeconfig secrets.
 	KubeconfigSecretSuffix = "kubeconfig"
+
+	// OIDCKubeconfigKey is the kubeconfig Secret key holding the kubeconfig that logs in
+	// with the kubelogin exec plugin, set when spec.vcluster.oidc is configured.
+	OIDCKubeconfigKey = "kubeconfig-oidc"
+
+	// PrivilegedApprovedByAnnotation records the cluster admin who approved
+	// spec.security.allowPrivileged. Setting it requires the approve-privileged verb on the Tenant.
+	PrivilegedApprovedByAnnotation = "tenant.platform.io/privileged-approved-by"
+
+	// DefaultVClusterPersistenceSize is the vCluster volume size when spec.vcluster does not set one.
+	DefaultVClusterPersistenceSize = "10Gi"
+
+	// TenantSetLabelKey is the label key linking a Tenant to the TenantSet that created it.
+	TenantSetLabelKey = "tenant.platform.io/tenantset"
+
+	// TenantSetSpecHashAnnotation records a hash of the spec a TenantSet last wrote to a
+	// member Tenant. The spec is only rewritten when the template changes, so the
+	// defaults the mutating webhook fills in are not reverted on every reconcile.
+	TenantSetSpecHashAnnotation = "tenant.platform.io/tenantset-spec-hash"
+
+	// QuotaScopeLabelKey marks scoped ResourceQuotas and records their scope.
+	QuotaScopeLabelKey = "tenant.platform.io/quota-scope"
+
+	// QuotaScopePriorityClass is the QuotaScopeLabelKey value for per-PriorityClass quotas.
+	QuotaScopePriorityClass = "priority-class"
+
+	// EnvironmentLabelKey marks an environment namespace and records its environment name.
+	EnvironmentLabelKey = "tenant.platform.io/environment"
+
+	// EnvironmentIsolatedLabelKey records whether an environment namespace is isolated
+	// from the tenant's other environments ("true" or "false").
+	EnvironmentIsolatedLabelKey = "tenant.platform.io/environment-isolated"
+
+	// PackingPolicyAnnotation records spec.scheduling.packingPolicy on tenant namespaces,
+	// where the pod webhook turns it into affinities.
+	PackingPolicyAnnotation = "tenant.platform.io/packing-policy"
+
+	// DefaultTolerationsAnnotation carries spec.scheduling.tolerations on tenant namespaces
+	// as JSON. It is the PodTolerationRestriction admission plugin's annotation, so clusters
+	// with that plugin apply the tolerations even without the operator's pod webhook.
+	DefaultTolerationsAnnotation = "scheduler.alpha.kubernetes.io/defaultTolerations"
+
+	// DNSConfigAnnotation carries spec.network.dnsConfig on tenant namespaces as JSON,
+	// where the pod webhook adds it to the pods' DNS config.
+	DNSConfigAnnotation = "tenant.platform.io/dns-config"
+
+	// DefaultStorageClassAnnotation records spec.resources.storageClass on tenant namespaces,
+	// so charts and tooling deployed by the tenant can pick the class its quota allows.
+	DefaultStorageClassAnnotation = "tenant.platform.io/default-storage-class"
+
+	// AllowQuotaShrinkAnnotation lets a Tenant update lower spec.resources below the
+	// tenant's current usage, which is otherwise rejected by the validating webhook.
+	AllowQuotaShrinkAnnotation = "tenant.platform.io/allow-quota-shrink"
+
+	// PausedAnnotation stops all reconciliation of a tenant, including drift correction
+	// and deletion cleanup, while set to "true".
+	PausedAnnotation = "tenant.platform.io/paused"
+
+	// RotateCredentialsAnnotation requests a credential rotation. Its value is an RFC3339
+	// timestamp; the rotation runs once for every value newer than status.credentialsRotatedAt.
+	RotateCredentialsAnnotation = "tenant.platform.io/rotate-credentials-at"
+
+	// RotateCredentialsRequestedByAnnotation records who requested the rotation, for the audit trail.
+	RotateCredentialsRequestedByAnnotation = "tenant.platform.io/rotate-credentials-requested-by"
+
+	// SuspendedReplicasAnnotation records the replica count a Deployment or StatefulSet had
+	// before its tenant was suspended, so resuming can restore it.
+	SuspendedReplicasAnnotation = "tenant.platform.io/suspended-replicas"
+
+	// AccessRequestLabelKey links a break-glass RoleBinding to its TenantAccessRequest.
+	AccessRequestLabelKey = "tenant.platform.io/access-request"
+
+	// AccessRequestFinalizerName ensures break-glass access is revoked and audited on deletion.
+	AccessRequestFinalizerName = "tenant.platform.io/access-request-finalizer"
+
+	// MigrationFinalizerName ensures an unfinished TenantMigration is rolled back on deletion.
+	MigrationFinalizerName = "tenant.platform.io/migration-finalizer"
+
+	// AppliedTemplatesAnnotation records, as JSON, the TenantTemplates the mutating webhook
+	// merged into a Tenant on creation. The controller copies it to status.appliedTemplates.
+	AppliedTemplatesAnnotation = "tenant.platform.io/applied-templates"
+
+	// MigratedByAnnotation names the TenantMigration that created a Tenant on the target
+	// cluster. Rollback only deletes target Tenants carrying it.
+	MigratedByAnnotation = "tenant.platform.io/migrated-by"
+
+	// DefaultMigrationVerifyTimeout bounds how long a migrated Tenant may take to become
+	// Ready on the target cluster when spec.verifyTimeout is unset.
+	DefaultMigrationVerifyTimeout = 15 * time.Minute
+
+	// DefaultBreakGlassClusterRole is bound when a TenantAccessRequest does not name a ClusterRole.
+	DefaultBreakGlassClusterRole = "admin"
+
+	// MaxAccessRequestDuration bounds how long break-glass access may last.
+	MaxAccessRequestDuration = 24 * time.Hour
+)
+
+// Cloud instance metadata endpoints, excluded from internet egress so tenant pods cannot
+// read node credentials. The IPv6 address is the AWS IMDS endpoint.
+const (
+	MetadataCIDRIPv4 = "169.254.169.254/32"
+	MetadataCIDRIPv6 = "fd00:ec2::254/128"
+)
+
+// Default per-tier object counts in the tenant ResourceQuota, overridable with
+// spec.quotas.objects. Bronze tenants never get LoadBalancers or NodePorts, and their
+// PriorityClass-scoped quota cannot count other objects.
+const (
+	DefaultSilverLoadBalancers = 1
+	DefaultSilverNodePorts     = 2
+	DefaultGoldLoadBalancers   = 3
+	DefaultGoldNodePorts       = 5
+
+	DefaultSilverServices   = 20
+	DefaultSilverPVCs       = 10
+	DefaultSilverConfigMaps = 50
+	DefaultSilverSecrets    = 50
+	DefaultGoldServices     = 50
+	DefaultGoldPVCs         = 50
+	DefaultGoldConfigMaps   = 200
+	DefaultGoldSecrets      = 200
 )
 
 // ErrorReasonTimeout indicates a reconciliation timeout.
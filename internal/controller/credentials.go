@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+)
+
+// vClusterRestartedAtAnnotation is set on the vCluster pod template to roll it after a
+// certificate rotation.
+const vClusterRestartedAtAnnotation = "tenant.platform.io/restarted-at"
+
+// rotateCredentials invalidates every credential previously issued for the tenant when
+// the RotateCredentialsAnnotation holds a time newer than status.credentialsRotatedAt.
+//
+// The tenant ServiceAccount is deleted so ensureRBAC recreates it with a new UID,
+// which invalidates all tokens bound to the old one. For Gold tenants the vCluster
+// certificate and kubeconfig Secrets are deleted and the vCluster is restarted so it
+// issues a new admin certificate.
+func (r *TenantReconciler) rotateCredentials(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	requestedAt, ok := credentialRotationRequested(tenant)
+	if !ok {
+		return nil
+	}
+
+	namespaceName := buildNamespaceName(tenant)
+	log.Info("rotating tenant credentials", "requestedAt", requestedAt)
+
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+		Name:      fmt.Sprintf("%s-sa", tenant.Name),
+		Namespace: namespaceName,
+	}}
+	if err := r.Delete(ctx, sa); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete ServiceAccount %s: %w", sa.Name, err)
+	}
+
+	if tenant.Spec.Tier == platformv1alpha1.GoldTier {
+		if err := r.rotateVClusterCredentials(ctx, tenant, log); err != nil {
+			return err
+		}
+	}
+
+	tenant.Status.CredentialsRotatedAt = &metav1.Time{Time: requestedAt}
+	r.recordAudit(ctx, audit.Entry{
+		Tenant:  tenant.Name,
+		Action:  audit.ActionCredentialsRotated,
+		Actor:   tenant.Annotations[RotateCredentialsRequestedByAnnotation],
+		Message: fmt.Sprintf("credentials rotated; credentials issued before %s are revoked", requestedAt.Format(time.RFC3339)),
+	}, log)
+	return nil
+}
+
+// rotateVClusterCredentials deletes the vCluster certificates and the exported kubeconfig
+// and restarts the vCluster so it generates a new admin certificate.
+func (r *TenantReconciler) rotateVClusterCredentials(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	namespaceName := buildNamespaceName(tenant)
+	releaseName := fmt.Sprintf("%s-vcluster", tenant.Name)
+
+	for _, name := range []string{
+		fmt.Sprintf("%s-certs", releaseName),
+		fmt.Sprintf("vc-%s", releaseName),
+		fmt.Sprintf("%s-%s", tenant.Name, KubeconfigSecretSuffix),
+	} {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespaceName}}
+		if err := r.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete Secret %s: %w", name, err)
+		}
+	}
+
+	ss := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: releaseName}, ss); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to fetch vCluster StatefulSet: %w", err)
+		}
+		log.V(1).Info("vCluster StatefulSet not deployed; nothing to restart", "statefulset", releaseName)
+		return nil
+	}
+
+	patch := client.MergeFrom(ss.DeepCopy())
+	if ss.Spec.Template.Annotations == nil {
+		ss.Spec.Template.Annotations = map[string]string{}
+	}
+	ss.Spec.Template.Annotations[vClusterRestartedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if err := r.Patch(ctx, ss, patch); err != nil {
+		return fmt.Errorf("failed to restart vCluster: %w", err)
+	}
+	log.Info("restarted vCluster to regenerate certificates", "statefulset", releaseName)
+	return nil
+}
+
+// credentialRotationRequested returns the requested rotation time when it has not been
+// handled yet. Unparseable values are ignored.
+func credentialRotationRequested(tenant *platformv1alpha1.Tenant) (time.Time, bool) {
+	value, ok := tenant.Annotations[RotateCredentialsAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	requestedAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if rotated := tenant.Status.CredentialsRotatedAt; rotated != nil && !requestedAt.After(rotated.Time) {
+		return time.Time{}, false
+	}
+	return requestedAt, true
+}
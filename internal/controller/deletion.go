@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+)
+
+// namespaceTeardownRecheckInterval is how often a Terminating tenant is checked for
+// namespaces that have not finished terminating.
+const namespaceTeardownRecheckInterval = 10 * time.Second
+
+// DeletionSummary describes what was destroyed when a tenant was deleted.
+// It is stored as the details of a "deletion" audit entry.
+type DeletionSummary struct {
+	Tenant           string    `json:"tenant"`
+	Tier             string    `json:"tier"`
+	Owner            string    `json:"owner"`
+	Namespace        string    `json:"namespace"`
+	StartedAt        time.Time `json:"startedAt"`
+	CompletedAt      time.Time `json:"completedAt"`
+	DurationSeconds  float64   `json:"durationSeconds"`
+	ResourcesDeleted []string  `json:"resourcesDeleted"`
+	Snapshot         string    `json:"snapshot,omitempty"`
+	Warnings         []string  `json:"warnings,omitempty"`
+}
+
+// newDeletionSummary starts a deletion summary for a tenant being torn down.
+func newDeletionSummary(tenant *platformv1alpha1.Tenant) *DeletionSummary {
+	started := time.Now().UTC()
+	if tenant.DeletionTimestamp != nil {
+		started = tenant.DeletionTimestamp.UTC()
+	}
+	return &DeletionSummary{
+		Tenant:    tenant.Name,
+		Tier:      string(tenant.Spec.Tier),
+		Owner:     tenant.Spec.Owner,
+		Namespace: buildNamespaceName(tenant),
+		StartedAt: started,
+	}
+}
+
+// warn appends a residual warning to the summary.
+func (s *DeletionSummary) warn(format string, args ...interface{}) {
+	s.Warnings = append(s.Warnings, fmt.Sprintf(format, args...))
+}
+
+// complete stamps the completion time and duration.
+func (s *DeletionSummary) complete() {
+	s.CompletedAt = time.Now().UTC()
+	s.DurationSeconds = s.CompletedAt.Sub(s.StartedAt).Seconds()
+}
+
+// recordDeletionSummary stores the deletion summary in the audit trail.
+func (r *TenantReconciler) recordDeletionSummary(ctx context.Context, summary *DeletionSummary, log logr.Logger) {
+	r.recordAudit(ctx, audit.Entry{
+		Timestamp: summary.CompletedAt,
+		Tenant:    summary.Tenant,
+		Action:    audit.ActionDeletion,
+		Message: fmt.Sprintf("tenant %s deleted: %d resources, %d warnings",
+			summary.Tenant, len(summary.ResourcesDeleted), len(summary.Warnings)),
+		Details: summary,
+	}, log)
+}
+
+// deleteTenantNamespaces deletes the namespaces the tenant owns and returns those that
+// still exist. The shared Bronze namespace is not owned by any tenant and is kept.
+func (r *TenantReconciler) deleteTenantNamespaces(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) ([]string, error) {
+	list := &corev1.NamespaceList{}
+	if err := r.List(ctx, list, client.MatchingLabels{TenantNameLabelKey: tenant.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list tenant namespaces: %w", err)
+	}
+
+	var remaining []string
+	for i := range list.Items {
+		ns := &list.Items[i]
+		if !metav1.IsControlledBy(ns, tenant) {
+			continue
+		}
+		if ns.DeletionTimestamp.IsZero() {
+			if err := r.Delete(ctx, ns); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to delete namespace %s: %w", ns.Name, err)
+			}
+			log.Info("deleting tenant namespace", "namespace", ns.Name)
+			// Without finalizers the namespace is gone right away
+			if err := r.Get(ctx, client.ObjectKeyFromObject(ns), ns); apierrors.IsNotFound(err) {
+				continue
+			}
+		}
+		remaining = append(remaining, ns.Name)
+	}
+	return remaining, nil
+}
+
+// setTerminatingMessage records what a Terminating tenant is waiting for in its Ready
+// condition. Failures are logged, as the deletion is retried anyway.
+func (r *TenantReconciler) setTerminatingMessage(ctx context.Context, tenant *platformv1alpha1.Tenant, message string, log logr.Logger) {
+	if condition := apimeta.FindStatusCondition(tenant.Status.Conditions, platformv1alpha1.ConditionReady); condition != nil &&
+		condition.Message == message {
+		return
+	}
+	setReadyCondition(tenant, metav1.ConditionFalse, "Terminating", message)
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		log.Error(err, "failed to update Terminating status")
+	}
+}
+
+// refuseDeletion records that a deleted tenant is kept because of
+// spec.deletionProtection, once.
+func (r *TenantReconciler) refuseDeletion(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	ready := apimeta.FindStatusCondition(tenant.Status.Conditions, platformv1alpha1.ConditionReady)
+	if ready != nil && ready.Reason == "DeletionProtected" {
+		return nil
+	}
+
+	message := "tenant was deleted but spec.deletionProtection is set; clear it to delete the tenant"
+	log.Info("refusing to tear down protected tenant")
+	setReadyCondition(tenant, metav1.ConditionFalse, "DeletionProtected", message)
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		return err
+	}
+	r.event(tenant, corev1.EventTypeWarning, "DeletionProtected", message)
+	return nil
+}
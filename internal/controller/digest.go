@@ -0,0 +1,278 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/notify"
+)
+
+// digestCheckInterval is how often the DigestSender looks for tenants due a digest.
+const digestCheckInterval = time.Hour
+
+// vulnerabilityReportGVK is the Trivy Operator report kind summarized in digests.
+var vulnerabilityReportGVK = schema.GroupVersionKind{
+	Group:   "aquasecurity.github.io",
+	Version: "v1alpha1",
+	Kind:    "VulnerabilityReportList",
+}
+
+// +kubebuilder:rbac:groups=aquasecurity.github.io,resources=vulnerabilityreports,verbs=list
+
+// DigestSender periodically sends each tenant owner a digest of quota usage, an
+// estimated cost, a vulnerability summary, and upcoming expirations. It runs as a
+// manager Runnable, so only the elected leader sends digests.
+type DigestSender struct {
+	Client   client.Client
+	Notifier notify.Notifier
+	Config   *config.OperatorConfig
+	Log      logr.Logger
+}
+
+// Start checks for due digests until ctx is cancelled.
+func (d *DigestSender) Start(ctx context.Context) error {
+	ticker := time.NewTicker(digestCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.SendDue(ctx, time.Now()); err != nil {
+			d.Log.Error(err, "failed to send tenant digests")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDue sends a digest to every tenant that has digests enabled and has not
+// received one within the configured interval.
+func (d *DigestSender) SendDue(ctx context.Context, now time.Time) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := d.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	var errs []string
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		if !d.digestDue(tenant, now) {
+			continue
+		}
+		if err := d.sendDigest(ctx, tenant, now); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("digest failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// digestDue reports whether tenant should receive a digest at now.
+func (d *DigestSender) digestDue(tenant *platformv1alpha1.Tenant, now time.Time) bool {
+	if !tenant.DeletionTimestamp.IsZero() {
+		return false
+	}
+	enabled := d.Config.Digest.DefaultEnabled
+	if tenant.Spec.Notifications.Digest != nil {
+		enabled = *tenant.Spec.Notifications.Digest
+	}
+	if !enabled {
+		return false
+	}
+	last := tenant.Status.LastDigestSentAt
+	return last == nil || !now.Before(last.Add(d.Config.Digest.Interval))
+}
+
+// sendDigest builds and sends one tenant's digest and records when it was sent.
+func (d *DigestSender) sendDigest(ctx context.Context, tenant *platformv1alpha1.Tenant, now time.Time) error {
+	body, err := d.buildDigest(ctx, tenant, now)
+	if err != nil {
+		return err
+	}
+
+	msg := notify.Message{
+		To:      append([]string{tenant.Spec.Owner}, tenant.Spec.Notifications.Recipients...),
+		Subject: fmt.Sprintf("[tenant-master] Usage digest for %s", tenant.Name),
+		Body:    body,
+	}
+	if err := d.Notifier.Send(ctx, msg); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(tenant.DeepCopy())
+	tenant.Status.LastDigestSentAt = &metav1.Time{Time: now}
+	if err := d.Client.Status().Patch(ctx, tenant, patch); err != nil {
+		return fmt.Errorf("failed to record digest: %w", err)
+	}
+
+	d.Log.Info("sent tenant digest", "tenant", tenant.Name, "recipients", len(msg.To))
+	return nil
+}
+
+// buildDigest renders the plain-text digest for tenant covering the interval before now.
+func (d *DigestSender) buildDigest(ctx context.Context, tenant *platformv1alpha1.Tenant, now time.Time) (string, error) {
+	interval := d.Config.Digest.Interval
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Usage digest for tenant %s (%s tier)\n", tenant.Name, tenant.Spec.Tier)
+	fmt.Fprintf(&b, "Period: %s to %s\n\n", now.Add(-interval).UTC().Format("2006-01-02"), now.UTC().Format("2006-01-02"))
+
+	// Usage vs quota, per namespace
+	quotas := &corev1.ResourceQuotaList{}
+	if err := d.Client.List(ctx, quotas, client.MatchingLabels{TenantNameLabelKey: tenant.Name}); err != nil {
+		return "", fmt.Errorf("failed to list quotas: %w", err)
+	}
+	sort.Slice(quotas.Items, func(i, j int) bool {
+		return quotas.Items[i].Namespace+"/"+quotas.Items[i].Name < quotas.Items[j].Namespace+"/"+quotas.Items[j].Name
+	})
+
+	b.WriteString("Usage vs quota\n")
+	if len(quotas.Items) == 0 {
+		b.WriteString("  No quota-managed namespaces.\n")
+	}
+	cpuUsed, memoryUsed := resource.Quantity{}, resource.Quantity{}
+	for _, rq := range quotas.Items {
+		fmt.Fprintf(&b, "  %s\n", rq.Namespace)
+		names := make([]string, 0, len(rq.Status.Hard))
+		for name := range rq.Status.Hard {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			hard := rq.Status.Hard[corev1.ResourceName(name)]
+			used := rq.Status.Used[corev1.ResourceName(name)]
+			pct := 0.0
+			if hard.MilliValue() > 0 {
+				pct = float64(used.MilliValue()) * 100 / float64(hard.MilliValue())
+			}
+			fmt.Fprintf(&b, "    %-18s %s / %s (%.0f%%)\n", name, used.String(), hard.String(), pct)
+		}
+		if used, ok := rq.Status.Used[corev1.ResourceRequestsCPU]; ok {
+			cpuUsed.Add(used)
+		}
+		if used, ok := rq.Status.Used[corev1.ResourceRequestsMemory]; ok {
+			memoryUsed.Add(used)
+		}
+	}
+
+	// Cost estimate from current requests held over the whole period
+	hours := interval.Hours()
+	cpuCost := float64(cpuUsed.MilliValue()) / 1000 * hours * d.Config.Digest.CPUCoreHourCost
+	memoryCost := float64(memoryUsed.Value()) / (1 << 30) * hours * d.Config.Digest.MemoryGiBHourCost
+	fmt.Fprintf(&b, "\nEstimated cost: %.2f (CPU %.2f, memory %.2f), based on current requests\n",
+		cpuCost+memoryCost, cpuCost, memoryCost)
+
+	// Vulnerabilities reported by the Trivy Operator, if installed
+	b.WriteString("\nVulnerabilities\n")
+	b.WriteString("  " + d.vulnerabilitySummary(ctx, quotas.Items) + "\n")
+
+	// Expirations within the next period
+	b.WriteString("\nUpcoming expirations\n")
+	expiries, err := d.upcomingExpirations(ctx, tenant, now, now.Add(interval))
+	if err != nil {
+		return "", err
+	}
+	if len(expiries) == 0 {
+		b.WriteString("  None.\n")
+	}
+	for _, e := range expiries {
+		fmt.Fprintf(&b, "  - %s\n", e)
+	}
+
+	return b.String(), nil
+}
+
+// vulnerabilitySummary totals the Trivy VulnerabilityReports in the tenant namespaces.
+func (d *DigestSender) vulnerabilitySummary(ctx context.Context, quotas []corev1.ResourceQuota) string {
+	severities := []string{"critical", "high", "medium", "low"}
+	counts := map[string]int64{}
+	reports := 0
+
+	seen := map[string]bool{}
+	for _, rq := range quotas {
+		if seen[rq.Namespace] {
+			continue
+		}
+		seen[rq.Namespace] = true
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(vulnerabilityReportGVK)
+		if err := d.Client.List(ctx, list, client.InNamespace(rq.Namespace)); err != nil {
+			return "Not available (no vulnerability scanner reports)."
+		}
+		for _, item := range list.Items {
+			reports++
+			for _, sev := range severities {
+				n, _, _ := unstructured.NestedInt64(item.Object, "report", "summary", sev+"Count")
+				counts[sev] += n
+			}
+		}
+	}
+
+	if reports == 0 {
+		return "No vulnerability reports found."
+	}
+	parts := make([]string, 0, len(severities))
+	for _, sev := range severities {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[sev], sev))
+	}
+	return fmt.Sprintf("%s across %d scanned workloads.", strings.Join(parts, ", "), reports)
+}
+
+// upcomingExpirations lists quota bursts and break-glass grants ending before until.
+func (d *DigestSender) upcomingExpirations(ctx context.Context, tenant *platformv1alpha1.Tenant, now, until time.Time) ([]string, error) {
+	var out []string
+
+	if burst := tenant.Status.Burst; burst != nil && burst.ExpiresAt != nil &&
+		burst.ExpiresAt.After(now) && burst.ExpiresAt.Time.Before(until) {
+		out = append(out, fmt.Sprintf("Quota burst ends %s", burst.ExpiresAt.UTC().Format(time.RFC1123)))
+	}
+
+	requests := &platformv1alpha1.TenantAccessRequestList{}
+	if err := d.Client.List(ctx, requests); err != nil {
+		return nil, fmt.Errorf("failed to list access requests: %w", err)
+	}
+	for _, ar := range requests.Items {
+		if ar.Spec.TenantRef != tenant.Name || ar.Status.Phase != platformv1alpha1.AccessRequestActive {
+			continue
+		}
+		if exp := ar.Status.ExpiresAt; exp != nil && exp.Time.Before(until) {
+			out = append(out, fmt.Sprintf("Break-glass access %q for %s %s ends %s",
+				ar.Name, strings.ToLower(ar.Spec.Subject.Kind), ar.Spec.Subject.Name, exp.UTC().Format(time.RFC1123)))
+		}
+	}
+
+	return out, nil
+}
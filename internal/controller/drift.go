@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// DesiredHashAnnotation records a hash of the fields the operator manages on a child
+// object, as of its last write. A live object whose managed fields no longer match
+// while the hash still does was changed by someone else.
+const DesiredHashAnnotation = "tenant.platform.io/desired-hash"
+
+// managedFields returns the fields of a child object the operator owns, compared
+// semantically to detect drift. Labels are limited to the operator's own keys.
+func managedFields(obj client.Object) interface{} {
+	labels := map[string]string{}
+	for _, key := range []string{TenantNameLabelKey, TierLabelKey, OwnerLabelKey, ManagedByLabelKey, PodSecurityEnforceLabelKey, IstioInjectionLabelKey, MonitoringLabelKey} {
+		if value, ok := obj.GetLabels()[key]; ok {
+			labels[key] = value
+		}
+	}
+
+	var spec interface{}
+	switch o := obj.(type) {
+	case *corev1.ResourceQuota:
+		spec = o.Spec.DeepCopy()
+	case *corev1.LimitRange:
+		spec = o.Spec.DeepCopy()
+	case *rbacv1.Role:
+		spec = append([]rbacv1.PolicyRule(nil), o.Rules...)
+	case *rbacv1.RoleBinding:
+		spec = struct {
+			RoleRef  rbacv1.RoleRef   `json:"roleRef"`
+			Subjects []rbacv1.Subject `json:"subjects"`
+		}{o.RoleRef, append([]rbacv1.Subject(nil), o.Subjects...)}
+	case *netv1.NetworkPolicy:
+		spec = o.Spec.DeepCopy()
+	}
+	return struct {
+		Labels map[string]string `json:"labels"`
+		Spec   interface{}       `json:"spec,omitempty"`
+	}{labels, spec}
+}
+
+// desiredHash hashes the managed fields of obj.
+func desiredHash(obj client.Object) (string, error) {
+	raw, err := json.Marshal(managedFields(obj))
+	if err != nil {
+		return "", fmt.Errorf("failed to hash managed fields: %w", err)
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// applyManaged creates or updates a child object of the tenant with mutate, like
+// controllerutil.CreateOrUpdate, and reports drift: an update that reverts edits made
+// by someone else, since the operator's desired state is unchanged since its last
+// write, or a re-creation of an object deleted after the tenant was provisioned.
+// Drift is counted per kind in tenant_drift_detected_total and emitted as an Event.
+func (r *TenantReconciler) applyManaged(ctx context.Context, tenant *platformv1alpha1.Tenant, obj client.Object, mutate controllerutil.MutateFn, log logr.Logger) (controllerutil.OperationResult, error) {
+	drifted := false
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+		exists := obj.GetResourceVersion() != ""
+		live := managedFields(obj)
+		liveHash := obj.GetAnnotations()[DesiredHashAnnotation]
+
+		if err := mutate(); err != nil {
+			return err
+		}
+		hash, err := desiredHash(obj)
+		if err != nil {
+			return err
+		}
+		drifted = exists && liveHash == hash && !equality.Semantic.DeepEqual(live, managedFields(obj))
+		setAnnotation(obj, DesiredHashAnnotation, hash)
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if result == controllerutil.OperationResultCreated && stepCompleted(tenant, platformv1alpha1.ConditionBaseResourcesProvisioned) {
+		drifted = true
+	}
+	if drifted && result != controllerutil.OperationResultNone {
+		kind := fmt.Sprintf("%T", obj)
+		if gvk, err := apiutil.GVKForObject(obj, r.Scheme); err == nil {
+			kind = gvk.Kind
+		}
+		ref := obj.GetName()
+		if obj.GetNamespace() != "" {
+			ref = obj.GetNamespace() + "/" + ref
+		}
+		metrics.RecordDriftDetected(tenant.Name, kind)
+		log.Info("drift detected, reverted to desired state", "kind", kind, "object", ref, "operation", result)
+		r.event(tenant, corev1.EventTypeWarning, "DriftCorrected",
+			fmt.Sprintf("reverted changes made outside the operator to %s %s", kind, ref))
+	}
+	return result, nil
+}
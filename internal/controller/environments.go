@@ -0,0 +1,268 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// ensureEnvironments creates one namespace per entry in spec.environments, each with
+// its share of the tenant quota and a default-deny NetworkPolicy, and removes
+// environment namespaces that are no longer requested.
+func (r *TenantReconciler) ensureEnvironments(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	_, shares := environmentQuotaShares(tenant)
+	desired := make(map[string]bool, len(tenant.Spec.Environments))
+
+	for _, env := range tenant.Spec.Environments {
+		namespaceName := buildEnvironmentNamespaceName(tenant, env.Name)
+		desired[namespaceName] = true
+
+		if err := r.ensureEnvironmentNamespace(ctx, tenant, env, log); err != nil {
+			return fmt.Errorf("environment %s: %w", env.Name, err)
+		}
+
+		hard := scaleQuotaHard(buildQuotaHard(tenant, r.config().Quota.LimitsOvercommitRatio), shares[env.Name])
+		rq := &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-quota", tenant.Name),
+				Namespace: namespaceName,
+				Labels: map[string]string{
+					TenantNameLabelKey:  tenant.Name,
+					ManagedByLabelKey:   ManagedByValue,
+					EnvironmentLabelKey: env.Name,
+				},
+			},
+		}
+		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, rq, func() error {
+			rq.Spec.Hard = hard
+			return controllerutil.SetControllerReference(tenant, rq, r.Scheme)
+		})
+		if err != nil {
+			log.Error(err, "failed to create or update environment ResourceQuota", "namespace", namespaceName)
+			return fmt.Errorf("environment %s: %w", env.Name, err)
+		}
+		log.Info("ensured environment ResourceQuota", "namespace", namespaceName,
+			"quotaPercent", shares[env.Name], "operation", result)
+
+		if err := r.ensureEnvironmentNetworkPolicy(ctx, tenant, env, log); err != nil {
+			return fmt.Errorf("environment %s: %w", env.Name, err)
+		}
+	}
+
+	// Remove environments dropped from the spec
+	existing := &corev1.NamespaceList{}
+	if err := r.List(ctx, existing, client.MatchingLabels{TenantNameLabelKey: tenant.Name}); err != nil {
+		return fmt.Errorf("failed to list environment namespaces: %w", err)
+	}
+	for i := range existing.Items {
+		ns := &existing.Items[i]
+		if _, ok := ns.Labels[EnvironmentLabelKey]; !ok || desired[ns.Name] {
+			continue
+		}
+		if err := r.Delete(ctx, ns); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete stale environment namespace %s: %w", ns.Name, err)
+		}
+		log.Info("removed stale environment namespace", "namespace", ns.Name)
+	}
+
+	return nil
+}
+
+// ensureEnvironmentNamespace creates or updates the namespace for one environment.
+func (r *TenantReconciler) ensureEnvironmentNamespace(ctx context.Context, tenant *platformv1alpha1.Tenant, env platformv1alpha1.TenantEnvironment, log logr.Logger) error {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: buildEnvironmentNamespaceName(tenant, env.Name)},
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, ns, func() error {
+		ns.Labels = map[string]string{
+			TenantNameLabelKey:          tenant.Name,
+			TierLabelKey:                string(tenant.Spec.Tier),
+			OwnerLabelKey:               tenant.Spec.Owner,
+			ManagedByLabelKey:           ManagedByValue,
+			EnvironmentLabelKey:         env.Name,
+			EnvironmentIsolatedLabelKey: strconv.FormatBool(environmentIsolated(env)),
+		}
+		setPodSecurityLabel(ns, r.podSecurityLevel(tenant))
+		setMeshMetadata(ns, tenant)
+		setMonitoringLabel(ns, tenant)
+		if err := setSchedulingAnnotations(ns, tenant); err != nil {
+			return err
+		}
+		setAnnotation(ns, DefaultStorageClassAnnotation, tenant.Spec.Resources.StorageClass)
+		if err := setDNSConfigAnnotation(ns, tenant); err != nil {
+			return err
+		}
+		return controllerutil.SetControllerReference(tenant, ns, r.Scheme)
+	})
+	if err != nil {
+		log.Error(err, "failed to create or update environment namespace", "namespace", ns.Name)
+		return err
+	}
+
+	log.Info("ensured environment namespace", "namespace", ns.Name, "operation", result)
+	return nil
+}
+
+// ensureEnvironmentNetworkPolicy creates the default-deny NetworkPolicy for one environment.
+// Non-isolated environments accept traffic from each other; isolated environments
+// (prod by default) only accept traffic from inside their own namespace.
+func (r *TenantReconciler) ensureEnvironmentNetworkPolicy(ctx context.Context, tenant *platformv1alpha1.Tenant, env platformv1alpha1.TenantEnvironment, log logr.Logger) error {
+	namespaceName := buildEnvironmentNamespaceName(tenant, env.Name)
+
+	ingressRules := []netv1.NetworkPolicyIngressRule{
+		{From: []netv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}},
+	}
+	if rule := allowedIngressRule(tenant); rule != nil {
+		ingressRules = append(ingressRules, *rule)
+	}
+	egressRules, err := r.buildEgressRules(ctx, tenant, log)
+	if err != nil {
+		return err
+	}
+
+	if !environmentIsolated(env) {
+		siblings := netv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					TenantNameLabelKey:          tenant.Name,
+					EnvironmentIsolatedLabelKey: "false",
+				},
+			},
+		}
+		ingressRules = append(ingressRules, netv1.NetworkPolicyIngressRule{From: []netv1.NetworkPolicyPeer{siblings}})
+		egressRules = append(egressRules, netv1.NetworkPolicyEgressRule{To: []netv1.NetworkPolicyPeer{siblings}})
+	}
+
+	netPolicy := &netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultNetworkPolicyName,
+			Namespace: namespaceName,
+			Labels: map[string]string{
+				TenantNameLabelKey:  tenant.Name,
+				ManagedByLabelKey:   ManagedByValue,
+				EnvironmentLabelKey: env.Name,
+			},
+		},
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, netPolicy, func() error {
+		netPolicy.Spec = netv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeIngress, netv1.PolicyTypeEgress},
+			Ingress:     ingressRules,
+			Egress:      egressRules,
+		}
+		return controllerutil.SetControllerReference(tenant, netPolicy, r.Scheme)
+	})
+	if err != nil {
+		log.Error(err, "failed to create or update environment NetworkPolicy", "namespace", namespaceName)
+		return err
+	}
+
+	log.Info("ensured environment NetworkPolicy", "namespace", namespaceName,
+		"isolated", environmentIsolated(env), "operation", result)
+	return nil
+}
+
+// buildEnvironmentNamespaceName generates the namespace name for a tenant environment.
+func buildEnvironmentNamespaceName(tenant *platformv1alpha1.Tenant, env string) string {
+	return fmt.Sprintf("%s-%s", buildNamespaceName(tenant), env)
+}
+
+// environmentIsolated reports whether an environment is cut off from its siblings.
+func environmentIsolated(env platformv1alpha1.TenantEnvironment) bool {
+	if env.Isolated != nil {
+		return *env.Isolated
+	}
+	return env.Name == "prod" || env.Name == "production"
+}
+
+// environmentQuotaShares splits the tenant budget (in percent) between the tenant
+// namespace and its environments. Environments without an explicit quotaPercent
+// share the unclaimed remainder evenly; the tenant namespace keeps what is left.
+// Without environments the tenant namespace keeps the whole budget.
+func environmentQuotaShares(tenant *platformv1alpha1.Tenant) (int64, map[string]int64) {
+	shares := make(map[string]int64, len(tenant.Spec.Environments))
+	remaining := int64(100)
+	var unset []string
+
+	for _, env := range tenant.Spec.Environments {
+		if env.QuotaPercent == nil {
+			unset = append(unset, env.Name)
+			continue
+		}
+		shares[env.Name] = int64(*env.QuotaPercent)
+		remaining -= int64(*env.QuotaPercent)
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	if len(unset) > 0 {
+		each := remaining / int64(len(unset))
+		for _, name := range unset {
+			shares[name] = each
+		}
+		remaining -= each * int64(len(unset))
+	}
+
+	return remaining, shares
+}
+
+// scaleQuotaHard scales the CPU, memory, storage (total and per StorageClass), and pod limits in hard to percent of
+// their value. Other object count limits are left unchanged and apply per namespace.
+func scaleQuotaHard(hard corev1.ResourceList, percent int64) corev1.ResourceList {
+	if percent >= 100 {
+		return hard
+	}
+
+	scaled := hard.DeepCopy()
+	for _, name := range []corev1.ResourceName{corev1.ResourceRequestsCPU, corev1.ResourceLimitsCPU} {
+		if qty, ok := hard[name]; ok {
+			scaled[name] = *resource.NewMilliQuantity(qty.MilliValue()*percent/100, resource.DecimalSI)
+		}
+	}
+	binary := []corev1.ResourceName{corev1.ResourceRequestsMemory, corev1.ResourceLimitsMemory, corev1.ResourceRequestsStorage}
+	for name := range hard {
+		if strings.HasSuffix(string(name), storageClassQuotaSuffix) {
+			binary = append(binary, name)
+		}
+	}
+	for _, name := range binary {
+		if qty, ok := hard[name]; ok {
+			scaled[name] = *resource.NewQuantity(qty.Value()*percent/100, resource.BinarySI)
+		}
+	}
+	if qty, ok := hard[corev1.ResourcePods]; ok {
+		scaled[corev1.ResourcePods] = *resource.NewQuantity(qty.Value()*percent/100, resource.DecimalSI)
+	}
+	return scaled
+}
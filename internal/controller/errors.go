@@ -0,0 +1,108 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+)
+
+// ErrorClass groups reconciliation errors by how they should be retried.
+type ErrorClass string
+
+const (
+	// ErrorClassCapacity covers quota and capacity exhaustion.
+	ErrorClassCapacity ErrorClass = "Capacity"
+
+	// ErrorClassTransient covers API errors that are expected to clear on retry.
+	ErrorClassTransient ErrorClass = "Transient"
+
+	// ErrorClassValidation covers errors caused by an invalid tenant spec.
+	ErrorClassValidation ErrorClass = "Validation"
+
+	// ErrorClassUnknown covers everything else.
+	ErrorClassUnknown ErrorClass = "Unknown"
+)
+
+// validationError marks an error as caused by the tenant spec rather than the cluster.
+type validationError struct {
+	err error
+}
+
+func (e *validationError) Error() string { return e.err.Error() }
+func (e *validationError) Unwrap() error { return e.err }
+
+// newValidationError wraps err so it is classified as ErrorClassValidation.
+func newValidationError(err error) error {
+	return &validationError{err: err}
+}
+
+// classifyError determines the ErrorClass of a reconciliation error.
+func classifyError(err error) ErrorClass {
+	var vErr *validationError
+	switch {
+	case errors.As(err, &vErr):
+		return ErrorClassValidation
+	case apierrors.IsForbidden(err) && strings.Contains(err.Error(), "exceeded quota"):
+		return ErrorClassCapacity
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return ErrorClassValidation
+	case apierrors.IsConflict(err),
+		apierrors.IsServerTimeout(err),
+		apierrors.IsTimeout(err),
+		apierrors.IsTooManyRequests(err),
+		apierrors.IsServiceUnavailable(err),
+		apierrors.IsInternalError(err),
+		errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTransient
+	default:
+		return ErrorClassUnknown
+	}
+}
+
+// requeueAfter returns the retry interval configured for an error class.
+func requeueAfter(policy config.RequeuePolicy, class ErrorClass) time.Duration {
+	switch class {
+	case ErrorClassCapacity:
+		return policy.Capacity
+	case ErrorClassTransient:
+		return policy.Transient
+	case ErrorClassValidation:
+		return policy.Validation
+	default:
+		return policy.Default
+	}
+}
+
+// resultForError builds the reconcile result for a failed reconciliation.
+// The error is returned as terminal when the policy disables retries for its
+// class, so controller-runtime does not apply its own backoff.
+func resultForError(policy config.RequeuePolicy, err error) (ctrl.Result, error) {
+	after := requeueAfter(policy, classifyError(err))
+	if after <= 0 {
+		return ctrl.Result{}, reconcile.TerminalError(err)
+	}
+	return ctrl.Result{RequeueAfter: after}, nil
+}
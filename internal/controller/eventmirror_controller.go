@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// FailedSchedulingSpikeThreshold is how many times a Pod must fail scheduling before
+// the event is mirrored to its Tenant. Isolated scheduling retries are normal.
+const FailedSchedulingSpikeThreshold = 5
+
+// EventMirrorReconciler copies significant Events from tenant namespaces onto the
+// cluster-scoped Tenant, so `kubectl describe tenant` shows quota, scheduling, and
+// image pull problems without access to the namespace.
+type EventMirrorReconciler struct {
+	client.Client
+	Log logr.Logger
+
+	// Recorder emits the mirrored Events on the Tenant.
+	Recorder record.EventRecorder
+}
+
+// Reconcile mirrors one namespace Event to the owning Tenant.
+func (r *EventMirrorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ev := &corev1.Event{}
+	if err := r.Get(ctx, req.NamespacedName, ev); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !isSignificantEvent(ev) {
+		return ctrl.Result{}, nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: ev.Namespace}, ns); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	tenantName := ns.Labels[TenantNameLabelKey]
+	if tenantName == "" {
+		return ctrl.Result{}, nil
+	}
+
+	tenant := &platformv1alpha1.Tenant{}
+	if err := r.Get(ctx, client.ObjectKey{Name: tenantName}, tenant); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The recorder aggregates repeats of the same message into one Event with a count
+	r.Recorder.Eventf(tenant, corev1.EventTypeWarning, ev.Reason, "%s/%s %s: %s",
+		ev.Namespace, strings.ToLower(ev.InvolvedObject.Kind), ev.InvolvedObject.Name, ev.Message)
+	r.Log.V(1).Info("mirrored event to tenant", "tenant", tenantName, "namespace", ev.Namespace, "reason", ev.Reason)
+	return ctrl.Result{}, nil
+}
+
+// isSignificantEvent reports whether ev signals a tenant-level problem worth showing
+// on the Tenant: exceeded quota, repeated scheduling failures, or image pull failures.
+func isSignificantEvent(ev *corev1.Event) bool {
+	if ev.Type != corev1.EventTypeWarning {
+		return false
+	}
+	msg := strings.ToLower(ev.Message)
+
+	switch ev.Reason {
+	case "FailedScheduling":
+		return ev.Count >= FailedSchedulingSpikeThreshold
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+		return true
+	case "Failed", "BackOff":
+		// The kubelet reports pull failures under generic reasons
+		return strings.Contains(msg, "pull") && strings.Contains(msg, "image")
+	case "FailedCreate":
+		return strings.Contains(msg, "exceeded quota") || strings.Contains(msg, "forbidden: failed quota")
+	}
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *EventMirrorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	significant := func(obj client.Object) bool {
+		ev, ok := obj.(*corev1.Event)
+		return ok && isSignificantEvent(ev)
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("eventmirror").
+		For(&corev1.Event{}, builder.WithPredicates(predicate.Funcs{
+			CreateFunc:  func(e event.CreateEvent) bool { return significant(e.Object) },
+			UpdateFunc:  func(e event.UpdateEvent) bool { return significant(e.ObjectNew) },
+			DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+			GenericFunc: func(e event.GenericEvent) bool { return false },
+		})).
+		Complete(r)
+}
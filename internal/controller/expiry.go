@@ -0,0 +1,220 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+	"github.com/amartyaa/tenant-master/operator/internal/notify"
+)
+
+// expiryCheckInterval is how often the TenantExpirer looks for expired tenants.
+const expiryCheckInterval = time.Minute
+
+// TenantExpirer deletes ephemeral tenants whose spec.ttl ran out. An expired tenant is
+// first marked with the Expired condition and its owner notified; it is deleted once
+// the grace period has passed, unless the TTL is extended or removed in the meantime.
+// It runs as a manager Runnable, so only the elected leader acts.
+type TenantExpirer struct {
+	Client   client.Client
+	Recorder record.EventRecorder
+	Notifier notify.Notifier
+	Audit    *audit.Recorder
+	Config   *config.OperatorConfig
+	Log      logr.Logger
+}
+
+// Start checks for expired tenants until ctx is cancelled.
+func (e *TenantExpirer) Start(ctx context.Context) error {
+	ticker := time.NewTicker(expiryCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Expire(ctx, time.Now()); err != nil {
+			e.Log.Error(err, "failed to expire tenants")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Expire records when each tenant with a TTL expires, marks and notifies tenants that
+// expired since the last check, and deletes those whose grace period has passed.
+func (e *TenantExpirer) Expire(ctx context.Context, now time.Time) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := e.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	remaining := map[string]metrics.TTLRemaining{}
+	var errs []string
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		if expiresAt, ok := tenantExpiresAt(tenant); ok && tenant.DeletionTimestamp.IsZero() {
+			remaining[tenant.Name] = metrics.TTLRemaining{
+				Tier:    string(tenant.Spec.Tier),
+				Seconds: expiresAt.Sub(now).Seconds(),
+			}
+		}
+		if err := e.expireTenant(ctx, tenant, now); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+		}
+	}
+	metrics.SetTTLRemaining(remaining)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("tenant expiry failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// tenantExpiresAt returns when the tenant's spec.ttl runs out, counted from its
+// creation, and whether it has a TTL at all.
+func tenantExpiresAt(tenant *platformv1alpha1.Tenant) (time.Time, bool) {
+	if tenant.Spec.TTL == nil || tenant.CreationTimestamp.IsZero() {
+		return time.Time{}, false
+	}
+	return tenant.CreationTimestamp.Add(tenant.Spec.TTL.Duration), true
+}
+
+// expireTenant brings one tenant's expiry status up to date and deletes it once it
+// expired and the grace period has passed. Paused and protected tenants are left alone.
+func (e *TenantExpirer) expireTenant(ctx context.Context, tenant *platformv1alpha1.Tenant, now time.Time) error {
+	if !tenant.DeletionTimestamp.IsZero() || isPaused(tenant) || tenant.Spec.DeletionProtection {
+		return nil
+	}
+	before := tenant.DeepCopy()
+	expired := apimeta.FindStatusCondition(tenant.Status.Conditions, platformv1alpha1.ConditionExpired)
+	isExpired := expired != nil && expired.Status == metav1.ConditionTrue
+
+	expiresAt, ok := tenantExpiresAt(tenant)
+	if !ok {
+		tenant.Status.ExpiresAt = nil
+		if isExpired {
+			e.setExpiredCondition(tenant, now, metav1.ConditionFalse, "TTLRemoved", "spec.ttl was removed")
+		}
+		return e.patchStatus(ctx, before, tenant)
+	}
+	at := metav1.NewTime(expiresAt)
+	previous := tenant.Status.ExpiresAt
+	tenant.Status.ExpiresAt = &at
+
+	switch {
+	case now.Before(expiresAt):
+		if isExpired {
+			e.setExpiredCondition(tenant, now, metav1.ConditionFalse, "TTLExtended",
+				fmt.Sprintf("spec.ttl was extended; tenant expires at %s", expiresAt.UTC().Format(time.RFC3339)))
+		}
+	case !isExpired || (previous != nil && !previous.Equal(&at)):
+		// A TTL changed after the notice gets a notice and grace period of its own
+		return e.markExpired(ctx, before, tenant, expiresAt, now)
+	case !now.Before(expired.LastTransitionTime.Add(e.Config.Expiry.Grace)):
+		return e.deleteExpired(ctx, tenant, expiresAt)
+	}
+	return e.patchStatus(ctx, before, tenant)
+}
+
+// markExpired notifies the owner of a newly expired tenant and records the Expired
+// condition, whose transition time starts the grace period. A failed notification is
+// retried on the next check, so the notice is never skipped.
+func (e *TenantExpirer) markExpired(ctx context.Context, before, tenant *platformv1alpha1.Tenant, expiresAt, now time.Time) error {
+	deleteAt := now.Add(e.Config.Expiry.Grace)
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Tenant %s expired at %s (spec.ttl %s) and will be deleted at %s.\n\n",
+		tenant.Name, expiresAt.UTC().Format(time.RFC1123), tenant.Spec.TTL.Duration, deleteAt.UTC().Format(time.RFC1123))
+	body.WriteString("Extend or remove spec.ttl to keep the tenant.\n")
+	msg := notify.Message{
+		To:      append([]string{tenant.Spec.Owner}, tenant.Spec.Notifications.Recipients...),
+		Subject: fmt.Sprintf("[tenant-master] Tenant %s expired and will be deleted", tenant.Name),
+		Body:    body.String(),
+	}
+	if err := e.Notifier.Send(ctx, msg); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("tenant expired at %s and will be deleted at %s unless spec.ttl is extended",
+		expiresAt.UTC().Format(time.RFC3339), deleteAt.UTC().Format(time.RFC3339))
+	e.setExpiredCondition(tenant, now, metav1.ConditionTrue, "TTLExpired", message)
+	if err := e.patchStatus(ctx, before, tenant); err != nil {
+		return err
+	}
+	e.Recorder.Event(tenant, corev1.EventTypeWarning, "TenantExpired", message)
+	e.Log.Info("tenant expired", "tenant", tenant.Name, "deleteAt", deleteAt)
+	return nil
+}
+
+// deleteExpired deletes an expired tenant and records it in the audit trail.
+func (e *TenantExpirer) deleteExpired(ctx context.Context, tenant *platformv1alpha1.Tenant, expiresAt time.Time) error {
+	message := fmt.Sprintf("tenant expired at %s (spec.ttl %s)", expiresAt.UTC().Format(time.RFC3339), tenant.Spec.TTL.Duration)
+	e.Recorder.Event(tenant, corev1.EventTypeWarning, "ExpiredTenantDeleted", message)
+	if err := e.Client.Delete(ctx, tenant); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete tenant: %w", err)
+	}
+
+	recordAuditEntry(ctx, e.Audit, audit.Entry{
+		Tenant:  tenant.Name,
+		Action:  audit.ActionExpired,
+		Actor:   "tenant-master",
+		Message: message,
+		Details: map[string]string{"ttl": tenant.Spec.TTL.Duration.String(), "expiresAt": expiresAt.UTC().Format(time.RFC3339)},
+	}, e.Log)
+	e.Log.Info("deleted expired tenant", "tenant", tenant.Name)
+	return nil
+}
+
+// setExpiredCondition sets the Expired condition with now as its transition time.
+func (e *TenantExpirer) setExpiredCondition(tenant *platformv1alpha1.Tenant, now time.Time, status metav1.ConditionStatus, reason, message string) {
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               platformv1alpha1.ConditionExpired,
+		Status:             status,
+		ObservedGeneration: tenant.Generation,
+		LastTransitionTime: metav1.NewTime(now),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// patchStatus patches the tenant status if it changed.
+func (e *TenantExpirer) patchStatus(ctx context.Context, before, tenant *platformv1alpha1.Tenant) error {
+	if equality.Semantic.DeepEqual(before.Status, tenant.Status) {
+		return nil
+	}
+	if err := e.Client.Status().Patch(ctx, tenant, client.MergeFrom(before)); err != nil {
+		return fmt.Errorf("failed to record expiry: %w", err)
+	}
+	return nil
+}
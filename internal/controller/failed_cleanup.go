@@ -0,0 +1,218 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/notify"
+)
+
+// failedCleanupCheckInterval is how often the FailedTenantCleaner looks for expired tenants.
+const failedCleanupCheckInterval = 10 * time.Minute
+
+// FailedTenantCleaner deletes or suspends tenants that stayed Failed beyond the
+// configured retention, so half-provisioned tenants do not accumulate. The owner is
+// notified when the cleanup is scheduled, at least the notice period before it
+// happens. It runs as a manager Runnable, so only the elected leader acts.
+type FailedTenantCleaner struct {
+	Client   client.Client
+	Recorder record.EventRecorder
+	Notifier notify.Notifier
+	Audit    *audit.Recorder
+	Config   *config.OperatorConfig
+	Log      logr.Logger
+}
+
+// Start runs the cleanup until ctx is cancelled. It returns immediately when no
+// cleanup action is configured.
+func (c *FailedTenantCleaner) Start(ctx context.Context) error {
+	if c.Config.Cleanup.Action == "" {
+		return nil
+	}
+
+	ticker := time.NewTicker(failedCleanupCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Cleanup(ctx, time.Now()); err != nil {
+			c.Log.Error(err, "failed to clean up Failed tenants")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Cleanup schedules the cleanup of tenants whose retention ends within the notice
+// period, applies it to tenants whose notice period has passed, and unschedules
+// tenants that recovered.
+func (c *FailedTenantCleaner) Cleanup(ctx context.Context, now time.Time) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := c.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	var errs []string
+	for i := range tenants.Items {
+		if err := c.cleanupTenant(ctx, &tenants.Items[i], now); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenants.Items[i].Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed tenant cleanup failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (c *FailedTenantCleaner) cleanupTenant(ctx context.Context, tenant *platformv1alpha1.Tenant, now time.Time) error {
+	scheduled := apimeta.FindStatusCondition(tenant.Status.Conditions, platformv1alpha1.ConditionCleanupScheduled)
+	isScheduled := scheduled != nil && scheduled.Status == metav1.ConditionTrue
+
+	failedSince, failed := c.failedSince(tenant)
+	switch {
+	case !failed:
+		if isScheduled {
+			return c.setCleanupCondition(ctx, tenant, now, metav1.ConditionFalse, "Recovered", "tenant is no longer Failed")
+		}
+		return nil
+	case !isScheduled:
+		if now.Before(failedSince.Add(c.Config.Cleanup.Retention - c.Config.Cleanup.Notice)) {
+			return nil
+		}
+		return c.schedule(ctx, tenant, now)
+	case now.Before(scheduled.LastTransitionTime.Add(c.Config.Cleanup.Notice)):
+		return nil
+	}
+	return c.apply(ctx, tenant, now, now.Sub(failedSince))
+}
+
+// failedSince returns when the tenant last entered the Failed state, and whether it
+// is still Failed and eligible for cleanup. Protected tenants are never deleted.
+func (c *FailedTenantCleaner) failedSince(tenant *platformv1alpha1.Tenant) (time.Time, bool) {
+	if tenant.Status.State != platformv1alpha1.StateFailed || !tenant.DeletionTimestamp.IsZero() ||
+		isPaused(tenant) || tenant.Spec.Suspend ||
+		(tenant.Spec.DeletionProtection && c.Config.Cleanup.Action == config.FailedCleanupDelete) {
+		return time.Time{}, false
+	}
+	ready := apimeta.FindStatusCondition(tenant.Status.Conditions, platformv1alpha1.ConditionReady)
+	if ready == nil || ready.Status != metav1.ConditionFalse {
+		return time.Time{}, false
+	}
+	return ready.LastTransitionTime.Time, true
+}
+
+// schedule notifies the owner and records the scheduled cleanup. A failed
+// notification is retried on the next check, so the notice is never skipped.
+func (c *FailedTenantCleaner) schedule(ctx context.Context, tenant *platformv1alpha1.Tenant, now time.Time) error {
+	action := strings.ToLower(c.Config.Cleanup.Action)
+	at := now.Add(c.Config.Cleanup.Notice)
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Tenant %s has been Failed for longer than the %s retention and will be %s at %s.\n\n",
+		tenant.Name, c.Config.Cleanup.Retention, action+"d", at.UTC().Format(time.RFC1123))
+	if tenant.Status.LastError != "" {
+		fmt.Fprintf(&body, "Last error: %s\n\n", tenant.Status.LastError)
+	}
+	fmt.Fprintf(&body, "Fix the tenant spec, or set the %s annotation to keep the tenant as is.\n", PausedAnnotation)
+
+	msg := notify.Message{
+		To:      append([]string{tenant.Spec.Owner}, tenant.Spec.Notifications.Recipients...),
+		Subject: fmt.Sprintf("[tenant-master] Failed tenant %s will be %s", tenant.Name, action+"d"),
+		Body:    body.String(),
+	}
+	if err := c.Notifier.Send(ctx, msg); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("tenant will be %s at %s unless it recovers", action+"d", at.UTC().Format(time.RFC3339))
+	if err := c.setCleanupCondition(ctx, tenant, now, metav1.ConditionTrue, "RetentionExpired", message); err != nil {
+		return err
+	}
+	c.Recorder.Event(tenant, corev1.EventTypeWarning, "CleanupScheduled", message)
+	c.Log.Info("scheduled Failed tenant cleanup", "tenant", tenant.Name, "action", c.Config.Cleanup.Action, "at", at)
+	return nil
+}
+
+// apply deletes or suspends the tenant and records the action in the audit trail.
+func (c *FailedTenantCleaner) apply(ctx context.Context, tenant *platformv1alpha1.Tenant, now time.Time, failedFor time.Duration) error {
+	message := fmt.Sprintf("tenant was Failed for %s, beyond the %s retention", failedFor.Round(time.Minute), c.Config.Cleanup.Retention)
+
+	switch c.Config.Cleanup.Action {
+	case config.FailedCleanupDelete:
+		c.Recorder.Event(tenant, corev1.EventTypeWarning, "FailedTenantDeleted", message)
+		if err := c.Client.Delete(ctx, tenant); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete tenant: %w", err)
+		}
+	case config.FailedCleanupSuspend:
+		patch := client.MergeFrom(tenant.DeepCopy())
+		tenant.Spec.Suspend = true
+		if err := c.Client.Patch(ctx, tenant, patch); err != nil {
+			return fmt.Errorf("failed to suspend tenant: %w", err)
+		}
+		if err := c.setCleanupCondition(ctx, tenant, now, metav1.ConditionFalse, "Suspended", message); err != nil {
+			return err
+		}
+		c.Recorder.Event(tenant, corev1.EventTypeWarning, "FailedTenantSuspended", message)
+	default:
+		return nil
+	}
+
+	recordAuditEntry(ctx, c.Audit, audit.Entry{
+		Tenant:  tenant.Name,
+		Action:  audit.ActionFailedCleanup,
+		Actor:   "tenant-master",
+		Message: message,
+		Details: map[string]string{"action": c.Config.Cleanup.Action, "lastError": tenant.Status.LastError},
+	}, c.Log)
+	c.Log.Info("cleaned up Failed tenant", "tenant", tenant.Name, "action", c.Config.Cleanup.Action)
+	return nil
+}
+
+// setCleanupCondition patches the CleanupScheduled condition onto the tenant status.
+// The transition time is now, which starts the notice period.
+func (c *FailedTenantCleaner) setCleanupCondition(ctx context.Context, tenant *platformv1alpha1.Tenant, now time.Time, status metav1.ConditionStatus, reason, message string) error {
+	patch := client.MergeFrom(tenant.DeepCopy())
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               platformv1alpha1.ConditionCleanupScheduled,
+		Status:             status,
+		ObservedGeneration: tenant.Generation,
+		LastTransitionTime: metav1.NewTime(now),
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := c.Client.Status().Patch(ctx, tenant, patch); err != nil {
+		return fmt.Errorf("failed to record %s: %w", platformv1alpha1.ConditionCleanupScheduled, err)
+	}
+	return nil
+}
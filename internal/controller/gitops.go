@@ -0,0 +1,335 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=argoproj.io,resources=appprojects;applications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kustomize.toolkit.fluxcd.io,resources=kustomizations,verbs=get;list;watch;create;update;patch;delete
+
+// The Argo CD and Flux kinds. The operator does not depend on their API modules, so they
+// are managed as unstructured objects.
+var (
+	appProjectGVK    = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "AppProject"}
+	applicationGVK   = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Application"}
+	gitRepositoryGVK = schema.GroupVersionKind{Group: "source.toolkit.fluxcd.io", Version: "v1", Kind: "GitRepository"}
+	kustomizationGVK = schema.GroupVersionKind{Group: "kustomize.toolkit.fluxcd.io", Version: "v1", Kind: "Kustomization"}
+)
+
+// defaultFluxInterval is how often Flux fetches the repository and applies it when
+// spec.gitops.flux.interval is unset.
+const defaultFluxInterval = "5m"
+
+// argoCDInClusterServer is the Argo CD destination for the cluster Argo CD runs in.
+const argoCDInClusterServer = "https://kubernetes.default.svc"
+
+// argoCDProjectName names the AppProject of a tenant after its namespace, which keeps
+// it apart from the other projects in the Argo CD namespace.
+func argoCDProjectName(tenant *platformv1alpha1.Tenant) string {
+	return buildNamespaceName(tenant)
+}
+
+// argoCDBootstrapName names the bootstrap Application of a tenant.
+func argoCDBootstrapName(tenant *platformv1alpha1.Tenant) string {
+	return fmt.Sprintf("%s-bootstrap", buildNamespaceName(tenant))
+}
+
+// fluxBootstrapName names the GitRepository and Kustomization of a tenant.
+func fluxBootstrapName(tenant *platformv1alpha1.Tenant) string {
+	return fmt.Sprintf("%s-bootstrap", tenant.Name)
+}
+
+// fluxEnabled reports whether spec.gitops.flux.enabled is set.
+func fluxEnabled(tenant *platformv1alpha1.Tenant) bool {
+	return tenant.Spec.GitOps != nil && tenant.Spec.GitOps.Flux != nil && tenant.Spec.GitOps.Flux.Enabled
+}
+
+// argoCDEnabled reports whether spec.gitops.argocd.enabled is set.
+func argoCDEnabled(tenant *platformv1alpha1.Tenant) bool {
+	return tenant.Spec.GitOps != nil && tenant.Spec.GitOps.ArgoCD != nil && tenant.Spec.GitOps.ArgoCD.Enabled
+}
+
+// argoCDRolePolicies are the Argo CD RBAC actions on the project's Applications granted
+// to each access role.
+var argoCDRolePolicies = map[platformv1alpha1.AccessRole][]string{
+	platformv1alpha1.AccessRoleAdmin: {"*"},
+	platformv1alpha1.AccessRoleEdit:  {"get", "create", "update", "sync", "action/*"},
+	platformv1alpha1.AccessRoleView:  {"get"},
+}
+
+// ensureGitOps hands the tenant to the GitOps controllers enabled in spec.gitops, and
+// deletes the objects of those that are disabled.
+func (r *TenantReconciler) ensureGitOps(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	err := r.ensureArgoCD(ctx, tenant, log)
+	if err == nil {
+		err = r.ensureFlux(ctx, tenant, log)
+	}
+	if !argoCDEnabled(tenant) && !fluxEnabled(tenant) {
+		apimeta.RemoveStatusCondition(&tenant.Status.Conditions, platformv1alpha1.ConditionGitOpsReady)
+		return err
+	}
+	setResourceCondition(tenant, platformv1alpha1.ConditionGitOpsReady, "CreateFailed", err)
+	return err
+}
+
+// ensureArgoCD creates the tenant's Argo CD AppProject, and its bootstrap Application
+// when spec.gitops.repoURL is set, and deletes them once spec.gitops.argocd is disabled.
+func (r *TenantReconciler) ensureArgoCD(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	argoNamespace := r.config().GitOps.ArgoCDNamespace
+	project := &unstructured.Unstructured{}
+	project.SetGroupVersionKind(appProjectGVK)
+	project.SetNamespace(argoNamespace)
+	project.SetName(argoCDProjectName(tenant))
+	application := &unstructured.Unstructured{}
+	application.SetGroupVersionKind(applicationGVK)
+	application.SetNamespace(argoNamespace)
+	application.SetName(argoCDBootstrapName(tenant))
+
+	if !argoCDEnabled(tenant) {
+		return r.deleteIntegrationObjects(ctx, "Argo CD", application, project)
+	}
+	return r.ensureArgoCDObjects(ctx, tenant, project, application, log)
+}
+
+// deleteIntegrationObjects deletes the objects of a disabled integration with a
+// third-party controller. Without the controller installed there is nothing to clean up.
+func (r *TenantReconciler) deleteIntegrationObjects(ctx context.Context, controller string, objs ...*unstructured.Unstructured) error {
+	for _, obj := range objs {
+		if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil && !apimeta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to delete stale %s %s %s: %w", controller, obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// ensureArgoCDObjects creates or updates the AppProject and the bootstrap Application.
+func (r *TenantReconciler) ensureArgoCDObjects(ctx context.Context, tenant *platformv1alpha1.Tenant, project, application *unstructured.Unstructured, log logr.Logger) error {
+	gitops := tenant.Spec.GitOps
+	namespaces := []string{buildNamespaceName(tenant)}
+	for _, env := range tenant.Spec.Environments {
+		namespaces = append(namespaces, buildEnvironmentNamespaceName(tenant, env.Name))
+	}
+	labels := map[string]string{
+		TenantNameLabelKey: tenant.Name,
+		ManagedByLabelKey:  ManagedByValue,
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, project, func() error {
+		project.SetLabels(labels)
+		project.Object["spec"] = buildAppProjectSpec(tenant, namespaces)
+		return controllerutil.SetControllerReference(tenant, project, r.Scheme)
+	})
+	if apimeta.IsNoMatchError(err) {
+		return newValidationError(fmt.Errorf("spec.gitops.argocd requires Argo CD, which is not installed"))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create or update Argo CD AppProject: %w", err)
+	}
+	log.Info("ensured Argo CD AppProject", "project", project.GetName(), "operation", result)
+
+	if gitops.RepoURL == "" {
+		if err := r.Delete(ctx, application); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete stale Argo CD bootstrap Application: %w", err)
+		}
+		return nil
+	}
+
+	path := gitops.Path
+	if path == "" {
+		path = "."
+	}
+	revision := gitops.TargetRevision
+	if revision == "" {
+		revision = "HEAD"
+	}
+	result, err = controllerutil.CreateOrUpdate(ctx, r.Client, application, func() error {
+		application.SetLabels(labels)
+		application.Object["spec"] = map[string]interface{}{
+			"project": project.GetName(),
+			"source": map[string]interface{}{
+				"repoURL":        gitops.RepoURL,
+				"path":           path,
+				"targetRevision": revision,
+			},
+			"destination": map[string]interface{}{
+				"server":    argoCDInClusterServer,
+				"namespace": buildNamespaceName(tenant),
+			},
+			"syncPolicy": map[string]interface{}{
+				"automated": map[string]interface{}{
+					"prune":    true,
+					"selfHeal": true,
+				},
+			},
+		}
+		return controllerutil.SetControllerReference(tenant, application, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update Argo CD bootstrap Application: %w", err)
+	}
+	log.Info("ensured Argo CD bootstrap Application", "application", application.GetName(), "repoURL", gitops.RepoURL, "operation", result)
+	return nil
+}
+
+// buildAppProjectSpec renders an AppProject spec that only deploys namespaced objects
+// into the tenant namespaces, never the quota, limits, and network policies the
+// operator manages, from spec.gitops.repoURL when it is set. The spec.accessControl
+// groups get a project role matching spec.accessControl.role.
+func buildAppProjectSpec(tenant *platformv1alpha1.Tenant, namespaces []string) map[string]interface{} {
+	destinations := make([]interface{}, 0, len(namespaces))
+	for _, ns := range namespaces {
+		destinations = append(destinations, map[string]interface{}{
+			"server":    argoCDInClusterServer,
+			"namespace": ns,
+		})
+	}
+	sourceRepo := "*"
+	if tenant.Spec.GitOps.RepoURL != "" {
+		sourceRepo = tenant.Spec.GitOps.RepoURL
+	}
+
+	spec := map[string]interface{}{
+		"description":              fmt.Sprintf("Tenant %s (%s tier)", tenant.Name, tenant.Spec.Tier),
+		"sourceRepos":              []interface{}{sourceRepo},
+		"destinations":             destinations,
+		"clusterResourceWhitelist": []interface{}{},
+		"namespaceResourceBlacklist": []interface{}{
+			map[string]interface{}{"group": "", "kind": "ResourceQuota"},
+			map[string]interface{}{"group": "", "kind": "LimitRange"},
+			map[string]interface{}{"group": "networking.k8s.io", "kind": "NetworkPolicy"},
+		},
+	}
+
+	access := tenant.Spec.AccessControl
+	if len(access.Groups) == 0 {
+		return spec
+	}
+	role := access.Role
+	if role == "" {
+		role = platformv1alpha1.AccessRoleAdmin
+	}
+	roleName := strings.ToLower(string(role))
+	project := argoCDProjectName(tenant)
+	var policies []interface{}
+	for _, action := range argoCDRolePolicies[role] {
+		policies = append(policies, fmt.Sprintf("p, proj:%s:%s, applications, %s, %s/*, allow", project, roleName, action, project))
+	}
+	groups := make([]interface{}, 0, len(access.Groups))
+	for _, group := range access.Groups {
+		groups = append(groups, group)
+	}
+	spec["roles"] = []interface{}{
+		map[string]interface{}{
+			"name":        roleName,
+			"description": fmt.Sprintf("%s access to the applications of tenant %s", role, tenant.Name),
+			"policies":    policies,
+			"groups":      groups,
+		},
+	}
+	return spec
+}
+
+// ensureFlux creates a GitRepository for spec.gitops.repoURL and a Kustomization that
+// applies it to the tenant namespace as the tenant ServiceAccount, so Flux can do no
+// more than the tenant itself, and deletes them once spec.gitops.flux is disabled.
+func (r *TenantReconciler) ensureFlux(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	namespaceName := buildNamespaceName(tenant)
+	repository := &unstructured.Unstructured{}
+	repository.SetGroupVersionKind(gitRepositoryGVK)
+	repository.SetNamespace(namespaceName)
+	repository.SetName(fluxBootstrapName(tenant))
+	kustomization := &unstructured.Unstructured{}
+	kustomization.SetGroupVersionKind(kustomizationGVK)
+	kustomization.SetNamespace(namespaceName)
+	kustomization.SetName(fluxBootstrapName(tenant))
+
+	if !fluxEnabled(tenant) {
+		return r.deleteIntegrationObjects(ctx, "Flux", kustomization, repository)
+	}
+
+	gitops := tenant.Spec.GitOps
+	interval := defaultFluxInterval
+	if gitops.Flux.Interval != nil {
+		interval = gitops.Flux.Interval.Duration.String()
+	}
+	labels := map[string]string{
+		TenantNameLabelKey: tenant.Name,
+		ManagedByLabelKey:  ManagedByValue,
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, repository, func() error {
+		repository.SetLabels(labels)
+		spec := map[string]interface{}{
+			"url":      gitops.RepoURL,
+			"interval": interval,
+		}
+		if gitops.TargetRevision != "" {
+			spec["ref"] = map[string]interface{}{"branch": gitops.TargetRevision}
+		}
+		if gitops.Flux.SecretName != "" {
+			spec["secretRef"] = map[string]interface{}{"name": gitops.Flux.SecretName}
+		}
+		repository.Object["spec"] = spec
+		return controllerutil.SetControllerReference(tenant, repository, r.Scheme)
+	})
+	if apimeta.IsNoMatchError(err) {
+		return newValidationError(fmt.Errorf("spec.gitops.flux requires Flux, which is not installed"))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create or update Flux GitRepository: %w", err)
+	}
+	log.Info("ensured Flux GitRepository", "gitRepository", repository.GetName(), "url", gitops.RepoURL, "operation", result)
+
+	path := gitops.Path
+	if path == "" {
+		path = "."
+	}
+	result, err = controllerutil.CreateOrUpdate(ctx, r.Client, kustomization, func() error {
+		kustomization.SetLabels(labels)
+		kustomization.Object["spec"] = map[string]interface{}{
+			"interval": interval,
+			"path":     path,
+			"prune":    true,
+			"sourceRef": map[string]interface{}{
+				"kind": gitRepositoryGVK.Kind,
+				"name": repository.GetName(),
+			},
+			"targetNamespace":    namespaceName,
+			"serviceAccountName": fmt.Sprintf("%s-sa", tenant.Name),
+		}
+		return controllerutil.SetControllerReference(tenant, kustomization, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update Flux Kustomization: %w", err)
+	}
+	log.Info("ensured Flux Kustomization", "kustomization", kustomization.GetName(), "path", path, "operation", result)
+	return nil
+}
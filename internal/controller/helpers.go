@@ -18,8 +18,10 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"time"
+	"net"
+	"slices"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
 )
 
 // ensureNamespace creates or updates the tenant namespace.
@@ -56,32 +60,104 @@ func (r *TenantReconciler) ensureNamespace(ctx context.Context, tenant *platform
 	}
 
 	// Create or update the namespace
-	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, ns, func() error {
+	podSecurity := r.podSecurityLevel(tenant)
+	result, err := r.applyManaged(ctx, tenant, ns, func() error {
 		ns.Labels = map[string]string{
 			TenantNameLabelKey: tenant.Name,
 			TierLabelKey:       string(tenant.Spec.Tier),
 			OwnerLabelKey:      tenant.Spec.Owner,
 			ManagedByLabelKey:  ManagedByValue,
 		}
-		return nil
-	})
+		setPodSecurityLabel(ns, podSecurity)
+		setMeshMetadata(ns, tenant)
+		setMonitoringLabel(ns, tenant)
+		if err := setSchedulingAnnotations(ns, tenant); err != nil {
+			return err
+		}
+		setAnnotation(ns, DefaultStorageClassAnnotation, tenant.Spec.Resources.StorageClass)
+		return setDNSConfigAnnotation(ns, tenant)
+	}, log)
 
 	if err != nil {
 		log.Error(err, "failed to create or update namespace", "namespace", namespaceName)
 		return err
 	}
 
-	log.Info("ensured namespace", "namespace", namespaceName, "operation", result)
+	log.Info("ensured namespace", "namespace", namespaceName, "podSecurity", podSecurity, "operation", result)
 	tenant.Status.Namespace = namespaceName
+	tenant.Status.PodSecurityLevel = podSecurity
+	return nil
+}
+
+// setSchedulingAnnotations records the tenant's packing policy and default tolerations
+// on one of its namespaces, keeping annotations set by others.
+func setSchedulingAnnotations(ns *corev1.Namespace, tenant *platformv1alpha1.Tenant) error {
+	scheduling := tenant.Spec.Scheduling
+	setAnnotation(ns, PackingPolicyAnnotation, string(scheduling.PackingPolicy))
+
+	tolerations := ""
+	if len(scheduling.Tolerations) > 0 {
+		raw, err := json.Marshal(scheduling.Tolerations)
+		if err != nil {
+			return fmt.Errorf("failed to encode default tolerations: %w", err)
+		}
+		tolerations = string(raw)
+	}
+	setAnnotation(ns, DefaultTolerationsAnnotation, tolerations)
 	return nil
 }
 
+// setDNSConfigAnnotation records spec.network.dnsConfig on one of the tenant's namespaces,
+// where the pod webhook applies it.
+func setDNSConfigAnnotation(ns *corev1.Namespace, tenant *platformv1alpha1.Tenant) error {
+	dnsConfig := ""
+	if dns := tenant.Spec.Network.DNSConfig; dns != nil && (len(dns.Nameservers) > 0 || len(dns.Searches) > 0) {
+		raw, err := json.Marshal(dns)
+		if err != nil {
+			return fmt.Errorf("failed to encode DNS config: %w", err)
+		}
+		dnsConfig = string(raw)
+	}
+	setAnnotation(ns, DNSConfigAnnotation, dnsConfig)
+	return nil
+}
+
+// setAnnotation sets an annotation on obj, or removes it when value is empty.
+func setAnnotation(obj metav1.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+	if value == "" {
+		delete(annotations, key)
+		return
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	obj.SetAnnotations(annotations)
+}
+
+// setAppliedTemplates copies the templates recorded by the mutating webhook into
+// status.appliedTemplates. A malformed annotation leaves the status unchanged.
+func setAppliedTemplates(tenant *platformv1alpha1.Tenant) {
+	raw := tenant.Annotations[AppliedTemplatesAnnotation]
+	if raw == "" {
+		return
+	}
+	var applied []platformv1alpha1.AppliedTemplate
+	if err := json.Unmarshal([]byte(raw), &applied); err != nil {
+		return
+	}
+	tenant.Status.AppliedTemplates = applied
+}
+
 // ensureResourceQuota creates or updates ResourceQuota for the tenant namespace.
 func (r *TenantReconciler) ensureResourceQuota(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
 	namespaceName := buildNamespaceName(tenant)
 
-	// Parse resource requirements
-	cpuQty, memQty := parseResources(tenant.Spec.Resources)
+	// Environment namespaces carve their share out of the tenant budget
+	baseShare, _ := environmentQuotaShares(tenant)
+	hard := scaleQuotaHard(buildQuotaHard(tenant, r.config().Quota.LimitsOvercommitRatio), baseShare)
+	reserveExposureQuota(hard, tenant.Spec.Exposure)
 
 	rq := &corev1.ResourceQuota{
 		ObjectMeta: metav1.ObjectMeta{
@@ -93,13 +169,7 @@ func (r *TenantReconciler) ensureResourceQuota(ctx context.Context, tenant *plat
 			},
 		},
 		Spec: corev1.ResourceQuotaSpec{
-			Hard: corev1.ResourceList{
-				corev1.ResourceName("requests.cpu"):    cpuQty,
-				corev1.ResourceName("requests.memory"): memQty,
-				corev1.ResourceName("limits.cpu"):      cpuQty,
-				corev1.ResourceName("limits.memory"):   memQty,
-				corev1.ResourcePods:                    resource.MustParse("100"), // Limit pods to prevent DOS
-			},
+			Hard: hard,
 		},
 	}
 
@@ -107,16 +177,14 @@ func (r *TenantReconciler) ensureResourceQuota(ctx context.Context, tenant *plat
 		return fmt.Errorf("failed to set OwnerReference: %w", err)
 	}
 
-	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, rq, func() error {
-		rq.Spec.Hard = corev1.ResourceList{
-			corev1.ResourceName("requests.cpu"):    cpuQty,
-			corev1.ResourceName("requests.memory"): memQty,
-			corev1.ResourceName("limits.cpu"):      cpuQty,
-			corev1.ResourceName("limits.memory"):   memQty,
-			corev1.ResourcePods:                    resource.MustParse("100"),
+	result, err := r.applyManaged(ctx, tenant, rq, func() error {
+		rq.Labels = map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			ManagedByLabelKey:  ManagedByValue,
 		}
+		rq.Spec = corev1.ResourceQuotaSpec{Hard: hard}
 		return nil
-	})
+	}, log)
 
 	if err != nil {
 		log.Error(err, "failed to create or update ResourceQuota", "namespace", namespaceName)
@@ -127,7 +195,9 @@ func (r *TenantReconciler) ensureResourceQuota(ctx context.Context, tenant *plat
 	return nil
 }
 
-// ensureRBAC creates ServiceAccount and RoleBinding for the tenant.
+// ensureRBAC creates the tenant ServiceAccount, its admin, edit, and view Roles, the
+// RoleBinding of the admin Role to the ServiceAccount, and the RoleBinding for the
+// users and groups in spec.accessControl.
 func (r *TenantReconciler) ensureRBAC(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
 	namespaceName := buildNamespaceName(tenant)
 	saName := fmt.Sprintf("%s-sa", tenant.Name)
@@ -159,47 +229,30 @@ func (r *TenantReconciler) ensureRBAC(ctx context.Context, tenant *platformv1alp
 
 	log.Info("ensured ServiceAccount", "namespace", namespaceName, "serviceAccount", saName, "operation", result)
 
-	// Create Role that allows full access within the namespace
-	role := &rbacv1.Role{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-admin", tenant.Name),
-			Namespace: namespaceName,
-			Labels: map[string]string{
+	// Create the admin, edit, and view Roles; none of them can change the quota,
+	// LimitRanges, or NetworkPolicies that enforce the tier
+	for _, template := range tenantRoles {
+		role := &rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tenant.Name + template.suffix,
+				Namespace: namespaceName,
+			},
+		}
+		result, err = r.applyManaged(ctx, tenant, role, func() error {
+			role.Labels = map[string]string{
 				TenantNameLabelKey: tenant.Name,
 				ManagedByLabelKey:  ManagedByValue,
-			},
-		},
-		Rules: []rbacv1.PolicyRule{
-			{
-				APIGroups: []string{"*"},
-				Resources: []string{"*"},
-				Verbs:     []string{"*"},
-			},
-		},
-	}
-
-	if err := controllerutil.SetControllerReference(tenant, role, r.Scheme); err != nil {
-		return fmt.Errorf("failed to set OwnerReference on Role: %w", err)
-	}
-
-	result, err = controllerutil.CreateOrUpdate(ctx, r.Client, role, func() error {
-		role.Rules = []rbacv1.PolicyRule{
-			{
-				APIGroups: []string{"*"},
-				Resources: []string{"*"},
-				Verbs:     []string{"*"},
-			},
+			}
+			role.Rules = template.rules
+			return controllerutil.SetControllerReference(tenant, role, r.Scheme)
+		}, log)
+		if err != nil {
+			log.Error(err, "failed to create or update Role", "namespace", namespaceName, "role", role.Name)
+			return err
 		}
-		return nil
-	})
-
-	if err != nil {
-		log.Error(err, "failed to create or update Role", "namespace", namespaceName)
-		return err
+		log.Info("ensured Role", "namespace", namespaceName, "role", role.Name, "operation", result)
 	}
 
-	log.Info("ensured Role", "namespace", namespaceName, "operation", result)
-
 	// Create RoleBinding that binds the role to the ServiceAccount
 	rb := &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
@@ -213,7 +266,7 @@ func (r *TenantReconciler) ensureRBAC(ctx context.Context, tenant *platformv1alp
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: rbacv1.GroupName,
 			Kind:     "Role",
-			Name:     role.Name,
+			Name:     tenant.Name + TenantAdminRoleSuffix,
 		},
 		Subjects: []rbacv1.Subject{
 			{
@@ -228,11 +281,15 @@ func (r *TenantReconciler) ensureRBAC(ctx context.Context, tenant *platformv1alp
 		return fmt.Errorf("failed to set OwnerReference on RoleBinding: %w", err)
 	}
 
-	result, err = controllerutil.CreateOrUpdate(ctx, r.Client, rb, func() error {
+	result, err = r.applyManaged(ctx, tenant, rb, func() error {
+		rb.Labels = map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			ManagedByLabelKey:  ManagedByValue,
+		}
 		rb.RoleRef = rbacv1.RoleRef{
 			APIGroup: rbacv1.GroupName,
 			Kind:     "Role",
-			Name:     role.Name,
+			Name:     tenant.Name + TenantAdminRoleSuffix,
 		}
 		rb.Subjects = []rbacv1.Subject{
 			{
@@ -242,7 +299,7 @@ func (r *TenantReconciler) ensureRBAC(ctx context.Context, tenant *platformv1alp
 			},
 		}
 		return nil
-	})
+	}, log)
 
 	if err != nil {
 		log.Error(err, "failed to create or update RoleBinding", "namespace", namespaceName)
@@ -250,123 +307,92 @@ func (r *TenantReconciler) ensureRBAC(ctx context.Context, tenant *platformv1alp
 	}
 
 	log.Info("ensured RoleBinding", "namespace", namespaceName, "operation", result)
-	return nil
+
+	return r.ensureAccessBinding(ctx, tenant, log)
 }
 
-// ensureSecretsAndConfigMaps propagates image pull secrets and ConfigMaps from controller namespace to tenant namespace.
-// E1-05: Implements automatic secret/configmap propagation for tenant environments.
-func (r *TenantReconciler) ensureSecretsAndConfigMaps(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+// ensureNetworkPolicy creates a default-deny NetworkPolicy for the tenant namespace.
+func (r *TenantReconciler) ensureNetworkPolicy(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
 	namespaceName := buildNamespaceName(tenant)
-	controllerNamespace := "tenant-master-system" // Controller's namespace (typically kube-system or custom)
-
-	// Copy all image pull secrets from controller namespace to tenant namespace
-	secretList := &corev1.SecretList{}
-	if err := r.List(ctx, secretList, &client.ListOptions{Namespace: controllerNamespace}); err != nil {
-		log.Error(err, "failed to list secrets in controller namespace", "namespace", controllerNamespace)
-		// Non-fatal: continue if secrets can't be listed
-		return nil
-	}
 
-	for _, secret := range secretList.Items {
-		// Only copy image pull secrets
-		if secret.Type != corev1.SecretTypeDockercfg && secret.Type != corev1.SecretTypeDockerConfigJson {
-			continue
-		}
+	// Build ingress rules for whitelisted services
+	var ingressRules []netv1.NetworkPolicyIngressRule
 
-		// Create a copy of the secret in the tenant namespace
-		tenantSecret := &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      secret.Name,
-				Namespace: namespaceName,
-				Labels: map[string]string{
-					TenantNameLabelKey: tenant.Name,
-					ManagedByLabelKey:  ManagedByValue,
-				},
+	// Allow ingress from within the same namespace
+	ingressRules = append(ingressRules, netv1.NetworkPolicyIngressRule{
+		From: []netv1.NetworkPolicyPeer{
+			{
+				PodSelector: &metav1.LabelSelector{}, // All pods in this namespace
 			},
-			Type: secret.Type,
-			Data: secret.Data,
-		}
+		},
+	})
 
-		if err := controllerutil.SetControllerReference(tenant, tenantSecret, r.Scheme); err != nil {
-			return fmt.Errorf("failed to set OwnerReference on secret: %w", err)
-		}
+	// Allow ingress from ingress controllers, monitoring, and other platform namespaces
+	if rule := allowedIngressRule(tenant); rule != nil {
+		ingressRules = append(ingressRules, *rule)
+	}
 
-		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, tenantSecret, func() error {
-			tenantSecret.Data = secret.Data
-			tenantSecret.Type = secret.Type
-			return nil
-		})
+	egressRules, err := r.buildEgressRules(ctx, tenant, log)
+	if err != nil {
+		return err
+	}
 
-		if err != nil {
-			log.Error(err, "failed to propagate image pull secret", "secret", secret.Name)
-			continue // Non-fatal: continue with other secrets
-		}
+	netPolicy := &netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultNetworkPolicyName,
+			Namespace: namespaceName,
+			Labels: map[string]string{
+				TenantNameLabelKey: tenant.Name,
+				ManagedByLabelKey:  ManagedByValue,
+			},
+		},
+		Spec: netv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{}, // Apply to all pods in namespace
+			PolicyTypes: []netv1.PolicyType{
+				netv1.PolicyTypeIngress,
+				netv1.PolicyTypeEgress,
+			},
+			Ingress: ingressRules,
+			Egress:  egressRules,
+		},
+	}
 
-		log.Info("propagated image pull secret", "secret", secret.Name, "operation", result)
+	if err := controllerutil.SetControllerReference(tenant, netPolicy, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set OwnerReference: %w", err)
 	}
 
-	// Copy standard ConfigMaps (e.g., "platform-config" if it exists)
-	standardConfigMaps := []string{"platform-config"}
-	for _, cmName := range standardConfigMaps {
-		sourceConfigMap := &corev1.ConfigMap{}
-		sourceKey := client.ObjectKey{Namespace: controllerNamespace, Name: cmName}
-		if err := r.Get(ctx, sourceKey, sourceConfigMap); err != nil {
-			log.V(1).Info("standard ConfigMap not found, skipping", "configmap", cmName)
-			continue // Non-fatal: ConfigMap may not exist
+	result, err := r.applyManaged(ctx, tenant, netPolicy, func() error {
+		netPolicy.Labels = map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			ManagedByLabelKey:  ManagedByValue,
 		}
-
-		// Create a copy in the tenant namespace
-		tenantConfigMap := &corev1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      cmName,
-				Namespace: namespaceName,
-				Labels: map[string]string{
-					TenantNameLabelKey: tenant.Name,
-					ManagedByLabelKey:  ManagedByValue,
-				},
+		netPolicy.Spec = netv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []netv1.PolicyType{
+				netv1.PolicyTypeIngress,
+				netv1.PolicyTypeEgress,
 			},
-			Data:       sourceConfigMap.Data,
-			BinaryData: sourceConfigMap.BinaryData,
-		}
-
-		if err := controllerutil.SetControllerReference(tenant, tenantConfigMap, r.Scheme); err != nil {
-			return fmt.Errorf("failed to set OwnerReference on ConfigMap: %w", err)
-		}
-
-		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, tenantConfigMap, func() error {
-			tenantConfigMap.Data = sourceConfigMap.Data
-			tenantConfigMap.BinaryData = sourceConfigMap.BinaryData
-			return nil
-		})
-
-		if err != nil {
-			log.Error(err, "failed to propagate ConfigMap", "configmap", cmName)
-			continue // Non-fatal: continue
+			Ingress: ingressRules,
+			Egress:  egressRules,
 		}
+		return nil
+	}, log)
 
-		log.Info("propagated ConfigMap", "configmap", cmName, "operation", result)
+	if err != nil {
+		log.Error(err, "failed to create or update NetworkPolicy", "namespace", namespaceName)
+		return err
 	}
 
+	log.Info("ensured NetworkPolicy", "namespace", namespaceName, "operation", result)
 	return nil
 }
 
-// ensureNetworkPolicy creates a default-deny NetworkPolicy for the tenant namespace.
-func (r *TenantReconciler) ensureNetworkPolicy(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
-	namespaceName := buildNamespaceName(tenant)
-
-	// Build ingress rules for whitelisted services
-	var ingressRules []netv1.NetworkPolicyIngressRule
+// buildEgressRules builds the egress rules shared by every tenant namespace: DNS,
+// whitelisted services, and optionally the internet in each configured IP family.
+func (r *TenantReconciler) buildEgressRules(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) ([]netv1.NetworkPolicyEgressRule, error) {
+	ipFamilies := r.config().Network.IPFamilies
 	var egressRules []netv1.NetworkPolicyEgressRule
 
-	// Allow ingress from within the same namespace
-	ingressRules = append(ingressRules, netv1.NetworkPolicyIngressRule{
-		From: []netv1.NetworkPolicyPeer{
-			{
-				PodSelector: &metav1.LabelSelector{}, // All pods in this namespace
-			},
-		},
-	})
-
 	// Allow DNS egress (required for service discovery)
 	egressRules = append(egressRules, netv1.NetworkPolicyEgressRule{
 		To: []netv1.NetworkPolicyPeer{
@@ -387,77 +413,137 @@ func (r *TenantReconciler) ensureNetworkPolicy(ctx context.Context, tenant *plat
 	})
 
 	// Add whitelisted services as egress rules
-	for _, service := range tenant.Spec.Network.WhitelistedServices {
-		namespace, svcName := parseServiceRef(service)
+	whitelisted, err := r.whitelistedServiceRules(ctx, tenant, log)
+	if err != nil {
+		return nil, err
+	}
+	egressRules = append(egressRules, whitelisted...)
+
+	// Allow DNS egress to the tenant's own nameservers
+	if rule := customDNSEgressRule(tenant.Spec.Network.DNSConfig); rule != nil {
+		egressRules = append(egressRules, *rule)
+	}
+
+	// Allow egress to internet if configured, or to the allowed CIDRs only
+	network := tenant.Spec.Network
+	if network.AllowInternetAccess {
+		cfg := r.config().Network
+		denied := slices.Concat(cfg.PrivateCIDRs, cfg.ServiceCIDRs, network.DeniedCIDRs)
 		egressRules = append(egressRules, netv1.NetworkPolicyEgressRule{
-			To: []netv1.NetworkPolicyPeer{
-				{
-					NamespaceSelector: &metav1.LabelSelector{
-						MatchLabels: map[string]string{
-							"name": namespace,
-						},
-					},
-					PodSelector: &metav1.LabelSelector{
-						// This matches all pods in the whitelisted namespace
-						// For more granular control, would need service labels
-					},
-				},
-			},
+			To: internetEgressPeers(ipFamilies, denied),
 		})
-		log.Info("added whitelisted service to NetworkPolicy", "namespace", namespace, "service", svcName)
+		log.Info("added internet egress to NetworkPolicy", "ipFamilies", ipFamilies, "deniedCIDRs", network.DeniedCIDRs)
+	} else if peers := cidrEgressPeers(network.AllowedCIDRs, network.DeniedCIDRs); len(peers) > 0 {
+		egressRules = append(egressRules, netv1.NetworkPolicyEgressRule{To: peers})
+		log.Info("added CIDR egress to NetworkPolicy", "allowedCIDRs", network.AllowedCIDRs, "deniedCIDRs", network.DeniedCIDRs)
 	}
 
-	// Allow egress to internet if configured
-	if tenant.Spec.Network.AllowInternetAccess {
-		egressRules = append(egressRules, netv1.NetworkPolicyEgressRule{
-			To: []netv1.NetworkPolicyPeer{
-				{
-					IPBlock: &netv1.IPBlock{
-						CIDR: "0.0.0.0/0",
-					},
+	return egressRules, nil
+}
+
+// allowedIngressRule allows ingress from all pods in the namespaces of
+// spec.network.allowedIngressNamespaces, or returns nil when the tenant has none.
+// Namespaces of any tenant, including the shared Bronze namespace, are excluded even
+// when listed, so tenants can never be opened to each other.
+func allowedIngressRule(tenant *platformv1alpha1.Tenant) *netv1.NetworkPolicyIngressRule {
+	namespaces := tenant.Spec.Network.AllowedIngressNamespaces
+	if len(namespaces) == 0 {
+		return nil
+	}
+	return &netv1.NetworkPolicyIngressRule{
+		From: []netv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: slices.Clone(namespaces)},
+					{Key: TenantNameLabelKey, Operator: metav1.LabelSelectorOpDoesNotExist},
+					{Key: TierLabelKey, Operator: metav1.LabelSelectorOpDoesNotExist},
 				},
 			},
-		})
-		log.Info("added internet egress to NetworkPolicy")
+		}},
 	}
+}
 
-	netPolicy := &netv1.NetworkPolicy{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      DefaultNetworkPolicyName,
-			Namespace: namespaceName,
-			Labels: map[string]string{
-				TenantNameLabelKey: tenant.Name,
-				ManagedByLabelKey:  ManagedByValue,
+// customDNSEgressRule allows DNS over UDP and TCP to the nameservers of spec.network.dnsConfig,
+// or returns nil when the tenant has none.
+func customDNSEgressRule(dns *platformv1alpha1.DNSConfig) *netv1.NetworkPolicyEgressRule {
+	if dns == nil || len(dns.Nameservers) == 0 {
+		return nil
+	}
+	rule := &netv1.NetworkPolicyEgressRule{
+		Ports: []netv1.NetworkPolicyPort{
+			{
+				Protocol: &[]corev1.Protocol{corev1.ProtocolUDP}[0],
+				Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: 53},
 			},
-		},
-		Spec: netv1.NetworkPolicySpec{
-			PodSelector: metav1.LabelSelector{}, // Apply to all pods in namespace
-			PolicyTypes: []netv1.PolicyType{
-				netv1.PolicyTypeIngress,
-				netv1.PolicyTypeEgress,
+			{
+				Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
+				Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: 53},
 			},
-			Ingress: ingressRules,
-			Egress:  egressRules,
 		},
 	}
-
-	if err := controllerutil.SetControllerReference(tenant, netPolicy, r.Scheme); err != nil {
-		return fmt.Errorf("failed to set OwnerReference: %w", err)
+	for _, nameserver := range dns.Nameservers {
+		cidr := nameserver + "/32"
+		if net.ParseIP(nameserver).To4() == nil {
+			cidr = nameserver + "/128"
+		}
+		rule.To = append(rule.To, netv1.NetworkPolicyPeer{IPBlock: &netv1.IPBlock{CIDR: cidr}})
 	}
+	return rule
+}
 
-	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, netPolicy, func() error {
-		netPolicy.Spec.Ingress = ingressRules
-		netPolicy.Spec.Egress = egressRules
-		return nil
-	})
-
-	if err != nil {
-		log.Error(err, "failed to create or update NetworkPolicy", "namespace", namespaceName)
-		return err
+// internetEgressPeers allows the whole internet in each IP family except the denied
+// CIDRs, such as the private and Service ranges, and the cloud metadata endpoint. A
+// 0.0.0.0/0 block alone would leave IPv6 egress blocked on dual-stack clusters.
+func internetEgressPeers(ipFamilies []string, denied []string) []netv1.NetworkPolicyPeer {
+	var blocks []string
+	for _, family := range ipFamilies {
+		switch family {
+		case config.IPFamilyIPv4:
+			blocks = append(blocks, "0.0.0.0/0")
+		case config.IPFamilyIPv6:
+			blocks = append(blocks, "::/0")
+		}
 	}
+	return cidrEgressPeers(blocks, denied)
+}
 
-	log.Info("ensured NetworkPolicy", "namespace", namespaceName, "operation", result)
-	return nil
+// cidrEgressPeers allows each of the allowed CIDRs except the denied CIDRs and cloud
+// metadata endpoint inside it. An allowed CIDR that is wholly denied is left out, and
+// denied CIDRs outside every allowed one need no exception. Malformed CIDRs, which the
+// validating webhook rejects, are skipped.
+func cidrEgressPeers(allowed, denied []string) []netv1.NetworkPolicyPeer {
+	var peers []netv1.NetworkPolicyPeer
+	denied = append([]string{MetadataCIDRIPv4, MetadataCIDRIPv6}, denied...)
+	for _, cidr := range allowed {
+		_, block, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		blockOnes, blockBits := block.Mask.Size()
+		ipBlock := &netv1.IPBlock{CIDR: block.String()}
+		for _, except := range denied {
+			_, exceptNet, err := net.ParseCIDR(except)
+			if err != nil {
+				continue
+			}
+			ones, bits := exceptNet.Mask.Size()
+			switch {
+			case bits != blockBits:
+				// Other IP family
+			case ones <= blockOnes && exceptNet.Contains(block.IP):
+				ipBlock = nil
+			case ones > blockOnes && block.Contains(exceptNet.IP) && !slices.Contains(ipBlock.Except, exceptNet.String()):
+				ipBlock.Except = append(ipBlock.Except, exceptNet.String())
+			}
+			if ipBlock == nil {
+				break
+			}
+		}
+		if ipBlock != nil {
+			peers = append(peers, netv1.NetworkPolicyPeer{IPBlock: ipBlock})
+		}
+	}
+	return peers
 }
 
 // Helper functions
@@ -467,7 +553,8 @@ func buildNamespaceName(tenant *platformv1alpha1.Tenant) string {
 	return fmt.Sprintf("%s-%s", NamespacePrefix, tenant.Name)
 }
 
-// parseResources parses resource requirements and returns k8s quantities.
+// parseResources parses resource requirements and returns the CPU and memory requests
+// as k8s quantities. spec.resources.requests takes precedence over cpu and memory.
 func parseResources(req platformv1alpha1.ResourceRequirements) (resource.Quantity, resource.Quantity) {
 	cpu := resource.MustParse("1000m")
 	memory := resource.MustParse("1Gi")
@@ -484,162 +571,176 @@ func parseResources(req platformv1alpha1.ResourceRequirements) (resource.Quantit
 		}
 	}
 
+	if requests := req.Requests; requests != nil {
+		overrideQuantity(&cpu, requests.CPU)
+		overrideQuantity(&memory, requests.Memory)
+	}
+
 	return cpu, memory
 }
 
-// parseServiceRef parses a service reference like "namespace/service" or "namespace/service:port".
-func parseServiceRef(serviceRef string) (string, string) {
-	// For now, simple split by "/"
-	// TODO: Handle port parsing if needed
-	parts := []rune(serviceRef)
-	for i, c := range parts {
-		if c == '/' {
-			return string(parts[:i]), string(parts[i+1:])
-		}
+// QuotaComputeResources returns the CPU and memory requests and limits of a tenant's
+// quota, before any boost. Limits missing from spec.resources.limits are the requests
+// times ratio; a ratio below 1 counts as 1.
+func QuotaComputeResources(req platformv1alpha1.ResourceRequirements, ratio float64) corev1.ResourceList {
+	cpu, memory := parseResources(req)
+	if ratio < 1 {
+		ratio = 1
+	}
+	limitsCPU := *resource.NewMilliQuantity(int64(float64(cpu.MilliValue())*ratio), resource.DecimalSI)
+	limitsMemory := *resource.NewQuantity(int64(float64(memory.Value())*ratio), resource.BinarySI)
+	if ratio == 1 {
+		limitsCPU, limitsMemory = cpu, memory
+	}
+	if limits := req.Limits; limits != nil {
+		overrideQuantity(&limitsCPU, limits.CPU)
+		overrideQuantity(&limitsMemory, limits.Memory)
+	}
+
+	return corev1.ResourceList{
+		corev1.ResourceRequestsCPU:    cpu,
+		corev1.ResourceRequestsMemory: memory,
+		corev1.ResourceLimitsCPU:      limitsCPU,
+		corev1.ResourceLimitsMemory:   limitsMemory,
 	}
-	// Fallback: assume it's just a service name in current namespace
-	return "default", serviceRef
 }
 
-// detectAndCorrectNetworkPolicyDrift checks for manual edits to NetworkPolicies and reverts to desired state.
-// E1-06: Implements drift detection and reconciliation for NetworkPolicies.
-func (r *TenantReconciler) detectAndCorrectNetworkPolicyDrift(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
-	namespaceName := buildNamespaceName(tenant)
+// overrideQuantity replaces qty with value when value is set and parses.
+func overrideQuantity(qty *resource.Quantity, value string) {
+	if value == "" {
+		return
+	}
+	if parsed, err := resource.ParseQuantity(value); err == nil {
+		*qty = parsed
+	}
+}
 
-	// Fetch the current NetworkPolicy
-	currentNetPolicy := &netv1.NetworkPolicy{}
-	if err := r.Get(ctx, client.ObjectKey{
-		Namespace: namespaceName,
-		Name:      DefaultNetworkPolicyName,
-	}, currentNetPolicy); err != nil {
-		if client.IgnoreNotFound(err) == nil {
-			log.V(1).Info("NetworkPolicy not found, skipping drift detection", "policy", DefaultNetworkPolicyName)
-			return nil
+// buildQuotaHard computes the desired ResourceQuota hard limits for a tenant, with
+// limits defaulting to the requests times limitsRatio.
+func buildQuotaHard(tenant *platformv1alpha1.Tenant, limitsRatio float64) corev1.ResourceList {
+	hard := QuotaComputeResources(tenant.Spec.Resources, limitsRatio)
+	hard[corev1.ResourcePods] = resource.MustParse("100") // Limit pods to prevent DOS
+
+	// Cap PVC storage when the tenant has a storage budget, in total and on its StorageClass
+	if tenant.Spec.Resources.Storage != "" {
+		if qty, err := resource.ParseQuantity(tenant.Spec.Resources.Storage); err == nil {
+			hard[corev1.ResourceRequestsStorage] = qty
+			if class := tenant.Spec.Resources.StorageClass; class != "" {
+				hard[storageClassRequestsStorage(class)] = qty
+			}
 		}
-		return fmt.Errorf("failed to fetch NetworkPolicy for drift detection: %w", err)
 	}
 
-	// Reconstruct the desired state (same logic as ensureNetworkPolicy)
-	var ingressRules []netv1.NetworkPolicyIngressRule
-	var egressRules []netv1.NetworkPolicyEgressRule
-
-	// Allow ingress from within the same namespace
-	ingressRules = append(ingressRules, netv1.NetworkPolicyIngressRule{
-		From: []netv1.NetworkPolicyPeer{
-			{
-				PodSelector: &metav1.LabelSelector{},
-			},
-		},
-	})
-
-	// Allow DNS egress
-	egressRules = append(egressRules, netv1.NetworkPolicyEgressRule{
-		To: []netv1.NetworkPolicyPeer{
-			{
-				NamespaceSelector: &metav1.LabelSelector{
-					MatchLabels: map[string]string{
-						"name": "kube-system",
-					},
-				},
-			},
-		},
-		Ports: []netv1.NetworkPolicyPort{
-			{
-				Protocol: &[]corev1.Protocol{corev1.ProtocolUDP}[0],
-				Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: 53},
-			},
-		},
-	})
-
-	// Add whitelisted services
-	for _, service := range tenant.Spec.Network.WhitelistedServices {
-		namespace, _ := parseServiceRef(service)
-		egressRules = append(egressRules, netv1.NetworkPolicyEgressRule{
-			To: []netv1.NetworkPolicyPeer{
-				{
-					NamespaceSelector: &metav1.LabelSelector{
-						MatchLabels: map[string]string{
-							"name": namespace,
-						},
-					},
-					PodSelector: &metav1.LabelSelector{},
-				},
-			},
-		})
+	// Bound object counts, including cloud load balancer cost exposure, per tenant
+	for name, count := range objectCountQuotas(tenant) {
+		hard[name] = *resource.NewQuantity(count, resource.DecimalSI)
 	}
 
-	// Allow internet egress if configured
-	if tenant.Spec.Network.AllowInternetAccess {
-		egressRules = append(egressRules, netv1.NetworkPolicyEgressRule{
-			To: []netv1.NetworkPolicyPeer{
-				{
-					IPBlock: &netv1.IPBlock{
-						CIDR: "0.0.0.0/0",
-					},
-				},
-			},
-		})
-	}
+	// Apply an active time-boxed boost on top of the regular budget
+	addBurstToQuota(hard, tenant.Status.Burst)
+
+	return hard
+}
 
-	// Compare ingress and egress rules for drift
-	if len(currentNetPolicy.Spec.Ingress) != len(ingressRules) ||
-		len(currentNetPolicy.Spec.Egress) != len(egressRules) {
-		log.Error(nil, "NetworkPolicy drift detected, correcting", "namespace", namespaceName, "policy", DefaultNetworkPolicyName)
+// storageClassQuotaSuffix completes the name of a per-StorageClass storage quota.
+const storageClassQuotaSuffix = ".storageclass.storage.k8s.io/requests.storage"
 
-		// Revert to desired state
-		currentNetPolicy.Spec.Ingress = ingressRules
-		currentNetPolicy.Spec.Egress = egressRules
+// storageClassRequestsStorage returns the quota resource for the storage requested by
+// PersistentVolumeClaims of one StorageClass.
+func storageClassRequestsStorage(class string) corev1.ResourceName {
+	return corev1.ResourceName(class + storageClassQuotaSuffix)
+}
 
-		if err := r.Update(ctx, currentNetPolicy); err != nil {
-			return fmt.Errorf("failed to correct NetworkPolicy drift: %w", err)
+// objectCountQuotas returns the object count limits for a tenant: the tier defaults,
+// overridden by spec.quotas.objects. LoadBalancers and NodePorts stay at 0 unless the
+// tenant may expose Services externally.
+func objectCountQuotas(tenant *platformv1alpha1.Tenant) map[corev1.ResourceName]int64 {
+	var counts map[corev1.ResourceName]int64
+	switch tenant.Spec.Tier {
+	case platformv1alpha1.GoldTier:
+		counts = map[corev1.ResourceName]int64{
+			corev1.ResourceServices:               DefaultGoldServices,
+			corev1.ResourceServicesLoadBalancers:  DefaultGoldLoadBalancers,
+			corev1.ResourceServicesNodePorts:      DefaultGoldNodePorts,
+			corev1.ResourcePersistentVolumeClaims: DefaultGoldPVCs,
+			corev1.ResourceConfigMaps:             DefaultGoldConfigMaps,
+			corev1.ResourceSecrets:                DefaultGoldSecrets,
+		}
+	case platformv1alpha1.SilverTier:
+		counts = map[corev1.ResourceName]int64{
+			corev1.ResourceServices:               DefaultSilverServices,
+			corev1.ResourceServicesLoadBalancers:  DefaultSilverLoadBalancers,
+			corev1.ResourceServicesNodePorts:      DefaultSilverNodePorts,
+			corev1.ResourcePersistentVolumeClaims: DefaultSilverPVCs,
+			corev1.ResourceConfigMaps:             DefaultSilverConfigMaps,
+			corev1.ResourceSecrets:                DefaultSilverSecrets,
+		}
+	default:
+		counts = map[corev1.ResourceName]int64{
+			corev1.ResourceServicesLoadBalancers: 0,
+			corev1.ResourceServicesNodePorts:     0,
 		}
+	}
 
-		log.Info("NetworkPolicy drift corrected", "namespace", namespaceName)
+	if objects := tenant.Spec.Quotas.Objects; objects != nil {
+		for name, override := range map[corev1.ResourceName]*int64{
+			corev1.ResourceServices:               objects.Services,
+			corev1.ResourceServicesLoadBalancers:  objects.LoadBalancers,
+			corev1.ResourceServicesNodePorts:      objects.NodePorts,
+			corev1.ResourcePersistentVolumeClaims: objects.PersistentVolumeClaims,
+			corev1.ResourceConfigMaps:             objects.ConfigMaps,
+			corev1.ResourceSecrets:                objects.Secrets,
+		} {
+			if override != nil {
+				counts[name] = *override
+			}
+		}
 	}
 
-	return nil
+	if !tenant.Spec.Network.AllowExternalServices {
+		counts[corev1.ResourceServicesLoadBalancers] = 0
+		counts[corev1.ResourceServicesNodePorts] = 0
+	}
+	return counts
 }
 
-// takeSnapshotBeforeDeletion creates a snapshot of tenant resources before deletion.
-// E3-04: Implements snapshot routine for graceful teardown.
-func (r *TenantReconciler) takeSnapshotBeforeDeletion(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
-	namespaceName := buildNamespaceName(tenant)
-	snapshotName := fmt.Sprintf("snapshot-%s-%d", tenant.Name, time.Now().Unix())
-
-	log.Info("creating snapshot before deletion", "tenant", tenant.Name, "snapshot", snapshotName)
+// tenantConfigMaps returns the ConfigMaps of a Silver or Gold tenant's namespace, except
+// the cluster CA bundle Kubernetes publishes in every namespace.
+func tenantConfigMaps(ctx context.Context, c client.Client, tenant *platformv1alpha1.Tenant) ([]corev1.ConfigMap, error) {
+	if tenant.Status.Namespace == "" || tenant.Status.Namespace == BronzeNamespace {
+		return nil, nil
+	}
+	configMaps := &corev1.ConfigMapList{}
+	if err := c.List(ctx, configMaps, client.InNamespace(tenant.Status.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ConfigMaps in %s: %w", tenant.Status.Namespace, err)
+	}
+	var out []corev1.ConfigMap
+	for _, cm := range configMaps.Items {
+		if cm.Name == "kube-root-ca.crt" {
+			continue
+		}
+		cm.ManagedFields = nil
+		out = append(out, cm)
+	}
+	return out, nil
+}
 
-	// In production, this would:
-	// 1. Export all ConfigMaps, Secrets, PVs from the tenant namespace
-	// 2. Store them in an archive (S3, GCS, or local PV)
-	// 3. Record metadata (timestamp, owner, resource count)
+// recordAudit writes an entry to the audit trail. Failures are logged, not returned,
+// so auditing never blocks reconciliation.
+func (r *TenantReconciler) recordAudit(ctx context.Context, entry audit.Entry, log logr.Logger) {
+	recordAuditEntry(ctx, r.Audit, entry, log)
+}
 
-	// For now, create a ConfigMap that records the snapshot metadata
-	snapshotConfigMap := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      snapshotName,
-			Namespace: "tenant-master-system", // Store in operator namespace
-			Labels: map[string]string{
-				TenantNameLabelKey: tenant.Name,
-				"type":             "snapshot",
-				ManagedByLabelKey:  ManagedByValue,
-			},
-		},
-		Data: map[string]string{
-			"tenant-name":      tenant.Name,
-			"snapshot-time":    time.Now().Format(time.RFC3339),
-			"source-namespace": namespaceName,
-			"tier":             string(tenant.Spec.Tier),
-			"owner":            tenant.Spec.Owner,
-			"status":           "completed",
-		},
+// recordAuditEntry writes an entry using recorder, which may be nil.
+func recordAuditEntry(ctx context.Context, recorder *audit.Recorder, entry audit.Entry, log logr.Logger) {
+	if recorder == nil {
+		return
 	}
 
-	if err := r.Create(ctx, snapshotConfigMap); err != nil {
-		// Non-fatal: log and continue with deletion
-		log.Error(err, "failed to create snapshot metadata", "snapshot", snapshotName)
-	} else {
-		log.Info("snapshot metadata recorded", "snapshot", snapshotName)
+	name, err := recorder.Record(ctx, entry)
+	if err != nil {
+		log.Error(err, "failed to record audit entry", "action", entry.Action)
+		return
 	}
-
-	return nil
+	log.Info("audit entry recorded", "action", entry.Action, "auditEntry", name)
 }
@@ -0,0 +1,158 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// hibernationLookback is how far back the last start and end of a hibernation window
+// are searched for, so windows must recur at least weekly.
+const hibernationLookback = 8 * 24 * time.Hour
+
+// ParseHibernationWindow parses the start and end of a spec.hibernation window: five
+// cron fields or a descriptor such as "@midnight", in timeZone, or UTC when empty.
+func ParseHibernationWindow(window platformv1alpha1.HibernationWindow, timeZone string) (start, end cron.Schedule, err error) {
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	prefix := "CRON_TZ=" + timeZone + " "
+	if start, err = ParseBackupSchedule(prefix + window.Start); err != nil {
+		return nil, nil, fmt.Errorf("invalid start %q: %w", window.Start, err)
+	}
+	if end, err = ParseBackupSchedule(prefix + window.End); err != nil {
+		return nil, nil, fmt.Errorf("invalid end %q: %w", window.End, err)
+	}
+	return start, end, nil
+}
+
+// InHibernationWindow reports whether any window of the hibernation schedule is open at
+// now, meaning it started more recently than it ended, and returns the next start or end
+// of a window, when the schedule must be evaluated again.
+func InHibernationWindow(hibernation *platformv1alpha1.HibernationConfig, now time.Time) (bool, time.Time, error) {
+	open := false
+	var next time.Time
+	for _, window := range hibernation.Schedule {
+		start, end, err := ParseHibernationWindow(window, hibernation.TimeZone)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		lastStart, lastEnd := lastActivation(start, now), lastActivation(end, now)
+		boundary := start.Next(now)
+		if !lastStart.IsZero() && lastStart.After(lastEnd) {
+			open = true
+			boundary = end.Next(now)
+		}
+		if !boundary.IsZero() && (next.IsZero() || boundary.Before(next)) {
+			next = boundary
+		}
+	}
+	return open, next, nil
+}
+
+// lastActivation returns the latest activation of schedule at or before now, or the zero
+// time if it did not fire within hibernationLookback.
+func lastActivation(schedule cron.Schedule, now time.Time) time.Time {
+	var last time.Time
+	for t := schedule.Next(now.Add(-hibernationLookback)); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		last = t
+	}
+	return last
+}
+
+// updateHibernation brings status.hibernation up to date with the tenant's schedule at
+// now, adding a finished hibernation to the suspended total, and reports whether the
+// tenant should be hibernating.
+func updateHibernation(tenant *platformv1alpha1.Tenant, now time.Time) bool {
+	status := tenant.Status.Hibernation
+	if tenant.Spec.Hibernation == nil {
+		// Keep the total of a removed schedule, but close an open hibernation
+		if status != nil {
+			endHibernation(status, now)
+			status.NextTransitionTime = nil
+			status.Message = ""
+		}
+		return false
+	}
+	if status == nil {
+		status = &platformv1alpha1.HibernationStatus{}
+		tenant.Status.Hibernation = status
+	}
+
+	hibernating, next, err := InHibernationWindow(tenant.Spec.Hibernation, now)
+	if err != nil {
+		// Only reachable with webhooks disabled; the tenant stays awake until the schedule is fixed
+		status.Message = fmt.Sprintf("invalid hibernation schedule: %v", err)
+	} else {
+		status.Message = ""
+	}
+
+	switch {
+	case hibernating && !status.Hibernating:
+		since := metav1.NewTime(now)
+		status.Hibernating = true
+		status.Since = &since
+	case !hibernating:
+		endHibernation(status, now)
+	}
+	status.NextTransitionTime = nil
+	if !next.IsZero() {
+		at := metav1.NewTime(next)
+		status.NextTransitionTime = &at
+	}
+
+	metrics.SetHibernationSuspended(tenant.Name, string(tenant.Spec.Tier), hibernatedSeconds(status, now))
+	return hibernating
+}
+
+// endHibernation adds the current hibernation, if any, to the suspended total.
+func endHibernation(status *platformv1alpha1.HibernationStatus, now time.Time) {
+	status.SuspendedSeconds = int64(hibernatedSeconds(status, now))
+	status.Hibernating = false
+	status.Since = nil
+}
+
+// hibernatedSeconds is the total time the tenant spent hibernating, including the
+// current hibernation.
+func hibernatedSeconds(status *platformv1alpha1.HibernationStatus, now time.Time) float64 {
+	total := float64(status.SuspendedSeconds)
+	if status.Hibernating && status.Since != nil && now.After(status.Since.Time) {
+		total += now.Sub(status.Since.Time).Truncate(time.Second).Seconds()
+	}
+	return total
+}
+
+// hibernationRequeueAfter returns how long until the tenant's hibernation window next
+// opens or closes, or zero if it has no schedule.
+func hibernationRequeueAfter(tenant *platformv1alpha1.Tenant) time.Duration {
+	status := tenant.Status.Hibernation
+	if tenant.Spec.Hibernation == nil || status == nil || status.NextTransitionTime == nil {
+		return 0
+	}
+	after := time.Until(status.NextTransitionTime.Time)
+	if after <= 0 {
+		return time.Second
+	}
+	return after
+}
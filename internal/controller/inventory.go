@@ -0,0 +1,215 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// VClusterReleaseKind is the ManagedResource kind used for a Gold tier vCluster Helm release.
+const VClusterReleaseKind = "VClusterRelease"
+
+// listManagedResources inventories the child objects the operator created for a tenant.
+// Results are sorted so repeated inventories produce a stable status.
+func (r *TenantReconciler) listManagedResources(ctx context.Context, tenant *platformv1alpha1.Tenant) ([]platformv1alpha1.ManagedResource, error) {
+	if tenant.Spec.Tier == platformv1alpha1.BronzeTier {
+		return r.listBronzeResources(ctx, tenant)
+	}
+
+	namespaceName := buildNamespaceName(tenant)
+	namespaces := []string{namespaceName}
+	for _, env := range tenant.Spec.Environments {
+		namespaces = append(namespaces, buildEnvironmentNamespaceName(tenant, env.Name))
+	}
+
+	var resources []platformv1alpha1.ManagedResource
+	for _, name := range namespaces {
+		found, err := r.inventoryNamespace(ctx, tenant, name, &resources)
+		if err != nil {
+			return resources, err
+		}
+		// Nothing namespaced can exist without the tenant namespace
+		if !found && name == namespaceName {
+			return resources, nil
+		}
+	}
+
+	if tenant.Spec.Tier == platformv1alpha1.GoldTier {
+		resources = append(resources, platformv1alpha1.ManagedResource{
+			Kind:      VClusterReleaseKind,
+			Namespace: namespaceName,
+			Name:      fmt.Sprintf("%s-vcluster", tenant.Name),
+		})
+	}
+	if tenant.Spec.Tier == platformv1alpha1.PlatinumTier {
+		resources = append(resources, platformv1alpha1.ManagedResource{
+			Kind:      ClusterKind,
+			Namespace: namespaceName,
+			Name:      clusterName(tenant),
+		})
+	}
+	if meshProvider(tenant) == platformv1alpha1.MeshProviderIstio {
+		for _, name := range namespaces {
+			resources = append(resources,
+				platformv1alpha1.ManagedResource{
+					APIVersion: peerAuthenticationGVK.GroupVersion().String(),
+					Kind:       peerAuthenticationGVK.Kind,
+					Namespace:  name,
+					Name:       MeshPeerAuthenticationName,
+				},
+				platformv1alpha1.ManagedResource{
+					APIVersion: authorizationPolicyGVK.GroupVersion().String(),
+					Kind:       authorizationPolicyGVK.Kind,
+					Namespace:  name,
+					Name:       MeshAuthorizationPolicyName,
+				})
+		}
+	}
+	if monitoringEnabled(tenant) {
+		resources = append(resources,
+			platformv1alpha1.ManagedResource{
+				APIVersion: serviceMonitorGVK.GroupVersion().String(),
+				Kind:       serviceMonitorGVK.Kind,
+				Namespace:  namespaceName,
+				Name:       MonitoringServiceMonitorName,
+			},
+			platformv1alpha1.ManagedResource{
+				APIVersion: prometheusRuleGVK.GroupVersion().String(),
+				Kind:       prometheusRuleGVK.Kind,
+				Namespace:  namespaceName,
+				Name:       MonitoringRuleName,
+			})
+	}
+	if argoCDEnabled(tenant) {
+		argoNamespace := r.config().GitOps.ArgoCDNamespace
+		resources = append(resources, platformv1alpha1.ManagedResource{
+			APIVersion: appProjectGVK.GroupVersion().String(),
+			Kind:       appProjectGVK.Kind,
+			Namespace:  argoNamespace,
+			Name:       argoCDProjectName(tenant),
+		})
+		if tenant.Spec.GitOps.RepoURL != "" {
+			resources = append(resources, platformv1alpha1.ManagedResource{
+				APIVersion: applicationGVK.GroupVersion().String(),
+				Kind:       applicationGVK.Kind,
+				Namespace:  argoNamespace,
+				Name:       argoCDBootstrapName(tenant),
+			})
+		}
+	}
+	if fluxEnabled(tenant) {
+		for _, gvk := range []schema.GroupVersionKind{gitRepositoryGVK, kustomizationGVK} {
+			resources = append(resources, platformv1alpha1.ManagedResource{
+				APIVersion: gvk.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Namespace:  namespaceName,
+				Name:       fluxBootstrapName(tenant),
+			})
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].String() < resources[j].String()
+	})
+	return resources, nil
+}
+
+// inventoryNamespace appends the namespace and the tenant objects inside it to resources.
+// It reports false when the namespace does not exist.
+func (r *TenantReconciler) inventoryNamespace(ctx context.Context, tenant *platformv1alpha1.Tenant, namespaceName string, resources *[]platformv1alpha1.ManagedResource) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: namespaceName}, ns); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to fetch namespace: %w", err)
+		}
+		return false, nil
+	}
+	*resources = append(*resources, platformv1alpha1.ManagedResource{APIVersion: "v1", Kind: "Namespace", Name: namespaceName})
+	return true, r.inventoryObjects(ctx, tenant, namespaceName, resources)
+}
+
+// inventoryObjects appends the objects labeled with the tenant in a namespace to resources.
+func (r *TenantReconciler) inventoryObjects(ctx context.Context, tenant *platformv1alpha1.Tenant, namespaceName string, resources *[]platformv1alpha1.ManagedResource) error {
+	opts := []client.ListOption{
+		client.InNamespace(namespaceName),
+		client.MatchingLabels{TenantNameLabelKey: tenant.Name},
+	}
+	lists := []struct {
+		apiVersion string
+		kind       string
+		list       client.ObjectList
+	}{
+		{"v1", "ResourceQuota", &corev1.ResourceQuotaList{}},
+		{netv1.SchemeGroupVersion.String(), "NetworkPolicy", &netv1.NetworkPolicyList{}},
+		{"v1", "ServiceAccount", &corev1.ServiceAccountList{}},
+		{rbacv1.SchemeGroupVersion.String(), "Role", &rbacv1.RoleList{}},
+		{rbacv1.SchemeGroupVersion.String(), "RoleBinding", &rbacv1.RoleBindingList{}},
+		{"v1", "Secret", &corev1.SecretList{}},
+		{"v1", "ConfigMap", &corev1.ConfigMapList{}},
+	}
+
+	for _, l := range lists {
+		if err := r.List(ctx, l.list, opts...); err != nil {
+			return fmt.Errorf("failed to list %s objects: %w", l.kind, err)
+		}
+		for _, name := range objectNames(l.list) {
+			*resources = append(*resources, platformv1alpha1.ManagedResource{
+				APIVersion: l.apiVersion,
+				Kind:       l.kind,
+				Namespace:  namespaceName,
+				Name:       name,
+			})
+		}
+	}
+	return nil
+}
+
+// updateManagedResources refreshes status.managedResources. Inventory failures are
+// logged and leave the previous list in place.
+func (r *TenantReconciler) updateManagedResources(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) {
+	resources, err := r.listManagedResources(ctx, tenant)
+	if err != nil {
+		log.Error(err, "failed to inventory managed resources (non-fatal)")
+		return
+	}
+	tenant.Status.ManagedResources = resources
+}
+
+// objectNames extracts object names from a typed list.
+func objectNames(list client.ObjectList) []string {
+	var names []string
+	_ = apimeta.EachListItem(list, func(obj runtime.Object) error {
+		if o, ok := obj.(client.Object); ok {
+			names = append(names, o.GetName())
+		}
+		return nil
+	})
+	return names
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// Annotations on a Silver tier kubeconfig Secret describing the token it embeds.
+const (
+	kubeconfigExpiresAtAnnotation         = "tenant.platform.io/token-expires-at"
+	kubeconfigServiceAccountUIDAnnotation = "tenant.platform.io/service-account-uid"
+)
+
+// rootCAConfigMap is the ConfigMap Kubernetes publishes in every namespace with the
+// cluster CA bundle.
+const rootCAConfigMap = "kube-root-ca.crt"
+
+// ensureServiceAccountKubeconfig stores a kubeconfig for the tenant ServiceAccount, and
+// so scoped to the tenant namespace by its admin Role, in the "<tenant>-kubeconfig"
+// Secret. The token comes from the TokenRequest API and is reissued when less than a
+// fifth of its lifetime remains or the ServiceAccount was recreated by a credential
+// rotation.
+func (r *TenantReconciler) ensureServiceAccountKubeconfig(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	namespaceName := buildNamespaceName(tenant)
+	secretName := fmt.Sprintf("%s-%s", tenant.Name, KubeconfigSecretSuffix)
+	saName := fmt.Sprintf("%s-sa", tenant.Name)
+	cfg := r.config().Kubeconfig
+
+	sa := &corev1.ServiceAccount{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: saName}, sa); err != nil {
+		return fmt.Errorf("failed to fetch ServiceAccount %s: %w", saName, err)
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: secretName}, secret)
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to fetch kubeconfig Secret %s: %w", secretName, err)
+	}
+	if err == nil {
+		if expiresAt, ok := kubeconfigValid(secret, sa, cfg.TokenTTL); ok {
+			tenant.Status.AdminKubeconfigSecret = secretName
+			tenant.Status.KubeconfigExpiresAt = &metav1.Time{Time: expiresAt}
+			return nil
+		}
+	}
+
+	rootCA := &corev1.ConfigMap{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: rootCAConfigMap}, rootCA); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("cluster CA bundle is not yet published in namespace %s", namespaceName)
+		}
+		return fmt.Errorf("failed to fetch cluster CA bundle: %w", err)
+	}
+
+	expirationSeconds := int64(cfg.TokenTTL.Seconds())
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds},
+	}
+	if err := r.SubResource("token").Create(ctx, sa, tokenRequest); err != nil {
+		return fmt.Errorf("failed to request token for ServiceAccount %s: %w", saName, err)
+	}
+	// The API server may shorten the lifetime, e.g. to its --service-account-max-token-expiration
+	expiresAt := tokenRequest.Status.ExpirationTimestamp.Time.UTC()
+
+	kubeconfig, err := buildServiceAccountKubeconfig(tenant, cfg.APIServer, []byte(rootCA.Data["ca.crt"]),
+		namespaceName, saName, tokenRequest.Status.Token)
+	if err != nil {
+		return err
+	}
+
+	secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespaceName}}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			ManagedByLabelKey:  ManagedByValue,
+		}
+		secret.Annotations = map[string]string{
+			kubeconfigExpiresAtAnnotation:         expiresAt.Format(time.RFC3339),
+			kubeconfigServiceAccountUIDAnnotation: string(sa.UID),
+		}
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{"kubeconfig": kubeconfig}
+		return controllerutil.SetControllerReference(tenant, secret, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store kubeconfig Secret %s: %w", secretName, err)
+	}
+
+	log.Info("issued Silver tier kubeconfig", "secret", secretName, "expiresAt", expiresAt, "operation", result)
+	tenant.Status.AdminKubeconfigSecret = secretName
+	tenant.Status.KubeconfigExpiresAt = &metav1.Time{Time: expiresAt}
+	tenant.Status.KubeconfigRotatedAt = &metav1.Time{Time: time.Now().UTC()}
+	return nil
+}
+
+// clientCertificateExpiry returns when the client certificate of the current context
+// of a kubeconfig expires, and false when it has none that parses.
+func clientCertificateExpiry(kubeconfig []byte) (time.Time, bool) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return time.Time{}, false
+	}
+	current := config.Contexts[config.CurrentContext]
+	if current == nil || config.AuthInfos[current.AuthInfo] == nil {
+		return time.Time{}, false
+	}
+	block, _ := pem.Decode(config.AuthInfos[current.AuthInfo].ClientCertificateData)
+	if block == nil {
+		return time.Time{}, false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return cert.NotAfter.UTC(), true
+}
+
+// kubeconfigValid returns the token expiry of a kubeconfig Secret, and whether the
+// token was issued to the current ServiceAccount and is not yet due for renewal.
+func kubeconfigValid(secret *corev1.Secret, sa *corev1.ServiceAccount, ttl time.Duration) (time.Time, bool) {
+	if secret.Annotations[kubeconfigServiceAccountUIDAnnotation] != string(sa.UID) || len(secret.Data["kubeconfig"]) == 0 {
+		return time.Time{}, false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, secret.Annotations[kubeconfigExpiresAtAnnotation])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return expiresAt, time.Until(expiresAt) > ttl/5
+}
+
+// kubeconfigRequeueAfter returns when a Silver tier tenant must be reconciled again to
+// renew its kubeconfig, or to retry issuing it, and when a Gold tier tenant must check
+// again for its cert-manager certificate. Zero means no renewal is pending.
+func (r *TenantReconciler) kubeconfigRequeueAfter(tenant *platformv1alpha1.Tenant) time.Duration {
+	if tenant.Spec.Tier == platformv1alpha1.GoldTier && certificatePending(tenant) {
+		return r.config().Requeue.Transient
+	}
+	if tenant.Spec.Tier != platformv1alpha1.SilverTier {
+		return 0
+	}
+	if apimeta.IsStatusConditionFalse(tenant.Status.Conditions, platformv1alpha1.ConditionKubeconfigAvailable) {
+		return r.config().Requeue.Transient
+	}
+	if tenant.Status.KubeconfigExpiresAt == nil {
+		return 0
+	}
+	after := time.Until(tenant.Status.KubeconfigExpiresAt.Time) - r.config().Kubeconfig.TokenTTL/5
+	if after <= 0 {
+		return time.Second
+	}
+	return after
+}
+
+// buildServiceAccountKubeconfig returns a kubeconfig that authenticates as the tenant
+// ServiceAccount with token and defaults to the tenant namespace.
+func buildServiceAccountKubeconfig(tenant *platformv1alpha1.Tenant, server string, caData []byte, namespace, saName, token string) ([]byte, error) {
+	name := fmt.Sprintf("tenant-%s", tenant.Name)
+	config := clientcmdapi.NewConfig()
+	config.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: caData,
+	}
+	config.AuthInfos[saName] = &clientcmdapi.AuthInfo{Token: token}
+	config.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: saName, Namespace: namespace}
+	config.CurrentContext = name
+	kubeconfig, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode kubeconfig: %w", err)
+	}
+	return kubeconfig, nil
+}
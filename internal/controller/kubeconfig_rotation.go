@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+)
+
+// kubeconfigRotationInterval is how often the KubeconfigRotator checks tenant kubeconfigs.
+const kubeconfigRotationInterval = 5 * time.Minute
+
+// vClusterCertRenewalGrace is how long a vCluster restarted to renew its certificate
+// is given to publish the new one before it is restarted again.
+const vClusterCertRenewalGrace = 30 * time.Minute
+
+// KubeconfigRotator renews the credentials in tenant kubeconfig Secrets before they
+// expire, independently of tenant reconciles, so downloaded kubeconfigs do not go stale
+// silently. Silver tier ServiceAccount tokens are reissued once less than a fifth of
+// their lifetime remains. Gold tier kubeconfigs are refreshed from the vCluster, which
+// is restarted to issue a new client certificate once the current one is within
+// Kubeconfig.CertRenewBefore of expiry. Every rotation updates
+// status.kubeconfigRotatedAt and emits a KubeconfigRotated Event. It runs as a manager
+// Runnable, so only the elected leader rotates.
+type KubeconfigRotator struct {
+	Client   client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Config   *config.OperatorConfig
+	Log      logr.Logger
+}
+
+// Start checks tenant kubeconfigs until ctx is cancelled.
+func (k *KubeconfigRotator) Start(ctx context.Context) error {
+	ticker := time.NewTicker(kubeconfigRotationInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := k.Check(ctx, time.Now()); err != nil {
+			k.Log.Error(err, "failed to rotate tenant kubeconfigs")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check rotates the kubeconfigs of Ready Silver and Gold tier tenants that are due.
+func (k *KubeconfigRotator) Check(ctx context.Context, now time.Time) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := k.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	r := &TenantReconciler{Client: k.Client, Scheme: k.Scheme, Config: k.Config, Log: k.Log}
+	var errs []string
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		if tenant.Status.State != platformv1alpha1.StateReady || !tenant.DeletionTimestamp.IsZero() || isPaused(tenant) {
+			continue
+		}
+		if err := k.rotate(ctx, r, tenant, now); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("kubeconfig rotation failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// rotate renews one tenant's kubeconfig if due and records the new status.
+func (k *KubeconfigRotator) rotate(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, now time.Time) error {
+	log := k.Log.WithValues("tenant", tenant.Name)
+	before := tenant.DeepCopy()
+
+	switch tenant.Spec.Tier {
+	case platformv1alpha1.SilverTier:
+		if err := r.ensureServiceAccountKubeconfig(ctx, tenant, log); err != nil {
+			return err
+		}
+	case platformv1alpha1.GoldTier:
+		if err := r.ensureKubeconfigSecret(ctx, tenant, log); err != nil {
+			return err
+		}
+		if err := k.renewVClusterCertificate(ctx, r, tenant, now, log); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	if equality.Semantic.DeepEqual(before.Status, tenant.Status) {
+		return nil
+	}
+	rotated := !equality.Semantic.DeepEqual(before.Status.KubeconfigRotatedAt, tenant.Status.KubeconfigRotatedAt)
+	if err := k.Client.Status().Patch(ctx, tenant, client.MergeFrom(before)); err != nil {
+		return fmt.Errorf("failed to record kubeconfig rotation: %w", err)
+	}
+	if rotated {
+		message := fmt.Sprintf("kubeconfig Secret %s has new credentials", tenant.Status.AdminKubeconfigSecret)
+		if expiresAt := tenant.Status.KubeconfigExpiresAt; expiresAt != nil {
+			message += fmt.Sprintf(", valid until %s", expiresAt.UTC().Format(time.RFC3339))
+		}
+		k.Recorder.Event(tenant, corev1.EventTypeNormal, "KubeconfigRotated", message)
+		log.Info("rotated tenant kubeconfig", "expiresAt", tenant.Status.KubeconfigExpiresAt)
+	}
+	return nil
+}
+
+// renewVClusterCertificate restarts a Gold tier vCluster to issue a new client
+// certificate once the exported one is within Kubeconfig.CertRenewBefore of expiry. A
+// vCluster restarted within the grace period is left to finish starting.
+func (k *KubeconfigRotator) renewVClusterCertificate(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, now time.Time, log logr.Logger) error {
+	expiresAt := tenant.Status.KubeconfigExpiresAt
+	if expiresAt == nil || expiresAt.Sub(now) > k.Config.Kubeconfig.CertRenewBefore {
+		return nil
+	}
+
+	ss := &appsv1.StatefulSet{}
+	key := client.ObjectKey{Namespace: buildNamespaceName(tenant), Name: fmt.Sprintf("%s-vcluster", tenant.Name)}
+	if err := k.Client.Get(ctx, key, ss); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to fetch vCluster StatefulSet: %w", err)
+	}
+	if restartedAt, err := time.Parse(time.RFC3339, ss.Spec.Template.Annotations[vClusterRestartedAtAnnotation]); err == nil &&
+		now.Sub(restartedAt) < vClusterCertRenewalGrace {
+		return nil
+	}
+
+	log.Info("renewing vCluster client certificate", "expiresAt", expiresAt)
+	return r.rotateVClusterCredentials(ctx, tenant, log)
+}
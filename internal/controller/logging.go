@@ -0,0 +1,308 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// LogRoutingLabelKey marks ConfigMaps in the operator namespace holding a tenant's
+// log routing snippet. The cluster log agent includes every ConfigMap with this label.
+const LogRoutingLabelKey = "tenant.platform.io/log-routing"
+
+// LogCollectorLabelKey records the log agent a routing ConfigMap is rendered for, so
+// Fluent Bit and Vector can each select their own.
+const LogCollectorLabelKey = "tenant.platform.io/log-collector"
+
+// vectorKubernetesSource is the kubernetes_logs source of the cluster Vector agent that
+// the tenant's filter transform reads from.
+const vectorKubernetesSource = "kubernetes_logs"
+
+// fluentBitEndpoint is an HTTP endpoint split into Fluent Bit output settings.
+type fluentBitEndpoint struct {
+	Host string
+	Port string
+	Path string
+	TLS  string
+}
+
+// parseFluentBitEndpoint splits an http(s) URL into host, port, path, and TLS
+// settings, using defaultPath when the URL has none.
+func parseFluentBitEndpoint(raw, defaultPath string) (fluentBitEndpoint, error) {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fluentBitEndpoint{}, fmt.Errorf("%q is not an http(s) URL", raw)
+	}
+
+	ep := fluentBitEndpoint{Host: u.Hostname(), Port: u.Port(), Path: u.Path, TLS: "off"}
+	if u.Scheme == "https" {
+		ep.TLS = "on"
+	}
+	if ep.Port == "" {
+		ep.Port = "80"
+		if u.Scheme == "https" {
+			ep.Port = "443"
+		}
+	}
+	if ep.Path == "" {
+		ep.Path = defaultPath
+	}
+	return ep, nil
+}
+
+// LoggingTenantID returns the log stream identifier of a tenant: spec.logging.tenantID,
+// or the tenant name.
+func LoggingTenantID(tenant *platformv1alpha1.Tenant) string {
+	if tenant.Spec.Logging != nil && tenant.Spec.Logging.TenantID != "" {
+		return tenant.Spec.Logging.TenantID
+	}
+	return tenant.Name
+}
+
+// loggingCollector returns spec.logging.collector, defaulting to Fluent Bit.
+func loggingCollector(tenant *platformv1alpha1.Tenant) platformv1alpha1.LogCollector {
+	if tenant.Spec.Logging.Collector == "" {
+		return platformv1alpha1.LogCollectorFluentBit
+	}
+	return tenant.Spec.Logging.Collector
+}
+
+// loggingStream returns the Loki tenant or Elasticsearch index receiving the logs.
+func loggingStream(tenant *platformv1alpha1.Tenant) string {
+	if tenant.Spec.Logging.Backend == "Elasticsearch" {
+		return fmt.Sprintf("tenant-%s", LoggingTenantID(tenant))
+	}
+	return LoggingTenantID(tenant)
+}
+
+// ensureLogRouting writes the Fluent Bit or Vector routing snippet that sends container
+// logs from the tenant namespace and its environment namespaces to the tenant's log
+// stream and reports the mapping in status.logging, or removes both when spec.logging
+// is unset.
+func (r *TenantReconciler) ensureLogRouting(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("tenant-logging-%s", tenant.Name),
+			Namespace: OperatorNamespace,
+			Labels: map[string]string{
+				TenantNameLabelKey: tenant.Name,
+				ManagedByLabelKey:  ManagedByValue,
+				LogRoutingLabelKey: "true",
+			},
+		},
+	}
+
+	if tenant.Spec.Logging == nil {
+		if err := r.Delete(ctx, cm); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete log routing config: %w", err)
+		}
+		tenant.Status.Logging = nil
+		return nil
+	}
+
+	namespaces := []string{buildNamespaceName(tenant)}
+	for _, env := range tenant.Spec.Environments {
+		namespaces = append(namespaces, buildEnvironmentNamespaceName(tenant, env.Name))
+	}
+	collector := loggingCollector(tenant)
+	lokiURL, elasticsearchURL := r.config().Logging.LokiURL, r.config().Logging.ElasticsearchURL
+	key, collectorLabel := "fluent-bit.conf", "fluent-bit"
+	var routing string
+	var err error
+	if collector == platformv1alpha1.LogCollectorVector {
+		key, collectorLabel = "vector.yaml", "vector"
+		routing, err = buildVectorRoutingConfig(tenant, namespaces, lokiURL, elasticsearchURL)
+	} else {
+		routing, err = buildLogRoutingConfig(tenant, namespaces, lokiURL, elasticsearchURL)
+	}
+	if err != nil {
+		return err
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels[LogRoutingLabelKey] = "true"
+		cm.Labels[LogCollectorLabelKey] = collectorLabel
+		cm.Data = map[string]string{key: routing}
+		return controllerutil.SetControllerReference(tenant, cm, r.Scheme)
+	})
+	if err != nil {
+		log.Error(err, "failed to create or update log routing config")
+		return err
+	}
+
+	tenant.Status.Logging = &platformv1alpha1.LoggingStatus{
+		Backend:    tenant.Spec.Logging.Backend,
+		Collector:  collector,
+		Stream:     loggingStream(tenant),
+		Namespaces: namespaces,
+		ConfigMap:  fmt.Sprintf("%s/%s", cm.Namespace, cm.Name),
+	}
+	log.Info("ensured log routing", "backend", tenant.Spec.Logging.Backend, "collector", collector, "tenantID", LoggingTenantID(tenant), "operation", result)
+	return nil
+}
+
+// buildLogRoutingConfig renders one Fluent Bit output per namespace. Outputs match
+// the kubernetes tail tag (kube.*_<namespace>_*), so each namespace is routed exactly
+// and tenants with similar names never share a stream.
+func buildLogRoutingConfig(tenant *platformv1alpha1.Tenant, namespaces []string, lokiURL, elasticsearchURL string) (string, error) {
+	tenantID := LoggingTenantID(tenant)
+	var b strings.Builder
+
+	switch tenant.Spec.Logging.Backend {
+	case "Loki":
+		if lokiURL == "" {
+			return "", fmt.Errorf("spec.logging.backend is Loki but the operator has no --logging-loki-url")
+		}
+		ep, err := parseFluentBitEndpoint(lokiURL, "/loki/api/v1/push")
+		if err != nil {
+			return "", fmt.Errorf("invalid --logging-loki-url: %w", err)
+		}
+		for _, ns := range namespaces {
+			fmt.Fprintf(&b, `[OUTPUT]
+    Name       loki
+    Match      kube.*_%s_*
+    Host       %s
+    Port       %s
+    Uri        %s
+    tls        %s
+    tenant_id  %s
+    Labels     job=tenant-logs, tenant=%s, namespace=%s
+
+`, ns, ep.Host, ep.Port, ep.Path, ep.TLS, tenantID, tenant.Name, ns)
+		}
+
+	case "Elasticsearch":
+		if elasticsearchURL == "" {
+			return "", fmt.Errorf("spec.logging.backend is Elasticsearch but the operator has no --logging-elasticsearch-url")
+		}
+		ep, err := parseFluentBitEndpoint(elasticsearchURL, "")
+		if err != nil {
+			return "", fmt.Errorf("invalid --logging-elasticsearch-url: %w", err)
+		}
+		for _, ns := range namespaces {
+			fmt.Fprintf(&b, `[OUTPUT]
+    Name              es
+    Match             kube.*_%s_*
+    Host              %s
+    Port              %s
+    tls               %s
+    Index             tenant-%s
+    Suppress_Type_Name On
+
+`, ns, ep.Host, ep.Port, ep.TLS, tenantID)
+		}
+
+	default:
+		return "", fmt.Errorf("unsupported logging backend %q", tenant.Spec.Logging.Backend)
+	}
+
+	return b.String(), nil
+}
+
+// buildVectorRoutingConfig renders a Vector filter transform selecting the tenant
+// namespaces from the cluster's kubernetes_logs source and a sink writing them to the
+// tenant's Loki tenant or Elasticsearch index. Component IDs carry the tenant name, so
+// the snippets of all tenants can be loaded into one Vector config.
+func buildVectorRoutingConfig(tenant *platformv1alpha1.Tenant, namespaces []string, lokiURL, elasticsearchURL string) (string, error) {
+	id := fmt.Sprintf("tenant-%s", tenant.Name)
+	quoted := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		quoted = append(quoted, fmt.Sprintf("%q", ns))
+	}
+
+	var sink map[string]interface{}
+	switch tenant.Spec.Logging.Backend {
+	case "Loki":
+		if lokiURL == "" {
+			return "", fmt.Errorf("spec.logging.backend is Loki but the operator has no --logging-loki-url")
+		}
+		ep, err := parseFluentBitEndpoint(lokiURL, "/loki/api/v1/push")
+		if err != nil {
+			return "", fmt.Errorf("invalid --logging-loki-url: %w", err)
+		}
+		sink = map[string]interface{}{
+			"type":      "loki",
+			"endpoint":  vectorEndpoint(ep),
+			"path":      ep.Path,
+			"tenant_id": LoggingTenantID(tenant),
+			"encoding":  map[string]interface{}{"codec": "json"},
+			"labels": map[string]interface{}{
+				"job":       "tenant-logs",
+				"tenant":    tenant.Name,
+				"namespace": "{{ kubernetes.pod_namespace }}",
+			},
+		}
+
+	case "Elasticsearch":
+		if elasticsearchURL == "" {
+			return "", fmt.Errorf("spec.logging.backend is Elasticsearch but the operator has no --logging-elasticsearch-url")
+		}
+		ep, err := parseFluentBitEndpoint(elasticsearchURL, "")
+		if err != nil {
+			return "", fmt.Errorf("invalid --logging-elasticsearch-url: %w", err)
+		}
+		sink = map[string]interface{}{
+			"type":      "elasticsearch",
+			"endpoints": []interface{}{vectorEndpoint(ep) + ep.Path},
+			"bulk":      map[string]interface{}{"index": loggingStream(tenant)},
+		}
+
+	default:
+		return "", fmt.Errorf("unsupported logging backend %q", tenant.Spec.Logging.Backend)
+	}
+	sink["inputs"] = []interface{}{id}
+
+	raw, err := yaml.Marshal(map[string]interface{}{
+		"transforms": map[string]interface{}{
+			id: map[string]interface{}{
+				"type":      "filter",
+				"inputs":    []interface{}{vectorKubernetesSource},
+				"condition": fmt.Sprintf("includes([%s], .kubernetes.pod_namespace)", strings.Join(quoted, ", ")),
+			},
+		},
+		"sinks": map[string]interface{}{id: sink},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode Vector routing config: %w", err)
+	}
+	return string(raw), nil
+}
+
+// vectorEndpoint joins the scheme, host, and port of ep into a base URL.
+func vectorEndpoint(ep fluentBitEndpoint) string {
+	scheme := "http"
+	if ep.TLS == "on" {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(ep.Host, ep.Port))
+}
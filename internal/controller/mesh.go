@@ -0,0 +1,216 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=security.istio.io,resources=peerauthentications;authorizationpolicies,verbs=get;list;watch;create;update;patch;delete
+
+const (
+	// IstioInjectionLabelKey enables Istio sidecar injection in a namespace.
+	IstioInjectionLabelKey = "istio-injection"
+
+	// LinkerdInjectAnnotation enables Linkerd proxy injection in a namespace.
+	LinkerdInjectAnnotation = "linkerd.io/inject"
+
+	// LinkerdInboundPolicyAnnotation sets the default inbound policy of the Linkerd
+	// proxies in a namespace.
+	LinkerdInboundPolicyAnnotation = "config.linkerd.io/default-inbound-policy"
+
+	// MeshPeerAuthenticationName is the PeerAuthentication enforcing strict mTLS in each
+	// tenant namespace.
+	MeshPeerAuthenticationName = "tenant-mtls"
+
+	// MeshAuthorizationPolicyName is the AuthorizationPolicy admitting traffic from the
+	// tenant's own namespaces in each tenant namespace.
+	MeshAuthorizationPolicyName = "tenant-allow"
+)
+
+// The Istio security kinds, managed as unstructured objects like the GitOps kinds.
+var (
+	peerAuthenticationGVK  = schema.GroupVersionKind{Group: "security.istio.io", Version: "v1beta1", Kind: "PeerAuthentication"}
+	authorizationPolicyGVK = schema.GroupVersionKind{Group: "security.istio.io", Version: "v1beta1", Kind: "AuthorizationPolicy"}
+)
+
+// meshProvider returns the service mesh of spec.mesh, or "" when it is unset.
+func meshProvider(tenant *platformv1alpha1.Tenant) platformv1alpha1.MeshProvider {
+	if tenant.Spec.Mesh == nil {
+		return ""
+	}
+	return tenant.Spec.Mesh.Provider
+}
+
+// setMeshMetadata enrolls one of the tenant's namespaces in the mesh of spec.mesh. The
+// namespace labels are rebuilt on every update, so only the Linkerd annotations need
+// removing once the tenant leaves the mesh.
+func setMeshMetadata(ns *corev1.Namespace, tenant *platformv1alpha1.Tenant) {
+	provider := meshProvider(tenant)
+	if provider == platformv1alpha1.MeshProviderIstio {
+		if ns.Labels == nil {
+			ns.Labels = map[string]string{}
+		}
+		ns.Labels[IstioInjectionLabelKey] = "enabled"
+	}
+
+	inject, inboundPolicy := "", ""
+	if provider == platformv1alpha1.MeshProviderLinkerd {
+		inject, inboundPolicy = "enabled", "all-authenticated"
+	}
+	setAnnotation(ns, LinkerdInjectAnnotation, inject)
+	setAnnotation(ns, LinkerdInboundPolicyAnnotation, inboundPolicy)
+}
+
+// meshNamespaces maps each tenant namespace to the namespaces its workloads accept mesh
+// traffic from, mirroring the NetworkPolicies: the namespace itself, the non-isolated
+// environments for each other, and spec.mesh.allowedNamespaces for all of them.
+func meshNamespaces(tenant *platformv1alpha1.Tenant) map[string][]string {
+	var siblings []string
+	for _, env := range tenant.Spec.Environments {
+		if !environmentIsolated(env) {
+			siblings = append(siblings, buildEnvironmentNamespaceName(tenant, env.Name))
+		}
+	}
+	var allowed []string
+	if tenant.Spec.Mesh != nil {
+		allowed = tenant.Spec.Mesh.AllowedNamespaces
+	}
+
+	namespaceName := buildNamespaceName(tenant)
+	sources := map[string][]string{
+		namespaceName: append([]string{namespaceName}, allowed...),
+	}
+	for _, env := range tenant.Spec.Environments {
+		name := buildEnvironmentNamespaceName(tenant, env.Name)
+		if environmentIsolated(env) {
+			sources[name] = append([]string{name}, allowed...)
+			continue
+		}
+		sources[name] = append(append([]string{}, siblings...), allowed...)
+	}
+	return sources
+}
+
+// ensureMesh creates the Istio PeerAuthentication and AuthorizationPolicy in every
+// tenant namespace when spec.mesh selects Istio, and deletes them otherwise. Linkerd
+// needs nothing beyond the namespace annotations.
+func (r *TenantReconciler) ensureMesh(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	var err error
+	for namespaceName, sources := range meshNamespaces(tenant) {
+		if meshProvider(tenant) == platformv1alpha1.MeshProviderIstio {
+			err = r.ensureIstioPolicies(ctx, tenant, namespaceName, sources, log)
+		} else {
+			err = r.deleteIstioPolicies(ctx, namespaceName)
+		}
+		if err != nil {
+			break
+		}
+	}
+	if tenant.Spec.Mesh == nil {
+		apimeta.RemoveStatusCondition(&tenant.Status.Conditions, platformv1alpha1.ConditionMeshReady)
+		return err
+	}
+	setResourceCondition(tenant, platformv1alpha1.ConditionMeshReady, "CreateFailed", err)
+	return err
+}
+
+// ensureIstioPolicies enforces strict mTLS in a tenant namespace and only admits
+// requests from workloads in the source namespaces.
+func (r *TenantReconciler) ensureIstioPolicies(ctx context.Context, tenant *platformv1alpha1.Tenant, namespaceName string, sources []string, log logr.Logger) error {
+	labels := map[string]string{
+		TenantNameLabelKey: tenant.Name,
+		ManagedByLabelKey:  ManagedByValue,
+	}
+
+	peerAuthentication := newIstioPolicy(peerAuthenticationGVK, namespaceName, MeshPeerAuthenticationName)
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, peerAuthentication, func() error {
+		peerAuthentication.SetLabels(labels)
+		peerAuthentication.Object["spec"] = map[string]interface{}{
+			"mtls": map[string]interface{}{"mode": "STRICT"},
+		}
+		return controllerutil.SetControllerReference(tenant, peerAuthentication, r.Scheme)
+	})
+	if apimeta.IsNoMatchError(err) {
+		return newValidationError(fmt.Errorf("spec.mesh requires Istio, which is not installed"))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create or update PeerAuthentication in %s: %w", namespaceName, err)
+	}
+	log.Info("ensured PeerAuthentication", "namespace", namespaceName, "operation", result)
+
+	namespaces := make([]interface{}, 0, len(sources))
+	for _, ns := range sources {
+		namespaces = append(namespaces, ns)
+	}
+	authorizationPolicy := newIstioPolicy(authorizationPolicyGVK, namespaceName, MeshAuthorizationPolicyName)
+	result, err = controllerutil.CreateOrUpdate(ctx, r.Client, authorizationPolicy, func() error {
+		authorizationPolicy.SetLabels(labels)
+		authorizationPolicy.Object["spec"] = map[string]interface{}{
+			"action": "ALLOW",
+			"rules": []interface{}{
+				map[string]interface{}{
+					"from": []interface{}{
+						map[string]interface{}{
+							"source": map[string]interface{}{"namespaces": namespaces},
+						},
+					},
+				},
+			},
+		}
+		return controllerutil.SetControllerReference(tenant, authorizationPolicy, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update AuthorizationPolicy in %s: %w", namespaceName, err)
+	}
+	log.Info("ensured AuthorizationPolicy", "namespace", namespaceName, "sources", sources, "operation", result)
+	return nil
+}
+
+// deleteIstioPolicies deletes the Istio policies of a tenant namespace that left the
+// mesh. Without Istio installed there is nothing to clean up.
+func (r *TenantReconciler) deleteIstioPolicies(ctx context.Context, namespaceName string) error {
+	for _, obj := range []*unstructured.Unstructured{
+		newIstioPolicy(authorizationPolicyGVK, namespaceName, MeshAuthorizationPolicyName),
+		newIstioPolicy(peerAuthenticationGVK, namespaceName, MeshPeerAuthenticationName),
+	} {
+		if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil && !apimeta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to delete stale %s in %s: %w", obj.GetKind(), namespaceName, err)
+		}
+	}
+	return nil
+}
+
+// newIstioPolicy returns an empty Istio security object of the given kind.
+func newIstioPolicy(gvk schema.GroupVersionKind, namespaceName, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace(namespaceName)
+	obj.SetName(name)
+	return obj
+}
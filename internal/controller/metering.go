@@ -0,0 +1,223 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+)
+
+// billingPeriodLayout and billingDayLayout format the billing periods, calendar months
+// in UTC, and the days within them.
+const (
+	billingPeriodLayout = "2006-01"
+	billingDayLayout    = "2006-01-02"
+)
+
+// Meter samples the requested CPU, memory, and storage of every tenant from
+// status.resourceUsage and accumulates them over time, priced at the unit prices of
+// the tenant's tier, in status.billing and the tenant_cost_total metric. It runs as a
+// manager Runnable, so only the elected leader meters. Tenants without
+// status.resourceUsage, such as Platinum tenants, are not metered. The totals of every
+// day and month closed are exported as usage reports.
+type Meter struct {
+	Client client.Client
+	Config *config.OperatorConfig
+	// Archive, when set, also receives the usage reports.
+	Archive storage.Storage
+	Log     logr.Logger
+}
+
+// Start meters tenant usage until ctx is cancelled.
+func (m *Meter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(m.Config.Metering.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Sample(ctx); err != nil {
+			m.Log.Error(err, "failed to meter tenant usage")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sample meters the usage of every tenant since its previous sample, exports the usage
+// reports of the days and months that closed, and prunes expired daily reports.
+func (m *Meter) Sample(ctx context.Context) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := m.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	var errs []string
+	now := time.Now()
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		if !tenant.DeletionTimestamp.IsZero() || tenant.Status.ResourceUsage == nil {
+			continue
+		}
+		patch := client.MergeFrom(tenant.DeepCopy())
+		cost, closed := meterUsage(tenant, m.Config.Metering, now)
+		// The status keeps the closed totals until their reports are stored, so a
+		// failed export is retried on the next sample.
+		if err := m.exportUsageReports(ctx, tenant, closed, now); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+			continue
+		}
+		if err := m.Client.Status().Patch(ctx, tenant, patch); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+			}
+			continue
+		}
+		metrics.RecordTenantCost(tenant.Name, string(tenant.Spec.Tier), cost.cpu, cost.memory, cost.storage)
+	}
+	if err := m.pruneUsageReports(ctx, now); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("metering failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// meteredCost is the CPU, memory, and storage cost of the usage metered in one sample.
+type meteredCost struct {
+	cpu, memory, storage float64
+}
+
+// meterUsage adds the tenant's requests held since its previous sample to
+// status.billing and returns their cost. Usage before midnight UTC is added to the day
+// and month closing then, which are returned to be exported as usage reports, before
+// new ones are started. The first sample only starts the clock, and a gap of more than
+// two intervals, such as while no operator instance was leading, is metered as two
+// intervals.
+func meterUsage(tenant *platformv1alpha1.Tenant, cfg config.MeteringConfig, now time.Time) (meteredCost, []platformv1alpha1.BillingTotals) {
+	now = now.UTC()
+	billing := tenant.Status.Billing
+	if billing == nil {
+		billing = &platformv1alpha1.BillingStatus{}
+		tenant.Status.Billing = billing
+	}
+	prices := cfg.Prices(string(tenant.Spec.Tier))
+
+	var cost meteredCost
+	meter := func(hours float64) {
+		if hours <= 0 {
+			return
+		}
+		c := meterHours(billing, tenant.Status.ResourceUsage, prices, hours)
+		cost.cpu += c.cpu
+		cost.memory += c.memory
+		cost.storage += c.storage
+	}
+
+	start := now
+	if billing.LastSampleTime != nil {
+		start = billing.LastSampleTime.Time
+		if earliest := now.Add(-2 * cfg.Interval); start.Before(earliest) {
+			start = earliest
+		}
+		if dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC); start.Before(dayStart) {
+			meter(dayStart.Sub(start).Hours())
+			start = dayStart
+		}
+	}
+
+	var closed []platformv1alpha1.BillingTotals
+	if day := now.Format(billingDayLayout); billing.Day == nil || billing.Day.Period != day {
+		if billing.Day != nil && billing.Day.Period != "" {
+			closed = append(closed, *billing.Day)
+		}
+		billing.Day = &platformv1alpha1.BillingTotals{Period: day}
+	}
+	if period := now.Format(billingPeriodLayout); billing.Period != period {
+		if billing.Period != "" {
+			closed = append(closed, platformv1alpha1.BillingTotals{
+				Period:          billing.Period,
+				CPUCoreHours:    billing.CPUCoreHours,
+				MemoryGiBHours:  billing.MemoryGiBHours,
+				StorageGiBHours: billing.StorageGiBHours,
+				Cost:            billing.Cost,
+			})
+		}
+		*billing = platformv1alpha1.BillingStatus{Period: period, Day: billing.Day}
+	}
+
+	meter(now.Sub(start).Hours())
+	billing.LastSampleTime = &metav1.Time{Time: now}
+	return cost, closed
+}
+
+// meterHours adds hours of the tenant's requests to the month and day totals and
+// returns their cost at the tier's unit prices.
+func meterHours(billing *platformv1alpha1.BillingStatus, usage *platformv1alpha1.ResourceUsage, prices config.UnitPrices, hours float64) meteredCost {
+	cpuCoreHours := quotaUsed(usage.CPU) * hours
+	memoryGiBHours := quotaUsed(usage.Memory) / (1 << 30) * hours
+	storageGiBHours := quotaUsed(usage.Storage) / (1 << 30) * hours
+	cost := meteredCost{
+		cpu:     cpuCoreHours * prices.CPUCoreHour,
+		memory:  memoryGiBHours * prices.MemoryGiBHour,
+		storage: storageGiBHours * prices.StorageGiBHour,
+	}
+	total := cost.cpu + cost.memory + cost.storage
+
+	billing.CPUCoreHours = addDecimal(billing.CPUCoreHours, cpuCoreHours)
+	billing.MemoryGiBHours = addDecimal(billing.MemoryGiBHours, memoryGiBHours)
+	billing.StorageGiBHours = addDecimal(billing.StorageGiBHours, storageGiBHours)
+	billing.Cost = addDecimal(billing.Cost, total)
+	if day := billing.Day; day != nil {
+		day.CPUCoreHours = addDecimal(day.CPUCoreHours, cpuCoreHours)
+		day.MemoryGiBHours = addDecimal(day.MemoryGiBHours, memoryGiBHours)
+		day.StorageGiBHours = addDecimal(day.StorageGiBHours, storageGiBHours)
+		day.Cost = addDecimal(day.Cost, total)
+	}
+	return cost
+}
+
+// quotaUsed returns the used amount of a quota in cores or bytes, or 0 when unset.
+func quotaUsed(q platformv1alpha1.QuotaUsage) float64 {
+	used, err := resource.ParseQuantity(q.Used)
+	if err != nil {
+		return 0
+	}
+	return used.AsApproximateFloat64()
+}
+
+// addDecimal adds v to the decimal string total, keeping six decimal places.
+func addDecimal(total string, v float64) string {
+	sum, _ := strconv.ParseFloat(total, 64)
+	return strconv.FormatFloat(sum+v, 'f', 6, 64)
+}
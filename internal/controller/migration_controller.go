@@ -0,0 +1,481 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+)
+
+// migrationPollInterval is how often a migration checks the target Tenant while it
+// waits for its namespace or for it to become Ready.
+const migrationPollInterval = 15 * time.Second
+
+// TenantMigrationReconciler moves a tenant to another cluster: it snapshots the source
+// tenant, provisions it on the target, copies its ConfigMaps, waits for the target to
+// become Ready, and deletes the source. A target that does not become Ready in time is
+// deleted again, leaving the source untouched.
+type TenantMigrationReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+
+	// Archive stores the snapshot of the source tenant outside the cluster. Optional.
+	Archive storage.Storage
+
+	// Audit records completed and rolled back migrations. Optional.
+	Audit *audit.Recorder
+
+	// RemoteClient builds a client for the target cluster from a kubeconfig. Defaults
+	// to a client using the reconciler's scheme.
+	RemoteClient func(kubeconfig []byte) (client.Client, error)
+}
+
+// +kubebuilder:rbac:groups=platform.io,resources=tenantmigrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=platform.io,resources=tenantmigrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=platform.io,resources=tenantmigrations/finalizers,verbs=update
+
+// Reconcile advances a TenantMigration by one step.
+func (r *TenantMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("migration", req.NamespacedName)
+
+	tm := &platformv1alpha1.TenantMigration{}
+	if err := r.Get(ctx, req.NamespacedName, tm); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !tm.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.handleDeletion(ctx, tm, log)
+	}
+
+	if !controllerutil.ContainsFinalizer(tm, MigrationFinalizerName) {
+		controllerutil.AddFinalizer(tm, MigrationFinalizerName)
+		if err := r.Update(ctx, tm); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	switch tm.Status.Phase {
+	case platformv1alpha1.MigrationCompleted, platformv1alpha1.MigrationRolledBack, platformv1alpha1.MigrationFailed:
+		// Terminal: a new migration must be created to retry
+		return ctrl.Result{}, nil
+	case platformv1alpha1.MigrationSnapshotting:
+		return ctrl.Result{}, r.snapshot(ctx, tm, log)
+	case platformv1alpha1.MigrationProvisioning:
+		return ctrl.Result{}, r.provision(ctx, tm, log)
+	case platformv1alpha1.MigrationRestoring:
+		return r.restore(ctx, tm, log)
+	case platformv1alpha1.MigrationVerifying:
+		return r.verify(ctx, tm, log)
+	case platformv1alpha1.MigrationDecommissioning:
+		return ctrl.Result{}, r.decommission(ctx, tm, log)
+	default:
+		return ctrl.Result{}, r.start(ctx, tm, log)
+	}
+}
+
+// start checks that the migration can run before anything is changed.
+func (r *TenantMigrationReconciler) start(ctx context.Context, tm *platformv1alpha1.TenantMigration, log logr.Logger) error {
+	tenant := &platformv1alpha1.Tenant{}
+	if err := r.Get(ctx, client.ObjectKey{Name: tm.Spec.TenantName}, tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.fail(ctx, tm, fmt.Sprintf("tenant %q not found", tm.Spec.TenantName), log)
+		}
+		return err
+	}
+	if !tenant.DeletionTimestamp.IsZero() {
+		return r.fail(ctx, tm, fmt.Sprintf("tenant %q is being deleted", tm.Spec.TenantName), log)
+	}
+
+	migrations := &platformv1alpha1.TenantMigrationList{}
+	if err := r.List(ctx, migrations); err != nil {
+		return err
+	}
+	for _, other := range migrations.Items {
+		if other.Name != tm.Name && other.Spec.TenantName == tm.Spec.TenantName &&
+			other.Status.Phase != "" && migrationInProgress(&other) {
+			return r.fail(ctx, tm, fmt.Sprintf("tenant %q is already being migrated by %s", tm.Spec.TenantName, other.Name), log)
+		}
+	}
+
+	if _, err := r.targetClient(ctx, tm); err != nil {
+		return r.fail(ctx, tm, err.Error(), log)
+	}
+
+	tm.Status.StartedAt = &metav1.Time{Time: time.Now().UTC()}
+	tm.Status.Message = ""
+	log.Info("tenant migration started", "tenant", tm.Spec.TenantName, "target", tm.Spec.Target.KubeconfigSecret)
+	return r.advance(ctx, tm, platformv1alpha1.MigrationSnapshotting, "")
+}
+
+// snapshot records a snapshot of the source tenant, archived if an Archive is configured.
+func (r *TenantMigrationReconciler) snapshot(ctx context.Context, tm *platformv1alpha1.TenantMigration, log logr.Logger) error {
+	tenant, err := r.sourceTenant(ctx, tm)
+	if err != nil {
+		return err
+	}
+	snapshots := &TenantReconciler{Client: r.Client, Scheme: r.Scheme, Log: r.Log, Archive: r.Archive}
+	snapshot, err := snapshots.takeSnapshot(ctx, tenant, platformv1alpha1.SnapshotMigration, log)
+	if err != nil {
+		return err
+	}
+	tm.Status.Snapshot = snapshot.Name
+	return r.advance(ctx, tm, platformv1alpha1.MigrationProvisioning, fmt.Sprintf("snapshot %s recorded", snapshot.Name))
+}
+
+// provision creates the Tenant on the target cluster with the source spec. A Tenant of
+// the same name that this migration did not create is never touched.
+func (r *TenantMigrationReconciler) provision(ctx context.Context, tm *platformv1alpha1.TenantMigration, log logr.Logger) error {
+	tenant, err := r.sourceTenant(ctx, tm)
+	if err != nil {
+		return err
+	}
+	target, err := r.targetClient(ctx, tm)
+	if err != nil {
+		return err
+	}
+
+	existing := &platformv1alpha1.Tenant{}
+	err = target.Get(ctx, client.ObjectKey{Name: tenant.Name}, existing)
+	switch {
+	case err == nil && existing.Annotations[MigratedByAnnotation] != tm.Name:
+		return r.fail(ctx, tm, fmt.Sprintf("tenant %q already exists on the target cluster", tenant.Name), log)
+	case err == nil:
+		// Created by an earlier attempt of this step
+	case apierrors.IsNotFound(err):
+		if err := target.Create(ctx, migratedTenant(tenant, tm)); err != nil {
+			if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) {
+				return r.rollback(ctx, tm, fmt.Sprintf("target cluster rejected the tenant: %v", err), log)
+			}
+			return fmt.Errorf("failed to create tenant on target cluster: %w", err)
+		}
+	default:
+		return fmt.Errorf("failed to get tenant on target cluster: %w", err)
+	}
+
+	return r.advance(ctx, tm, platformv1alpha1.MigrationRestoring, "tenant created on target cluster")
+}
+
+// restore waits for the target namespace and copies the ConfigMaps of the source
+// namespace into it. Operator-managed ConfigMaps are recreated by the target operator.
+func (r *TenantMigrationReconciler) restore(ctx context.Context, tm *platformv1alpha1.TenantMigration, log logr.Logger) (ctrl.Result, error) {
+	tenant, err := r.sourceTenant(ctx, tm)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	target, err := r.targetClient(ctx, tm)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	migrated := &platformv1alpha1.Tenant{}
+	if err := target.Get(ctx, client.ObjectKey{Name: tenant.Name}, migrated); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get tenant on target cluster: %w", err)
+	}
+	if migrated.Status.Namespace == "" {
+		if r.stepExpired(tm) {
+			return ctrl.Result{}, r.rollback(ctx, tm, "target tenant namespace was not created in time", log)
+		}
+		return ctrl.Result{RequeueAfter: migrationPollInterval}, nil
+	}
+
+	// Bronze tenants share a namespace, so they have no ConfigMaps of their own
+	var restored int32
+	if migrated.Status.Namespace != BronzeNamespace {
+		configMaps, err := tenantConfigMaps(ctx, r.Client, tenant)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		for _, src := range configMaps {
+			if src.Labels[ManagedByLabelKey] == ManagedByValue {
+				continue
+			}
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: src.Name, Namespace: migrated.Status.Namespace}}
+			if _, err := controllerutil.CreateOrUpdate(ctx, target, cm, func() error {
+				cm.Labels = src.Labels
+				cm.Annotations = src.Annotations
+				cm.Data = src.Data
+				cm.BinaryData = src.BinaryData
+				return nil
+			}); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to restore ConfigMap %s: %w", src.Name, err)
+			}
+			restored++
+		}
+	}
+
+	tm.Status.TargetNamespace = migrated.Status.Namespace
+	tm.Status.RestoredConfigMaps = restored
+	return ctrl.Result{RequeueAfter: migrationPollInterval}, r.advance(ctx, tm, platformv1alpha1.MigrationVerifying,
+		fmt.Sprintf("%d ConfigMap(s) restored to %s", restored, migrated.Status.Namespace))
+}
+
+// verify waits for the target Tenant to become Ready and rolls back if it fails or
+// spec.verifyTimeout passes.
+func (r *TenantMigrationReconciler) verify(ctx context.Context, tm *platformv1alpha1.TenantMigration, log logr.Logger) (ctrl.Result, error) {
+	target, err := r.targetClient(ctx, tm)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	migrated := &platformv1alpha1.Tenant{}
+	if err := target.Get(ctx, client.ObjectKey{Name: tm.Spec.TenantName}, migrated); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, r.rollback(ctx, tm, "tenant was removed from the target cluster", log)
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get tenant on target cluster: %w", err)
+	}
+
+	switch {
+	case migrated.Status.State == platformv1alpha1.StateReady:
+		return ctrl.Result{}, r.advance(ctx, tm, platformv1alpha1.MigrationDecommissioning, "tenant is Ready on target cluster")
+	case migrated.Status.State == platformv1alpha1.StateFailed:
+		return ctrl.Result{}, r.rollback(ctx, tm, "tenant failed to provision on target cluster: "+migrated.Status.LastError, log)
+	case r.stepExpired(tm):
+		return ctrl.Result{}, r.rollback(ctx, tm, fmt.Sprintf("tenant did not become Ready on target cluster within %s", migrationVerifyTimeout(tm)), log)
+	}
+	return ctrl.Result{RequeueAfter: migrationPollInterval}, nil
+}
+
+// decommission deletes the source Tenant, whose finalizer cleans up its namespaces.
+func (r *TenantMigrationReconciler) decommission(ctx context.Context, tm *platformv1alpha1.TenantMigration, log logr.Logger) error {
+	tenant := &platformv1alpha1.Tenant{ObjectMeta: metav1.ObjectMeta{Name: tm.Spec.TenantName}}
+	if err := r.Delete(ctx, tenant); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete source tenant: %w", err)
+	}
+
+	tm.Status.CompletedAt = &metav1.Time{Time: time.Now().UTC()}
+	if err := r.advance(ctx, tm, platformv1alpha1.MigrationCompleted, "source tenant deleted"); err != nil {
+		return err
+	}
+
+	log.Info("tenant migration completed", "tenant", tm.Spec.TenantName, "target", tm.Spec.Target.KubeconfigSecret)
+	recordAuditEntry(ctx, r.Audit, audit.Entry{
+		Tenant: tm.Spec.TenantName,
+		Action: audit.ActionMigrated,
+		Actor:  tm.Spec.RequestedBy,
+		Message: fmt.Sprintf("tenant migrated to the cluster in Secret %s by %s (snapshot %s)",
+			tm.Spec.Target.KubeconfigSecret, tm.Name, tm.Status.Snapshot),
+		Details: tm.Status,
+	}, log)
+	return nil
+}
+
+// rollback deletes the target Tenant if this migration created it, leaving the source
+// tenant as it was, and marks the migration RolledBack.
+func (r *TenantMigrationReconciler) rollback(ctx context.Context, tm *platformv1alpha1.TenantMigration, reason string, log logr.Logger) error {
+	if err := r.deleteTarget(ctx, tm); err != nil {
+		return err
+	}
+
+	tm.Status.Message = reason
+	tm.Status.CompletedAt = &metav1.Time{Time: time.Now().UTC()}
+	if err := r.advance(ctx, tm, platformv1alpha1.MigrationRolledBack, reason); err != nil {
+		return err
+	}
+
+	log.Info("tenant migration rolled back", "tenant", tm.Spec.TenantName, "reason", reason)
+	recordAuditEntry(ctx, r.Audit, audit.Entry{
+		Tenant:  tm.Spec.TenantName,
+		Action:  audit.ActionMigrationRolledBack,
+		Actor:   tm.Spec.RequestedBy,
+		Message: fmt.Sprintf("migration %s rolled back: %s", tm.Name, reason),
+		Details: tm.Status,
+	}, log)
+	return nil
+}
+
+// fail marks a migration that could not proceed as Failed without changing either cluster.
+func (r *TenantMigrationReconciler) fail(ctx context.Context, tm *platformv1alpha1.TenantMigration, msg string, log logr.Logger) error {
+	tm.Status.Message = msg
+	tm.Status.CompletedAt = &metav1.Time{Time: time.Now().UTC()}
+	log.Info("tenant migration failed", "tenant", tm.Spec.TenantName, "reason", msg)
+	return r.advance(ctx, tm, platformv1alpha1.MigrationFailed, msg)
+}
+
+// handleDeletion rolls back an unfinished migration before it is removed. A Tenant
+// already decommissioned on the source is left running on the target.
+func (r *TenantMigrationReconciler) handleDeletion(ctx context.Context, tm *platformv1alpha1.TenantMigration, log logr.Logger) error {
+	if !controllerutil.ContainsFinalizer(tm, MigrationFinalizerName) {
+		return nil
+	}
+
+	if migrationInProgress(tm) && tm.Status.Phase != platformv1alpha1.MigrationDecommissioning {
+		if err := r.deleteTarget(ctx, tm); err != nil {
+			return err
+		}
+		log.Info("unfinished tenant migration deleted, target tenant removed", "tenant", tm.Spec.TenantName, "phase", tm.Status.Phase)
+	}
+
+	controllerutil.RemoveFinalizer(tm, MigrationFinalizerName)
+	return r.Update(ctx, tm)
+}
+
+// deleteTarget deletes the Tenant on the target cluster if this migration created it.
+func (r *TenantMigrationReconciler) deleteTarget(ctx context.Context, tm *platformv1alpha1.TenantMigration) error {
+	switch tm.Status.Phase {
+	case platformv1alpha1.MigrationProvisioning, platformv1alpha1.MigrationRestoring, platformv1alpha1.MigrationVerifying:
+	default:
+		return nil
+	}
+
+	target, err := r.targetClient(ctx, tm)
+	if err != nil {
+		return err
+	}
+	migrated := &platformv1alpha1.Tenant{}
+	if err := target.Get(ctx, client.ObjectKey{Name: tm.Spec.TenantName}, migrated); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if migrated.Annotations[MigratedByAnnotation] != tm.Name {
+		return nil
+	}
+	if err := target.Delete(ctx, migrated); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete tenant on target cluster: %w", err)
+	}
+	return nil
+}
+
+// advance completes the current step and moves the migration to phase.
+func (r *TenantMigrationReconciler) advance(ctx context.Context, tm *platformv1alpha1.TenantMigration, phase platformv1alpha1.MigrationPhase, msg string) error {
+	now := metav1.Now()
+	if n := len(tm.Status.Steps); n > 0 && tm.Status.Steps[n-1].CompletedAt == nil {
+		tm.Status.Steps[n-1].CompletedAt = &now
+		tm.Status.Steps[n-1].Message = msg
+	}
+	tm.Status.Phase = phase
+	if migrationInProgress(tm) {
+		tm.Status.Steps = append(tm.Status.Steps, platformv1alpha1.MigrationStep{Phase: phase, StartedAt: now})
+	}
+	return r.Status().Update(ctx, tm)
+}
+
+// stepExpired reports whether the current step has run longer than the verify timeout.
+func (r *TenantMigrationReconciler) stepExpired(tm *platformv1alpha1.TenantMigration) bool {
+	n := len(tm.Status.Steps)
+	if n == 0 {
+		return false
+	}
+	return time.Since(tm.Status.Steps[n-1].StartedAt.Time) > migrationVerifyTimeout(tm)
+}
+
+// sourceTenant fetches the Tenant being migrated.
+func (r *TenantMigrationReconciler) sourceTenant(ctx context.Context, tm *platformv1alpha1.TenantMigration) (*platformv1alpha1.Tenant, error) {
+	tenant := &platformv1alpha1.Tenant{}
+	if err := r.Get(ctx, client.ObjectKey{Name: tm.Spec.TenantName}, tenant); err != nil {
+		return nil, fmt.Errorf("failed to get source tenant: %w", err)
+	}
+	return tenant, nil
+}
+
+// targetClient builds a client for the target cluster from the kubeconfig Secret.
+func (r *TenantMigrationReconciler) targetClient(ctx context.Context, tm *platformv1alpha1.TenantMigration) (client.Client, error) {
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: OperatorNamespace, Name: tm.Spec.Target.KubeconfigSecret}
+	if err := r.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("failed to get kubeconfig Secret %s: %w", key, err)
+	}
+	dataKey := tm.Spec.Target.KubeconfigKey
+	if dataKey == "" {
+		dataKey = "kubeconfig"
+	}
+	kubeconfig := secret.Data[dataKey]
+	if len(kubeconfig) == 0 {
+		return nil, fmt.Errorf("kubeconfig Secret %s has no key %s", key, dataKey)
+	}
+
+	newClient := r.RemoteClient
+	if newClient == nil {
+		newClient = r.remoteClient
+	}
+	target, err := newClient(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build client for target cluster: %w", err)
+	}
+	return target, nil
+}
+
+// remoteClient is the default RemoteClient.
+func (r *TenantMigrationReconciler) remoteClient(kubeconfig []byte) (client.Client, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(config, client.Options{Scheme: r.Scheme})
+}
+
+// migratedTenant returns the Tenant to create on the target cluster. The template the
+// source was created from has already been merged into its spec, and may not exist on
+// the target.
+func migratedTenant(source *platformv1alpha1.Tenant, tm *platformv1alpha1.TenantMigration) *platformv1alpha1.Tenant {
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        source.Name,
+			Labels:      source.Labels,
+			Annotations: map[string]string{},
+		},
+		Spec: *source.Spec.DeepCopy(),
+	}
+	for k, v := range source.Annotations {
+		if k != corev1.LastAppliedConfigAnnotation {
+			tenant.Annotations[k] = v
+		}
+	}
+	tenant.Annotations[MigratedByAnnotation] = tm.Name
+	tenant.Spec.TemplateRef = nil
+	tenant.Spec.ProfileRef = nil
+	return tenant
+}
+
+// migrationVerifyTimeout returns spec.verifyTimeout, applying the default.
+func migrationVerifyTimeout(tm *platformv1alpha1.TenantMigration) time.Duration {
+	if tm.Spec.VerifyTimeout == nil || tm.Spec.VerifyTimeout.Duration <= 0 {
+		return DefaultMigrationVerifyTimeout
+	}
+	return tm.Spec.VerifyTimeout.Duration
+}
+
+// migrationInProgress reports whether a migration has not reached a terminal phase.
+func migrationInProgress(tm *platformv1alpha1.TenantMigration) bool {
+	switch tm.Status.Phase {
+	case platformv1alpha1.MigrationCompleted, platformv1alpha1.MigrationRolledBack, platformv1alpha1.MigrationFailed:
+		return false
+	}
+	return true
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TenantMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&platformv1alpha1.TenantMigration{}).
+		Complete(r)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;prometheusrules,verbs=get;list;watch;create;update;patch;delete
+
+const (
+	// MonitoringLabelKey marks the namespaces, ServiceMonitors, and PrometheusRules of
+	// tenants with spec.observability.monitoring, for the namespace and object
+	// selectors of the Prometheus operator.
+	MonitoringLabelKey = "tenant.platform.io/monitoring"
+
+	// MonitoringServiceMonitorName is the ServiceMonitor in the tenant namespace.
+	MonitoringServiceMonitorName = "tenant-metrics"
+
+	// MonitoringRuleName is the PrometheusRule with the default tenant alerts.
+	MonitoringRuleName = "tenant-alerts"
+)
+
+// quotaAlertThreshold is the share of a ResourceQuota's hard limit above which the
+// TenantQuotaNearLimit alert fires.
+const quotaAlertThreshold = 0.9
+
+// The Prometheus operator kinds, managed as unstructured objects like the GitOps kinds.
+var (
+	serviceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+	prometheusRuleGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+)
+
+// monitoringEnabled reports whether spec.observability.monitoring is set.
+func monitoringEnabled(tenant *platformv1alpha1.Tenant) bool {
+	return tenant.Spec.Observability != nil && tenant.Spec.Observability.Monitoring
+}
+
+// setMonitoringLabel selects one of the tenant's namespaces for the Prometheus operator
+// while monitoring is enabled.
+func setMonitoringLabel(ns *corev1.Namespace, tenant *platformv1alpha1.Tenant) {
+	if !monitoringEnabled(tenant) {
+		delete(ns.Labels, MonitoringLabelKey)
+		return
+	}
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	ns.Labels[MonitoringLabelKey] = "true"
+}
+
+// ensureMonitoring creates a ServiceMonitor scraping the metrics port of every Service
+// in the tenant namespaces and a PrometheusRule with the default tenant alerts, or
+// deletes them once spec.observability.monitoring is cleared.
+func (r *TenantReconciler) ensureMonitoring(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	namespaceName := buildNamespaceName(tenant)
+	serviceMonitor := &unstructured.Unstructured{}
+	serviceMonitor.SetGroupVersionKind(serviceMonitorGVK)
+	serviceMonitor.SetNamespace(namespaceName)
+	serviceMonitor.SetName(MonitoringServiceMonitorName)
+	rule := &unstructured.Unstructured{}
+	rule.SetGroupVersionKind(prometheusRuleGVK)
+	rule.SetNamespace(namespaceName)
+	rule.SetName(MonitoringRuleName)
+
+	if !monitoringEnabled(tenant) {
+		apimeta.RemoveStatusCondition(&tenant.Status.Conditions, platformv1alpha1.ConditionMonitoringReady)
+		return r.deleteIntegrationObjects(ctx, "Prometheus operator", rule, serviceMonitor)
+	}
+	err := r.ensureMonitoringObjects(ctx, tenant, serviceMonitor, rule, log)
+	setResourceCondition(tenant, platformv1alpha1.ConditionMonitoringReady, "CreateFailed", err)
+	return err
+}
+
+// ensureMonitoringObjects creates or updates the ServiceMonitor and the PrometheusRule.
+func (r *TenantReconciler) ensureMonitoringObjects(ctx context.Context, tenant *platformv1alpha1.Tenant, serviceMonitor, rule *unstructured.Unstructured, log logr.Logger) error {
+	namespaces := []string{buildNamespaceName(tenant)}
+	for _, env := range tenant.Spec.Environments {
+		namespaces = append(namespaces, buildEnvironmentNamespaceName(tenant, env.Name))
+	}
+	labels := map[string]string{
+		TenantNameLabelKey: tenant.Name,
+		ManagedByLabelKey:  ManagedByValue,
+		MonitoringLabelKey: "true",
+	}
+
+	matchNames := make([]interface{}, 0, len(namespaces))
+	for _, ns := range namespaces {
+		matchNames = append(matchNames, ns)
+	}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, serviceMonitor, func() error {
+		serviceMonitor.SetLabels(labels)
+		serviceMonitor.Object["spec"] = map[string]interface{}{
+			"selector":          map[string]interface{}{},
+			"namespaceSelector": map[string]interface{}{"matchNames": matchNames},
+			"endpoints": []interface{}{
+				map[string]interface{}{"port": "metrics", "interval": "30s"},
+			},
+		}
+		return controllerutil.SetControllerReference(tenant, serviceMonitor, r.Scheme)
+	})
+	if apimeta.IsNoMatchError(err) {
+		return newValidationError(fmt.Errorf("spec.observability.monitoring requires the Prometheus operator, which is not installed"))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create or update ServiceMonitor: %w", err)
+	}
+	log.Info("ensured ServiceMonitor", "serviceMonitor", serviceMonitor.GetName(), "operation", result)
+
+	result, err = controllerutil.CreateOrUpdate(ctx, r.Client, rule, func() error {
+		rule.SetLabels(labels)
+		rule.Object["spec"] = buildPrometheusRuleSpec(tenant, namespaces)
+		return controllerutil.SetControllerReference(tenant, rule, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update PrometheusRule: %w", err)
+	}
+	log.Info("ensured PrometheusRule", "prometheusRule", rule.GetName(), "operation", result)
+	return nil
+}
+
+// buildPrometheusRuleSpec renders the default alerts of a tenant: a ResourceQuota in one
+// of its namespaces above quotaAlertThreshold of a hard limit, and a container stuck in
+// CrashLoopBackOff. Both rely on kube-state-metrics.
+func buildPrometheusRuleSpec(tenant *platformv1alpha1.Tenant, namespaces []string) map[string]interface{} {
+	selector := fmt.Sprintf(`namespace=~"%s"`, strings.Join(namespaces, "|"))
+	labels := map[string]interface{}{
+		"severity": "warning",
+		"tenant":   tenant.Name,
+	}
+	return map[string]interface{}{
+		"groups": []interface{}{
+			map[string]interface{}{
+				"name": fmt.Sprintf("tenant-%s", tenant.Name),
+				"rules": []interface{}{
+					map[string]interface{}{
+						"alert": "TenantQuotaNearLimit",
+						"expr": fmt.Sprintf(`kube_resourcequota{%[1]s,type="used"} / ignoring(type) (kube_resourcequota{%[1]s,type="hard"} > 0) > %[2]g`,
+							selector, quotaAlertThreshold),
+						"for":    "15m",
+						"labels": labels,
+						"annotations": map[string]interface{}{
+							"summary": fmt.Sprintf("Tenant %s is using {{ $value | humanizePercentage }} of its {{ $labels.resource }} quota in {{ $labels.namespace }}", tenant.Name),
+						},
+					},
+					map[string]interface{}{
+						"alert":  "TenantPodCrashLooping",
+						"expr":   fmt.Sprintf(`max_over_time(kube_pod_container_status_waiting_reason{%s,reason="CrashLoopBackOff"}[5m]) >= 1`, selector),
+						"for":    "15m",
+						"labels": labels,
+						"annotations": map[string]interface{}{
+							"summary": fmt.Sprintf("Container {{ $labels.container }} of pod {{ $labels.namespace }}/{{ $labels.pod }} in tenant %s is crashlooping", tenant.Name),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// clusterKubeconfigKey is the key of the kubeconfig in a cluster registration Secret.
+const clusterKubeconfigKey = "kubeconfig"
+
+// registeredCluster is a remote cluster registered for spec.placement.
+type registeredCluster struct {
+	client client.Client
+	server string
+}
+
+// clusterClientCache keeps a client per registered cluster, rebuilt when its
+// registration Secret changes.
+type clusterClientCache struct {
+	mu       sync.Mutex
+	clusters map[string]cachedCluster
+}
+
+type cachedCluster struct {
+	secret          types.UID
+	resourceVersion string
+	cluster         registeredCluster
+}
+
+func newClusterClientCache() *clusterClientCache {
+	return &clusterClientCache{clusters: map[string]cachedCluster{}}
+}
+
+// registeredCluster looks up the registration Secret of the named cluster in the
+// operator namespace and returns a client for it. An unregistered cluster or a broken
+// kubeconfig is a validation error.
+func (r *TenantReconciler) registeredCluster(ctx context.Context, name string) (registeredCluster, error) {
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, client.InNamespace(OperatorNamespace), client.MatchingLabels{ClusterNameLabelKey: name}); err != nil {
+		return registeredCluster{}, fmt.Errorf("failed to list cluster registrations: %w", err)
+	}
+	switch len(secrets.Items) {
+	case 0:
+		return registeredCluster{}, newValidationError(fmt.Errorf("cluster %q is not registered", name))
+	case 1:
+	default:
+		return registeredCluster{}, newValidationError(fmt.Errorf("cluster %q is registered by %d Secrets", name, len(secrets.Items)))
+	}
+	secret := &secrets.Items[0]
+
+	if r.clusterClients != nil {
+		r.clusterClients.mu.Lock()
+		defer r.clusterClients.mu.Unlock()
+		if cached, ok := r.clusterClients.clusters[name]; ok && cached.secret == secret.UID && cached.resourceVersion == secret.ResourceVersion {
+			return cached.cluster, nil
+		}
+	}
+
+	kubeconfig := secret.Data[clusterKubeconfigKey]
+	if len(kubeconfig) == 0 {
+		return registeredCluster{}, newValidationError(fmt.Errorf("cluster registration Secret %s has no key %s", secret.Name, clusterKubeconfigKey))
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return registeredCluster{}, newValidationError(fmt.Errorf("invalid kubeconfig in cluster registration Secret %s: %w", secret.Name, err))
+	}
+
+	newClient := r.RemoteClient
+	if newClient == nil {
+		newClient = r.remoteClient
+	}
+	remote, err := newClient(kubeconfig)
+	if err != nil {
+		return registeredCluster{}, fmt.Errorf("failed to build client for cluster %s: %w", name, err)
+	}
+	cluster := registeredCluster{client: remote, server: restConfig.Host}
+	if r.clusterClients != nil {
+		r.clusterClients.clusters[name] = cachedCluster{secret: secret.UID, resourceVersion: secret.ResourceVersion, cluster: cluster}
+	}
+	return cluster, nil
+}
+
+// remoteClient is the default RemoteClient.
+func (r *TenantReconciler) remoteClient(kubeconfig []byte) (client.Client, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(config, client.Options{Scheme: r.Scheme})
+}
+
+// forPlacement returns the reconciler to provision tenant with. For a tenant with
+// spec.placement it is a copy of r whose client creates the tenant's objects on the
+// registered cluster, and whose kubeconfigs point at that cluster's API server. The
+// outcome is recorded in status.placement and the PlacementReady condition.
+func (r *TenantReconciler) forPlacement(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) (*TenantReconciler, error) {
+	if tenant.Spec.Placement == nil {
+		tenant.Status.Placement = nil
+		apimeta.RemoveStatusCondition(&tenant.Status.Conditions, platformv1alpha1.ConditionPlacementReady)
+		metrics.SetPlacementConnected(tenant.Name, "", false)
+		return r, nil
+	}
+
+	name := tenant.Spec.Placement.Cluster
+	status := tenant.Status.Placement
+	if status == nil || status.Cluster != name {
+		status = &platformv1alpha1.PlacementStatus{Cluster: name}
+		tenant.Status.Placement = status
+	}
+
+	cluster, err := r.registeredCluster(ctx, name)
+	if err == nil {
+		// Any answer from the API server, even NotFound, shows the cluster is reachable
+		probe := &corev1.Namespace{}
+		if getErr := cluster.client.Get(ctx, client.ObjectKey{Name: metav1.NamespaceDefault}, probe); getErr != nil && !apierrors.IsNotFound(getErr) {
+			err = fmt.Errorf("cluster %s is not reachable: %w", name, getErr)
+		}
+	}
+	metrics.SetPlacementConnected(tenant.Name, name, err == nil)
+	if err != nil {
+		log.Error(err, "placement cluster unavailable", "cluster", name)
+		status.Connected = false
+		status.Message = err.Error()
+		reason := "ClusterUnreachable"
+		if classifyError(err) == ErrorClassValidation {
+			reason = "ClusterNotRegistered"
+		}
+		apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+			Type:               platformv1alpha1.ConditionPlacementReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: tenant.Generation,
+			Reason:             reason,
+			Message:            err.Error(),
+		})
+		return nil, err
+	}
+
+	status.Server = cluster.server
+	status.Connected = true
+	status.LastConnectedTime = &metav1.Time{Time: time.Now()}
+	status.Message = ""
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               platformv1alpha1.ConditionPlacementReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: tenant.Generation,
+		Reason:             "Connected",
+		Message:            fmt.Sprintf("provisioned on cluster %s", name),
+	})
+
+	placed := *r
+	placed.Client = &placementClient{Client: r.Client, remote: cluster.client, scheme: r.Scheme, tenant: tenant}
+	config := *r.config()
+	config.Kubeconfig.APIServer = cluster.server
+	placed.Config = &config
+	return &placed, nil
+}
+
+// placementClient sends the objects of a placed tenant to its remote cluster. Tenant
+// API objects and objects in the operator namespace stay on the local cluster. An
+// owner reference cannot point at an object in another cluster, so remote objects
+// carry the tenant UID label in place of the Tenant controller reference, which is
+// restored when they are read back.
+type placementClient struct {
+	client.Client
+	remote client.Client
+	scheme *runtime.Scheme
+	tenant *platformv1alpha1.Tenant
+}
+
+// isLocal reports whether obj belongs on the local cluster. Lists are matched by
+// their type and the namespace they are listed in.
+func (c *placementClient) isLocal(obj runtime.Object, namespace string) bool {
+	if namespace == OperatorNamespace {
+		return true
+	}
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	return err == nil && gvk.Group == platformv1alpha1.GroupVersion.Group
+}
+
+func (c *placementClient) target(obj client.Object) client.Client {
+	if c.isLocal(obj, obj.GetNamespace()) {
+		return c.Client
+	}
+	return c.remote
+}
+
+// toRemote replaces the Tenant owner references of obj with the tenant UID label.
+func (c *placementClient) toRemote(obj client.Object) {
+	refs := obj.GetOwnerReferences()
+	if len(refs) == 0 {
+		return
+	}
+	kept := refs[:0]
+	for _, ref := range refs {
+		if ref.UID == c.tenant.UID {
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[TenantUIDLabelKey] = string(c.tenant.UID)
+			obj.SetLabels(labels)
+			continue
+		}
+		kept = append(kept, ref)
+	}
+	obj.SetOwnerReferences(kept)
+}
+
+// fromRemote restores the Tenant controller reference of obj from the tenant UID label.
+func (c *placementClient) fromRemote(obj client.Object) {
+	labels := obj.GetLabels()
+	if labels[TenantUIDLabelKey] != string(c.tenant.UID) {
+		return
+	}
+	delete(labels, TenantUIDLabelKey)
+	obj.SetLabels(labels)
+	isController := true
+	obj.SetOwnerReferences(append(obj.GetOwnerReferences(), metav1.OwnerReference{
+		APIVersion:         platformv1alpha1.GroupVersion.String(),
+		Kind:               "Tenant",
+		Name:               c.tenant.Name,
+		UID:                c.tenant.UID,
+		Controller:         &isController,
+		BlockOwnerDeletion: &isController,
+	}))
+}
+
+// write runs a write on the target of obj, converting its owner references for the
+// remote cluster and back.
+func (c *placementClient) write(obj client.Object, fn func(client.Client) error) error {
+	target := c.target(obj)
+	if target == c.Client {
+		return fn(target)
+	}
+	c.toRemote(obj)
+	err := fn(target)
+	c.fromRemote(obj)
+	return err
+}
+
+func (c *placementClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if c.isLocal(obj, key.Namespace) {
+		return c.Client.Get(ctx, key, obj, opts...)
+	}
+	if err := c.remote.Get(ctx, key, obj, opts...); err != nil {
+		return err
+	}
+	c.fromRemote(obj)
+	return nil
+}
+
+func (c *placementClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if c.isLocal(list, listOpts.Namespace) {
+		return c.Client.List(ctx, list, opts...)
+	}
+	if err := c.remote.List(ctx, list, opts...); err != nil {
+		return err
+	}
+	return apimeta.EachListItem(list, func(item runtime.Object) error {
+		if obj, ok := item.(client.Object); ok {
+			c.fromRemote(obj)
+		}
+		return nil
+	})
+}
+
+func (c *placementClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.write(obj, func(target client.Client) error { return target.Create(ctx, obj, opts...) })
+}
+
+func (c *placementClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.write(obj, func(target client.Client) error { return target.Update(ctx, obj, opts...) })
+}
+
+func (c *placementClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.write(obj, func(target client.Client) error { return target.Patch(ctx, obj, patch, opts...) })
+}
+
+func (c *placementClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.target(obj).Delete(ctx, obj, opts...)
+}
+
+func (c *placementClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	deleteOpts := &client.DeleteAllOfOptions{}
+	deleteOpts.ApplyOptions(opts)
+	if c.isLocal(obj, deleteOpts.Namespace) {
+		return c.Client.DeleteAllOf(ctx, obj, opts...)
+	}
+	return c.remote.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *placementClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *placementClient) SubResource(subResource string) client.SubResourceClient {
+	return &placementSubResourceClient{client: c, subResource: subResource}
+}
+
+// placementSubResourceClient routes subresource requests like placementClient, so that
+// e.g. ServiceAccount tokens are requested from the cluster holding the ServiceAccount.
+type placementSubResourceClient struct {
+	client      *placementClient
+	subResource string
+}
+
+func (s *placementSubResourceClient) of(obj client.Object) client.SubResourceClient {
+	return s.client.target(obj).SubResource(s.subResource)
+}
+
+func (s *placementSubResourceClient) Get(ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceGetOption) error {
+	return s.of(obj).Get(ctx, obj, subResource, opts...)
+}
+
+func (s *placementSubResourceClient) Create(ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return s.of(obj).Create(ctx, obj, subResource, opts...)
+}
+
+func (s *placementSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return s.client.write(obj, func(target client.Client) error {
+		return target.SubResource(s.subResource).Update(ctx, obj, opts...)
+	})
+}
+
+func (s *placementSubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return s.client.write(obj, func(target client.Client) error {
+		return target.SubResource(s.subResource).Patch(ctx, obj, patch, opts...)
+	})
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+)
+
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch;create;update;patch;delete
+
+// clusterGVK is the Cluster API Cluster kind. The operator does not depend on the
+// Cluster API module, so Clusters are managed as unstructured objects.
+var clusterGVK = schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "Cluster"}
+
+// ClusterKind is the ManagedResource kind used for a Platinum tier dedicated cluster.
+const ClusterKind = "Cluster"
+
+// Defaults of spec.cluster.
+const (
+	DefaultControlPlaneReplicas int32 = 3
+	DefaultWorkerReplicas       int32 = 3
+)
+
+// ClusterProvider creates and deletes the dedicated workload clusters of Platinum tier
+// tenants. The default provider uses Cluster API; others can be set on
+// TenantReconciler.ClusterProvider.
+type ClusterProvider interface {
+	// EnsureCluster creates or updates the tenant's cluster to match spec.cluster and
+	// returns its current state. It does not wait for the cluster to be provisioned.
+	EnsureCluster(ctx context.Context, tenant *platformv1alpha1.Tenant) (*ProvisionedCluster, error)
+
+	// DeleteCluster starts deleting the tenant's cluster and reports whether it is gone.
+	DeleteCluster(ctx context.Context, tenant *platformv1alpha1.Tenant) (bool, error)
+}
+
+// ProvisionedCluster is the state of a dedicated workload cluster.
+type ProvisionedCluster struct {
+	// Name of the cluster, recorded in status.cluster.
+	Name string
+
+	// Phase is the provider's provisioning phase, recorded in status.cluster.
+	Phase string
+
+	// Ready reports that the control plane is reachable at Endpoint with Kubeconfig.
+	Ready bool
+
+	// Endpoint is the API server URL, published in status.apiEndpoint.
+	Endpoint string
+
+	// Kubeconfig is the cluster admin kubeconfig, set once the cluster is ready.
+	Kubeconfig []byte
+}
+
+// ClusterAPIProvider provisions dedicated clusters as Cluster API Clusters created from a
+// ClusterClass, in the tenant namespace of the management cluster.
+type ClusterAPIProvider struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Config holds the cluster defaults spec.cluster overrides.
+	Config config.ClusterAPIConfig
+}
+
+// clusterName names the Cluster API Cluster of a tenant. It must differ from the tenant
+// name, since Cluster API stores its kubeconfig in "<cluster>-kubeconfig", the name of
+// the tenant kubeconfig Secret.
+func clusterName(tenant *platformv1alpha1.Tenant) string {
+	return fmt.Sprintf("%s-cluster", tenant.Name)
+}
+
+// EnsureCluster implements ClusterProvider.
+func (p *ClusterAPIProvider) EnsureCluster(ctx context.Context, tenant *platformv1alpha1.Tenant) (*ProvisionedCluster, error) {
+	spec := platformv1alpha1.ClusterConfig{}
+	if tenant.Spec.Cluster != nil {
+		spec = *tenant.Spec.Cluster
+	}
+	if spec.Class == "" {
+		spec.Class = p.Config.ClusterClass
+	}
+	if spec.KubernetesVersion == "" {
+		spec.KubernetesVersion = p.Config.KubernetesVersion
+	}
+	if spec.ControlPlaneReplicas == 0 {
+		spec.ControlPlaneReplicas = DefaultControlPlaneReplicas
+	}
+	if spec.WorkerReplicas == 0 {
+		spec.WorkerReplicas = DefaultWorkerReplicas
+	}
+	if spec.Class == "" {
+		return nil, newValidationError(errors.New("no ClusterClass set in spec.cluster.class or --platinum-cluster-class"))
+	}
+
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(clusterGVK)
+	cluster.SetNamespace(buildNamespaceName(tenant))
+	cluster.SetName(clusterName(tenant))
+	_, err := controllerutil.CreateOrUpdate(ctx, p.Client, cluster, func() error {
+		cluster.SetLabels(map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			ManagedByLabelKey:  ManagedByValue,
+		})
+		// Only the topology is managed; the control plane endpoint is set by Cluster API
+		if err := unstructured.SetNestedMap(cluster.Object, map[string]interface{}{
+			"class":   spec.Class,
+			"version": spec.KubernetesVersion,
+			"controlPlane": map[string]interface{}{
+				"replicas": int64(spec.ControlPlaneReplicas),
+			},
+			"workers": map[string]interface{}{
+				"machineDeployments": []interface{}{map[string]interface{}{
+					"class":    p.Config.WorkerClass,
+					"name":     "md-0",
+					"replicas": int64(spec.WorkerReplicas),
+				}},
+			},
+		}, "spec", "topology"); err != nil {
+			return err
+		}
+		return controllerutil.SetControllerReference(tenant, cluster, p.Scheme)
+	})
+	if apimeta.IsNoMatchError(err) {
+		return nil, newValidationError(errors.New("the Platinum tier requires Cluster API, which is not installed"))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create or update Cluster %s: %w", cluster.GetName(), err)
+	}
+
+	phase, _, _ := unstructured.NestedString(cluster.Object, "status", "phase")
+	controlPlaneReady, _, _ := unstructured.NestedBool(cluster.Object, "status", "controlPlaneReady")
+	host, _, _ := unstructured.NestedString(cluster.Object, "spec", "controlPlaneEndpoint", "host")
+	port, _, _ := unstructured.NestedInt64(cluster.Object, "spec", "controlPlaneEndpoint", "port")
+	if port == 0 {
+		port = 443
+	}
+	provisioned := &ProvisionedCluster{Name: cluster.GetName(), Phase: phase}
+	if host != "" {
+		provisioned.Endpoint = vClusterEndpointURL(host, int32(port))
+	}
+	if phase != "Provisioned" || !controlPlaneReady || host == "" {
+		return provisioned, nil
+	}
+
+	// Cluster API writes the admin kubeconfig once the control plane is initialized
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: cluster.GetNamespace(), Name: fmt.Sprintf("%s-%s", cluster.GetName(), KubeconfigSecretSuffix)}
+	if err := p.Get(ctx, key, secret); err != nil {
+		return provisioned, client.IgnoreNotFound(err)
+	}
+	provisioned.Kubeconfig = secret.Data["value"]
+	provisioned.Ready = len(provisioned.Kubeconfig) > 0
+	return provisioned, nil
+}
+
+// DeleteCluster implements ClusterProvider.
+func (p *ClusterAPIProvider) DeleteCluster(ctx context.Context, tenant *platformv1alpha1.Tenant) (bool, error) {
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(clusterGVK)
+	key := client.ObjectKey{Namespace: buildNamespaceName(tenant), Name: clusterName(tenant)}
+	if err := p.Get(ctx, key, cluster); err != nil {
+		// Without Cluster API installed there is no cluster to delete
+		if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to fetch Cluster %s: %w", key.Name, err)
+	}
+	if cluster.GetDeletionTimestamp() == nil {
+		if err := p.Delete(ctx, cluster); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to delete Cluster %s: %w", key.Name, err)
+		}
+	}
+	return false, nil
+}
+
+// clusterProvider returns the configured cluster provider, defaulting to Cluster API.
+func (r *TenantReconciler) clusterProvider() ClusterProvider {
+	if r.ClusterProvider != nil {
+		return r.ClusterProvider
+	}
+	return &ClusterAPIProvider{Client: r.Client, Scheme: r.Scheme, Config: r.config().ClusterAPI}
+}
+
+// platinumProvisioner provisions Platinum tenants: a namespace in the management cluster
+// holding a dedicated workload cluster and its kubeconfig.
+type platinumProvisioner struct{}
+
+func (platinumProvisioner) Provision(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	return r.reconcilePlatinumTier(ctx, tenant, log)
+}
+
+func (platinumProvisioner) Steps() []ProvisioningStep {
+	return []ProvisioningStep{
+		{Name: "Namespace", Condition: platformv1alpha1.ConditionNamespaceReady},
+		{Name: "Cluster", Condition: platformv1alpha1.ConditionClusterProvisioned},
+		{Name: "Kubeconfig", Condition: platformv1alpha1.ConditionKubeconfigAvailable},
+	}
+}
+
+// Deprovision deletes the dedicated cluster before the tenant namespace holding it, so
+// the provider can release its infrastructure.
+func (platinumProvisioner) Deprovision(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, log logr.Logger) (bool, error) {
+	gone, err := r.clusterProvider().DeleteCluster(ctx, tenant)
+	if err != nil {
+		return false, err
+	}
+	if !gone {
+		log.Info("waiting for the dedicated cluster to be deleted")
+		if tenant.Status.Cluster != nil {
+			tenant.Status.Cluster.Phase = "Deleting"
+		}
+	}
+	return gone, nil
+}
+
+// reconcilePlatinumTier handles the Platinum tier provisioning (dedicated cluster).
+func (r *TenantReconciler) reconcilePlatinumTier(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	// Refuse names that collide with system namespaces or other tenants' namespaces
+	if err := r.validateNamespaceNames(ctx, tenant); err != nil {
+		setResourceCondition(tenant, platformv1alpha1.ConditionNamespaceReady, "NameConflict", err)
+		return err
+	}
+
+	// The namespace holds the cluster objects and the kubeconfig; workloads run in the cluster
+	err := r.ensureNamespace(ctx, tenant, log)
+	setResourceCondition(tenant, platformv1alpha1.ConditionNamespaceReady, "CreateFailed", err)
+	if err != nil {
+		return fmt.Errorf("namespace creation failed: %w", err)
+	}
+
+	cluster, err := r.clusterProvider().EnsureCluster(ctx, tenant)
+	if err != nil {
+		failStep(tenant, platformv1alpha1.ConditionClusterProvisioned, "ProvisioningFailed", err)
+		return fmt.Errorf("cluster provisioning failed: %w", err)
+	}
+	tenant.Status.Cluster = &platformv1alpha1.ClusterStatus{Name: cluster.Name, Phase: cluster.Phase}
+	tenant.Status.APIEndpoint = cluster.Endpoint
+	log.Info("ensured dedicated cluster", "cluster", cluster.Name, "phase", cluster.Phase, "ready", cluster.Ready)
+
+	// Stay Provisioning and check again later while the provider creates the machines
+	if !cluster.Ready {
+		phase := cluster.Phase
+		if phase == "" {
+			phase = "Pending"
+		}
+		failStep(tenant, platformv1alpha1.ConditionClusterProvisioned, phase,
+			fmt.Errorf("waiting for cluster %s to be provisioned", cluster.Name))
+		tenant.Status.State = platformv1alpha1.StateProvisioning
+		return nil
+	}
+	if err := r.completeStep(ctx, tenant, platformv1alpha1.ConditionClusterProvisioned,
+		fmt.Sprintf("cluster %s is reachable at %s", cluster.Name, cluster.Endpoint)); err != nil {
+		return err
+	}
+
+	if err := r.storeClusterKubeconfig(ctx, tenant, cluster.Kubeconfig, log); err != nil {
+		failStep(tenant, platformv1alpha1.ConditionKubeconfigAvailable, "RetrievalFailed", err)
+		return fmt.Errorf("kubeconfig retrieval failed: %w", err)
+	}
+	if err := r.completeStep(ctx, tenant, platformv1alpha1.ConditionKubeconfigAvailable,
+		fmt.Sprintf("kubeconfig stored in Secret %s", tenant.Status.AdminKubeconfigSecret)); err != nil {
+		return err
+	}
+
+	tenant.Status.State = platformv1alpha1.StateReady
+	return nil
+}
+
+// storeClusterKubeconfig copies the dedicated cluster's admin kubeconfig into the
+// "<tenant>-kubeconfig" Secret, where tenants find it for every tier.
+func (r *TenantReconciler) storeClusterKubeconfig(ctx context.Context, tenant *platformv1alpha1.Tenant, kubeconfig []byte, log logr.Logger) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      fmt.Sprintf("%s-%s", tenant.Name, KubeconfigSecretSuffix),
+		Namespace: buildNamespaceName(tenant),
+	}}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			ManagedByLabelKey:  ManagedByValue,
+		}
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{"kubeconfig": kubeconfig}
+		return controllerutil.SetControllerReference(tenant, secret, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store kubeconfig Secret %s: %w", secret.Name, err)
+	}
+	log.Info("stored Platinum tier kubeconfig", "secret", secret.Name, "operation", result)
+	tenant.Status.AdminKubeconfigSecret = secret.Name
+	return nil
+}
+
+// clusterPending reports whether a Platinum tier tenant is provisioned except for its
+// dedicated cluster, which is still being created.
+func clusterPending(tenant *platformv1alpha1.Tenant) bool {
+	return tenant.Spec.Tier == platformv1alpha1.PlatinumTier && tenant.Status.State == platformv1alpha1.StateProvisioning &&
+		!stepCompleted(tenant, platformv1alpha1.ConditionClusterProvisioned)
+}
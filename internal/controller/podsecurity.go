@@ -0,0 +1,58 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+)
+
+// PodSecurityEnforceLabelKey sets the Pod Security Admission level enforced in a namespace.
+const PodSecurityEnforceLabelKey = "pod-security.kubernetes.io/enforce"
+
+// podSecurityLevel returns the Pod Security Admission level for the tenant's own
+// namespaces: the level configured for its tier, or privileged once privileged
+// workloads are approved, which the pod webhook would otherwise allow in vain. The
+// shared Bronze namespace always keeps the Bronze level.
+func (r *TenantReconciler) podSecurityLevel(tenant *platformv1alpha1.Tenant) string {
+	if tenant.Spec.Tier != platformv1alpha1.BronzeTier &&
+		tenant.Spec.Security.AllowPrivileged && tenant.Annotations[PrivilegedApprovedByAnnotation] != "" {
+		return config.PodSecurityPrivileged
+	}
+	levels := r.config().PodSecurity
+	switch tenant.Spec.Tier {
+	case platformv1alpha1.BronzeTier:
+		return levels.Bronze
+	case platformv1alpha1.GoldTier:
+		return levels.Gold
+	}
+	return levels.Silver
+}
+
+// setPodSecurityLabel enforces level in ns, or removes the label when level is empty.
+func setPodSecurityLabel(ns *corev1.Namespace, level string) {
+	if level == "" {
+		delete(ns.Labels, PodSecurityEnforceLabelKey)
+		return
+	}
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	ns.Labels[PodSecurityEnforceLabelKey] = level
+}
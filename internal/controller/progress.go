@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// stepCompleted reports whether a provisioning step already completed for the
+// tenant's current generation, e.g. before an operator restart.
+func stepCompleted(tenant *platformv1alpha1.Tenant, conditionType string) bool {
+	cond := apimeta.FindStatusCondition(tenant.Status.Conditions, conditionType)
+	return cond != nil && cond.Status == metav1.ConditionTrue && cond.ObservedGeneration == tenant.Generation
+}
+
+// completeStep marks a provisioning step as completed and persists it right away, so
+// the progress survives a crash later in the same reconcile.
+func (r *TenantReconciler) completeStep(ctx context.Context, tenant *platformv1alpha1.Tenant, conditionType, message string) error {
+	if stepCompleted(tenant, conditionType) {
+		return nil
+	}
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: tenant.Generation,
+		Reason:             "Completed",
+		Message:            message,
+	})
+	setProgress(tenant)
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		return fmt.Errorf("failed to record %s: %w", conditionType, err)
+	}
+	return nil
+}
+
+// failStep marks a provisioning step as not completed. The condition is persisted
+// with the rest of the status at the end of the reconcile.
+func failStep(tenant *platformv1alpha1.Tenant, conditionType, reason string, err error) {
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: tenant.Generation,
+		Reason:             reason,
+		Message:            err.Error(),
+	})
+}
+
+// setResourceCondition records the readiness of one kind of child resource: True when
+// err is nil, otherwise False with the given reason and the error as message.
+func setResourceCondition(tenant *platformv1alpha1.Tenant, conditionType, reason string, err error) {
+	cond := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: tenant.Generation,
+		Reason:             "Reconciled",
+		Message:            "resource matches the tenant spec",
+	}
+	if err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reason
+		cond.Message = err.Error()
+	}
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, cond)
+}
+
+// setProgress publishes the completed provisioning steps of the tenant's tier and the
+// first step still outstanding in status.progress. It is persisted with the status.
+func setProgress(tenant *platformv1alpha1.Tenant) {
+	var steps []ProvisioningStep
+	if p, ok := tierProvisioners[tenant.Spec.Tier]; ok {
+		steps = p.Steps()
+	}
+	if len(steps) == 0 {
+		tenant.Status.Progress = nil
+		return
+	}
+
+	progress := &platformv1alpha1.ProvisioningProgress{TotalSteps: int32(len(steps))}
+	for _, step := range steps {
+		if stepCompleted(tenant, step.Condition) {
+			progress.CompletedSteps++
+		} else if progress.CurrentStep == "" {
+			progress.CurrentStep = step.Name
+		}
+	}
+	progress.Percent = progress.CompletedSteps * 100 / progress.TotalSteps
+	progress.Summary = fmt.Sprintf("%d/%d", progress.CompletedSteps, progress.TotalSteps)
+	if progress.CurrentStep != "" {
+		progress.Summary += " " + progress.CurrentStep
+	}
+	tenant.Status.Progress = progress
+}
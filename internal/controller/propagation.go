@@ -0,0 +1,218 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+const (
+	// PropagateLabelKey marks Secrets and ConfigMaps in the operator namespace that are
+	// copied into tenant namespaces while set to "true".
+	PropagateLabelKey = "tenant.platform.io/propagate"
+
+	// PropagateTiersAnnotation restricts a propagated object to a comma-separated list of
+	// tiers, such as "Silver,Gold". Objects without it are propagated to every tier.
+	PropagateTiersAnnotation = "tenant.platform.io/propagate-tiers"
+
+	// PropagatedLabelKey marks the copies of propagated objects in tenant namespaces.
+	PropagatedLabelKey = "tenant.platform.io/propagated"
+)
+
+// errNotPropagated is returned when a tenant namespace already has an object of the same
+// name as a propagated one that the operator did not create.
+var errNotPropagated = errors.New("an object with this name exists and was not propagated by the operator")
+
+// ensureSecretsAndConfigMaps syncs the Secrets and ConfigMaps in the operator namespace
+// labeled tenant.platform.io/propagate=true into the tenant namespace, and deletes copies
+// whose source was deleted, unlabeled, or restricted to other tiers. A failure on one
+// object does not stop the others from syncing.
+func (r *TenantReconciler) ensureSecretsAndConfigMaps(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	namespaceName := buildNamespaceName(tenant)
+	sourceLabels := client.MatchingLabels{PropagateLabelKey: "true"}
+	copyLabels := client.MatchingLabels{PropagatedLabelKey: "true", TenantNameLabelKey: tenant.Name}
+	var errs []error
+
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, client.InNamespace(OperatorNamespace), sourceLabels); err != nil {
+		return fmt.Errorf("failed to list Secrets to propagate: %w", err)
+	}
+	wantSecrets := map[string]bool{}
+	for i := range secrets.Items {
+		source := &secrets.Items[i]
+		if !propagatesToTier(source, tenant.Spec.Tier) {
+			continue
+		}
+		wantSecrets[source.Name] = true
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: source.Name, Namespace: namespaceName}}
+		errs = append(errs, r.propagate(ctx, tenant, "Secret", secret, func() {
+			secret.Type = source.Type
+			secret.Data = source.Data
+		}, log))
+	}
+
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.List(ctx, configMaps, client.InNamespace(OperatorNamespace), sourceLabels); err != nil {
+		return fmt.Errorf("failed to list ConfigMaps to propagate: %w", err)
+	}
+	wantConfigMaps := map[string]bool{}
+	for i := range configMaps.Items {
+		source := &configMaps.Items[i]
+		if !propagatesToTier(source, tenant.Spec.Tier) {
+			continue
+		}
+		wantConfigMaps[source.Name] = true
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: source.Name, Namespace: namespaceName}}
+		errs = append(errs, r.propagate(ctx, tenant, "ConfigMap", cm, func() {
+			cm.Data = source.Data
+			cm.BinaryData = source.BinaryData
+		}, log))
+	}
+
+	// Delete copies that are no longer wanted
+	copiedSecrets := &corev1.SecretList{}
+	if err := r.List(ctx, copiedSecrets, client.InNamespace(namespaceName), copyLabels); err != nil {
+		return fmt.Errorf("failed to list propagated Secrets: %w", err)
+	}
+	for i := range copiedSecrets.Items {
+		if !wantSecrets[copiedSecrets.Items[i].Name] {
+			errs = append(errs, r.deletePropagated(ctx, "Secret", &copiedSecrets.Items[i], log))
+		}
+	}
+	copiedConfigMaps := &corev1.ConfigMapList{}
+	if err := r.List(ctx, copiedConfigMaps, client.InNamespace(namespaceName), copyLabels); err != nil {
+		return fmt.Errorf("failed to list propagated ConfigMaps: %w", err)
+	}
+	for i := range copiedConfigMaps.Items {
+		if !wantConfigMaps[copiedConfigMaps.Items[i].Name] {
+			errs = append(errs, r.deletePropagated(ctx, "ConfigMap", &copiedConfigMaps.Items[i], log))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// propagate creates or updates the copy obj of a propagated object, with sync copying
+// the source's content onto it. Objects of the same name the tenant created are left alone.
+func (r *TenantReconciler) propagate(ctx context.Context, tenant *platformv1alpha1.Tenant, kind string, obj client.Object, sync func(), log logr.Logger) error {
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+		if obj.GetResourceVersion() != "" && obj.GetLabels()[PropagatedLabelKey] != "true" {
+			return errNotPropagated
+		}
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[TenantNameLabelKey] = tenant.Name
+		labels[ManagedByLabelKey] = ManagedByValue
+		labels[PropagatedLabelKey] = "true"
+		obj.SetLabels(labels)
+		sync()
+		return controllerutil.SetControllerReference(tenant, obj, r.Scheme)
+	})
+	if errors.Is(err, errNotPropagated) {
+		log.Info("not propagating over an existing object", "kind", kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+		return nil
+	}
+	if err != nil {
+		log.Error(err, "failed to propagate object", "kind", kind, "name", obj.GetName())
+		return fmt.Errorf("failed to propagate %s %s: %w", kind, obj.GetName(), err)
+	}
+	if result != controllerutil.OperationResultNone {
+		log.Info("propagated object", "kind", kind, "name", obj.GetName(), "operation", result)
+	}
+	return nil
+}
+
+// deletePropagated deletes a copy whose source is no longer propagated to the tenant.
+func (r *TenantReconciler) deletePropagated(ctx context.Context, kind string, obj client.Object, log logr.Logger) error {
+	if err := r.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete propagated %s %s: %w", kind, obj.GetName(), err)
+	}
+	log.Info("deleted propagated object", "kind", kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+	return nil
+}
+
+// propagatesToTier reports whether the propagated object obj applies to tenants of tier,
+// according to its tenant.platform.io/propagate-tiers annotation.
+func propagatesToTier(obj client.Object, tier platformv1alpha1.TenantTier) bool {
+	tiers, ok := obj.GetAnnotations()[PropagateTiersAnnotation]
+	if !ok || strings.TrimSpace(tiers) == "" {
+		return true
+	}
+	for _, t := range strings.Split(tiers, ",") {
+		if strings.EqualFold(strings.TrimSpace(t), string(tier)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPropagationSource reports whether obj is a Secret or ConfigMap labeled for propagation
+// in the operator namespace.
+func isPropagationSource(obj client.Object) bool {
+	return obj.GetNamespace() == OperatorNamespace && obj.GetLabels()[PropagateLabelKey] == "true"
+}
+
+// propagationSourcePredicate passes changes to propagation sources, including the update
+// that removes the label, so the copies can be deleted.
+var propagationSourcePredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool { return isPropagationSource(e.Object) },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if e.ObjectOld.GetResourceVersion() == e.ObjectNew.GetResourceVersion() {
+			return false
+		}
+		return isPropagationSource(e.ObjectOld) || isPropagationSource(e.ObjectNew)
+	},
+	DeleteFunc:  func(e event.DeleteEvent) bool { return isPropagationSource(e.Object) },
+	GenericFunc: func(e event.GenericEvent) bool { return isPropagationSource(e.Object) },
+}
+
+// PropagationSourceRequests fans a change to a propagated Secret or ConfigMap out to every
+// Silver and Gold tenant, so rotated credentials reach tenant namespaces without waiting for
+// the next resync. Tenants the object is not propagated to only prune copies they no
+// longer need, which is what a changed propagate-tiers annotation requires.
+func (r *TenantReconciler) PropagationSourceRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := r.List(ctx, tenants); err != nil {
+		r.Log.Error(err, "failed to list tenants for propagated object", "name", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, tenant := range tenants.Items {
+		if tenant.Spec.Tier == platformv1alpha1.BronzeTier {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&tenant)})
+	}
+	return requests
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// ensurePriorityClassQuotas creates one PriorityClass-scoped ResourceQuota per entry in
+// spec.quotas.byPriorityClass and removes scoped quotas that are no longer requested.
+func (r *TenantReconciler) ensurePriorityClassQuotas(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	namespaceName := buildNamespaceName(tenant)
+	desired := make(map[string]bool, len(tenant.Spec.Quotas.ByPriorityClass))
+
+	for _, pcq := range tenant.Spec.Quotas.ByPriorityClass {
+		name := priorityClassQuotaName(tenant, pcq.PriorityClassName)
+		desired[name] = true
+
+		hard := buildPriorityClassQuotaHard(pcq)
+		scopeSelector := &corev1.ScopeSelector{
+			MatchExpressions: []corev1.ScopedResourceSelectorRequirement{
+				{
+					ScopeName: corev1.ResourceQuotaScopePriorityClass,
+					Operator:  corev1.ScopeSelectorOpIn,
+					Values:    []string{pcq.PriorityClassName},
+				},
+			},
+		}
+
+		rq := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespaceName}}
+		result, err := r.applyManaged(ctx, tenant, rq, func() error {
+			rq.Labels = map[string]string{
+				TenantNameLabelKey: tenant.Name,
+				ManagedByLabelKey:  ManagedByValue,
+				QuotaScopeLabelKey: QuotaScopePriorityClass,
+			}
+			rq.Spec.Hard = hard
+			rq.Spec.ScopeSelector = scopeSelector
+			return controllerutil.SetControllerReference(tenant, rq, r.Scheme)
+		}, log)
+		if err != nil {
+			log.Error(err, "failed to create or update PriorityClass ResourceQuota",
+				"namespace", namespaceName, "priorityClass", pcq.PriorityClassName)
+			return err
+		}
+		log.Info("ensured PriorityClass ResourceQuota", "namespace", namespaceName,
+			"priorityClass", pcq.PriorityClassName, "operation", result)
+	}
+
+	existing := &corev1.ResourceQuotaList{}
+	if err := r.List(ctx, existing,
+		client.InNamespace(namespaceName),
+		client.MatchingLabels{
+			TenantNameLabelKey: tenant.Name,
+			QuotaScopeLabelKey: QuotaScopePriorityClass,
+		},
+	); err != nil {
+		return fmt.Errorf("failed to list PriorityClass ResourceQuotas: %w", err)
+	}
+
+	for i := range existing.Items {
+		rq := &existing.Items[i]
+		if desired[rq.Name] {
+			continue
+		}
+		if err := r.Delete(ctx, rq); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete stale ResourceQuota %s: %w", rq.Name, err)
+		}
+		log.Info("removed stale PriorityClass ResourceQuota", "namespace", namespaceName, "resourceQuota", rq.Name)
+	}
+
+	return nil
+}
+
+// buildPriorityClassQuotaHard computes the hard limits for a PriorityClass-scoped quota.
+// Only the dimensions set on the entry are constrained.
+func buildPriorityClassQuotaHard(pcq platformv1alpha1.PriorityClassQuota) corev1.ResourceList {
+	hard := corev1.ResourceList{}
+
+	if pcq.CPU != "" {
+		if qty, err := resource.ParseQuantity(pcq.CPU); err == nil {
+			hard[corev1.ResourceRequestsCPU] = qty
+			hard[corev1.ResourceLimitsCPU] = qty
+		}
+	}
+	if pcq.Memory != "" {
+		if qty, err := resource.ParseQuantity(pcq.Memory); err == nil {
+			hard[corev1.ResourceRequestsMemory] = qty
+			hard[corev1.ResourceLimitsMemory] = qty
+		}
+	}
+	if pcq.Pods != nil {
+		hard[corev1.ResourcePods] = *resource.NewQuantity(*pcq.Pods, resource.DecimalSI)
+	}
+
+	return hard
+}
+
+// priorityClassQuotaName returns the ResourceQuota name for a tenant's PriorityClass budget.
+func priorityClassQuotaName(tenant *platformv1alpha1.Tenant, priorityClass string) string {
+	return fmt.Sprintf("%s-quota-%s", tenant.Name, priorityClass)
+}
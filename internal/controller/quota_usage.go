@@ -0,0 +1,195 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// resourceUsageInterval is how often the ResourceUsageReporter refreshes
+// status.resourceUsage between reconciles.
+const resourceUsageInterval = time.Minute
+
+// tenantQuotaKeys returns the ResourceQuotas holding a tenant's budget: the one in the
+// shared Bronze namespace, or those in the tenant namespace and its environments.
+func tenantQuotaKeys(tenant *platformv1alpha1.Tenant) []client.ObjectKey {
+	name := fmt.Sprintf("%s-quota", tenant.Name)
+	if tenant.Spec.Tier == platformv1alpha1.BronzeTier {
+		return []client.ObjectKey{{Namespace: BronzeNamespace, Name: name}}
+	}
+	keys := []client.ObjectKey{{Namespace: buildNamespaceName(tenant), Name: name}}
+	for _, env := range tenant.Spec.Environments {
+		keys = append(keys, client.ObjectKey{Namespace: buildEnvironmentNamespaceName(tenant, env.Name), Name: name})
+	}
+	return keys
+}
+
+// readResourceUsage sums the used and hard amounts of the tenant's ResourceQuotas. It
+// returns nil when none exist, as for Platinum tenants, whose dedicated cluster has no
+// quota on this one.
+func readResourceUsage(ctx context.Context, c client.Reader, tenant *platformv1alpha1.Tenant) (*platformv1alpha1.ResourceUsage, error) {
+	used, hard := corev1.ResourceList{}, corev1.ResourceList{}
+	found := false
+	for _, key := range tenantQuotaKeys(tenant) {
+		rq := &corev1.ResourceQuota{}
+		if err := c.Get(ctx, key, rq); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("failed to fetch ResourceQuota %s: %w", key, err)
+			}
+			continue
+		}
+		found = true
+		addResources(used, rq.Status.Used)
+		addResources(hard, rq.Spec.Hard)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	usage := func(name corev1.ResourceName) platformv1alpha1.QuotaUsage {
+		h, ok := hard[name]
+		if !ok {
+			return platformv1alpha1.QuotaUsage{}
+		}
+		u := used[name]
+		return platformv1alpha1.QuotaUsage{
+			Used:    u.String(),
+			Hard:    h.String(),
+			Summary: fmt.Sprintf("%s/%s", u.String(), h.String()),
+		}
+	}
+	return &platformv1alpha1.ResourceUsage{
+		CPU:       usage(corev1.ResourceRequestsCPU),
+		Memory:    usage(corev1.ResourceRequestsMemory),
+		Pods:      usage(corev1.ResourcePods),
+		Storage:   usage(corev1.ResourceRequestsStorage),
+		Suspended: workloadsSuspended(tenant),
+	}, nil
+}
+
+// addResources adds the quantities of src to dst.
+func addResources(dst, src corev1.ResourceList) {
+	for name, qty := range src {
+		sum := dst[name]
+		sum.Add(qty)
+		dst[name] = sum
+	}
+}
+
+// setResourceUsage records usage in the tenant status, keeping the utilization sampled
+// by the UtilizationCollector, and LastUpdateTime while the usage is unchanged. It
+// reports whether the status changed.
+func setResourceUsage(tenant *platformv1alpha1.Tenant, usage *platformv1alpha1.ResourceUsage, now time.Time) bool {
+	previous := tenant.Status.ResourceUsage
+	if usage == nil || previous == nil {
+		tenant.Status.ResourceUsage = usage
+		if usage != nil {
+			usage.LastUpdateTime = &metav1.Time{Time: now}
+		}
+		return usage != nil || previous != nil
+	}
+	usage.Utilization = previous.Utilization
+	usage.LastUpdateTime = previous.LastUpdateTime
+	if equality.Semantic.DeepEqual(previous, usage) {
+		return false
+	}
+	usage.LastUpdateTime = &metav1.Time{Time: now}
+	tenant.Status.ResourceUsage = usage
+	return true
+}
+
+// updateResourceUsage refreshes status.resourceUsage. Failures are logged and leave
+// the previous usage in place.
+func (r *TenantReconciler) updateResourceUsage(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) {
+	usage, err := readResourceUsage(ctx, r.Client, tenant)
+	if err != nil {
+		log.Error(err, "failed to read resource usage (non-fatal)")
+		return
+	}
+	setResourceUsage(tenant, usage, time.Now())
+}
+
+// ResourceUsageReporter refreshes status.resourceUsage of every tenant between
+// reconciles, which only run on changes to the tenant and its objects and on the
+// periodic resync. It runs as a manager Runnable, so only the elected leader reports.
+// Tenants placed on a remote cluster are refreshed by their reconciles only.
+type ResourceUsageReporter struct {
+	Client client.Client
+	Log    logr.Logger
+}
+
+// Start refreshes resource usage until ctx is cancelled.
+func (u *ResourceUsageReporter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(resourceUsageInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.Refresh(ctx); err != nil {
+			u.Log.Error(err, "failed to refresh tenant resource usage")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh reads the quota usage of every tenant and records it where it changed.
+func (u *ResourceUsageReporter) Refresh(ctx context.Context) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := u.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	var errs []string
+	now := time.Now()
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		if !tenant.DeletionTimestamp.IsZero() || tenant.Spec.Placement != nil {
+			continue
+		}
+		usage, err := readResourceUsage(ctx, u.Client, tenant)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+			continue
+		}
+		patch := client.MergeFrom(tenant.DeepCopy())
+		if !setResourceUsage(tenant, usage, now) {
+			continue
+		}
+		if err := u.Client.Status().Patch(ctx, tenant, patch); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("resource usage failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
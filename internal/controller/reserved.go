@@ -0,0 +1,80 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// reservedNamespaces are system namespaces a tenant must never take over.
+var reservedNamespaces = map[string]bool{
+	"default":         true,
+	"kube-system":     true,
+	"kube-public":     true,
+	"kube-node-lease": true,
+	OperatorNamespace: true,
+}
+
+// IsReservedNamespace reports whether a namespace belongs to the cluster or the operator,
+// e.g. "tenant-master-system" for a tenant named "master-system".
+func IsReservedNamespace(name string) bool {
+	return reservedNamespaces[name] || strings.HasPrefix(name, "kube-")
+}
+
+// validateNamespaceNames checks the namespaces the tenant would get before any of them is
+// created: each must be a valid namespace name, not reserved, and not already owned by
+// another tenant or created outside the operator. Taking over such a namespace would
+// also make it garbage collected with the tenant.
+func (r *TenantReconciler) validateNamespaceNames(ctx context.Context, tenant *platformv1alpha1.Tenant) error {
+	names := []string{buildNamespaceName(tenant)}
+	for _, env := range tenant.Spec.Environments {
+		names = append(names, buildEnvironmentNamespaceName(tenant, env.Name))
+	}
+
+	for _, name := range names {
+		if msgs := validation.IsDNS1123Label(name); len(msgs) > 0 {
+			return newValidationError(fmt.Errorf("namespace name %q is invalid: %s", name, strings.Join(msgs, "; ")))
+		}
+		if IsReservedNamespace(name) {
+			return newValidationError(fmt.Errorf("namespace name %q is reserved for the system", name))
+		}
+
+		ns := &corev1.Namespace{}
+		if err := r.Get(ctx, client.ObjectKey{Name: name}, ns); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to check namespace %s: %w", name, err)
+		}
+		if owner := ns.Labels[TenantNameLabelKey]; owner != tenant.Name {
+			if owner == "" {
+				return newValidationError(fmt.Errorf("namespace %q already exists and is not managed by the operator", name))
+			}
+			return newValidationError(fmt.Errorf("namespace %q already belongs to tenant %q", name, owner))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// Suffixes of the Roles created in every Silver and Gold tenant namespace. The admin
+// Role is bound to the tenant ServiceAccount; tenant admins bind the edit and view
+// Roles to their own users.
+const (
+	TenantAdminRoleSuffix = "-admin"
+	TenantEditRoleSuffix  = "-edit"
+	TenantViewRoleSuffix  = "-view"
+)
+
+var (
+	readVerbs  = []string{"get", "list", "watch"}
+	writeVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete", "deletecollection"}
+)
+
+// tenantViewRules read the tenant's workloads and configuration, but not its Secrets.
+var tenantViewRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods", "pods/log", "services", "endpoints", "configmaps", "persistentvolumeclaims",
+			"serviceaccounts", "replicationcontrollers", "events", "resourcequotas", "limitranges"},
+		Verbs: readVerbs,
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "statefulsets", "daemonsets", "replicasets"},
+		Verbs:     readVerbs,
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"jobs", "cronjobs"},
+		Verbs:     readVerbs,
+	},
+	{
+		APIGroups: []string{"autoscaling"},
+		Resources: []string{"horizontalpodautoscalers"},
+		Verbs:     readVerbs,
+	},
+	{
+		APIGroups: []string{"policy"},
+		Resources: []string{"poddisruptionbudgets"},
+		Verbs:     readVerbs,
+	},
+	{
+		APIGroups: []string{"networking.k8s.io"},
+		Resources: []string{"ingresses", "networkpolicies"},
+		Verbs:     readVerbs,
+	},
+	{
+		APIGroups: []string{"events.k8s.io"},
+		Resources: []string{"events"},
+		Verbs:     readVerbs,
+	},
+}
+
+// tenantEditRules run and configure workloads. ResourceQuotas, LimitRanges, and
+// NetworkPolicies stay read-only: they enforce the tenant's tier.
+var tenantEditRules = append([]rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods", "services", "endpoints", "configmaps", "secrets", "persistentvolumeclaims",
+			"serviceaccounts", "replicationcontrollers"},
+		Verbs: writeVerbs,
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods/exec", "pods/attach", "pods/portforward", "pods/eviction"},
+		Verbs:     []string{"get", "create"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods/ephemeralcontainers"},
+		Verbs:     []string{"update", "patch"},
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "deployments/scale", "statefulsets", "statefulsets/scale", "daemonsets", "replicasets"},
+		Verbs:     writeVerbs,
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"jobs", "cronjobs"},
+		Verbs:     writeVerbs,
+	},
+	{
+		APIGroups: []string{"autoscaling"},
+		Resources: []string{"horizontalpodautoscalers"},
+		Verbs:     writeVerbs,
+	},
+	{
+		APIGroups: []string{"policy"},
+		Resources: []string{"poddisruptionbudgets"},
+		Verbs:     writeVerbs,
+	},
+	{
+		APIGroups: []string{"networking.k8s.io"},
+		Resources: []string{"ingresses"},
+		Verbs:     writeVerbs,
+	},
+}, tenantViewRules...)
+
+// tenantAdminRules add managing the namespace's Roles and RoleBindings to the edit
+// rules, so tenant admins can hand out the edit and view Roles. The RBAC webhook
+// keeps them from granting escalate, bind, or impersonate.
+var tenantAdminRules = append([]rbacv1.PolicyRule{
+	{
+		APIGroups: []string{rbacv1.GroupName},
+		Resources: []string{"roles", "rolebindings"},
+		Verbs:     writeVerbs,
+	},
+}, tenantEditRules...)
+
+// tenantRoles are the Roles created in each tenant namespace, by name suffix.
+var tenantRoles = []struct {
+	suffix string
+	rules  []rbacv1.PolicyRule
+}{
+	{suffix: TenantAdminRoleSuffix, rules: tenantAdminRules},
+	{suffix: TenantEditRoleSuffix, rules: tenantEditRules},
+	{suffix: TenantViewRoleSuffix, rules: tenantViewRules},
+}
+
+// accessRoleSuffixes maps spec.accessControl.role to the suffix of the bound Role.
+var accessRoleSuffixes = map[platformv1alpha1.AccessRole]string{
+	platformv1alpha1.AccessRoleAdmin: TenantAdminRoleSuffix,
+	platformv1alpha1.AccessRoleEdit:  TenantEditRoleSuffix,
+	platformv1alpha1.AccessRoleView:  TenantViewRoleSuffix,
+}
+
+// accessBindingName returns the name of the RoleBinding for spec.accessControl.
+func accessBindingName(tenant *platformv1alpha1.Tenant) string {
+	return tenant.Name + "-access-binding"
+}
+
+// ensureAccessBinding binds the users and groups in spec.accessControl to the selected
+// tenant Role, or deletes the binding when there are none. A binding whose Role changed
+// is recreated, as the roleRef of a RoleBinding is immutable.
+func (r *TenantReconciler) ensureAccessBinding(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	access := tenant.Spec.AccessControl
+	namespaceName := buildNamespaceName(tenant)
+	key := client.ObjectKey{Namespace: namespaceName, Name: accessBindingName(tenant)}
+
+	var subjects []rbacv1.Subject
+	for _, user := range access.Users {
+		subjects = append(subjects, rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: user})
+	}
+	for _, group := range access.Groups {
+		subjects = append(subjects, rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: group})
+	}
+
+	existing := &rbacv1.RoleBinding{}
+	if err := r.Get(ctx, key, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get access RoleBinding: %w", err)
+		}
+		existing = nil
+	}
+
+	suffix, ok := accessRoleSuffixes[access.Role]
+	if !ok {
+		suffix = TenantAdminRoleSuffix
+	}
+	roleRef := rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: tenant.Name + suffix}
+
+	if existing != nil && (len(subjects) == 0 || existing.RoleRef != roleRef) {
+		if err := r.Delete(ctx, existing); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete access RoleBinding: %w", err)
+		}
+		log.Info("deleted access RoleBinding", "namespace", namespaceName, "role", existing.RoleRef.Name)
+	}
+	if len(subjects) == 0 {
+		return nil
+	}
+
+	rb := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+	result, err := r.applyManaged(ctx, tenant, rb, func() error {
+		rb.Labels = map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			ManagedByLabelKey:  ManagedByValue,
+		}
+		rb.RoleRef = roleRef
+		rb.Subjects = subjects
+		return controllerutil.SetControllerReference(tenant, rb, r.Scheme)
+	}, log)
+	if err != nil {
+		return fmt.Errorf("failed to create or update access RoleBinding: %w", err)
+	}
+	log.Info("ensured access RoleBinding", "namespace", namespaceName, "role", roleRef.Name,
+		"users", len(access.Users), "groups", len(access.Groups), "operation", result)
+	return nil
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+)
+
+// tenantSnapshot is the archived content of a snapshot. Secrets are left out so that
+// credentials never leave the cluster.
+type tenantSnapshot struct {
+	Snapshot   string                      `json:"snapshot"`
+	TakenAt    time.Time                   `json:"takenAt"`
+	Tenant     string                      `json:"tenant"`
+	Spec       platformv1alpha1.TenantSpec `json:"spec"`
+	ConfigMaps []corev1.ConfigMap          `json:"configMaps,omitempty"`
+}
+
+// takeSnapshotBeforeDeletion snapshots a tenant that is about to be deleted and returns
+// the completed snapshot. Each deletion takes one snapshot: later calls return it, or
+// retake it in place if it failed, so deletion can wait until it completed.
+// E3-04: Implements snapshot routine for graceful teardown.
+func (r *TenantReconciler) takeSnapshotBeforeDeletion(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) (*platformv1alpha1.TenantSnapshot, error) {
+	snapshots := &platformv1alpha1.TenantSnapshotList{}
+	if err := r.List(ctx, snapshots, client.MatchingLabels{
+		TenantNameLabelKey: tenant.Name,
+		TenantUIDLabelKey:  string(tenant.UID),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	for i := range snapshots.Items {
+		snapshot := &snapshots.Items[i]
+		if snapshot.Spec.Trigger != platformv1alpha1.SnapshotDeletion {
+			continue
+		}
+		if snapshot.Status.Phase == platformv1alpha1.SnapshotCompleted {
+			return snapshot, nil
+		}
+		return snapshot, r.retakeSnapshot(ctx, tenant, snapshot, log)
+	}
+	return r.takeSnapshot(ctx, tenant, platformv1alpha1.SnapshotDeletion, log)
+}
+
+// takeSnapshot records a TenantSnapshot of tenant and takes it right away.
+func (r *TenantReconciler) takeSnapshot(ctx context.Context, tenant *platformv1alpha1.Tenant, trigger platformv1alpha1.SnapshotTrigger, log logr.Logger) (*platformv1alpha1.TenantSnapshot, error) {
+	snapshot := &platformv1alpha1.TenantSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("snapshot-%s-", tenant.Name),
+			Labels: map[string]string{
+				TenantNameLabelKey: tenant.Name,
+				TenantUIDLabelKey:  string(tenant.UID),
+				ManagedByLabelKey:  ManagedByValue,
+			},
+		},
+		Spec: platformv1alpha1.TenantSnapshotSpec{TenantName: tenant.Name, Trigger: trigger},
+	}
+	if err := r.Create(ctx, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to record snapshot of tenant %s: %w", tenant.Name, err)
+	}
+	log.Info("taking snapshot", "snapshot", snapshot.Name, "trigger", trigger)
+	return snapshot, r.retakeSnapshot(ctx, tenant, snapshot, log)
+}
+
+// retakeSnapshot takes the snapshot of tenant recorded by snapshot and saves the
+// outcome in its status.
+func (r *TenantReconciler) retakeSnapshot(ctx context.Context, tenant *platformv1alpha1.Tenant, snapshot *platformv1alpha1.TenantSnapshot, log logr.Logger) error {
+	captureErr := captureSnapshot(ctx, r.Client, r.Archive, tenant, snapshot)
+	if captureErr != nil {
+		snapshot.Status.Phase = platformv1alpha1.SnapshotFailed
+		snapshot.Status.Message = captureErr.Error()
+	}
+	if err := r.Status().Update(ctx, snapshot); err != nil {
+		return fmt.Errorf("failed to record snapshot %s: %w", snapshot.Name, err)
+	}
+	if captureErr != nil {
+		return fmt.Errorf("snapshot %s failed: %w", snapshot.Name, captureErr)
+	}
+
+	log.Info("snapshot taken", "snapshot", snapshot.Name, "location", snapshot.Status.Location)
+	return nil
+}
+
+// captureSnapshot takes the snapshot of tenant recorded by snapshot: with an archive,
+// the tenant spec and the ConfigMaps of its namespace are stored as
+// "snapshots/<tenant>/<snapshot>.json". What was captured and where is recorded in the
+// snapshot status, which the caller saves.
+func captureSnapshot(ctx context.Context, c client.Client, archive storage.Storage, tenant *platformv1alpha1.Tenant, snapshot *platformv1alpha1.TenantSnapshot) error {
+	now := metav1.Now()
+	snapshot.Status.TakenAt = &now
+	snapshot.Status.Tier = tenant.Spec.Tier
+	snapshot.Status.Owner = tenant.Spec.Owner
+	snapshot.Status.SourceNamespace = tenant.Status.Namespace
+
+	// Without an archive only the record is kept
+	if archive != nil {
+		configMaps, err := tenantConfigMaps(ctx, c, tenant)
+		if err != nil {
+			return err
+		}
+		payload, err := json.MarshalIndent(tenantSnapshot{
+			Snapshot:   snapshot.Name,
+			TakenAt:    now.UTC(),
+			Tenant:     tenant.Name,
+			Spec:       tenant.Spec,
+			ConfigMaps: configMaps,
+		}, "", "  ")
+		if err != nil {
+			return err
+		}
+		key := "snapshots/" + tenant.Name + "/" + snapshot.Name + ".json"
+		if err := archive.Put(ctx, key, payload); err != nil {
+			return fmt.Errorf("failed to archive snapshot: %w", err)
+		}
+		snapshot.Status.Location = key
+		snapshot.Status.Contents = nil
+		for _, cm := range configMaps {
+			snapshot.Status.Contents = append(snapshot.Status.Contents, platformv1alpha1.ManagedResource{
+				APIVersion: "v1", Kind: "ConfigMap", Namespace: cm.Namespace, Name: cm.Name,
+			})
+		}
+	}
+
+	snapshot.Status.Phase = platformv1alpha1.SnapshotCompleted
+	snapshot.Status.Message = ""
+	return nil
+}
+
+// restoreSnapshot restores the ConfigMaps captured by the TenantSnapshot in
+// spec.restoreFrom into the tenant namespace, once. ConfigMaps the operator manages are
+// left out, as the operator recreates them. Only snapshots of the same tenant can be
+// restored, so a tenant cannot read another tenant's data.
+func (r *TenantReconciler) restoreSnapshot(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	ref := tenant.Spec.RestoreFrom
+	if ref == nil || tenant.Status.RestoredFrom == ref.Name {
+		return nil
+	}
+	fail := func(reason string, err error) error {
+		apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+			Type:               platformv1alpha1.ConditionSnapshotRestored,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: tenant.Generation,
+			Reason:             reason,
+			Message:            err.Error(),
+		})
+		return err
+	}
+
+	snapshot := &platformv1alpha1.TenantSnapshot{}
+	if err := r.Get(ctx, client.ObjectKey{Name: ref.Name}, snapshot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fail("SnapshotNotFound", newValidationError(fmt.Errorf("TenantSnapshot %s not found", ref.Name)))
+		}
+		return fail("RestoreFailed", fmt.Errorf("failed to get TenantSnapshot %s: %w", ref.Name, err))
+	}
+	switch {
+	case snapshot.Spec.TenantName != tenant.Name:
+		return fail("SnapshotNotRestorable", newValidationError(fmt.Errorf(
+			"TenantSnapshot %s is a snapshot of tenant %s, not %s", ref.Name, snapshot.Spec.TenantName, tenant.Name)))
+	case !snapshot.Restorable():
+		return fail("SnapshotNotRestorable", newValidationError(fmt.Errorf(
+			"TenantSnapshot %s is %s without an archived copy and cannot be restored", ref.Name, snapshot.Status.Phase)))
+	case r.Archive == nil:
+		return fail("SnapshotNotRestorable", newValidationError(fmt.Errorf(
+			"TenantSnapshot %s cannot be restored without archive storage (--storage-backend)", ref.Name)))
+	}
+
+	data, err := r.Archive.Get(ctx, snapshot.Status.Location)
+	if err != nil {
+		return fail("RestoreFailed", fmt.Errorf("failed to read snapshot %s: %w", snapshot.Status.Location, err))
+	}
+	content := tenantSnapshot{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return fail("RestoreFailed", fmt.Errorf("failed to decode snapshot %s: %w", snapshot.Status.Location, err))
+	}
+
+	namespaceName := buildNamespaceName(tenant)
+	var restored int
+	for _, src := range content.ConfigMaps {
+		if src.Labels[ManagedByLabelKey] == ManagedByValue {
+			continue
+		}
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: src.Name, Namespace: namespaceName}}
+		if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+			cm.Labels = src.Labels
+			cm.Annotations = src.Annotations
+			cm.Data = src.Data
+			cm.BinaryData = src.BinaryData
+			return nil
+		}); err != nil {
+			return fail("RestoreFailed", fmt.Errorf("failed to restore ConfigMap %s: %w", src.Name, err))
+		}
+		restored++
+	}
+
+	message := fmt.Sprintf("%d ConfigMap(s) restored from TenantSnapshot %s", restored, ref.Name)
+	tenant.Status.RestoredFrom = ref.Name
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               platformv1alpha1.ConditionSnapshotRestored,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: tenant.Generation,
+		Reason:             "Restored",
+		Message:            message,
+	})
+	r.event(tenant, corev1.EventTypeNormal, "SnapshotRestored", message)
+	log.Info("restored snapshot", "snapshot", ref.Name, "configMaps", restored)
+	return nil
+}
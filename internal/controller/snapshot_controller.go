@@ -0,0 +1,97 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+)
+
+// TenantSnapshotReconciler takes the TenantSnapshots created by admins. Snapshots the
+// operator records itself on a backup schedule or before deleting or migrating a tenant
+// are taken right where they are created, and are left alone here.
+type TenantSnapshotReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+
+	// Archive stores the snapshot contents outside the cluster. Without it, snapshots
+	// only record the tenant's tier, owner, and namespace and cannot be restored.
+	Archive storage.Storage
+}
+
+// +kubebuilder:rbac:groups=platform.io,resources=tenantsnapshots,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=platform.io,resources=tenantsnapshots/status,verbs=get;update;patch
+
+// Reconcile takes a snapshot that has not been taken yet. Taken snapshots never change.
+func (r *TenantSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("snapshot", req.NamespacedName)
+
+	snapshot := &platformv1alpha1.TenantSnapshot{}
+	if err := r.Get(ctx, req.NamespacedName, snapshot); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if snapshot.Status.Phase == platformv1alpha1.SnapshotCompleted || snapshot.Status.Phase == platformv1alpha1.SnapshotFailed {
+		return ctrl.Result{}, nil
+	}
+	// Scheduled, deletion, and migration snapshots are taken by whoever created them
+	if snapshot.Spec.Trigger != "" && snapshot.Spec.Trigger != platformv1alpha1.SnapshotManual {
+		return ctrl.Result{}, nil
+	}
+
+	tenant := &platformv1alpha1.Tenant{}
+	if err := r.Get(ctx, client.ObjectKey{Name: snapshot.Spec.TenantName}, tenant); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		snapshot.Status.Phase = platformv1alpha1.SnapshotFailed
+		snapshot.Status.Message = fmt.Sprintf("tenant %q not found", snapshot.Spec.TenantName)
+		return ctrl.Result{}, r.Status().Update(ctx, snapshot)
+	}
+
+	if err := captureSnapshot(ctx, r.Client, r.Archive, tenant, snapshot); err != nil {
+		// Archive errors are usually transient; retry with backoff
+		log.Error(err, "failed to take snapshot", "tenant", tenant.Name)
+		snapshot.Status.Phase = platformv1alpha1.SnapshotPending
+		snapshot.Status.Message = err.Error()
+		if updateErr := r.Status().Update(ctx, snapshot); updateErr != nil {
+			log.Error(updateErr, "failed to record snapshot error")
+		}
+		return ctrl.Result{}, err
+	}
+	if err := r.Status().Update(ctx, snapshot); err != nil {
+		return ctrl.Result{}, err
+	}
+	log.Info("snapshot taken", "tenant", tenant.Name, "location", snapshot.Status.Location)
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TenantSnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&platformv1alpha1.TenantSnapshot{}).
+		Complete(r)
+}
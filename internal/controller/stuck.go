@@ -0,0 +1,208 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+	"github.com/amartyaa/tenant-master/operator/internal/notify"
+)
+
+// stuckCheckInterval is how often the StuckTenantDetector checks provisioning SLAs.
+const stuckCheckInterval = time.Minute
+
+// StuckTenantDetector flags tenants whose provisioning exceeded their tier's SLA
+// without ever reaching Ready. A flagged tenant gets the ProvisioningStuck
+// condition, a warning Event, the tenant_provisioning_stuck metric, and, when
+// escalation recipients are configured, a notification. It runs as a manager
+// Runnable, so only the elected leader alerts.
+type StuckTenantDetector struct {
+	Client   client.Client
+	Recorder record.EventRecorder
+	Notifier notify.Notifier
+	Config   *config.OperatorConfig
+	Log      logr.Logger
+}
+
+// Start checks provisioning SLAs until ctx is cancelled.
+func (d *StuckTenantDetector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(stuckCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Check(ctx, time.Now()); err != nil {
+			d.Log.Error(err, "failed to check for stuck tenants")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check flags tenants that became stuck since the last check and clears the
+// condition on tenants that are no longer stuck.
+func (d *StuckTenantDetector) Check(ctx context.Context, now time.Time) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := d.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	stuck := map[string]string{}
+	var errs []string
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		flagged := apimeta.IsStatusConditionTrue(tenant.Status.Conditions, platformv1alpha1.ConditionProvisioningStuck)
+
+		sla, isStuck := d.stuck(tenant, now)
+		var err error
+		switch {
+		case isStuck:
+			stuck[tenant.Name] = string(tenant.Spec.Tier)
+			if !flagged {
+				err = d.flag(ctx, tenant, sla, now)
+			}
+		case flagged:
+			err = d.setStuckCondition(ctx, tenant, metav1.ConditionFalse, "Resolved",
+				"tenant is no longer provisioning")
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+		}
+	}
+	metrics.SetProvisioningStuck(stuck)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("stuck tenant failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// stuck returns the tenant's provisioning SLA and whether the tenant exceeded it.
+// Only tenants that never reconciled successfully count; a Ready tenant that later
+// fails is reported through the Ready condition instead.
+func (d *StuckTenantDetector) stuck(tenant *platformv1alpha1.Tenant, now time.Time) (time.Duration, bool) {
+	sla := d.slaFor(tenant.Spec.Tier)
+	if sla <= 0 || !tenant.DeletionTimestamp.IsZero() || isPaused(tenant) {
+		return sla, false
+	}
+	if tenant.Status.ProvisioningStartTime == nil || tenant.Status.LastUpdateTime != nil {
+		return sla, false
+	}
+	if tenant.Status.State != platformv1alpha1.StateProvisioning && tenant.Status.State != platformv1alpha1.StateFailed {
+		return sla, false
+	}
+	return sla, now.Sub(tenant.Status.ProvisioningStartTime.Time) > sla
+}
+
+// slaFor returns the provisioning SLA of tier.
+func (d *StuckTenantDetector) slaFor(tier platformv1alpha1.TenantTier) time.Duration {
+	switch tier {
+	case platformv1alpha1.BronzeTier:
+		return d.Config.Stuck.BronzeSLA
+	case platformv1alpha1.SilverTier:
+		return d.Config.Stuck.SilverSLA
+	case platformv1alpha1.GoldTier:
+		return d.Config.Stuck.GoldSLA
+	case platformv1alpha1.PlatinumTier:
+		return d.Config.Stuck.PlatinumSLA
+	}
+	return 0
+}
+
+// flag escalates a newly stuck tenant and records the condition, so the Event and
+// notification are sent once per stuck period. A failed notification is retried on
+// the next check.
+func (d *StuckTenantDetector) flag(ctx context.Context, tenant *platformv1alpha1.Tenant, sla time.Duration, now time.Time) error {
+	elapsed := now.Sub(tenant.Status.ProvisioningStartTime.Time).Round(time.Second)
+	message := fmt.Sprintf("provisioning has not completed after %s (%s tier SLA %s)", elapsed, tenant.Spec.Tier, sla)
+
+	if recipients := d.Config.Stuck.EscalationRecipients; len(recipients) > 0 {
+		msg := notify.Message{
+			To:      recipients,
+			Subject: fmt.Sprintf("[tenant-master] Tenant %s is stuck provisioning", tenant.Name),
+			Body:    stuckReport(tenant, message),
+		}
+		if err := d.Notifier.Send(ctx, msg); err != nil {
+			return err
+		}
+	}
+
+	if err := d.setStuckCondition(ctx, tenant, metav1.ConditionTrue, "SLAExceeded", message); err != nil {
+		return err
+	}
+	d.Recorder.Event(tenant, corev1.EventTypeWarning, "ProvisioningStuck", message)
+	d.Log.Info("tenant stuck provisioning", "tenant", tenant.Name, "tier", tenant.Spec.Tier, "elapsed", elapsed)
+	return nil
+}
+
+// setStuckCondition patches the ProvisioningStuck condition onto the tenant status.
+func (d *StuckTenantDetector) setStuckCondition(ctx context.Context, tenant *platformv1alpha1.Tenant, status metav1.ConditionStatus, reason, message string) error {
+	patch := client.MergeFrom(tenant.DeepCopy())
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               platformv1alpha1.ConditionProvisioningStuck,
+		Status:             status,
+		ObservedGeneration: tenant.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := d.Client.Status().Patch(ctx, tenant, patch); err != nil {
+		return fmt.Errorf("failed to record %s: %w", platformv1alpha1.ConditionProvisioningStuck, err)
+	}
+	return nil
+}
+
+// stuckReport renders the escalation body: the tenant's state, last error, and the
+// other status conditions, which show the last completed provisioning step.
+func stuckReport(tenant *platformv1alpha1.Tenant, message string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Tenant %s (owner %s): %s.\n\n", tenant.Name, tenant.Spec.Owner, message)
+	fmt.Fprintf(&b, "State: %s\n", tenant.Status.State)
+	fmt.Fprintf(&b, "Provisioning started: %s\n", tenant.Status.ProvisioningStartTime.UTC().Format(time.RFC1123))
+	if progress := tenant.Status.Progress; progress != nil {
+		fmt.Fprintf(&b, "Progress: %s (%d%%)\n", progress.Summary, progress.Percent)
+	}
+	if tenant.Status.LastError != "" {
+		fmt.Fprintf(&b, "Last error: %s\n", tenant.Status.LastError)
+	}
+
+	conditions := append([]metav1.Condition(nil), tenant.Status.Conditions...)
+	sort.Slice(conditions, func(i, j int) bool { return conditions[i].Type < conditions[j].Type })
+	b.WriteString("\nConditions\n")
+	for _, c := range conditions {
+		if c.Type == platformv1alpha1.ConditionProvisioningStuck {
+			continue
+		}
+		fmt.Fprintf(&b, "  %-26s %-5s %s\n", c.Type, c.Status, c.Reason)
+	}
+	return b.String()
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// vClusterManagedByLabelKey marks the host Pods synced by a vCluster; its value is the
+// vCluster release name.
+const vClusterManagedByLabelKey = "vcluster.loft.sh/managed-by"
+
+// suspendRecheckInterval is how often a suspended tenant is checked for workloads
+// created or scaled up since it was suspended.
+const suspendRecheckInterval = 10 * time.Minute
+
+// handleSuspend scales every Deployment and StatefulSet in the tenant namespaces to zero,
+// including the vCluster control plane, and removes the Pods a vCluster synced to the
+// host. It serves both spec.suspend and open hibernation windows. Each workload keeps its previous replica count in an annotation for resume.
+func (r *TenantReconciler) handleSuspend(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) (ctrl.Result, error) {
+	namespaces, err := r.tenantNamespaces(ctx, tenant)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	scaled := 0
+	for _, ns := range namespaces {
+		n, err := r.scaleDownWorkloads(ctx, ns)
+		if err != nil {
+			metrics.ReconciliationErrors.Inc()
+			return ctrl.Result{}, fmt.Errorf("failed to scale down namespace %s: %w", ns, err)
+		}
+		scaled += n
+	}
+
+	if tenant.Spec.Tier == platformv1alpha1.GoldTier {
+		releaseName := fmt.Sprintf("%s-vcluster", tenant.Name)
+		if err := r.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(buildNamespaceName(tenant)),
+			client.MatchingLabels{vClusterManagedByLabelKey: releaseName}); client.IgnoreNotFound(err) != nil {
+			metrics.ReconciliationErrors.Inc()
+			return ctrl.Result{}, fmt.Errorf("failed to remove vCluster workloads: %w", err)
+		}
+	}
+
+	// A tenant suspended only by its hibernation schedule says so, and when it wakes up
+	reason, verb, readyMessage := "Suspended", "suspended", "tenant is suspended; workloads are scaled to zero"
+	if !tenant.Spec.Suspend {
+		reason, verb, readyMessage = "Hibernating", "hibernating", "tenant is hibernating; workloads are scaled to zero"
+		if status := tenant.Status.Hibernation; status != nil && status.NextTransitionTime != nil {
+			readyMessage = fmt.Sprintf("tenant is hibernating until %s; workloads are scaled to zero",
+				status.NextTransitionTime.UTC().Format(time.RFC3339))
+		}
+	}
+
+	if tenant.Status.State != platformv1alpha1.StateSuspended {
+		log.Info("tenant "+verb, "workloadsScaled", scaled)
+		r.recordAudit(ctx, audit.Entry{
+			Tenant:  tenant.Name,
+			Action:  audit.ActionSuspended,
+			Message: fmt.Sprintf("tenant %s; %d workloads scaled to zero", verb, scaled),
+		}, log)
+	} else if scaled > 0 {
+		log.Info("scaled down workloads started while suspended", "workloadsScaled", scaled)
+	}
+
+	previousState := tenant.Status.State
+	tenant.Status.State = platformv1alpha1.StateSuspended
+	tenant.Status.ObservedGeneration = tenant.Generation
+	r.updateResourceUsage(ctx, tenant, log)
+	setReadyCondition(tenant, metav1.ConditionFalse, reason, readyMessage)
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		log.Error(err, "failed to update status to Suspended")
+		return ctrl.Result{}, err
+	}
+	r.recordTransition(tenant, previousState, fmt.Sprintf("tenant %s; %d workloads scaled to zero", verb, scaled))
+
+	return ctrl.Result{RequeueAfter: suspendRecheckInterval}, nil
+}
+
+// workloadsSuspended reports whether the tenant's workloads are scaled to zero by
+// spec.suspend or an open hibernation window.
+func workloadsSuspended(tenant *platformv1alpha1.Tenant) bool {
+	return tenant.Spec.Suspend || (tenant.Status.Hibernation != nil && tenant.Status.Hibernation.Hibernating)
+}
+
+// resumeTenant restores the replica counts recorded when the tenant was suspended.
+// Workloads scaled up by hand in the meantime keep their new count.
+func (r *TenantReconciler) resumeTenant(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	namespaces, err := r.tenantNamespaces(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	restored := 0
+	for _, ns := range namespaces {
+		n, err := r.restoreWorkloads(ctx, ns)
+		if err != nil {
+			return fmt.Errorf("failed to restore workloads in namespace %s: %w", ns, err)
+		}
+		restored += n
+	}
+
+	log.Info("tenant resumed", "workloadsRestored", restored)
+	r.recordAudit(ctx, audit.Entry{
+		Tenant:  tenant.Name,
+		Action:  audit.ActionResumed,
+		Message: fmt.Sprintf("tenant resumed; %d workloads restored", restored),
+	}, log)
+	return nil
+}
+
+// tenantNamespaces returns the names of the tenant namespace and its environment namespaces.
+func (r *TenantReconciler) tenantNamespaces(ctx context.Context, tenant *platformv1alpha1.Tenant) ([]string, error) {
+	list := &corev1.NamespaceList{}
+	if err := r.List(ctx, list, client.MatchingLabels{TenantNameLabelKey: tenant.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list tenant namespaces: %w", err)
+	}
+	names := make([]string, 0, len(list.Items))
+	for _, ns := range list.Items {
+		names = append(names, ns.Name)
+	}
+	return names, nil
+}
+
+// scaleDownWorkloads scales the Deployments and StatefulSets of a namespace to zero and
+// returns how many it changed.
+func (r *TenantReconciler) scaleDownWorkloads(ctx context.Context, namespace string) (int, error) {
+	workloads, err := r.listWorkloads(ctx, namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	scaled := 0
+	for _, w := range workloads {
+		current := int32(1)
+		if *w.replicas != nil {
+			current = **w.replicas
+		}
+		if current == 0 {
+			continue
+		}
+
+		patch := client.MergeFrom(w.obj.DeepCopyObject().(client.Object))
+		// Keep the count from the first scale-down if the workload was scaled up while suspended
+		if _, ok := w.obj.GetAnnotations()[SuspendedReplicasAnnotation]; !ok {
+			setAnnotation(w.obj, SuspendedReplicasAnnotation, strconv.Itoa(int(current)))
+		}
+		zero := int32(0)
+		*w.replicas = &zero
+		if err := r.Patch(ctx, w.obj, patch); err != nil {
+			return scaled, fmt.Errorf("failed to scale down %s: %w", w.obj.GetName(), err)
+		}
+		scaled++
+	}
+	return scaled, nil
+}
+
+// restoreWorkloads restores the replica counts recorded by scaleDownWorkloads and returns
+// how many workloads it scaled back up.
+func (r *TenantReconciler) restoreWorkloads(ctx context.Context, namespace string) (int, error) {
+	workloads, err := r.listWorkloads(ctx, namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	restored := 0
+	for _, w := range workloads {
+		value, ok := w.obj.GetAnnotations()[SuspendedReplicasAnnotation]
+		if !ok {
+			continue
+		}
+
+		patch := client.MergeFrom(w.obj.DeepCopyObject().(client.Object))
+		setAnnotation(w.obj, SuspendedReplicasAnnotation, "")
+		previous, err := strconv.ParseInt(value, 10, 32)
+		if err == nil && *w.replicas != nil && **w.replicas == 0 {
+			replicas := int32(previous)
+			*w.replicas = &replicas
+			restored++
+		}
+		if err := r.Patch(ctx, w.obj, patch); err != nil {
+			return restored, fmt.Errorf("failed to restore %s: %w", w.obj.GetName(), err)
+		}
+	}
+	return restored, nil
+}
+
+// scalableWorkload is a Deployment or StatefulSet together with its replica field.
+type scalableWorkload struct {
+	obj      client.Object
+	replicas **int32
+}
+
+// listWorkloads returns the Deployments and StatefulSets of a namespace.
+func (r *TenantReconciler) listWorkloads(ctx context.Context, namespace string) ([]scalableWorkload, error) {
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Deployments: %w", err)
+	}
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := r.List(ctx, statefulSets, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list StatefulSets: %w", err)
+	}
+
+	workloads := make([]scalableWorkload, 0, len(deployments.Items)+len(statefulSets.Items))
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		workloads = append(workloads, scalableWorkload{obj: d, replicas: &d.Spec.Replicas})
+	}
+	for i := range statefulSets.Items {
+		ss := &statefulSets.Items[i]
+		workloads = append(workloads, scalableWorkload{obj: ss, replicas: &ss.Spec.Replicas})
+	}
+	return workloads, nil
+}
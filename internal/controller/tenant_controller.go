@@ -18,23 +18,35 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
 	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
 )
 
 // TenantReconciler reconciles a Tenant object.
@@ -42,6 +54,39 @@ type TenantReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 	Log    logr.Logger
+
+	// Audit records lifecycle events such as deletion summaries. Optional.
+	Audit *audit.Recorder
+
+	// Archive stores tenant snapshots taken before deletion outside the cluster. Optional.
+	Archive storage.Storage
+
+	// Config holds operator-wide settings. Defaults are used when nil.
+	Config *config.OperatorConfig
+
+	// Recorder emits Events on the Tenant for state transitions and provisioning
+	// failures, shown by kubectl describe. Optional.
+	Recorder record.EventRecorder
+
+	// ClusterProvider creates the dedicated clusters of Platinum tier tenants. Cluster
+	// API is used when nil.
+	ClusterProvider ClusterProvider
+
+	// RemoteClient builds a client for a cluster registered for spec.placement from its
+	// kubeconfig. Defaults to a client for the kubeconfig's current context.
+	RemoteClient func(kubeconfig []byte) (client.Client, error)
+
+	// clusterClients caches the clients of registered clusters. Set up by
+	// SetupWithManager; clients are built on every reconcile when nil.
+	clusterClients *clusterClientCache
+}
+
+// config returns the operator configuration, falling back to defaults.
+func (r *TenantReconciler) config() *config.OperatorConfig {
+	if r.Config == nil {
+		return config.Default()
+	}
+	return r.Config
 }
 
 // +kubebuilder:rbac:groups=platform.io,resources=tenants,verbs=get;list;watch;create;update;patch;delete
@@ -49,12 +94,20 @@ type TenantReconciler struct {
 // +kubebuilder:rbac:groups=platform.io,resources=tenants/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=escalate;bind
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=limitranges,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=deletecollection
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 
 // Reconcile implements the reconciliation loop for a Tenant.
 func (r *TenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -63,12 +116,38 @@ func (r *TenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// Fetch the Tenant object
 	tenant := &platformv1alpha1.Tenant{}
 	if err := r.Get(ctx, req.NamespacedName, tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.DeleteTenantInfo(req.Name)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	// Publish tenant_info with whatever state this reconcile leaves behind
+	defer recordTenantInfo(tenant)
+	previousState := tenant.Status.State
+
+	// Leave the tenant untouched during incident response or manual surgery
+	if isPaused(tenant) {
+		return r.handlePaused(ctx, tenant, log)
+	}
+	if err := r.clearPausedCondition(ctx, tenant); err != nil {
+		log.Error(err, "failed to clear ReconciliationPaused condition")
+		return ctrl.Result{}, err
+	}
+
 	// Record start time for metrics
 	startTime := time.Now()
 
+	// Provision on the cluster selected by spec.placement; the Tenant itself stays here
+	placed, err := r.forPlacement(ctx, tenant, log)
+	if err != nil {
+		if !tenant.DeletionTimestamp.IsZero() {
+			return ctrl.Result{}, err
+		}
+		return r.failReconcile(ctx, tenant, previousState, err, log)
+	}
+	r = placed
+
 	// Handle deletion
 	if !tenant.DeletionTimestamp.IsZero() {
 		return r.handleDeletion(ctx, tenant, log)
@@ -85,46 +164,70 @@ func (r *TenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// Update status to Provisioning if not yet started
+	// The start time is kept across restarts so provisioning duration stays accurate
 	if tenant.Status.State == "" {
 		tenant.Status.State = platformv1alpha1.StateProvisioning
-		tenant.Status.ProvisioningStartTime = &metav1.Time{Time: time.Now()}
+		if tenant.Status.ProvisioningStartTime == nil {
+			tenant.Status.ProvisioningStartTime = &metav1.Time{Time: time.Now()}
+		}
+		setAppliedTemplates(tenant)
+		setProgress(tenant)
 		if err := r.Status().Update(ctx, tenant); err != nil {
 			log.Error(err, "failed to update status to Provisioning")
 			metrics.ReconciliationErrors.Inc()
 			return ctrl.Result{Requeue: true}, err
 		}
+		r.recordTransition(tenant, previousState, fmt.Sprintf("provisioning %s tier tenant", tenant.Spec.Tier))
+		previousState = tenant.Status.State
 	}
 
-	// Main reconciliation logic based on tier
-	var reconcileErr error
-	switch tenant.Spec.Tier {
-	case platformv1alpha1.SilverTier:
-		reconcileErr = r.reconcileSilverTier(ctx, tenant, log)
-	case platformv1alpha1.GoldTier:
-		reconcileErr = r.reconcileGoldTier(ctx, tenant, log)
-	case platformv1alpha1.BronzeTier:
-		// Bronze tier: minimal isolation, placeholder for future implementation
-		log.Info("Bronze tier provisioning (minimal isolation)", "tenant", tenant.Name)
-		tenant.Status.State = platformv1alpha1.StateReady
-	default:
-		reconcileErr = fmt.Errorf("unknown tier: %s", tenant.Spec.Tier)
+	// Scale a suspended or hibernating tenant to zero instead of provisioning it, and
+	// restore its workloads once spec.suspend is cleared and the hibernation window closed
+	if hibernating := updateHibernation(tenant, time.Now()); tenant.Spec.Suspend || hibernating {
+		result, err := r.handleSuspend(ctx, tenant, log)
+		if wake := hibernationRequeueAfter(tenant); err == nil && wake > 0 && wake < result.RequeueAfter {
+			result.RequeueAfter = wake
+		}
+		return result, err
+	}
+	if tenant.Status.State == platformv1alpha1.StateSuspended {
+		if err := r.resumeTenant(ctx, tenant, log); err != nil {
+			log.Error(err, "failed to resume tenant")
+			r.event(tenant, corev1.EventTypeWarning, "ResumeFailed", err.Error())
+			metrics.ReconciliationErrors.Inc()
+			return ctrl.Result{}, err
+		}
 	}
 
+	// Provision the tier's resources with the provisioner registered for it
+	reconcileErr := r.provisionTier(ctx, tenant, log)
+
 	// Record provisioning time metric
 	provisioningTime := time.Since(startTime).Seconds()
 	metrics.RecordProvisioningTime(string(tenant.Spec.Tier), provisioningTime)
 
 	// Update status based on reconciliation result
 	if reconcileErr != nil {
-		log.Error(reconcileErr, "reconciliation failed")
-		tenant.Status.State = platformv1alpha1.StateFailed
-		tenant.Status.LastError = reconcileErr.Error()
-		metrics.ReconciliationErrors.Inc()
-		if err := r.Status().Update(ctx, tenant); err != nil {
-			log.Error(err, "failed to update status to Failed")
-		}
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, reconcileErr
+		return r.failReconcile(ctx, tenant, previousState, reconcileErr, log)
+	}
+
+	// Record references to every child object for tooling and the console
+	r.updateManagedResources(ctx, tenant, log)
+	r.updateResourceUsage(ctx, tenant, log)
+
+	transition := "all tenant resources are provisioned"
+	switch {
+	case vClusterPending(tenant):
+		transition = "waiting for the vCluster to become ready"
+		setReadyCondition(tenant, metav1.ConditionFalse, "VClusterNotReady", transition)
+	case clusterPending(tenant):
+		transition = "waiting for the dedicated cluster to be provisioned"
+		setReadyCondition(tenant, metav1.ConditionFalse, "ClusterNotReady", transition)
+	default:
+		setReadyCondition(tenant, metav1.ConditionTrue, "Provisioned", transition)
 	}
+	setAppliedTemplates(tenant)
+	setProgress(tenant)
 
 	// Update last update time and observed generation
 	tenant.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
@@ -134,43 +237,155 @@ func (r *TenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		metrics.ReconciliationErrors.Inc()
 		return ctrl.Result{Requeue: true}, err
 	}
+	r.recordTransition(tenant, previousState, transition)
 
 	metrics.RecordActiveTenant(string(tenant.Spec.Tier))
 	log.Info("reconciliation completed successfully", "state", tenant.Status.State)
-	return ctrl.Result{}, nil
+
+	// Come back when an active quota boost is due to be reverted, the kubeconfig is
+	// due for renewal or waiting for a certificate, the vCluster or dedicated cluster is
+	// still starting, the next hibernation window opens, or the periodic resync is due,
+	// whichever is first
+	after := burstRequeueAfter(tenant)
+	if wake := hibernationRequeueAfter(tenant); wake > 0 && (after == 0 || wake < after) {
+		after = wake
+	}
+	if renew := r.kubeconfigRequeueAfter(tenant); renew > 0 && (after == 0 || renew < after) {
+		after = renew
+	}
+	pending := r.config().Requeue.Pending
+	if (vClusterPending(tenant) || clusterPending(tenant)) && pending > 0 && (after == 0 || pending < after) {
+		after = pending
+	}
+	if resync := r.config().Requeue.Resync; resync > 0 && (after == 0 || resync < after) {
+		after = resync
+	}
+	return ctrl.Result{RequeueAfter: after}, nil
+}
+
+// failReconcile records a failed reconcile in the tenant status and requeues according
+// to the error class.
+func (r *TenantReconciler) failReconcile(ctx context.Context, tenant *platformv1alpha1.Tenant, previousState platformv1alpha1.TenantState, reconcileErr error, log logr.Logger) (ctrl.Result, error) {
+	log.Error(reconcileErr, "reconciliation failed", "errorClass", classifyError(reconcileErr))
+	tenant.Status.State = platformv1alpha1.StateFailed
+	tenant.Status.LastError = reconcileErr.Error()
+	setReadyCondition(tenant, metav1.ConditionFalse, string(classifyError(reconcileErr))+"Error", reconcileErr.Error())
+	setProgress(tenant)
+	metrics.ReconciliationErrors.Inc()
+	r.event(tenant, corev1.EventTypeWarning, "ReconcileFailed", reconcileErr.Error())
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		log.Error(err, "failed to update status to Failed")
+	} else {
+		r.recordTransition(tenant, previousState, fmt.Sprintf("provisioning failed with a %s error", classifyError(reconcileErr)))
+	}
+	return resultForError(r.config().Requeue, reconcileErr)
 }
 
 // reconcileSilverTier handles the Silver tier provisioning (namespace-isolated).
 func (r *TenantReconciler) reconcileSilverTier(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	// Refuse names that collide with system namespaces or other tenants' namespaces
+	if err := r.validateNamespaceNames(ctx, tenant); err != nil {
+		setResourceCondition(tenant, platformv1alpha1.ConditionNamespaceReady, "NameConflict", err)
+		return err
+	}
+
+	// Clean up the shared namespace after an upgrade from Bronze
+	if tenant.Status.Namespace == BronzeNamespace {
+		if err := r.removeBronzeResources(ctx, tenant, log); err != nil {
+			return fmt.Errorf("bronze resource cleanup failed: %w", err)
+		}
+	}
+
 	// Create namespace
-	if err := r.ensureNamespace(ctx, tenant, log); err != nil {
+	err := r.ensureNamespace(ctx, tenant, log)
+	setResourceCondition(tenant, platformv1alpha1.ConditionNamespaceReady, "CreateFailed", err)
+	if err != nil {
 		return fmt.Errorf("namespace creation failed: %w", err)
 	}
 
-	// Propagate secrets and ConfigMaps (E1-05)
+	// Propagate Secrets and ConfigMaps labeled for propagation (E1-05)
 	if err := r.ensureSecretsAndConfigMaps(ctx, tenant, log); err != nil {
 		return fmt.Errorf("secret/ConfigMap propagation failed: %w", err)
 	}
 
+	// Restore the namespace contents of spec.restoreFrom into the new namespace
+	if err := r.restoreSnapshot(ctx, tenant, log); err != nil {
+		return fmt.Errorf("snapshot restore failed: %w", err)
+	}
+
+	// Revoke previously issued credentials when a rotation was requested
+	if err := r.rotateCredentials(ctx, tenant, log); err != nil {
+		return fmt.Errorf("credential rotation failed: %w", err)
+	}
+
+	// Apply or revert time-boxed quota boosts before rendering the quota
+	r.reconcileBurst(ctx, tenant, log)
+
 	// Create ResourceQuota
 	if err := r.ensureResourceQuota(ctx, tenant, log); err != nil {
+		setResourceCondition(tenant, platformv1alpha1.ConditionQuotaReady, "CreateFailed", err)
 		return fmt.Errorf("resource quota creation failed: %w", err)
 	}
 
+	// Create PriorityClass-scoped quotas (burst pools inside the tenant)
+	err = r.ensurePriorityClassQuotas(ctx, tenant, log)
+	setResourceCondition(tenant, platformv1alpha1.ConditionQuotaReady, "CreateFailed", err)
+	if err != nil {
+		return fmt.Errorf("priority class quota creation failed: %w", err)
+	}
+
 	// Create RBAC (ServiceAccount + RoleBinding)
-	if err := r.ensureRBAC(ctx, tenant, log); err != nil {
+	err = r.ensureRBAC(ctx, tenant, log)
+	setResourceCondition(tenant, platformv1alpha1.ConditionRBACReady, "CreateFailed", err)
+	if err != nil {
 		return fmt.Errorf("RBAC creation failed: %w", err)
 	}
 
 	// Create default-deny NetworkPolicy
-	if err := r.ensureNetworkPolicy(ctx, tenant, log); err != nil {
+	err = r.ensureNetworkPolicy(ctx, tenant, log)
+	setResourceCondition(tenant, platformv1alpha1.ConditionNetworkPolicyReady, "CreateFailed", err)
+	if err != nil {
 		return fmt.Errorf("network policy creation failed: %w", err)
 	}
 
-	// Detect and correct NetworkPolicy drift (E1-06)
-	if err := r.detectAndCorrectNetworkPolicyDrift(ctx, tenant, log); err != nil {
-		log.Error(err, "drift detection failed (non-fatal)")
-		// Non-fatal: continue with reconciliation
+	// Create per-environment namespaces (dev/staging/prod)
+	if err := r.ensureEnvironments(ctx, tenant, log); err != nil {
+		return fmt.Errorf("environment provisioning failed: %w", err)
+	}
+
+	// Enroll the tenant namespaces in the service mesh
+	if err := r.ensureMesh(ctx, tenant, log); err != nil {
+		return fmt.Errorf("service mesh setup failed: %w", err)
+	}
+
+	// Scrape the tenant's Services and alert on quota pressure and crashloops
+	if err := r.ensureMonitoring(ctx, tenant, log); err != nil {
+		return fmt.Errorf("monitoring setup failed: %w", err)
+	}
+
+	// Route namespace logs to the tenant's log stream
+	if err := r.ensureLogRouting(ctx, tenant, log); err != nil {
+		return fmt.Errorf("log routing failed: %w", err)
+	}
+
+	// Hand the tenant namespaces to Argo CD or Flux
+	if err := r.ensureGitOps(ctx, tenant, log); err != nil {
+		return fmt.Errorf("GitOps setup failed: %w", err)
+	}
+
+	// Issue the namespace-scoped kubeconfig; Gold tenants export the vCluster's instead.
+	// Failures are retried without failing the tenant, whose namespace is already usable.
+	if tenant.Spec.Tier == platformv1alpha1.SilverTier {
+		err := r.ensureServiceAccountKubeconfig(ctx, tenant, log)
+		setResourceCondition(tenant, platformv1alpha1.ConditionKubeconfigAvailable, "IssueFailed", err)
+		if err != nil {
+			log.Error(err, "kubeconfig issuance failed (non-fatal)")
+		}
+	}
+
+	if err := r.completeStep(ctx, tenant, platformv1alpha1.ConditionBaseResourcesProvisioned,
+		"namespace, quota, RBAC, and network policies are provisioned"); err != nil {
+		return err
 	}
 
 	tenant.Status.State = platformv1alpha1.StateReady
@@ -186,55 +401,223 @@ func (r *TenantReconciler) reconcileGoldTier(ctx context.Context, tenant *platfo
 
 	// Deploy vCluster via Helm
 	if err := r.ensureVCluster(ctx, tenant, log); err != nil {
+		failStep(tenant, platformv1alpha1.ConditionVClusterDeployed, "DeploymentFailed", err)
+		setResourceCondition(tenant, platformv1alpha1.ConditionVClusterReady, "DeploymentFailed", err)
 		return fmt.Errorf("vCluster deployment failed: %w", err)
 	}
+	setVClusterReadyCondition(tenant)
+
+	// Stay Provisioning and check again later instead of holding a worker while the
+	// vCluster starts; its kubeconfig only exists once it runs
+	if !stepCompleted(tenant, platformv1alpha1.ConditionVClusterDeployed) {
+		tenant.Status.State = platformv1alpha1.StateProvisioning
+		return nil
+	}
 
 	// Retrieve and store kubeconfig
 	if err := r.ensureKubeconfigSecret(ctx, tenant, log); err != nil {
+		failStep(tenant, platformv1alpha1.ConditionKubeconfigAvailable, "RetrievalFailed", err)
 		return fmt.Errorf("kubeconfig retrieval failed: %w", err)
 	}
+	if err := r.completeStep(ctx, tenant, platformv1alpha1.ConditionKubeconfigAvailable,
+		fmt.Sprintf("kubeconfig stored in Secret %s", tenant.Status.AdminKubeconfigSecret)); err != nil {
+		return err
+	}
 
 	tenant.Status.State = platformv1alpha1.StateReady
 	return nil
 }
 
-// handleDeletion handles the Tenant deletion lifecycle (finalizers).
+// handleDeletion handles the Tenant deletion lifecycle (finalizers). The finalizer is
+// only removed once the deletion snapshot completed and the tenant namespaces are gone;
+// until then the tenant stays Terminating and is requeued. Tenants with
+// spec.deletionProtection are not torn down at all.
 func (r *TenantReconciler) handleDeletion(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) (ctrl.Result, error) {
-	if controllerutil.ContainsFinalizer(tenant, TenantFinalizerName) {
+	if !controllerutil.ContainsFinalizer(tenant, TenantFinalizerName) {
+		return ctrl.Result{}, nil
+	}
+	// The webhook rejects deleting a protected tenant; refuse teardown if it was bypassed.
+	// Clearing the flag changes the spec, which triggers a reconcile
+	if tenant.Spec.DeletionProtection {
+		return ctrl.Result{}, r.refuseDeletion(ctx, tenant, log)
+	}
+
+	if tenant.Status.State != platformv1alpha1.StateTerminating {
+		previousState := tenant.Status.State
+		// Inventory child resources once, before they are deleted
+		resources, err := r.listManagedResources(ctx, tenant)
+		if err != nil {
+			log.Error(err, "failed to inventory tenant resources (non-fatal)")
+		} else {
+			tenant.Status.ManagedResources = resources
+		}
 		tenant.Status.State = platformv1alpha1.StateTerminating
+		setReadyCondition(tenant, metav1.ConditionFalse, "Terminating", "tenant is being deleted")
 		if err := r.Status().Update(ctx, tenant); err != nil {
 			log.Error(err, "failed to update status to Terminating")
+			return ctrl.Result{}, err
 		}
+		r.recordTransition(tenant, previousState, "tenant is being deleted")
+	}
 
-		// Take snapshot before deletion (E3-04)
-		if err := r.takeSnapshotBeforeDeletion(ctx, tenant, log); err != nil {
-			log.Error(err, "snapshot creation failed (non-fatal), proceeding with deletion")
-		}
-
-		// Execute cleanup logic (namespace deletion is handled by OwnerReferences)
-		log.Info("cleaning up tenant resources", "tenant", tenant.Name)
+	// Take snapshot before deletion (E3-04); nothing is deleted until it completed
+	snapshot, err := r.takeSnapshotBeforeDeletion(ctx, tenant, log)
+	if err != nil {
+		log.Error(err, "snapshot before deletion failed, retrying")
+		r.event(tenant, corev1.EventTypeWarning, "SnapshotFailed", err.Error())
+		r.setTerminatingMessage(ctx, tenant, fmt.Sprintf("waiting for the deletion snapshot: %v", err), log)
+		return ctrl.Result{}, err
+	}
 
-		// Remove finalizer
-		controllerutil.RemoveFinalizer(tenant, TenantFinalizerName)
-		if err := r.Update(ctx, tenant); err != nil {
-			log.Error(err, "failed to remove finalizer")
+	// Release what the tier provisioned outside the tenant namespaces first
+	if done, err := r.deprovisionTier(ctx, tenant, log); err != nil || !done {
+		if err != nil {
 			return ctrl.Result{}, err
 		}
+		r.setTerminatingMessage(ctx, tenant, fmt.Sprintf("waiting for the %s tier resources to be released", tenant.Spec.Tier), log)
+		return ctrl.Result{RequeueAfter: namespaceTeardownRecheckInterval}, nil
 	}
 
+	// Owner references cannot garbage collect the namespaces while the finalizer is set
+	remaining, err := r.deleteTenantNamespaces(ctx, tenant, log)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(remaining) > 0 {
+		log.Info("waiting for tenant namespaces to terminate", "namespaces", remaining)
+		r.setTerminatingMessage(ctx, tenant,
+			fmt.Sprintf("waiting for namespaces to terminate: %s", strings.Join(remaining, ", ")), log)
+		return ctrl.Result{RequeueAfter: namespaceTeardownRecheckInterval}, nil
+	}
+
+	summary := newDeletionSummary(tenant)
+	for _, res := range tenant.Status.ManagedResources {
+		summary.ResourcesDeleted = append(summary.ResourcesDeleted, res.String())
+	}
+	summary.Snapshot = snapshot.Name
+	if !snapshot.Restorable() {
+		summary.warn("snapshot %s has no archived copy and cannot be restored", snapshot.Name)
+	}
+
+	// Remove finalizer
+	controllerutil.RemoveFinalizer(tenant, TenantFinalizerName)
+	if err := r.Update(ctx, tenant); err != nil {
+		log.Error(err, "failed to remove finalizer")
+		return ctrl.Result{}, err
+	}
+
+	summary.complete()
+	r.recordDeletionSummary(ctx, summary, log)
 	return ctrl.Result{}, nil
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// isPaused reports whether reconciliation of the tenant is paused.
+func isPaused(tenant *platformv1alpha1.Tenant) bool {
+	return tenant.Annotations[PausedAnnotation] == "true"
+}
+
+// handlePaused records the ReconciliationPaused condition and otherwise leaves the
+// tenant and its child objects alone. Removing the annotation triggers a reconcile.
+func (r *TenantReconciler) handlePaused(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) (ctrl.Result, error) {
+	log.Info("reconciliation paused", "annotation", PausedAnnotation)
+	if apimeta.IsStatusConditionTrue(tenant.Status.Conditions, platformv1alpha1.ConditionReconciliationPaused) {
+		return ctrl.Result{}, nil
+	}
+
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               platformv1alpha1.ConditionReconciliationPaused,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: tenant.Generation,
+		Reason:             "PausedByAnnotation",
+		Message:            fmt.Sprintf("reconciliation is paused until the %s annotation is removed", PausedAnnotation),
+	})
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		log.Error(err, "failed to set ReconciliationPaused condition")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// clearPausedCondition marks a previously paused tenant as resumed.
+func (r *TenantReconciler) clearPausedCondition(ctx context.Context, tenant *platformv1alpha1.Tenant) error {
+	if !apimeta.IsStatusConditionTrue(tenant.Status.Conditions, platformv1alpha1.ConditionReconciliationPaused) {
+		return nil
+	}
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               platformv1alpha1.ConditionReconciliationPaused,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: tenant.Generation,
+		Reason:             "Resumed",
+		Message:            "reconciliation resumed",
+	})
+	return r.Status().Update(ctx, tenant)
+}
+
+// setReadyCondition records the Ready condition for the tenant's current generation.
+func setReadyCondition(tenant *platformv1alpha1.Tenant, status metav1.ConditionStatus, reason, message string) {
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               platformv1alpha1.ConditionReady,
+		Status:             status,
+		ObservedGeneration: tenant.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// recordTransition emits an Event named after the tenant's state when it differs from
+// the state the tenant had before; Failed is reported as a warning.
+func (r *TenantReconciler) recordTransition(tenant *platformv1alpha1.Tenant, from platformv1alpha1.TenantState, message string) {
+	if tenant.Status.State == from {
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	if tenant.Status.State == platformv1alpha1.StateFailed {
+		eventType = corev1.EventTypeWarning
+	}
+	r.event(tenant, eventType, string(tenant.Status.State), message)
+}
+
+// event emits an Event on the tenant when a recorder is configured.
+func (r *TenantReconciler) event(tenant *platformv1alpha1.Tenant, eventType, reason, message string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(tenant, eventType, reason, message)
+}
+
+// vClusterPending reports whether a Gold tier tenant is provisioned except for its
+// vCluster, which is still starting.
+func vClusterPending(tenant *platformv1alpha1.Tenant) bool {
+	return tenant.Spec.Tier == platformv1alpha1.GoldTier && tenant.Status.State == platformv1alpha1.StateProvisioning &&
+		!stepCompleted(tenant, platformv1alpha1.ConditionVClusterDeployed)
+}
+
+// setVClusterReadyCondition mirrors the outcome of the vCluster readiness check, which
+// does not fail the reconcile, into the VClusterReady condition.
+func setVClusterReadyCondition(tenant *platformv1alpha1.Tenant) {
+	var err error
+	if !stepCompleted(tenant, platformv1alpha1.ConditionVClusterDeployed) {
+		err = errors.New("vCluster is not ready yet")
+		if cond := apimeta.FindStatusCondition(tenant.Status.Conditions, platformv1alpha1.ConditionVClusterDeployed); cond != nil {
+			err = errors.New(cond.Message)
+		}
+	}
+	setResourceCondition(tenant, platformv1alpha1.ConditionVClusterReady, "NotReady", err)
+}
+
+// recordTenantInfo publishes the tenant_info series for the tenant.
+func recordTenantInfo(tenant *platformv1alpha1.Tenant) {
+	metrics.SetTenantInfo(tenant.Name, string(tenant.Spec.Tier), tenant.Spec.Owner,
+		tenant.Status.Namespace, string(tenant.Status.State), tenant.Spec.Suspend)
+}
+
+// SetupWithManager sets up the controller with the Manager. Changes to, or deletion of,
+// the child objects the tenant owns trigger a reconcile that reverts them, and changes to
+// propagated Secrets and ConfigMaps reconcile every tenant they are copied to.
 func (r *TenantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.clusterClients = newClusterClientCache()
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&platformv1alpha1.Tenant{}).
-		Owns(&corev1.Namespace{}).
-		Owns(&corev1.Secret{}).
-		WithOptions(controller.Options{
-			MaxConcurrentReconciles: 3,
-		}).
-		WithEventFilter(predicate.Funcs{
+		For(&platformv1alpha1.Tenant{}, builder.WithPredicates(predicate.Funcs{
 			UpdateFunc: func(e event.UpdateEvent) bool {
 				// Only reconcile if spec or deletion timestamp changed
 				oldTenant := e.ObjectOld.(*platformv1alpha1.Tenant)
@@ -253,8 +636,28 @@ func (r *TenantReconciler) SetupWithManager(mgr ctrl.Manager) error {
 					}
 				}
 
-				return specChanged || deletionChanged
+				// Credential rotation is requested through an annotation
+				rotationRequested := oldTenant.Annotations[RotateCredentialsAnnotation] !=
+					newTenant.Annotations[RotateCredentialsAnnotation]
+
+				// Pausing and resuming are requested through an annotation
+				pauseChanged := isPaused(oldTenant) != isPaused(newTenant)
+
+				return specChanged || deletionChanged || rotationRequested || pauseChanged
 			},
+		})).
+		Owns(&corev1.Namespace{}).
+		Owns(&corev1.Secret{}).
+		Owns(&corev1.ResourceQuota{}).
+		Owns(&netv1.NetworkPolicy{}).
+		Owns(&rbacv1.Role{}).
+		Owns(&rbacv1.RoleBinding{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.PropagationSourceRequests),
+			builder.WithPredicates(propagationSourcePredicate)).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.PropagationSourceRequests),
+			builder.WithPredicates(propagationSourcePredicate)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 3,
 		}).
 		Complete(r)
 }
@@ -0,0 +1,219 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// errMemberConflict is returned for a member whose name is taken by a Tenant the set
+// does not control.
+var errMemberConflict = errors.New("tenant exists and is not controlled by this set")
+
+// TenantSetReconciler fans out a TenantSet into individual Tenant objects.
+type TenantSetReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+}
+
+// +kubebuilder:rbac:groups=platform.io,resources=tenantsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=platform.io,resources=tenantsets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=platform.io,resources=tenantsets/finalizers,verbs=update
+
+// Reconcile implements the reconciliation loop for a TenantSet.
+func (r *TenantSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("tenantset", req.NamespacedName)
+
+	set := &platformv1alpha1.TenantSet{}
+	if err := r.Get(ctx, req.NamespacedName, set); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Owned Tenants are garbage collected through their OwnerReferences
+	if !set.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Create or update one Tenant per member
+	desired := make(map[string]bool, len(set.Spec.Tenants))
+	var errs []error
+	var conflicts []string
+	for _, member := range set.Spec.Tenants {
+		desired[member.Name] = true
+		err := r.ensureMemberTenant(ctx, set, member, log)
+		switch {
+		case errors.Is(err, errMemberConflict):
+			log.Info("member name is taken by a tenant not controlled by this set", "tenant", member.Name)
+			conflicts = append(conflicts, member.Name)
+		case err != nil:
+			log.Error(err, "failed to ensure member tenant", "tenant", member.Name)
+			errs = append(errs, fmt.Errorf("tenant %s: %w", member.Name, err))
+		}
+	}
+	sort.Strings(conflicts)
+
+	// Prune Tenants that were removed from the member list
+	owned := &platformv1alpha1.TenantList{}
+	if err := r.List(ctx, owned, client.MatchingLabels{TenantSetLabelKey: set.Name}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list member tenants: %w", err)
+	}
+
+	var replicas, ready, failed int32
+	var failedTenants []string
+	for i := range owned.Items {
+		tenant := &owned.Items[i]
+		if !metav1.IsControlledBy(tenant, set) {
+			continue
+		}
+
+		if !desired[tenant.Name] {
+			log.Info("pruning tenant removed from set", "tenant", tenant.Name)
+			if err := r.Delete(ctx, tenant); client.IgnoreNotFound(err) != nil {
+				errs = append(errs, fmt.Errorf("failed to prune tenant %s: %w", tenant.Name, err))
+			}
+			continue
+		}
+
+		replicas++
+		switch tenant.Status.State {
+		case platformv1alpha1.StateReady:
+			ready++
+		case platformv1alpha1.StateFailed:
+			failed++
+			failedTenants = append(failedTenants, tenant.Name)
+		}
+	}
+	sort.Strings(failedTenants)
+
+	set.Status.Replicas = replicas
+	set.Status.ReadyReplicas = ready
+	set.Status.FailedReplicas = failed
+	set.Status.FailedTenants = failedTenants
+	set.Status.ConflictingTenants = conflicts
+	set.Status.ObservedGeneration = set.Generation
+	if err := r.Status().Update(ctx, set); err != nil {
+		log.Error(err, "failed to update TenantSet status")
+		return ctrl.Result{Requeue: true}, err
+	}
+
+	if len(errs) > 0 {
+		metrics.ReconciliationErrors.Inc()
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, errors.Join(errs...)
+	}
+
+	// Conflicting Tenants are not owned, so their deletion does not trigger a reconcile
+	if len(conflicts) > 0 {
+		log.Info("tenantset reconciled with conflicting members", "conflicting", conflicts)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	log.Info("tenantset reconciled", "replicas", replicas, "ready", ready, "failed", failed)
+	return ctrl.Result{}, nil
+}
+
+// ensureMemberTenant creates or updates the Tenant for a single TenantSet member. An
+// existing Tenant the set does not control is never adopted, and errMemberConflict is
+// returned. The member spec is only written when it differs from the one last written,
+// recorded in TenantSetSpecHashAnnotation, so webhook defaults on it are kept.
+func (r *TenantSetReconciler) ensureMemberTenant(ctx context.Context, set *platformv1alpha1.TenantSet, member platformv1alpha1.TenantSetMember, log logr.Logger) error {
+	spec := set.Spec.Template.Spec.DeepCopy()
+	if member.Owner != "" {
+		spec.Owner = member.Owner
+	}
+	hash, err := memberSpecHash(spec)
+	if err != nil {
+		return err
+	}
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: member.Name,
+		},
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, tenant, func() error {
+		if tenant.ResourceVersion != "" && !metav1.IsControlledBy(tenant, set) {
+			return errMemberConflict
+		}
+
+		if tenant.Labels == nil {
+			tenant.Labels = map[string]string{}
+		}
+		for k, v := range set.Spec.Template.Labels {
+			tenant.Labels[k] = v
+		}
+		tenant.Labels[TenantSetLabelKey] = set.Name
+
+		if len(set.Spec.Template.Annotations) > 0 {
+			if tenant.Annotations == nil {
+				tenant.Annotations = map[string]string{}
+			}
+			for k, v := range set.Spec.Template.Annotations {
+				tenant.Annotations[k] = v
+			}
+		}
+
+		if tenant.Annotations[TenantSetSpecHashAnnotation] != hash {
+			tenant.Spec = *spec
+			setAnnotation(tenant, TenantSetSpecHashAnnotation, hash)
+		}
+
+		return controllerutil.SetControllerReference(set, tenant, r.Scheme)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.V(1).Info("ensured member tenant", "tenant", member.Name, "operation", result)
+	return nil
+}
+
+// memberSpecHash hashes the spec a TenantSet writes to a member Tenant.
+func memberSpecHash(spec *platformv1alpha1.TenantSpec) (string, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash member spec: %w", err)
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TenantSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&platformv1alpha1.TenantSet{}).
+		Owns(&platformv1alpha1.Tenant{}).
+		Complete(r)
+}
@@ -0,0 +1,139 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestAccessRequestGrantAndExpiry verifies that break-glass access is granted and revoked at expiry.
+func TestAccessRequestGrantAndExpiry(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "owner@example.com"},
+	}
+	ar := &platformv1alpha1.TenantAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "inc-1234"},
+		Spec: platformv1alpha1.TenantAccessRequestSpec{
+			TenantRef: "acme",
+			Subject:   platformv1alpha1.AccessSubject{Kind: "User", Name: "oncall@example.com"},
+			Duration:  metav1.Duration{Duration: time.Hour},
+			Reason:    "INC-1234",
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, ar).
+		WithStatusSubresource(&platformv1alpha1.TenantAccessRequest{}).
+		Build()
+
+	r := &controller.TenantAccessRequestReconciler{
+		Client: cl,
+		Scheme: s,
+		Log:    logr.Discard(),
+		Audit:  &audit.Recorder{Client: cl, Namespace: controller.OperatorNamespace},
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "inc-1234"}}
+	rbKey := types.NamespacedName{Namespace: "tenant-acme", Name: "breakglass-inc-1234"}
+
+	// Grant
+	res, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, res.RequeueAfter)
+
+	rb := &rbacv1.RoleBinding{}
+	require.NoError(t, cl.Get(ctx, rbKey, rb))
+	assert.Equal(t, "admin", rb.RoleRef.Name)
+	require.Len(t, rb.Subjects, 1)
+	assert.Equal(t, "oncall@example.com", rb.Subjects[0].Name)
+
+	current := &platformv1alpha1.TenantAccessRequest{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.AccessRequestActive, current.Status.Phase)
+
+	// Expire
+	current.Status.ExpiresAt = &metav1.Time{Time: time.Now().Add(-time.Second)}
+	require.NoError(t, cl.Status().Update(ctx, current))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	err = cl.Get(ctx, rbKey, rb)
+	assert.True(t, apierrors.IsNotFound(err))
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.AccessRequestExpired, current.Status.Phase)
+}
+
+// TestAccessRequestDeniedForClusterAdmin verifies that cluster-admin cannot be requested.
+func TestAccessRequestDeniedForClusterAdmin(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	ar := &platformv1alpha1.TenantAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "too-much"},
+		Spec: platformv1alpha1.TenantAccessRequestSpec{
+			TenantRef:   "acme",
+			Subject:     platformv1alpha1.AccessSubject{Kind: "User", Name: "oncall@example.com"},
+			ClusterRole: "cluster-admin",
+			Duration:    metav1.Duration{Duration: time.Hour},
+			Reason:      "because",
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(ar).
+		WithStatusSubresource(&platformv1alpha1.TenantAccessRequest{}).
+		Build()
+
+	r := &controller.TenantAccessRequestReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "too-much"}})
+	require.NoError(t, err)
+
+	current := &platformv1alpha1.TenantAccessRequest{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "too-much"}, current))
+	assert.Equal(t, platformv1alpha1.AccessRequestDenied, current.Status.Phase)
+}
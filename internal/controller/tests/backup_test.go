@@ -0,0 +1,157 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestScheduledBackups verifies that a tenant due a backup gets a scheduled snapshot,
+// that only the newest completed scheduled snapshots within the retention are kept, and
+// that the outcome and next run are recorded in status.backup.
+func TestScheduledBackups(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	lastBackup := metav1.NewTime(now.Add(-26 * time.Hour))
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", CreationTimestamp: metav1.NewTime(now.Add(-30 * 24 * time.Hour))},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:   platformv1alpha1.SilverTier,
+			Owner:  "admin@example.com",
+			Backup: &platformv1alpha1.BackupConfig{Schedule: "0 2 * * *", Retention: 2},
+		},
+		Status: platformv1alpha1.TenantStatus{
+			Namespace: "tenant-acme",
+			Backup:    &platformv1alpha1.BackupStatus{LastBackupTime: &lastBackup},
+		},
+	}
+	archive := &storage.Filesystem{Root: t.TempDir()}
+	snapshot := func(name string, trigger platformv1alpha1.SnapshotTrigger, phase platformv1alpha1.SnapshotPhase, age time.Duration) *platformv1alpha1.TenantSnapshot {
+		takenAt := metav1.NewTime(now.Add(-age))
+		location := "snapshots/acme/" + name + ".json"
+		require.NoError(t, archive.Put(ctx, location, []byte("{}")))
+		return &platformv1alpha1.TenantSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{controller.TenantNameLabelKey: "acme"}},
+			Spec:       platformv1alpha1.TenantSnapshotSpec{TenantName: "acme", Trigger: trigger},
+			Status:     platformv1alpha1.TenantSnapshotStatus{Phase: phase, TakenAt: &takenAt, Location: location},
+		}
+	}
+	oldest := snapshot("scheduled-oldest", platformv1alpha1.SnapshotScheduled, platformv1alpha1.SnapshotCompleted, 74*time.Hour)
+	previous := snapshot("scheduled-previous", platformv1alpha1.SnapshotScheduled, platformv1alpha1.SnapshotCompleted, 50*time.Hour)
+	failed := snapshot("scheduled-failed", platformv1alpha1.SnapshotScheduled, platformv1alpha1.SnapshotFailed, 26*time.Hour)
+	manual := snapshot("before-upgrade", platformv1alpha1.SnapshotManual, platformv1alpha1.SnapshotCompleted, 100*time.Hour)
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, oldest, previous, failed, manual).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &platformv1alpha1.TenantSnapshot{}).
+		Build()
+	b := &controller.BackupScheduler{
+		Client:   cl,
+		Scheme:   s,
+		Recorder: record.NewFakeRecorder(10),
+		Archive:  archive,
+		Log:      logr.Discard(),
+	}
+	require.NoError(t, b.TakeDue(ctx, now))
+
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(tenant), tenant))
+	status := tenant.Status.Backup
+	require.NotNil(t, status)
+	assert.Equal(t, platformv1alpha1.SnapshotCompleted, status.LastResult)
+	assert.WithinDuration(t, now, status.LastBackupTime.Time, time.Second)
+	require.NotNil(t, status.NextBackupTime)
+	assert.True(t, status.NextBackupTime.After(now))
+	require.NotEmpty(t, status.LastSnapshot)
+
+	latest := &platformv1alpha1.TenantSnapshot{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Name: status.LastSnapshot}, latest))
+	assert.Equal(t, platformv1alpha1.SnapshotScheduled, latest.Spec.Trigger)
+	assert.True(t, latest.Restorable())
+
+	// Retention 2 keeps the new and the previous snapshot; manual snapshots are not counted
+	snapshots := &platformv1alpha1.TenantSnapshotList{}
+	require.NoError(t, cl.List(ctx, snapshots))
+	var names []string
+	for _, item := range snapshots.Items {
+		names = append(names, item.Name)
+	}
+	assert.ElementsMatch(t, []string{status.LastSnapshot, "scheduled-previous", "before-upgrade"}, names)
+	_, err := archive.Get(ctx, oldest.Status.Location)
+	assert.True(t, errors.Is(err, storage.ErrNotFound), "got %v", err)
+	_, err = archive.Get(ctx, previous.Status.Location)
+	assert.NoError(t, err)
+
+	// Nothing is due until the next run
+	require.NoError(t, b.TakeDue(ctx, now))
+	require.NoError(t, cl.List(ctx, snapshots))
+	assert.Len(t, snapshots.Items, 3)
+}
+
+// TestBackupValidation verifies that spec.backup needs a valid cron schedule and is
+// rejected for Bronze tenants.
+func TestBackupValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(tier platformv1alpha1.TenantTier, schedule string) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:   tier,
+				Owner:  "admin@example.com",
+				Backup: &platformv1alpha1.BackupConfig{Schedule: schedule, Retention: 7},
+			},
+		}
+	}
+
+	for _, schedule := range []string{"0 2 * * *", "@daily", "CRON_TZ=Europe/Berlin 30 1 * * 1-5"} {
+		_, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.GoldTier, schedule))
+		assert.NoError(t, err, schedule)
+	}
+
+	_, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.SilverTier, "every night"))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "spec.backup.schedule")
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.BronzeTier, "@daily"))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "backup is only supported for Silver and Gold tier tenants")
+}
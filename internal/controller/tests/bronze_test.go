@@ -0,0 +1,322 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/mutating"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestBronzeTierProvisioning verifies that Bronze tenants get a ServiceAccount, Role, and
+// PriorityClass-scoped quota in the shared namespace, removed again on upgrade to Silver.
+func TestBronzeTierProvisioning(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "trial", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:      platformv1alpha1.BronzeTier,
+			Owner:     "trial@example.com",
+			Resources: platformv1alpha1.ResourceRequirements{CPU: "500m", Memory: "512Mi"},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "trial"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+	assert.Equal(t, controller.BronzeNamespace, current.Status.Namespace)
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionQuotaReady))
+	assert.Contains(t, current.Status.ManagedResources, platformv1alpha1.ManagedResource{
+		APIVersion: "scheduling.k8s.io/v1", Kind: "PriorityClass", Name: "bronze-trial",
+	})
+
+	// The shared namespace is not owned by the tenant
+	ns := &corev1.Namespace{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: controller.BronzeNamespace}, ns))
+	assert.Empty(t, ns.OwnerReferences)
+	assert.NotContains(t, ns.Labels, controller.TenantNameLabelKey)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: controller.BronzeNamespace, Name: "bronze-defaults"}, &corev1.LimitRange{}))
+
+	pc := &schedulingv1.PriorityClass{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "bronze-trial"}, pc))
+	require.NotNil(t, pc.PreemptionPolicy)
+	assert.Equal(t, corev1.PreemptNever, *pc.PreemptionPolicy)
+
+	rq := &corev1.ResourceQuota{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: controller.BronzeNamespace, Name: "trial-quota"}, rq))
+	require.NotNil(t, rq.Spec.ScopeSelector)
+	assert.Equal(t, []string{"bronze-trial"}, rq.Spec.ScopeSelector.MatchExpressions[0].Values)
+	cpu := rq.Spec.Hard[corev1.ResourceLimitsCPU]
+	assert.Equal(t, resource.MustParse("500m"), cpu)
+	assert.NotContains(t, rq.Spec.Hard, corev1.ResourceServicesLoadBalancers, "not tracked by scoped quotas")
+
+	rb := &rbacv1.RoleBinding{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: controller.BronzeNamespace, Name: "trial-bronze-binding"}, rb))
+	assert.Equal(t, "trial-sa", rb.Subjects[0].Name)
+
+	// Upgrading to Silver moves the tenant out of the shared namespace
+	current.Spec.Tier = platformv1alpha1.SilverTier
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, "tenant-trial", current.Status.Namespace)
+	err = cl.Get(ctx, types.NamespacedName{Namespace: controller.BronzeNamespace, Name: "trial-sa"}, &corev1.ServiceAccount{})
+	assert.True(t, apierrors.IsNotFound(err))
+	err = cl.Get(ctx, types.NamespacedName{Name: "bronze-trial"}, &schedulingv1.PriorityClass{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+// TestBronzePodAssignment verifies that pods in the shared Bronze namespace are tagged
+// with the tenant of their ServiceAccount and its quota PriorityClass.
+func TestBronzePodAssignment(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: controller.BronzeNamespace}}
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+		Name:      "trial-sa",
+		Namespace: controller.BronzeNamespace,
+		Labels:    map[string]string{controller.TenantNameLabelKey: "trial"},
+	}}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(ns, sa).Build()
+	w := &mutating.PodMutatingWebhook{Client: cl}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: controller.BronzeNamespace},
+		Spec:       corev1.PodSpec{ServiceAccountName: "trial-sa", PriorityClassName: "high-priority"},
+	}
+	require.NoError(t, w.Default(ctx, pod))
+	assert.Equal(t, "trial", pod.Labels[controller.TenantNameLabelKey])
+	assert.Equal(t, "Bronze", pod.Labels[controller.TierLabelKey])
+	assert.Equal(t, "bronze-trial", pod.Spec.PriorityClassName)
+
+	// Pods of no tenant would escape every quota in the shared namespace
+	for _, serviceAccount := range []string{"default", "", "missing"} {
+		other := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "tool", Namespace: controller.BronzeNamespace},
+			Spec:       corev1.PodSpec{ServiceAccountName: serviceAccount},
+		}
+		err := w.Default(ctx, other)
+		require.True(t, apierrors.IsForbidden(err), "ServiceAccount %q: got %v", serviceAccount, err)
+		assert.Empty(t, other.Spec.PriorityClassName)
+	}
+}
+
+// TestBronzePodForeignServiceAccount verifies that a Bronze tenant cannot run Pods as
+// another tenant's ServiceAccount, charging them to that tenant's quota.
+func TestBronzePodForeignServiceAccount(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: controller.BronzeNamespace}}
+	newServiceAccount := func(tenantName string) *corev1.ServiceAccount {
+		return &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+			Name:      tenantName + "-sa",
+			Namespace: controller.BronzeNamespace,
+			Labels:    map[string]string{controller.TenantNameLabelKey: tenantName},
+		}}
+	}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(ns, newServiceAccount("alpha"), newServiceAccount("beta")).Build()
+	w := &mutating.PodMutatingWebhook{Client: cl}
+
+	for _, tc := range []struct {
+		name      string
+		requester string
+		wantErr   bool
+	}{
+		{"own ServiceAccount", "system:serviceaccount:bronze-tenants:alpha-sa", false},
+		{"another tenant's ServiceAccount", "system:serviceaccount:bronze-tenants:beta-sa", true},
+		{"ServiceAccount of no tenant", "system:serviceaccount:bronze-tenants:default", true},
+		{"workload controller", "system:serviceaccount:kube-system:replicaset-controller", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: tc.requester},
+				},
+			})
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: controller.BronzeNamespace},
+				Spec:       corev1.PodSpec{ServiceAccountName: "alpha-sa"},
+			}
+			err := w.Default(ctx, pod)
+			if tc.wantErr {
+				require.True(t, apierrors.IsForbidden(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "alpha", pod.Labels[controller.TenantNameLabelKey])
+		})
+	}
+}
+
+// TestBronzeOwnership verifies that a Bronze tenant can only change its own objects in
+// the shared namespace and only run workloads as its own ServiceAccount, while workload
+// controllers and the operator are unaffected.
+func TestBronzeOwnership(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+
+	newServiceAccount := func(tenantName string) *corev1.ServiceAccount {
+		return &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+			Name:      tenantName + "-sa",
+			Namespace: controller.BronzeNamespace,
+			Labels:    map[string]string{controller.TenantNameLabelKey: tenantName},
+		}}
+	}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(newServiceAccount("alpha"), newServiceAccount("beta")).Build()
+	w := &validating.BronzeOwnershipValidatingWebhook{Client: cl}
+
+	toUnstructured := func(obj runtime.Object) *unstructured.Unstructured {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		require.NoError(t, err)
+		return &unstructured.Unstructured{Object: content}
+	}
+	configMap := func(tenantName string) *unstructured.Unstructured {
+		cm := &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: controller.BronzeNamespace},
+		}
+		if tenantName != "" {
+			cm.Labels = map[string]string{controller.TenantNameLabelKey: tenantName}
+		}
+		return toUnstructured(cm)
+	}
+	deployment := func(serviceAccount string) *unstructured.Unstructured {
+		return toUnstructured(&appsv1.Deployment{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web",
+				Namespace: controller.BronzeNamespace,
+				Labels:    map[string]string{controller.TenantNameLabelKey: "alpha"},
+			},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{ServiceAccountName: serviceAccount},
+			}},
+		})
+	}
+	alpha := "system:serviceaccount:bronze-tenants:alpha-sa"
+
+	for _, tc := range []struct {
+		name     string
+		user     string
+		validate func(context.Context) error
+		wantErr  bool
+	}{
+		{"create own object", alpha, func(ctx context.Context) error {
+			_, err := w.ValidateCreate(ctx, configMap("alpha"))
+			return err
+		}, false},
+		{"create unlabeled object", alpha, func(ctx context.Context) error {
+			_, err := w.ValidateCreate(ctx, configMap(""))
+			return err
+		}, true},
+		{"update another tenant's object", alpha, func(ctx context.Context) error {
+			_, err := w.ValidateUpdate(ctx, configMap("beta"), configMap("beta"))
+			return err
+		}, true},
+		{"relabel another tenant's object", alpha, func(ctx context.Context) error {
+			_, err := w.ValidateUpdate(ctx, configMap("beta"), configMap("alpha"))
+			return err
+		}, true},
+		{"delete another tenant's object", alpha, func(ctx context.Context) error {
+			_, err := w.ValidateDelete(ctx, configMap("beta"))
+			return err
+		}, true},
+		{"workload as own ServiceAccount", alpha, func(ctx context.Context) error {
+			_, err := w.ValidateCreate(ctx, deployment("alpha-sa"))
+			return err
+		}, false},
+		{"workload as another tenant's ServiceAccount", alpha, func(ctx context.Context) error {
+			_, err := w.ValidateCreate(ctx, deployment("beta-sa"))
+			return err
+		}, true},
+		{"workload as the default ServiceAccount", alpha, func(ctx context.Context) error {
+			_, err := w.ValidateCreate(ctx, deployment(""))
+			return err
+		}, true},
+		{"workload controller", "system:serviceaccount:kube-system:deployment-controller", func(ctx context.Context) error {
+			_, err := w.ValidateDelete(ctx, configMap("beta"))
+			return err
+		}, false},
+		{"operator", "system:serviceaccount:tenant-system:tenant-master", func(ctx context.Context) error {
+			_, err := w.ValidateCreate(ctx, configMap(""))
+			return err
+		}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: tc.user},
+				},
+			})
+			err := tc.validate(ctx)
+			if tc.wantErr {
+				require.True(t, apierrors.IsForbidden(err), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestQuotaBurstLifecycle verifies that a quota boost is applied, requeued for expiry, and reverted.
+func TestQuotaBurstLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "loadtest", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "owner@example.com",
+			Resources: platformv1alpha1.ResourceRequirements{
+				CPU:    "2000m",
+				Memory: "4Gi",
+				Burst: &platformv1alpha1.BurstConfig{
+					CPU:      "1000m",
+					Memory:   "2Gi",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+		},
+		Status: platformv1alpha1.TenantStatus{State: platformv1alpha1.StateProvisioning},
+	}
+
+	rootCA := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "kube-root-ca.crt", Namespace: "tenant-loadtest"},
+		Data:       map[string]string{"ca.crt": "test"},
+	}
+
+	issued := 0
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, rootCA).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		WithInterceptorFuncs(tokenRequestInterceptor(&issued)).
+		Build()
+
+	cfg := config.Default()
+	cfg.Requeue.Resync = 0 // only the boost and renewals schedule a reconcile
+	r := &controller.TenantReconciler{
+		Client: cl,
+		Scheme: s,
+		Log:    logr.Discard(),
+		Audit:  &audit.Recorder{Client: cl, Namespace: controller.OperatorNamespace},
+		Config: cfg,
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "loadtest"}}
+
+	// Boost is applied and the reconcile is scheduled for its expiry
+	res, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Hour.Seconds(), res.RequeueAfter.Seconds(), 5)
+
+	rq := &corev1.ResourceQuota{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-loadtest", Name: "loadtest-quota"}, rq))
+	assert.True(t, rq.Spec.Hard[corev1.ResourceLimitsCPU].Equal(resource.MustParse("3000m")))
+	assert.True(t, rq.Spec.Hard[corev1.ResourceLimitsMemory].Equal(resource.MustParse("6Gi")))
+
+	// Force expiry
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	require.NotNil(t, current.Status.Burst)
+	assert.True(t, current.Status.Burst.Active)
+	current.Status.Burst.ExpiresAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	require.NoError(t, cl.Status().Update(ctx, current))
+
+	// Boost is reverted and not re-applied for the same request; only the kubeconfig
+	// renewal is left to schedule
+	res, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Greater(t, res.RequeueAfter, time.Hour)
+
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-loadtest", Name: "loadtest-quota"}, rq))
+	assert.True(t, rq.Spec.Hard[corev1.ResourceLimitsCPU].Equal(resource.MustParse("2000m")))
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.False(t, current.Status.Burst.Active)
+	assert.NotNil(t, current.Status.Burst.EndedAt)
+
+	// Both the start and end of the boost are audited
+	entries := &corev1.ConfigMapList{}
+	require.NoError(t, cl.List(ctx, entries,
+		client.InNamespace(controller.OperatorNamespace),
+		client.MatchingLabels{audit.TenantLabelKey: "loadtest"},
+	))
+	actions := map[string]bool{}
+	for _, cm := range entries.Items {
+		actions[cm.Labels[audit.ActionLabelKey]] = true
+	}
+	assert.True(t, actions[audit.ActionBurstStart])
+	assert.True(t, actions[audit.ActionBurstEnd])
+}
@@ -0,0 +1,127 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	platformclient "github.com/amartyaa/tenant-master/operator/pkg/client"
+	"github.com/amartyaa/tenant-master/operator/pkg/client/bff"
+)
+
+// TestClientsetTenants verifies that the typed clientset talks to the platform.io
+// REST paths and decodes Tenants.
+func TestClientsetTenants(t *testing.T) {
+	var created map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/platform.io/v1alpha1/tenants/acme":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"apiVersion": "platform.io/v1alpha1",
+				"kind":       "Tenant",
+				"metadata":   map[string]any{"name": "acme"},
+				"spec":       map[string]any{"tier": "Silver", "owner": "owner@example.com"},
+				"status":     map[string]any{"state": "Ready"},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/apis/platform.io/v1alpha1/tenants":
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(metav1.Status{Status: metav1.StatusFailure, Code: http.StatusNotFound, Reason: metav1.StatusReasonNotFound})
+		}
+	}))
+	defer srv.Close()
+
+	cs, err := platformclient.NewForConfig(&rest.Config{Host: srv.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tenant, err := cs.Tenants().Get(ctx, "acme", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, platformv1alpha1.SilverTier, tenant.Spec.Tier)
+	assert.Equal(t, platformv1alpha1.StateReady, tenant.Status.State)
+
+	_, err = cs.Tenants().Create(ctx, &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "new"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.BronzeTier, Owner: "owner@example.com"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "platform.io/v1alpha1", created["apiVersion"])
+	assert.Equal(t, "Tenant", created["kind"])
+
+	_, err = cs.Tenants().Get(ctx, "missing", metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+// TestBFFClient verifies that the BFF client sends credentials and surfaces API errors.
+func TestBFFClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid token"}`))
+			return
+		}
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/tenants/acme":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"platform.io/v1alpha1/tenants/acme","name":"acme","spec":{"tier":"Silver"}}`))
+		case r.URL.Path == "/api/v1/tenants":
+			_, _ = w.Write([]byte(`[{"name":"acme","tier":"Silver","owner":"owner@example.com"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"tenant not found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := bff.NewClient(srv.URL)
+	c.Token = "secret"
+
+	tenants, err := c.ListTenants(ctx)
+	require.NoError(t, err)
+	require.Len(t, tenants, 1)
+	assert.Equal(t, "acme", tenants[0].Name)
+
+	res, created, err := c.ApplyTenant(ctx, "acme", platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "owner@example.com"})
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, "platform.io/v1alpha1/tenants/acme", res.ID)
+
+	_, err = c.GetTenant(ctx, "missing")
+	assert.True(t, bff.IsNotFound(err))
+	assert.Contains(t, err.Error(), "tenant not found")
+
+	c.Token = ""
+	_, err = c.ListTenants(ctx)
+	var apiErr *bff.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
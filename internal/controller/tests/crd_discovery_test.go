@@ -0,0 +1,133 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/mutating"
+)
+
+// crdNames is the spec.names stanza of a CRD manifest.
+type crdNames struct {
+	Kind       string   `json:"kind"`
+	ShortNames []string `json:"shortNames"`
+	Categories []string `json:"categories"`
+}
+
+// loadCRDNames returns spec.names of a CRD manifest, dropping Helm template directives.
+func loadCRDNames(t *testing.T, path string) crdNames {
+	t.Helper()
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var lines []string
+	for _, line := range strings.Split(string(raw), "\n") {
+		if !strings.Contains(line, "{{") {
+			lines = append(lines, line)
+		}
+	}
+	var crd struct {
+		Spec struct {
+			Names crdNames `json:"names"`
+		} `json:"spec"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &crd))
+	return crd.Spec.Names
+}
+
+// TestCRDDiscovery verifies that every CRD, in the manifests and the Helm chart, has
+// its short names and belongs to the tenant-master category, so "kubectl get
+// tenant-master" lists all of them.
+func TestCRDDiscovery(t *testing.T) {
+	for _, tc := range []struct {
+		manifest, chart string
+		shortNames      []string
+	}{
+		{"tenant_crd.yaml", "crd.yaml", []string{"ten", "tnt"}},
+		{"tenantset_crd.yaml", "tenantset-crd.yaml", []string{"tset"}},
+		{"tenanttemplate_crd.yaml", "tenanttemplate-crd.yaml", []string{"ttpl"}},
+		{"tenantmigration_crd.yaml", "tenantmigration-crd.yaml", []string{"tm"}},
+		{"tenantaccessrequest_crd.yaml", "tenantaccessrequest-crd.yaml", []string{"tar"}},
+		{"tenantsnapshot_crd.yaml", "tenantsnapshot-crd.yaml", []string{"tsnap"}},
+	} {
+		for _, path := range []string{
+			"../../../config/crd/" + tc.manifest,
+			"../../../helm/tenant-operator/templates/" + tc.chart,
+		} {
+			names := loadCRDNames(t, path)
+			assert.Equal(t, tc.shortNames, names.ShortNames, path)
+			assert.Equal(t, []string{"tenant-master"}, names.Categories, path)
+		}
+	}
+}
+
+// TestTenantPrinterColumns verifies that the CRD manifest and the Helm chart print the
+// same Tenant columns, including the cost metered in the current billing period.
+func TestTenantPrinterColumns(t *testing.T) {
+	columns := func(path string) []map[string]interface{} {
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+		var lines []string
+		for _, line := range strings.Split(string(raw), "\n") {
+			if !strings.Contains(line, "{{") {
+				lines = append(lines, line)
+			}
+		}
+		var crd struct {
+			Spec struct {
+				Versions []struct {
+					Columns []map[string]interface{} `json:"additionalPrinterColumns"`
+				} `json:"versions"`
+			} `json:"spec"`
+		}
+		require.NoError(t, yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &crd))
+		return crd.Spec.Versions[0].Columns
+	}
+
+	manifest := columns("../../../config/crd/tenant_crd.yaml")
+	assert.Equal(t, manifest, columns("../../../helm/tenant-operator/templates/crd.yaml"))
+	assert.Contains(t, manifest, map[string]interface{}{"name": "Cost", "type": "string", "jsonPath": ".status.billing.cost"})
+}
+
+// TestTenantTierLabel verifies that the mutating webhook mirrors the tier into the
+// tier label, including the defaulted tier and a tier changed on update.
+func TestTenantTierLabel(t *testing.T) {
+	ctx := context.Background()
+	w := &mutating.TenantMutatingWebhook{}
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "labels"},
+		Spec:       platformv1alpha1.TenantSpec{Owner: "owner@example.com"},
+	}
+	require.NoError(t, w.Default(ctx, tenant))
+	assert.Equal(t, string(platformv1alpha1.SilverTier), tenant.Labels[controller.TierLabelKey])
+
+	tenant.Spec.Tier = platformv1alpha1.GoldTier
+	require.NoError(t, w.Default(ctx, tenant))
+	assert.Equal(t, string(platformv1alpha1.GoldTier), tenant.Labels[controller.TierLabelKey])
+}
@@ -0,0 +1,232 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"sigs.k8s.io/yaml"
+)
+
+// loadCRDSchema returns the openAPIV3Schema of the first version of a CRD manifest.
+// Lines with Helm template directives are dropped so chart templates parse as YAML.
+func loadCRDSchema(t *testing.T, path string) map[string]interface{} {
+	t.Helper()
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var lines []string
+	for _, line := range strings.Split(string(raw), "\n") {
+		if !strings.Contains(line, "{{") {
+			lines = append(lines, line)
+		}
+	}
+	crd := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &crd))
+
+	versions := crd["spec"].(map[string]interface{})["versions"].([]interface{})
+	schema := versions[0].(map[string]interface{})["schema"].(map[string]interface{})
+	return schema["openAPIV3Schema"].(map[string]interface{})
+}
+
+// celRules collects every x-kubernetes-validations rule in schema, keyed by its path.
+func celRules(schema map[string]interface{}, path string, out map[string][]string) {
+	if rules, ok := schema["x-kubernetes-validations"].([]interface{}); ok {
+		for _, r := range rules {
+			out[path] = append(out[path], r.(map[string]interface{})["rule"].(string))
+		}
+		sort.Strings(out[path])
+	}
+	if props, ok := schema["properties"].(map[string]interface{}); ok {
+		for name, prop := range props {
+			celRules(prop.(map[string]interface{}), path+"."+name, out)
+		}
+	}
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		celRules(items, path+"[]", out)
+	}
+}
+
+// TestTenantCRDValidationRules verifies that the CRD manifest and the Helm chart carry
+// the same CEL validation rules, which stand in for the webhook when it is disabled.
+func TestTenantCRDValidationRules(t *testing.T) {
+	manifest := loadCRDSchema(t, "../../../config/crd/tenant_crd.yaml")
+	chart := loadCRDSchema(t, "../../../helm/tenant-operator/templates/crd.yaml")
+
+	manifestRules := map[string][]string{}
+	celRules(manifest, "", manifestRules)
+	chartRules := map[string][]string{}
+	celRules(chart, "", chartRules)
+
+	assert.Equal(t, manifestRules, chartRules)
+	for _, path := range []string{".spec", ".spec.environments", ".spec.quotas.byPriorityClass",
+		".spec.resources.burst", ".spec.vcluster.audit.sink", ".spec.accessControl.users", ".spec.accessControl.groups"} {
+		assert.NotEmpty(t, manifestRules[path], path)
+	}
+	assert.Contains(t, strings.Join(manifestRules[".spec"], "\n"), "oldSelf.tier",
+		"the tier downgrade gate must be a transition rule")
+	assert.Contains(t, strings.Join(manifestRules[".spec"], "\n"), "oldSelf.restoreFrom",
+		"restoreFrom must only be settable at creation")
+
+	for _, schema := range []map[string]interface{}{manifest, chart} {
+		tier := schema["properties"].(map[string]interface{})["spec"].(map[string]interface{})["properties"].(map[string]interface{})["tier"]
+		assert.Equal(t, "Silver", tier.(map[string]interface{})["default"])
+	}
+}
+
+// tenantCRDValidator validates Tenants the way the API server does with a CRD schema:
+// defaulting, OpenAPI validation, then the CEL rules.
+type tenantCRDValidator struct {
+	structural *structuralschema.Structural
+	schema     apiservervalidation.SchemaValidator
+	cel        *cel.Validator
+}
+
+// newTenantCRDValidator compiles the schema and CEL rules of a Tenant CRD manifest.
+func newTenantCRDValidator(t *testing.T, path string) *tenantCRDValidator {
+	t.Helper()
+	raw, err := json.Marshal(loadCRDSchema(t, path))
+	require.NoError(t, err)
+	var external apiextensionsv1.JSONSchemaProps
+	require.NoError(t, json.Unmarshal(raw, &external))
+	var internal apiextensions.JSONSchemaProps
+	require.NoError(t, apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(&external, &internal, nil))
+
+	structural, err := structuralschema.NewStructural(&internal)
+	require.NoError(t, err, path)
+	schema, _, err := apiservervalidation.NewSchemaValidator(&internal)
+	require.NoError(t, err, path)
+	validator := cel.NewValidator(structural, true, celconfig.PerCallLimit)
+	require.NotNil(t, validator, path)
+	return &tenantCRDValidator{structural: structural, schema: schema, cel: validator}
+}
+
+// validate defaults and validates a Tenant spec given as YAML, and an update of
+// oldSpec when it is not empty.
+func (v *tenantCRDValidator) validate(t *testing.T, spec, oldSpec string) field.ErrorList {
+	t.Helper()
+	toTenant := func(spec string) map[string]interface{} {
+		// Decode like the API server, with integers as int64
+		raw, err := yaml.YAMLToJSON([]byte(spec))
+		require.NoError(t, err)
+		obj := map[string]interface{}{}
+		require.NoError(t, utiljson.Unmarshal(raw, &obj))
+		tenant := map[string]interface{}{
+			"apiVersion": "platform.io/v1alpha1",
+			"kind":       "Tenant",
+			"metadata":   map[string]interface{}{"name": "acme"},
+			"spec":       obj,
+		}
+		structuraldefaulting.Default(tenant, v.structural)
+		return tenant
+	}
+
+	tenant := toTenant(spec)
+	allErrs := apiservervalidation.ValidateCustomResource(nil, tenant, v.schema)
+	var oldTenant interface{}
+	if oldSpec != "" {
+		oldTenant = toTenant(oldSpec)
+	}
+	celErrs, _ := v.cel.Validate(context.Background(), nil, v.structural, tenant, oldTenant, celconfig.RuntimeCELCostBudget)
+	return append(allErrs, celErrs...)
+}
+
+// TestTenantCRDValidationRulesEvaluate compiles the CEL rules of the CRD manifest and
+// the Helm chart and evaluates them against valid and invalid Tenants, as the API
+// server does when the webhook is disabled.
+func TestTenantCRDValidationRulesEvaluate(t *testing.T) {
+	for _, path := range []string{
+		"../../../config/crd/tenant_crd.yaml",
+		"../../../helm/tenant-operator/templates/crd.yaml",
+	} {
+		v := newTenantCRDValidator(t, path)
+
+		for _, tc := range []struct {
+			name    string
+			spec    string
+			oldSpec string
+			wantErr string
+		}{
+			{name: "silver", spec: "{tier: Silver, owner: a@example.com}"},
+			{name: "tier optional", spec: "{owner: a@example.com}"},
+			{name: "tier defaults to Silver", spec: "{owner: a@example.com, vcluster: {}}",
+				wantErr: "vcluster settings are only supported for Gold tier tenants"},
+			{name: "owner required", spec: "{tier: Silver}", wantErr: "spec.owner: Required value"},
+			{name: "silver environments", spec: "{tier: Silver, owner: a@example.com, environments: [{name: dev, quotaPercent: 40}, {name: prod}]}"},
+			{name: "gold environments", spec: "{tier: Gold, owner: a@example.com, environments: [{name: dev}]}",
+				wantErr: "environments are only supported for Silver tier tenants"},
+			{name: "duplicate environments", spec: "{tier: Silver, owner: a@example.com, environments: [{name: dev}, {name: dev}]}",
+				wantErr: "environment names must be unique"},
+			{name: "environment quota over 100", spec: "{tier: Silver, owner: a@example.com, environments: [{name: dev, quotaPercent: 60}, {name: prod, quotaPercent: 50}]}",
+				wantErr: "quotaPercent values must not add up to more than 100"},
+			{name: "gold exposure", spec: "{tier: Gold, owner: a@example.com, exposure: {type: Ingress, hostname: acme.example.com}}"},
+			{name: "ingress without hostname", spec: "{tier: Gold, owner: a@example.com, exposure: {type: Ingress}}",
+				wantErr: "hostname is required for Ingress exposure"},
+			{name: "silver exposure", spec: "{tier: Silver, owner: a@example.com, exposure: {type: LoadBalancer}}",
+				wantErr: "exposure is only supported for Gold tier tenants"},
+			{name: "bronze users", spec: "{tier: Bronze, owner: a@example.com, accessControl: {users: [dev@example.com]}}",
+				wantErr: "accessControl users and groups are not supported for Bronze tier tenants"},
+			{name: "system users", spec: "{tier: Silver, owner: a@example.com, accessControl: {users: ['system:admin']}}",
+				wantErr: "users must be non-empty and must not be system users"},
+			{name: "gold storage fits", spec: "{tier: Gold, owner: a@example.com, resources: {storage: 30Gi}, vcluster: {replicas: 3}}"},
+			{name: "gold storage too small", spec: "{tier: Gold, owner: a@example.com, resources: {storage: 20Gi}, vcluster: {replicas: 3}}",
+				wantErr: "vCluster replicas x persistence size (10Gi by default) must fit in spec.resources.storage"},
+			{name: "priority budget over tenant", spec: "{tier: Silver, owner: a@example.com, resources: {cpu: 2000m}, quotas: {byPriorityClass: [{priorityClassName: batch, cpu: 4000m}]}}",
+				wantErr: "priority class budgets must not exceed spec.resources.cpu and spec.resources.memory"},
+			{name: "burst too long", spec: "{tier: Silver, owner: a@example.com, resources: {burst: {cpu: 1000m, duration: 200h}}}",
+				wantErr: "duration must be greater than 0 and at most 168h"},
+			{name: "audit sink", spec: "{tier: Gold, owner: a@example.com, vcluster: {audit: {sink: {lokiURL: 'https://loki.example.com/push'}}}}"},
+			{name: "audit sink twice", spec: "{tier: Gold, owner: a@example.com, vcluster: {audit: {sink: {lokiURL: 'https://loki.example.com/push', objectStoragePath: 's3://audit'}}}}",
+				wantErr: "exactly one of objectStoragePath or lokiURL must be set"},
+			{name: "silver privileged", spec: "{tier: Silver, owner: a@example.com, security: {allowPrivileged: true}}"},
+			{name: "bronze privileged", spec: "{tier: Bronze, owner: a@example.com, security: {allowPrivileged: true}}",
+				wantErr: "allowPrivileged is not supported for Bronze tier tenants"},
+			{name: "upgrade", spec: "{tier: Gold, owner: a@example.com}", oldSpec: "{tier: Silver, owner: a@example.com}"},
+			{name: "downgrade", spec: "{tier: Silver, owner: a@example.com}", oldSpec: "{tier: Gold, owner: a@example.com}",
+				wantErr: "unsafe tier downgrade"},
+			{name: "approved downgrade", spec: "{tier: Silver, owner: a@example.com, allowTierMigration: true}", oldSpec: "{tier: Gold, owner: a@example.com}"},
+			{name: "to Platinum", spec: "{tier: Platinum, owner: a@example.com}", oldSpec: "{tier: Gold, owner: a@example.com}",
+				wantErr: "tenants cannot be migrated to or from the Platinum tier"},
+			{name: "restore on create", spec: "{tier: Silver, owner: a@example.com, restoreFrom: {name: snap}}"},
+			{name: "restore on update", spec: "{tier: Silver, owner: a@example.com, restoreFrom: {name: snap}}", oldSpec: "{tier: Silver, owner: a@example.com}",
+				wantErr: "restoreFrom can only be set when the tenant is created"},
+		} {
+			errs := v.validate(t, tc.spec, tc.oldSpec)
+			if tc.wantErr == "" {
+				assert.Empty(t, errs, "%s: %s", path, tc.name)
+				continue
+			}
+			assert.Contains(t, errs.ToAggregate().Error(), tc.wantErr, "%s: %s", path, tc.name)
+		}
+	}
+}
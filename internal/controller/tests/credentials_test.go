@@ -0,0 +1,119 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestCredentialRotation verifies that the rotate-credentials annotation recreates the
+// tenant ServiceAccount once per request and records the rotation.
+func TestCredentialRotation(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	requestedAt := time.Now().UTC().Truncate(time.Second)
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "leaked",
+			Finalizers: []string{controller.TenantFinalizerName},
+			Annotations: map[string]string{
+				controller.RotateCredentialsAnnotation:            requestedAt.Format(time.RFC3339),
+				controller.RotateCredentialsRequestedByAnnotation: "security@example.com",
+			},
+		},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "owner@example.com",
+		},
+		Status: platformv1alpha1.TenantStatus{State: platformv1alpha1.StateReady},
+	}
+	oldSA := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "leaked-sa",
+			Namespace:   "tenant-leaked",
+			Annotations: map[string]string{"generation": "old"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, oldSA).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	r := &controller.TenantReconciler{
+		Client: cl,
+		Scheme: s,
+		Log:    logr.Discard(),
+		Audit:  &audit.Recorder{Client: cl, Namespace: controller.OperatorNamespace},
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "leaked"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	// The ServiceAccount was recreated, so tokens bound to the old one are invalid
+	sa := &corev1.ServiceAccount{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-leaked", Name: "leaked-sa"}, sa))
+	assert.Empty(t, sa.Annotations["generation"])
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	require.NotNil(t, current.Status.CredentialsRotatedAt)
+	assert.True(t, current.Status.CredentialsRotatedAt.Time.Equal(requestedAt))
+
+	entries := &corev1.ConfigMapList{}
+	require.NoError(t, cl.List(ctx, entries,
+		client.InNamespace(controller.OperatorNamespace),
+		client.MatchingLabels{audit.ActionLabelKey: audit.ActionCredentialsRotated},
+	))
+	assert.Len(t, entries.Items, 1)
+
+	// The same request is not handled twice
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.List(ctx, entries,
+		client.InNamespace(controller.OperatorNamespace),
+		client.MatchingLabels{audit.ActionLabelKey: audit.ActionCredentialsRotated},
+	))
+	assert.Len(t, entries.Items, 1)
+}
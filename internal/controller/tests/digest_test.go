@@ -0,0 +1,121 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/notify"
+)
+
+// recordingNotifier keeps sent messages in memory.
+type recordingNotifier struct {
+	sent []notify.Message
+}
+
+func (n *recordingNotifier) Send(ctx context.Context, msg notify.Message) error {
+	n.sent = append(n.sent, msg)
+	return nil
+}
+
+// TestUsageDigest verifies that digests go to opted-in tenants once per interval and
+// report quota usage and upcoming expirations.
+func TestUsageDigest(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	enabled := true
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:          platformv1alpha1.SilverTier,
+			Owner:         "owner@example.com",
+			Notifications: platformv1alpha1.NotificationConfig{Digest: &enabled, Recipients: []string{"finance@example.com"}},
+		},
+		Status: platformv1alpha1.TenantStatus{
+			Burst: &platformv1alpha1.BurstStatus{ExpiresAt: &metav1.Time{Time: now.Add(48 * time.Hour)}},
+		},
+	}
+	optedOut := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "quiet"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "quiet@example.com"},
+	}
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "acme-quota",
+			Namespace: "tenant-acme",
+			Labels:    map[string]string{controller.TenantNameLabelKey: "acme"},
+		},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")},
+			Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("1")},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, optedOut, quota).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	notifier := &recordingNotifier{}
+	d := &controller.DigestSender{
+		Client:   cl,
+		Notifier: notifier,
+		Config:   config.Default(),
+		Log:      logr.Discard(),
+	}
+
+	require.NoError(t, d.SendDue(ctx, now))
+	require.Len(t, notifier.sent, 1)
+	msg := notifier.sent[0]
+	assert.Equal(t, []string{"owner@example.com", "finance@example.com"}, msg.To)
+	assert.Contains(t, msg.Body, "tenant-acme")
+	assert.Contains(t, msg.Body, "requests.cpu")
+	assert.Contains(t, msg.Body, "(25%)")
+	assert.Contains(t, msg.Body, "Estimated cost")
+	assert.Contains(t, msg.Body, "Quota burst ends")
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "acme"}, current))
+	require.NotNil(t, current.Status.LastDigestSentAt)
+
+	// Not due again until the interval has passed
+	require.NoError(t, d.SendDue(ctx, now.Add(24*time.Hour)))
+	assert.Len(t, notifier.sent, 1)
+	require.NoError(t, d.SendDue(ctx, now.Add(7*24*time.Hour)))
+	assert.Len(t, notifier.sent, 2)
+}
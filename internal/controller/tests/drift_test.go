@@ -0,0 +1,136 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// TestDriftCorrection verifies that edits made outside the operator to a tenant's
+// quota, Roles, namespace labels, and NetworkPolicy are reverted and counted per kind,
+// while changes caused by a new tenant spec are not reported as drift.
+func TestDriftCorrection(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "drifty", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:      platformv1alpha1.SilverTier,
+			Owner:     "admin@example.com",
+			Resources: platformv1alpha1.ResourceRequirements{CPU: "2", Memory: "4Gi"},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	recorder := record.NewFakeRecorder(20)
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Recorder: recorder}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "drifty"}}
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	drainEvents(recorder)
+
+	drift := func(kind string) float64 {
+		return testutil.ToFloat64(metrics.DriftDetectedCounter.WithLabelValues("drifty", kind))
+	}
+	namespace := "tenant-drifty"
+
+	// Loosen the quota, widen the admin Role, relabel the namespace, and delete the policy
+	quota := &corev1.ResourceQuota{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "drifty-quota"}, quota))
+	quota.Spec.Hard[corev1.ResourceRequestsCPU] = resource.MustParse("64")
+	require.NoError(t, cl.Update(ctx, quota))
+
+	role := &rbacv1.Role{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "drifty" + controller.TenantAdminRoleSuffix}, role))
+	wantRules := role.Rules
+	role.Rules = append(role.Rules, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"resourcequotas"}, Verbs: []string{"*"}})
+	require.NoError(t, cl.Update(ctx, role))
+
+	ns := &corev1.Namespace{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Name: namespace}, ns))
+	ns.Labels[controller.TierLabelKey] = string(platformv1alpha1.GoldTier)
+	require.NoError(t, cl.Update(ctx, ns))
+
+	require.NoError(t, cl.Delete(ctx, &netv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: controller.DefaultNetworkPolicyName}}))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(quota), quota))
+	assert.Equal(t, 0, quota.Spec.Hard.Name(corev1.ResourceRequestsCPU, resource.DecimalSI).Cmp(resource.MustParse("2")))
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(role), role))
+	assert.Equal(t, wantRules, role.Rules)
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(ns), ns))
+	assert.Equal(t, string(platformv1alpha1.SilverTier), ns.Labels[controller.TierLabelKey])
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: controller.DefaultNetworkPolicyName}, &netv1.NetworkPolicy{}))
+
+	for _, kind := range []string{"ResourceQuota", "Role", "Namespace", "NetworkPolicy"} {
+		assert.Equal(t, 1.0, drift(kind), kind)
+	}
+	assert.Zero(t, drift("RoleBinding"))
+	events := drainEvents(recorder)
+	assert.Len(t, events, 4)
+	for _, event := range events {
+		assert.Contains(t, event, "Warning DriftCorrected")
+	}
+
+	// Steady state reports nothing
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, drainEvents(recorder))
+
+	// A spec change rewrites the quota without counting it as drift
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	current.Spec.Resources.CPU = "4"
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(quota), quota))
+	assert.Equal(t, 0, quota.Spec.Hard.Name(corev1.ResourceRequestsCPU, resource.DecimalSI).Cmp(resource.MustParse("4")))
+	assert.Equal(t, 1.0, drift("ResourceQuota"))
+}
@@ -0,0 +1,117 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestEnvironmentNamespaces verifies that spec.environments expands into per-environment
+// namespaces with quota shares and that prod is isolated from the other environments.
+func TestEnvironmentNamespaces(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	dev, staging := int32(20), int32(30)
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "envs", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:      platformv1alpha1.SilverTier,
+			Owner:     "admin@example.com",
+			Resources: platformv1alpha1.ResourceRequirements{CPU: "4000m", Memory: "8Gi"},
+			Environments: []platformv1alpha1.TenantEnvironment{
+				{Name: "dev", QuotaPercent: &dev},
+				{Name: "staging", QuotaPercent: &staging},
+				{Name: "prod"},
+			},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "envs"}}
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	// Quota shares: dev 20%, staging 30%, prod takes the remaining 50%
+	expectedCPU := map[string]string{
+		"tenant-envs-dev":     "800m",
+		"tenant-envs-staging": "1200m",
+		"tenant-envs-prod":    "2",
+		"tenant-envs":         "0",
+	}
+	for ns, cpu := range expectedCPU {
+		rq := &corev1.ResourceQuota{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: ns, Name: "envs-quota"}, rq))
+		limit := rq.Spec.Hard[corev1.ResourceLimitsCPU]
+		assert.Equal(t, cpu, limit.String(), "cpu quota in %s", ns)
+	}
+
+	prodNS := &corev1.Namespace{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-envs-prod"}, prodNS))
+	assert.Equal(t, "prod", prodNS.Labels[controller.EnvironmentLabelKey])
+	assert.Equal(t, "true", prodNS.Labels[controller.EnvironmentIsolatedLabelKey])
+
+	// prod only accepts traffic from itself; dev also accepts traffic from its siblings
+	prodPolicy := &netv1.NetworkPolicy{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-envs-prod", Name: controller.DefaultNetworkPolicyName}, prodPolicy))
+	assert.Len(t, prodPolicy.Spec.Ingress, 1)
+
+	devPolicy := &netv1.NetworkPolicy{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-envs-dev", Name: controller.DefaultNetworkPolicyName}, devPolicy))
+	require.Len(t, devPolicy.Spec.Ingress, 2)
+	assert.Equal(t, "false", devPolicy.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels[controller.EnvironmentIsolatedLabelKey])
+
+	// Dropping an environment removes its namespace
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	current.Spec.Environments = current.Spec.Environments[1:]
+	require.NoError(t, cl.Update(ctx, current))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	err = cl.Get(ctx, types.NamespacedName{Name: "tenant-envs-dev"}, &corev1.Namespace{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
@@ -0,0 +1,97 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestEventMirroring verifies that quota, image pull, and repeated scheduling failures
+// in a tenant namespace are mirrored onto the Tenant, and routine events are not.
+func TestEventMirroring(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "owner@example.com"},
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "tenant-acme",
+		Labels: map[string]string{controller.TenantNameLabelKey: "acme"},
+	}}
+	newEvent := func(name, reason, message string, count int32) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "tenant-acme"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-1", Namespace: "tenant-acme"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         reason,
+			Message:        message,
+			Count:          count,
+		}
+	}
+	events := []*corev1.Event{
+		newEvent("quota", "FailedCreate", `pods "web-1" is forbidden: exceeded quota: acme-quota`, 1),
+		newEvent("pull", "Failed", `Failed to pull image "acme/web:bad": not found`, 1),
+		newEvent("sched-once", "FailedScheduling", "0/3 nodes are available", 1),
+		newEvent("sched-spike", "FailedScheduling", "0/3 nodes are available", controller.FailedSchedulingSpikeThreshold),
+		newEvent("probe", "Unhealthy", "Readiness probe failed", 10),
+	}
+
+	builder := fake.NewClientBuilder().WithScheme(s).WithObjects(tenant, ns)
+	for _, ev := range events {
+		builder = builder.WithObjects(ev)
+	}
+	cl := builder.Build()
+
+	recorder := record.NewFakeRecorder(10)
+	r := &controller.EventMirrorReconciler{Client: cl, Log: logr.Discard(), Recorder: recorder}
+
+	for _, ev := range events {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: ev.Namespace, Name: ev.Name}})
+		require.NoError(t, err)
+	}
+
+	close(recorder.Events)
+	var mirrored []string
+	for e := range recorder.Events {
+		mirrored = append(mirrored, e)
+	}
+	require.Len(t, mirrored, 3)
+	assert.Contains(t, mirrored[0], "FailedCreate")
+	assert.Contains(t, mirrored[0], "tenant-acme/pod web-1")
+	assert.Contains(t, mirrored[1], "Failed to pull image")
+	assert.Contains(t, mirrored[2], "FailedScheduling")
+}
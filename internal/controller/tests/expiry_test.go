@@ -0,0 +1,159 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestTenantExpiry verifies that a tenant whose spec.ttl ran out is marked Expired with an
+// owner notice, kept while the TTL is extended, and deleted after the grace period, and
+// that the time to expiry is reported in status and as a metric.
+func TestTenantExpiry(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	newTenant := func(name string, age time.Duration, ttl time.Duration) *platformv1alpha1.Tenant {
+		tenant := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))},
+			Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: name + "@example.com"},
+		}
+		if ttl > 0 {
+			tenant.Spec.TTL = &metav1.Duration{Duration: ttl}
+		}
+		return tenant
+	}
+	preview := newTenant("preview", 73*time.Hour, 72*time.Hour)
+	fresh := newTenant("fresh", time.Hour, 72*time.Hour)
+	permanent := newTenant("permanent", 1000*time.Hour, 0)
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(preview, fresh, permanent).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	notifier := &recordingNotifier{}
+	e := &controller.TenantExpirer{
+		Client:   cl,
+		Recorder: record.NewFakeRecorder(10),
+		Notifier: notifier,
+		Audit:    &audit.Recorder{Client: cl, Namespace: controller.OperatorNamespace},
+		Config:   config.Default(),
+		Log:      logr.Discard(),
+	}
+
+	// The TTL ran out: the owner is notified, nothing is deleted yet
+	require.NoError(t, e.Expire(ctx, now))
+	require.Len(t, notifier.sent, 1)
+	assert.Equal(t, []string{"preview@example.com"}, notifier.sent[0].To)
+	assert.Contains(t, notifier.sent[0].Body, "will be deleted at Sat, 17 Oct 2026 09:00:00 UTC")
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "preview"}, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionExpired))
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "fresh"}, current))
+	require.NotNil(t, current.Status.ExpiresAt)
+	assert.True(t, current.Status.ExpiresAt.Time.Equal(now.Add(71*time.Hour)))
+	assert.Nil(t, apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionExpired))
+
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.TTLRemainingGauge))
+	assert.Equal(t, (71 * time.Hour).Seconds(), testutil.ToFloat64(metrics.TTLRemainingGauge.WithLabelValues("fresh", "Silver")))
+	assert.Equal(t, -time.Hour.Seconds(), testutil.ToFloat64(metrics.TTLRemainingGauge.WithLabelValues("preview", "Silver")))
+
+	// Extending the TTL during the grace period keeps the tenant
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "preview"}, current))
+	current.Spec.TTL = &metav1.Duration{Duration: 100 * time.Hour}
+	require.NoError(t, cl.Update(ctx, current))
+	require.NoError(t, e.Expire(ctx, now.Add(12*time.Hour)))
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "preview"}, current))
+	condition := apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionExpired)
+	require.NotNil(t, condition)
+	assert.Equal(t, "TTLExtended", condition.Reason)
+
+	// Once the extended TTL runs out, the grace period starts over
+	require.NoError(t, e.Expire(ctx, now.Add(28*time.Hour)))
+	require.Len(t, notifier.sent, 2)
+	require.NoError(t, e.Expire(ctx, now.Add(51*time.Hour)))
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "preview"}, current))
+
+	require.NoError(t, e.Expire(ctx, now.Add(52*time.Hour)))
+	err := cl.Get(ctx, types.NamespacedName{Name: "preview"}, current)
+	assert.True(t, apierrors.IsNotFound(err), "expired tenant should be deleted")
+
+	entries := &corev1.ConfigMapList{}
+	require.NoError(t, cl.List(ctx, entries, client.MatchingLabels{audit.ActionLabelKey: audit.ActionExpired}))
+	assert.Len(t, entries.Items, 1)
+
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "permanent"}, current))
+	assert.Nil(t, current.Status.ExpiresAt)
+}
+
+// TestTTLValidation verifies that spec.ttl must be positive and cannot be combined with
+// spec.deletionProtection.
+func TestTTLValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(ttl time.Duration, protected bool) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "preview"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:               platformv1alpha1.SilverTier,
+				Owner:              "admin@example.com",
+				TTL:                &metav1.Duration{Duration: ttl},
+				DeletionProtection: protected,
+			},
+		}
+	}
+
+	_, err := w.ValidateCreate(ctx, newTenant(72*time.Hour, false))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateCreate(ctx, newTenant(0, false))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "spec.ttl")
+
+	_, err = w.ValidateCreate(ctx, newTenant(72*time.Hour, true))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "ttl cannot be set on a tenant with spec.deletionProtection")
+}
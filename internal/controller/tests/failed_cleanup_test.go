@@ -0,0 +1,190 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestFailedTenantCleanup verifies that tenants Failed beyond the retention are
+// scheduled for cleanup with an owner notice, and deleted or suspended only after
+// the notice period.
+func TestFailedTenantCleanup(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	newFailed := func(name string, failedFor time.Duration) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: name + "@example.com"},
+			Status: platformv1alpha1.TenantStatus{
+				State:     platformv1alpha1.StateFailed,
+				LastError: "namespace creation failed",
+				Conditions: []metav1.Condition{{
+					Type:               platformv1alpha1.ConditionReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "TransientError",
+					LastTransitionTime: metav1.NewTime(now.Add(-failedFor)),
+				}},
+			},
+		}
+	}
+	expired := newFailed("expired", 7*24*time.Hour)
+	recent := newFailed("recent", time.Hour)
+	paused := newFailed("paused", 30*24*time.Hour)
+	paused.Annotations = map[string]string{controller.PausedAnnotation: "true"}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(expired, recent, paused).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	cfg.Cleanup.Action = config.FailedCleanupDelete
+	notifier := &recordingNotifier{}
+	c := &controller.FailedTenantCleaner{
+		Client:   cl,
+		Recorder: record.NewFakeRecorder(10),
+		Notifier: notifier,
+		Audit:    &audit.Recorder{Client: cl, Namespace: controller.OperatorNamespace},
+		Config:   cfg,
+		Log:      logr.Discard(),
+	}
+
+	// The retention ended: the owner is notified, nothing is deleted yet
+	require.NoError(t, c.Cleanup(ctx, now))
+	require.Len(t, notifier.sent, 1)
+	assert.Equal(t, []string{"expired@example.com"}, notifier.sent[0].To)
+	assert.Contains(t, notifier.sent[0].Body, "will be deleted at Sat, 17 Oct 2026 09:00:00 UTC")
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "expired"}, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionCleanupScheduled))
+
+	require.NoError(t, c.Cleanup(ctx, now.Add(12*time.Hour)))
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "expired"}, current))
+	assert.Len(t, notifier.sent, 1)
+
+	// The notice period passed: the tenant is deleted and audited
+	require.NoError(t, c.Cleanup(ctx, now.Add(25*time.Hour)))
+	err := cl.Get(ctx, types.NamespacedName{Name: "expired"}, current)
+	assert.True(t, apierrors.IsNotFound(err), "expired tenant should be deleted")
+
+	entries := &corev1.ConfigMapList{}
+	require.NoError(t, cl.List(ctx, entries, client.MatchingLabels{audit.ActionLabelKey: audit.ActionFailedCleanup}))
+	assert.Len(t, entries.Items, 1)
+
+	for _, name := range []string{"recent", "paused"} {
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: name}, current), name)
+		assert.Nil(t, apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionCleanupScheduled), name)
+	}
+}
+
+// TestFailedTenantCleanupSuspend verifies the Suspend action and that a tenant that
+// recovers during the notice period is unscheduled.
+func TestFailedTenantCleanupSuspend(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "sleepy"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.GoldTier, Owner: "sleepy@example.com"},
+		Status: platformv1alpha1.TenantStatus{
+			State: platformv1alpha1.StateFailed,
+			Conditions: []metav1.Condition{{
+				Type:               platformv1alpha1.ConditionReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             "CapacityError",
+				LastTransitionTime: metav1.NewTime(now.Add(-8 * 24 * time.Hour)),
+			}},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	cfg.Cleanup.Action = config.FailedCleanupSuspend
+	c := &controller.FailedTenantCleaner{
+		Client:   cl,
+		Recorder: record.NewFakeRecorder(10),
+		Notifier: &recordingNotifier{},
+		Config:   cfg,
+		Log:      logr.Discard(),
+	}
+	key := types.NamespacedName{Name: "sleepy"}
+
+	require.NoError(t, c.Cleanup(ctx, now))
+	require.NoError(t, c.Cleanup(ctx, now.Add(25*time.Hour)))
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, key, current))
+	assert.True(t, current.Spec.Suspend)
+	assert.True(t, apimeta.IsStatusConditionFalse(current.Status.Conditions, platformv1alpha1.ConditionCleanupScheduled))
+
+	// Recovery during the notice period cancels the cleanup
+	current.Spec.Suspend = false
+	require.NoError(t, cl.Update(ctx, current))
+	require.NoError(t, c.Cleanup(ctx, now.Add(26*time.Hour)))
+	require.NoError(t, cl.Get(ctx, key, current))
+	require.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionCleanupScheduled))
+
+	current.Status.State = platformv1alpha1.StateReady
+	apimeta.SetStatusCondition(&current.Status.Conditions, metav1.Condition{
+		Type: platformv1alpha1.ConditionReady, Status: metav1.ConditionTrue, Reason: "Provisioned",
+	})
+	require.NoError(t, cl.Status().Update(ctx, current))
+	require.NoError(t, c.Cleanup(ctx, now.Add(30*time.Hour)))
+
+	require.NoError(t, cl.Get(ctx, key, current))
+	assert.False(t, current.Spec.Suspend)
+	cond := apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionCleanupScheduled)
+	require.NotNil(t, cond)
+	assert.Equal(t, "Recovered", cond.Reason)
+}
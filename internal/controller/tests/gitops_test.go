@@ -0,0 +1,233 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestGitOpsArgoCD verifies that a tenant with spec.gitops.argocd gets an AppProject
+// limited to its namespaces with a role for its access control groups, plus a bootstrap
+// Application for spec.gitops.repoURL, and that both are removed once it is disabled.
+func TestGitOpsArgoCD(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:          platformv1alpha1.SilverTier,
+			Owner:         "owner@example.com",
+			Environments:  []platformv1alpha1.TenantEnvironment{{Name: "dev"}},
+			AccessControl: platformv1alpha1.AccessControlConfig{Groups: []string{"oidc:shop-devs"}, Role: platformv1alpha1.AccessRoleEdit},
+			GitOps: &platformv1alpha1.GitOpsConfig{
+				RepoURL: "https://git.example.com/shop/deploy.git",
+				Path:    "overlays/prod",
+				ArgoCD:  &platformv1alpha1.ArgoCDConfig{Enabled: true},
+			},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "shop"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	project := &unstructured.Unstructured{}
+	project.SetGroupVersionKind(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "AppProject"})
+	projectKey := types.NamespacedName{Namespace: "argocd", Name: "tenant-shop"}
+	require.NoError(t, cl.Get(ctx, projectKey, project))
+	repos, _, _ := unstructured.NestedStringSlice(project.Object, "spec", "sourceRepos")
+	assert.Equal(t, []string{"https://git.example.com/shop/deploy.git"}, repos)
+	destinations, _, _ := unstructured.NestedSlice(project.Object, "spec", "destinations")
+	var namespaces []string
+	for _, d := range destinations {
+		namespaces = append(namespaces, d.(map[string]interface{})["namespace"].(string))
+	}
+	assert.Equal(t, []string{"tenant-shop", "tenant-shop-dev"}, namespaces)
+	clusterResources, found, _ := unstructured.NestedSlice(project.Object, "spec", "clusterResourceWhitelist")
+	assert.True(t, found)
+	assert.Empty(t, clusterResources, "no cluster-scoped objects")
+	roles, _, _ := unstructured.NestedSlice(project.Object, "spec", "roles")
+	require.Len(t, roles, 1)
+	role := roles[0].(map[string]interface{})
+	assert.Equal(t, "edit", role["name"])
+	assert.Equal(t, []interface{}{"oidc:shop-devs"}, role["groups"])
+	assert.Contains(t, role["policies"], "p, proj:tenant-shop:edit, applications, sync, tenant-shop/*, allow")
+	assert.NotContains(t, role["policies"], "p, proj:tenant-shop:edit, applications, delete, tenant-shop/*, allow")
+
+	application := &unstructured.Unstructured{}
+	application.SetGroupVersionKind(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Application"})
+	applicationKey := types.NamespacedName{Namespace: "argocd", Name: "tenant-shop-bootstrap"}
+	require.NoError(t, cl.Get(ctx, applicationKey, application))
+	projectName, _, _ := unstructured.NestedString(application.Object, "spec", "project")
+	assert.Equal(t, "tenant-shop", projectName)
+	path, _, _ := unstructured.NestedString(application.Object, "spec", "source", "path")
+	assert.Equal(t, "overlays/prod", path)
+	revision, _, _ := unstructured.NestedString(application.Object, "spec", "source", "targetRevision")
+	assert.Equal(t, "HEAD", revision)
+	destination, _, _ := unstructured.NestedString(application.Object, "spec", "destination", "namespace")
+	assert.Equal(t, "tenant-shop", destination)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionGitOpsReady))
+
+	// Disabling Argo CD removes the project and the Application
+	current.Spec.GitOps.ArgoCD.Enabled = false
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, projectKey, project)))
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, applicationKey, application)))
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Nil(t, apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionGitOpsReady))
+}
+
+// TestGitOpsValidation verifies that spec.gitops is limited to Silver and Gold tenants
+// and needs a cloneable repository URL.
+func TestGitOpsValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(tier platformv1alpha1.TenantTier, repoURL string) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:  tier,
+				Owner: "admin@example.com",
+				GitOps: &platformv1alpha1.GitOpsConfig{
+					RepoURL: repoURL,
+					ArgoCD:  &platformv1alpha1.ArgoCDConfig{Enabled: true},
+				},
+			},
+		}
+	}
+
+	_, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.GoldTier, "git@git.example.com:shop/deploy.git"))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.BronzeTier, ""))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "gitops is only supported for Silver and Gold tier tenants")
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.SilverTier, "ftp://git.example.com/shop"))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "spec.gitops.repoURL")
+}
+
+// TestGitOpsFlux verifies that a tenant with spec.gitops.flux gets a GitRepository and a
+// Kustomization in its namespace that apply the repository as the tenant ServiceAccount.
+func TestGitOpsFlux(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.GoldTier,
+			Owner: "owner@example.com",
+			GitOps: &platformv1alpha1.GitOpsConfig{
+				RepoURL:        "ssh://git@git.example.com/shop/deploy.git",
+				TargetRevision: "main",
+				Flux: &platformv1alpha1.FluxConfig{
+					Enabled:    true,
+					Interval:   &metav1.Duration{Duration: time.Minute},
+					SecretName: "deploy-key",
+				},
+			},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "shop"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	key := types.NamespacedName{Namespace: "tenant-shop", Name: "shop-bootstrap"}
+	repository := &unstructured.Unstructured{}
+	repository.SetGroupVersionKind(schema.GroupVersionKind{Group: "source.toolkit.fluxcd.io", Version: "v1", Kind: "GitRepository"})
+	require.NoError(t, cl.Get(ctx, key, repository))
+	url, _, _ := unstructured.NestedString(repository.Object, "spec", "url")
+	assert.Equal(t, "ssh://git@git.example.com/shop/deploy.git", url)
+	branch, _, _ := unstructured.NestedString(repository.Object, "spec", "ref", "branch")
+	assert.Equal(t, "main", branch)
+	secret, _, _ := unstructured.NestedString(repository.Object, "spec", "secretRef", "name")
+	assert.Equal(t, "deploy-key", secret)
+
+	kustomization := &unstructured.Unstructured{}
+	kustomization.SetGroupVersionKind(schema.GroupVersionKind{Group: "kustomize.toolkit.fluxcd.io", Version: "v1", Kind: "Kustomization"})
+	require.NoError(t, cl.Get(ctx, key, kustomization))
+	serviceAccount, _, _ := unstructured.NestedString(kustomization.Object, "spec", "serviceAccountName")
+	assert.Equal(t, "shop-sa", serviceAccount)
+	target, _, _ := unstructured.NestedString(kustomization.Object, "spec", "targetNamespace")
+	assert.Equal(t, "tenant-shop", target)
+	interval, _, _ := unstructured.NestedString(kustomization.Object, "spec", "interval")
+	assert.Equal(t, "1m0s", interval)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionGitOpsReady))
+
+	// Flux cannot clone scp-style URLs
+	w := &validating.TenantValidatingWebhook{}
+	current.Spec.GitOps.RepoURL = "git@git.example.com:shop/deploy.git"
+	_, err = w.ValidateCreate(ctx, current)
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "Flux requires an https:// or ssh:// repository URL")
+}
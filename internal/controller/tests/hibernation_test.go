@@ -0,0 +1,196 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestHibernationWindows verifies that a weekday-night window, evaluated in its time
+// zone, also covers the weekend and reports when it next opens or closes.
+func TestHibernationWindows(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	hibernation := &platformv1alpha1.HibernationConfig{
+		Schedule: []platformv1alpha1.HibernationWindow{{Start: "0 19 * * 1-5", End: "0 7 * * 1-5"}},
+		TimeZone: "Europe/Berlin",
+	}
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		hibernating bool
+		next        time.Time
+	}{
+		{"weekday", time.Date(2026, 10, 14, 12, 0, 0, 0, berlin), false, time.Date(2026, 10, 14, 19, 0, 0, 0, berlin)},
+		{"weekday night", time.Date(2026, 10, 14, 23, 0, 0, 0, berlin), true, time.Date(2026, 10, 15, 7, 0, 0, 0, berlin)},
+		{"window opens", time.Date(2026, 10, 14, 19, 0, 0, 0, berlin), true, time.Date(2026, 10, 15, 7, 0, 0, 0, berlin)},
+		{"weekend", time.Date(2026, 10, 17, 12, 0, 0, 0, berlin), true, time.Date(2026, 10, 19, 7, 0, 0, 0, berlin)},
+		{"monday morning", time.Date(2026, 10, 19, 8, 0, 0, 0, berlin), false, time.Date(2026, 10, 19, 19, 0, 0, 0, berlin)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hibernating, next, err := controller.InHibernationWindow(hibernation, tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.hibernating, hibernating)
+			assert.True(t, next.Equal(tt.next), "next transition %s, want %s", next, tt.next)
+		})
+	}
+}
+
+// TestHibernationSuspendsTenant verifies that a tenant inside a hibernation window is
+// suspended until the window closes, and that the time spent hibernating is added up in
+// status and the suspended-duration metric.
+func TestHibernationSuspendsTenant(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, appsv1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	// A daily window from an hour ago until an hour from now
+	now := time.Now().UTC().Truncate(time.Second)
+	daily := func(at time.Time) string { return fmt.Sprintf("%d %d * * *", at.Minute(), at.Hour()) }
+	since := metav1.NewTime(now.Add(-3 * time.Hour))
+	two := int32(2)
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "owner@example.com",
+			Hibernation: &platformv1alpha1.HibernationConfig{
+				Schedule: []platformv1alpha1.HibernationWindow{{Start: daily(now.Add(-time.Hour)), End: daily(now.Add(time.Hour))}},
+			},
+		},
+		// An hour of earlier hibernation, and the current one started three hours ago
+		Status: platformv1alpha1.TenantStatus{
+			State:       platformv1alpha1.StateReady,
+			Hibernation: &platformv1alpha1.HibernationStatus{Hibernating: true, Since: &since, SuspendedSeconds: 3600},
+		},
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "tenant-nightly",
+		Labels: map[string]string{controller.TenantNameLabelKey: "nightly"},
+	}}
+	api := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "tenant-nightly"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &two},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, ns, api).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "nightly"}}
+
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Greater(t, result.RequeueAfter, time.Duration(0))
+	assert.LessOrEqual(t, result.RequeueAfter, time.Hour, "requeued when the window closes")
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateSuspended, current.Status.State)
+	ready := apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, "Hibernating", ready.Reason)
+	require.NotNil(t, current.Status.Hibernation)
+	assert.True(t, current.Status.Hibernation.Hibernating)
+	assert.True(t, current.Status.Hibernation.Since.Equal(&since), "an ongoing hibernation keeps its start")
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-nightly", Name: "api"}, api))
+	assert.Equal(t, int32(0), *api.Spec.Replicas)
+	assert.InDelta(t, 4*time.Hour.Seconds(), testutil.ToFloat64(metrics.HibernationSuspendedGauge.WithLabelValues("nightly", "Silver")), 5)
+
+	// Once the window closes the tenant is resumed and the hibernation added to the total
+	current.Spec.Hibernation.Schedule[0] = platformv1alpha1.HibernationWindow{Start: daily(now.Add(time.Hour)), End: daily(now.Add(2 * time.Hour))}
+	require.NoError(t, cl.Update(ctx, current))
+	result, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, result.RequeueAfter, time.Hour, "requeued when the next window opens")
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+	assert.False(t, current.Status.Hibernation.Hibernating)
+	assert.Nil(t, current.Status.Hibernation.Since)
+	assert.InDelta(t, 4*time.Hour.Seconds(), float64(current.Status.Hibernation.SuspendedSeconds), 5)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-nightly", Name: "api"}, api))
+	assert.Equal(t, int32(2), *api.Spec.Replicas)
+}
+
+// TestHibernationValidation verifies that spec.hibernation needs valid cron windows in a
+// known time zone and is rejected for Bronze tenants.
+func TestHibernationValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(tier platformv1alpha1.TenantTier, timeZone, start string) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:  tier,
+				Owner: "admin@example.com",
+				Hibernation: &platformv1alpha1.HibernationConfig{
+					Schedule: []platformv1alpha1.HibernationWindow{{Start: start, End: "0 7 * * 1-5"}},
+					TimeZone: timeZone,
+				},
+			},
+		}
+	}
+
+	_, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.GoldTier, "Europe/Berlin", "0 19 * * 1-5"))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.SilverTier, "", "after work"))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "spec.hibernation.schedule[0]")
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.SilverTier, "Mars/Olympus", "0 19 * * 1-5"))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "spec.hibernation.timeZone")
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.BronzeTier, "", "0 19 * * 1-5"))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "hibernation is only supported for Silver and Gold tier tenants")
+}
@@ -0,0 +1,169 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestKubeconfigRotationSilver verifies that the rotator reissues a Silver tier token
+// that is due for renewal and records the rotation.
+func TestKubeconfigRotationSilver(t *testing.T) {
+	ctx := context.Background()
+	issued := 0
+	cl, r := kubeconfigFixture(t, true, &issued)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}}
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, 1, issued)
+
+	recorder := record.NewFakeRecorder(10)
+	rotator := &controller.KubeconfigRotator{Client: cl, Scheme: r.Scheme, Recorder: recorder, Config: r.Config, Log: logr.Discard()}
+
+	// Nothing is due yet
+	require.NoError(t, rotator.Check(ctx, time.Now()))
+	assert.Equal(t, 1, issued)
+	assert.Empty(t, recorder.Events)
+
+	// One hour left of a 10h token is less than a fifth of its lifetime
+	secret := &corev1.Secret{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-acme", Name: "acme-kubeconfig"}, secret))
+	secret.Annotations["tenant.platform.io/token-expires-at"] = time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	require.NoError(t, cl.Update(ctx, secret))
+	tenant := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, tenant))
+	issuedAt := tenant.Status.KubeconfigRotatedAt
+	require.NotNil(t, issuedAt)
+
+	require.NoError(t, rotator.Check(ctx, time.Now().Add(time.Second)))
+	assert.Equal(t, 2, issued)
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, tenant))
+	require.NotNil(t, tenant.Status.KubeconfigExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(10*time.Hour), tenant.Status.KubeconfigExpiresAt.Time, time.Minute)
+	require.NotNil(t, tenant.Status.KubeconfigRotatedAt)
+	assert.False(t, tenant.Status.KubeconfigRotatedAt.Before(issuedAt))
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "KubeconfigRotated")
+}
+
+// TestKubeconfigRotationGold verifies that a Gold tier vCluster whose client certificate
+// is about to expire is restarted once to issue a new one.
+func TestKubeconfigRotationGold(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, appsv1.AddToScheme(s))
+
+	notAfter := time.Now().Add(10 * 24 * time.Hour).Truncate(time.Second)
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(
+			&platformv1alpha1.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "bank"},
+				Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.GoldTier, Owner: "owner@example.com"},
+				Status:     platformv1alpha1.TenantStatus{State: platformv1alpha1.StateReady},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "vc-bank-vcluster", Namespace: "tenant-bank"},
+				Data:       map[string][]byte{"config": vClusterKubeconfig(t, notAfter)},
+			},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bank-vcluster-certs", Namespace: "tenant-bank"}},
+			&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "bank-vcluster", Namespace: "tenant-bank"}},
+		).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	rotator := &controller.KubeconfigRotator{Client: cl, Scheme: s, Recorder: record.NewFakeRecorder(10), Config: cfg, Log: logr.Discard()}
+	require.NoError(t, rotator.Check(ctx, time.Now()))
+
+	tenant := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "bank"}, tenant))
+	require.NotNil(t, tenant.Status.KubeconfigExpiresAt)
+	assert.True(t, tenant.Status.KubeconfigExpiresAt.Time.Equal(notAfter))
+	assert.NotNil(t, tenant.Status.KubeconfigRotatedAt)
+
+	err := cl.Get(ctx, types.NamespacedName{Namespace: "tenant-bank", Name: "bank-vcluster-certs"}, &corev1.Secret{})
+	assert.True(t, apierrors.IsNotFound(err), "certificates are deleted for the vCluster to regenerate")
+	ss := &appsv1.StatefulSet{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-bank", Name: "bank-vcluster"}, ss))
+	restartedAt := ss.Spec.Template.Annotations["tenant.platform.io/restarted-at"]
+	require.NotEmpty(t, restartedAt)
+
+	// The restarted vCluster is given time to publish the new certificate
+	require.NoError(t, rotator.Check(ctx, time.Now()))
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-bank", Name: "bank-vcluster"}, ss))
+	assert.Equal(t, restartedAt, ss.Spec.Template.Annotations["tenant.platform.io/restarted-at"])
+}
+
+// vClusterKubeconfig returns a vCluster admin kubeconfig with a client certificate valid until notAfter.
+func vClusterKubeconfig(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "admin"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.Clusters["vcluster"] = &clientcmdapi.Cluster{Server: "https://localhost:8443"}
+	kubeconfig.AuthInfos["admin"] = &clientcmdapi.AuthInfo{
+		ClientCertificateData: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		ClientKeyData:         pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+	kubeconfig.Contexts["vcluster"] = &clientcmdapi.Context{Cluster: "vcluster", AuthInfo: "admin"}
+	kubeconfig.CurrentContext = "vcluster"
+	data, err := clientcmd.Write(*kubeconfig)
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,162 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// tokenRequestInterceptor answers ServiceAccount TokenRequests, which the fake client
+// does not support, and counts them in issued.
+func tokenRequestInterceptor(issued *int) interceptor.Funcs {
+	return interceptor.Funcs{
+		SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+			tr, ok := subResource.(*authenticationv1.TokenRequest)
+			if subResourceName != "token" || !ok {
+				return c.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+			}
+			*issued++
+			tr.Status.Token = "token-for-" + obj.GetName()
+			tr.Status.ExpirationTimestamp = metav1.NewTime(time.Now().Add(time.Duration(*tr.Spec.ExpirationSeconds) * time.Second))
+			return nil
+		},
+	}
+}
+
+// kubeconfigFixture returns a client holding a Silver tenant and, if withCA, the cluster
+// CA bundle of its namespace, and a reconciler issuing 10h tokens.
+func kubeconfigFixture(t *testing.T, withCA bool, issued *int) (client.Client, *controller.TenantReconciler) {
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	objs := []client.Object{&platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "owner@example.com"},
+	}}
+	if withCA {
+		objs = append(objs, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-root-ca.crt", Namespace: "tenant-acme"},
+			Data:       map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----\n"},
+		})
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		WithInterceptorFuncs(tokenRequestInterceptor(issued)).
+		Build()
+
+	cfg := config.Default()
+	cfg.Kubeconfig.APIServer = "https://api.example.com:6443"
+	cfg.Kubeconfig.TokenTTL = 10 * time.Hour
+	cfg.Requeue.Resync = 0 // only renewals schedule a reconcile
+	return cl, &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: cfg}
+}
+
+// TestSilverKubeconfig verifies that Silver tenants get a ServiceAccount kubeconfig that
+// is kept until renewal is due or the ServiceAccount is recreated.
+func TestSilverKubeconfig(t *testing.T) {
+	ctx := context.Background()
+	issued := 0
+	cl, r := kubeconfigFixture(t, true, &issued)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}}
+
+	res, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.InDelta(t, (8 * time.Hour).Seconds(), res.RequeueAfter.Seconds(), 5, "renewal is due with a fifth of the lifetime left")
+
+	tenant := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, tenant))
+	assert.Equal(t, "acme-kubeconfig", tenant.Status.AdminKubeconfigSecret)
+	require.NotNil(t, tenant.Status.KubeconfigExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(10*time.Hour), tenant.Status.KubeconfigExpiresAt.Time, time.Minute)
+	assert.True(t, apimeta.IsStatusConditionTrue(tenant.Status.Conditions, platformv1alpha1.ConditionKubeconfigAvailable))
+
+	secret := &corev1.Secret{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-acme", Name: "acme-kubeconfig"}, secret))
+	kubeconfig, err := clientcmd.Load(secret.Data["kubeconfig"])
+	require.NoError(t, err)
+	current := kubeconfig.Contexts[kubeconfig.CurrentContext]
+	require.NotNil(t, current)
+	assert.Equal(t, "tenant-acme", current.Namespace)
+	assert.Equal(t, "https://api.example.com:6443", kubeconfig.Clusters[current.Cluster].Server)
+	assert.Contains(t, string(kubeconfig.Clusters[current.Cluster].CertificateAuthorityData), "BEGIN CERTIFICATE")
+	assert.Equal(t, "token-for-acme-sa", kubeconfig.AuthInfos[current.AuthInfo].Token)
+
+	// A valid kubeconfig is kept
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 1, issued)
+
+	// A recreated ServiceAccount invalidates the token
+	sa := &corev1.ServiceAccount{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-acme", Name: "acme-sa"}, sa))
+	sa.UID = "recreated"
+	require.NoError(t, cl.Update(ctx, sa))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 2, issued)
+}
+
+// TestSilverKubeconfigRetry verifies that a kubeconfig that cannot be issued yet leaves the
+// tenant Ready and is retried.
+func TestSilverKubeconfigRetry(t *testing.T) {
+	ctx := context.Background()
+	issued := 0
+	cl, r := kubeconfigFixture(t, false, &issued)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}}
+
+	res, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, config.Default().Requeue.Transient, res.RequeueAfter)
+	assert.Zero(t, issued)
+
+	tenant := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, tenant))
+	assert.Equal(t, platformv1alpha1.StateReady, tenant.Status.State)
+	assert.Empty(t, tenant.Status.AdminKubeconfigSecret)
+	cond := apimeta.FindStatusCondition(tenant.Status.Conditions, platformv1alpha1.ConditionKubeconfigAvailable)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Contains(t, cond.Message, "cluster CA bundle")
+}
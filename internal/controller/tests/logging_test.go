@@ -0,0 +1,180 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestLogRouting verifies that a Silver tenant with spec.logging gets a Fluent Bit
+// routing snippet covering its namespace and environments under its own Loki tenant.
+func TestLogRouting(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "logs",
+			Finalizers: []string{controller.TenantFinalizerName},
+		},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:         platformv1alpha1.SilverTier,
+			Owner:        "owner@example.com",
+			Environments: []platformv1alpha1.TenantEnvironment{{Name: "dev"}},
+			Logging:      &platformv1alpha1.LoggingConfig{Backend: "Loki", TenantID: "team-logs"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	cfg.Logging.LokiURL = "https://loki.logging.svc:3100"
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: cfg}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "logs"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	cm := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: controller.OperatorNamespace, Name: "tenant-logging-logs"}
+	require.NoError(t, cl.Get(ctx, key, cm))
+	assert.Equal(t, "true", cm.Labels[controller.LogRoutingLabelKey])
+
+	routing := cm.Data["fluent-bit.conf"]
+	assert.Contains(t, routing, "Match      kube.*_tenant-logs_*")
+	assert.Contains(t, routing, "Match      kube.*_tenant-logs-dev_*")
+	assert.Contains(t, routing, "tenant_id  team-logs")
+	assert.Contains(t, routing, "Host       loki.logging.svc")
+	assert.Contains(t, routing, "tls        on")
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, &platformv1alpha1.LoggingStatus{
+		Backend:    "Loki",
+		Collector:  platformv1alpha1.LogCollectorFluentBit,
+		Stream:     "team-logs",
+		Namespaces: []string{"tenant-logs", "tenant-logs-dev"},
+		ConfigMap:  controller.OperatorNamespace + "/tenant-logging-logs",
+	}, current.Status.Logging)
+
+	// Dropping spec.logging removes the routing
+	current.Spec.Logging = nil
+	require.NoError(t, cl.Update(ctx, current))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Error(t, cl.Get(ctx, key, cm))
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Nil(t, current.Status.Logging)
+}
+
+// TestLogRoutingVector verifies that spec.logging.collector=Vector renders a filter
+// transform over the tenant namespaces and an Elasticsearch sink writing to the
+// tenant's index.
+func TestLogRoutingVector(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "logs", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:    platformv1alpha1.SilverTier,
+			Owner:   "owner@example.com",
+			Logging: &platformv1alpha1.LoggingConfig{Backend: "Elasticsearch", Collector: platformv1alpha1.LogCollectorVector},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	cfg.Logging.ElasticsearchURL = "https://es.logging.svc:9200"
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: cfg}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "logs"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: controller.OperatorNamespace, Name: "tenant-logging-logs"}, cm))
+	assert.Equal(t, "vector", cm.Labels[controller.LogCollectorLabelKey])
+	assert.NotContains(t, cm.Data, "fluent-bit.conf")
+
+	var routing struct {
+		Transforms map[string]struct {
+			Type      string   `json:"type"`
+			Inputs    []string `json:"inputs"`
+			Condition string   `json:"condition"`
+		} `json:"transforms"`
+		Sinks map[string]struct {
+			Type      string            `json:"type"`
+			Inputs    []string          `json:"inputs"`
+			Endpoints []string          `json:"endpoints"`
+			Bulk      map[string]string `json:"bulk"`
+		} `json:"sinks"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data["vector.yaml"]), &routing))
+	filter := routing.Transforms["tenant-logs"]
+	assert.Equal(t, "filter", filter.Type)
+	assert.Equal(t, []string{"kubernetes_logs"}, filter.Inputs)
+	assert.Equal(t, `includes(["tenant-logs"], .kubernetes.pod_namespace)`, filter.Condition)
+	sink := routing.Sinks["tenant-logs"]
+	assert.Equal(t, "elasticsearch", sink.Type)
+	assert.Equal(t, []string{"tenant-logs"}, sink.Inputs)
+	assert.Equal(t, []string{"https://es.logging.svc:9200"}, sink.Endpoints)
+	assert.Equal(t, "tenant-logs", sink.Bulk["index"])
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	require.NotNil(t, current.Status.Logging)
+	assert.Equal(t, "tenant-logs", current.Status.Logging.Stream)
+	assert.Equal(t, platformv1alpha1.LogCollectorVector, current.Status.Logging.Collector)
+}
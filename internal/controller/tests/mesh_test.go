@@ -0,0 +1,163 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestMeshIstio verifies that an Istio tenant's namespaces are labeled for sidecar
+// injection and get a STRICT PeerAuthentication and an AuthorizationPolicy admitting
+// only the tenant's namespaces, and that switching to Linkerd swaps the label for the
+// Linkerd annotations and removes the Istio policies.
+func TestMeshIstio(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:         platformv1alpha1.SilverTier,
+			Owner:        "owner@example.com",
+			Environments: []platformv1alpha1.TenantEnvironment{{Name: "dev"}, {Name: "staging"}, {Name: "prod"}},
+			Mesh: &platformv1alpha1.MeshConfig{
+				Provider:          platformv1alpha1.MeshProviderIstio,
+				AllowedNamespaces: []string{"istio-ingress"},
+			},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "shop"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	ns := &corev1.Namespace{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-shop-dev"}, ns))
+	assert.Equal(t, "enabled", ns.Labels[controller.IstioInjectionLabelKey])
+
+	peerAuthentication := &unstructured.Unstructured{}
+	peerAuthentication.SetGroupVersionKind(schema.GroupVersionKind{Group: "security.istio.io", Version: "v1beta1", Kind: "PeerAuthentication"})
+	peerAuthenticationKey := types.NamespacedName{Namespace: "tenant-shop", Name: controller.MeshPeerAuthenticationName}
+	require.NoError(t, cl.Get(ctx, peerAuthenticationKey, peerAuthentication))
+	mode, _, _ := unstructured.NestedString(peerAuthentication.Object, "spec", "mtls", "mode")
+	assert.Equal(t, "STRICT", mode)
+
+	sources := func(namespace string) []string {
+		policy := &unstructured.Unstructured{}
+		policy.SetGroupVersionKind(schema.GroupVersionKind{Group: "security.istio.io", Version: "v1beta1", Kind: "AuthorizationPolicy"})
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: controller.MeshAuthorizationPolicyName}, policy))
+		action, _, _ := unstructured.NestedString(policy.Object, "spec", "action")
+		assert.Equal(t, "ALLOW", action)
+		rules, _, _ := unstructured.NestedSlice(policy.Object, "spec", "rules")
+		require.Len(t, rules, 1)
+		from, _, _ := unstructured.NestedSlice(rules[0].(map[string]interface{}), "from")
+		require.Len(t, from, 1)
+		namespaces, _, _ := unstructured.NestedStringSlice(from[0].(map[string]interface{}), "source", "namespaces")
+		return namespaces
+	}
+	assert.Equal(t, []string{"tenant-shop", "istio-ingress"}, sources("tenant-shop"))
+	assert.Equal(t, []string{"tenant-shop-dev", "tenant-shop-staging", "istio-ingress"}, sources("tenant-shop-dev"))
+	assert.Equal(t, []string{"tenant-shop-prod", "istio-ingress"}, sources("tenant-shop-prod"), "isolated environments only admit themselves")
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionMeshReady))
+
+	// Moving to Linkerd annotates the namespaces instead and removes the Istio policies
+	current.Spec.Mesh = &platformv1alpha1.MeshConfig{Provider: platformv1alpha1.MeshProviderLinkerd}
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-shop-dev"}, ns))
+	assert.NotContains(t, ns.Labels, controller.IstioInjectionLabelKey)
+	assert.Equal(t, "enabled", ns.Annotations[controller.LinkerdInjectAnnotation])
+	assert.Equal(t, "all-authenticated", ns.Annotations[controller.LinkerdInboundPolicyAnnotation])
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, peerAuthenticationKey, peerAuthentication)))
+
+	// Leaving the mesh clears the annotations and the condition
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	current.Spec.Mesh = nil
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-shop"}, ns))
+	assert.NotContains(t, ns.Annotations, controller.LinkerdInjectAnnotation)
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Nil(t, apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionMeshReady))
+}
+
+// TestMeshValidation verifies that spec.mesh is limited to Silver and Gold tenants and
+// that allowedNamespaces needs Istio and cannot name tenant namespaces.
+func TestMeshValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(tier platformv1alpha1.TenantTier, provider platformv1alpha1.MeshProvider, allowed ...string) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:  tier,
+				Owner: "admin@example.com",
+				Mesh:  &platformv1alpha1.MeshConfig{Provider: provider, AllowedNamespaces: allowed},
+			},
+		}
+	}
+
+	_, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.GoldTier, platformv1alpha1.MeshProviderIstio, "istio-ingress"))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.BronzeTier, platformv1alpha1.MeshProviderLinkerd))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "mesh is only supported for Silver and Gold tier tenants")
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.SilverTier, platformv1alpha1.MeshProviderLinkerd, "istio-ingress"))
+	assert.ErrorContains(t, err, "allowedNamespaces is only supported with the Istio provider")
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.SilverTier, platformv1alpha1.MeshProviderIstio, "tenant-other"))
+	assert.ErrorContains(t, err, "must not be a tenant namespace")
+}
@@ -0,0 +1,128 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// TestMetering verifies that the requests held since the previous sample are added to
+// status.billing and tenant_cost_total at the tier's unit prices, that a new month
+// starts a new billing period, and that tenants without usage are not metered.
+func TestMetering(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	now := time.Now().UTC()
+	if now.Add(-time.Hour).Month() != now.Month() {
+		t.Skip("the metered hour would straddle two billing periods")
+	}
+	usage := &platformv1alpha1.ResourceUsage{
+		CPU:     platformv1alpha1.QuotaUsage{Used: "2", Hard: "4"},
+		Memory:  platformv1alpha1.QuotaUsage{Used: "4Gi", Hard: "8Gi"},
+		Storage: platformv1alpha1.QuotaUsage{Used: "10Gi", Hard: "100Gi"},
+	}
+	tenant := func(name string, usage *platformv1alpha1.ResourceUsage, billing *platformv1alpha1.BillingStatus) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier},
+			Status:     platformv1alpha1.TenantStatus{ResourceUsage: usage, Billing: billing},
+		}
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(
+			tenant("shop", usage, &platformv1alpha1.BillingStatus{
+				Period:         now.Format("2006-01"),
+				CPUCoreHours:   "1.000000",
+				Cost:           "0.500000",
+				LastSampleTime: &metav1.Time{Time: now.Add(-time.Hour)},
+			}),
+			tenant("old", usage, &platformv1alpha1.BillingStatus{
+				Period:         "2000-01",
+				CPUCoreHours:   "1000.000000",
+				LastSampleTime: &metav1.Time{Time: time.Date(2000, 1, 31, 0, 0, 0, 0, time.UTC)},
+			}),
+			tenant("new", nil, nil),
+		).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	cfg.Metering.Interval = 2 * time.Hour
+	meter := &controller.Meter{Client: cl, Config: cfg, Log: logr.Discard()}
+	require.NoError(t, meter.Sample(ctx))
+
+	amount := func(v string) float64 {
+		f, err := strconv.ParseFloat(v, 64)
+		require.NoError(t, err)
+		return f
+	}
+
+	// One hour of 2 cores, 4Gi, and 10Gi at the Silver prices
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "shop"}, current))
+	billing := current.Status.Billing
+	require.NotNil(t, billing)
+	assert.InDelta(t, 3.0, amount(billing.CPUCoreHours), 0.001)
+	assert.InDelta(t, 4.0, amount(billing.MemoryGiBHours), 0.01)
+	assert.InDelta(t, 10.0, amount(billing.StorageGiBHours), 0.01)
+	assert.InDelta(t, 0.5+2*0.04+4*0.005+10*0.00014, amount(billing.Cost), 0.0001)
+	assert.InDelta(t, 0.08, testutil.ToFloat64(metrics.TenantCostCounter.WithLabelValues("shop", "Silver", "cpu")), 0.0001)
+
+	// The previous month's totals are not carried over
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "old"}, current))
+	require.NotNil(t, current.Status.Billing)
+	assert.Equal(t, now.Format("2006-01"), current.Status.Billing.Period)
+	assert.LessOrEqual(t, amount(current.Status.Billing.CPUCoreHours), 8.0, "at most two intervals are metered after a gap")
+
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "new"}, current))
+	assert.Nil(t, current.Status.Billing)
+}
+
+// TestParseUnitPrices verifies the per-tier price flag format.
+func TestParseUnitPrices(t *testing.T) {
+	prices, err := config.ParseUnitPrices("cpu=0.05, memory=0.006")
+	require.NoError(t, err)
+	assert.Equal(t, config.UnitPrices{CPUCoreHour: 0.05, MemoryGiBHour: 0.006}, prices)
+
+	_, err = config.ParseUnitPrices("gpu=1")
+	assert.ErrorContains(t, err, "unknown resource")
+	_, err = config.ParseUnitPrices("cpu=-1")
+	assert.ErrorContains(t, err, "non-negative")
+}
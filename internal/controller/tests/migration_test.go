@@ -0,0 +1,234 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// migrationFixture returns a source cluster holding a Silver tenant and a migration of
+// it, an empty target cluster, and a reconciler connecting the two.
+func migrationFixture(t *testing.T, verifyTimeout time.Duration, targetObjs ...client.Object) (client.Client, client.Client, *controller.TenantMigrationReconciler) {
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:        platformv1alpha1.SilverTier,
+			Owner:       "owner@example.com",
+			TemplateRef: &platformv1alpha1.TenantTemplateReference{Name: "team-standard"},
+		},
+		Status: platformv1alpha1.TenantStatus{State: platformv1alpha1.StateReady, Namespace: "tenant-acme"},
+	}
+	tm := &platformv1alpha1.TenantMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme-to-eu"},
+		Spec: platformv1alpha1.TenantMigrationSpec{
+			TenantName:    "acme",
+			Target:        platformv1alpha1.MigrationTarget{KubeconfigSecret: "cluster-eu"},
+			VerifyTimeout: &metav1.Duration{Duration: verifyTimeout},
+		},
+	}
+	kubeconfig := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-eu", Namespace: controller.OperatorNamespace},
+		Data:       map[string][]byte{"kubeconfig": []byte("apiVersion: v1\nkind: Config\n")},
+	}
+	appConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "tenant-acme"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	}
+	managedConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fluent-bit",
+			Namespace: "tenant-acme",
+			Labels:    map[string]string{controller.ManagedByLabelKey: controller.ManagedByValue},
+		},
+	}
+	rootCA := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-root-ca.crt", Namespace: "tenant-acme"}}
+
+	source := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, tm, kubeconfig, appConfig, managedConfig, rootCA).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &platformv1alpha1.TenantMigration{}, &platformv1alpha1.TenantSnapshot{}).
+		Build()
+	target := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(targetObjs...).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	r := &controller.TenantMigrationReconciler{
+		Client: source,
+		Scheme: s,
+		Log:    logr.Discard(),
+		RemoteClient: func(kubeconfig []byte) (client.Client, error) {
+			return target, nil
+		},
+	}
+	return source, target, r
+}
+
+// reconcileMigration runs the reconciler once and returns the migration.
+func reconcileMigration(t *testing.T, ctx context.Context, source client.Client, r *controller.TenantMigrationReconciler) *platformv1alpha1.TenantMigration {
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme-to-eu"}})
+	require.NoError(t, err)
+
+	tm := &platformv1alpha1.TenantMigration{}
+	require.NoError(t, source.Get(ctx, client.ObjectKey{Name: "acme-to-eu"}, tm))
+	return tm
+}
+
+// setTargetStatus simulates the target cluster's operator provisioning the tenant.
+func setTargetStatus(t *testing.T, ctx context.Context, target client.Client, state platformv1alpha1.TenantState) {
+	migrated := &platformv1alpha1.Tenant{}
+	require.NoError(t, target.Get(ctx, client.ObjectKey{Name: "acme"}, migrated))
+	migrated.Status.Namespace = "tenant-acme"
+	migrated.Status.State = state
+	require.NoError(t, target.Status().Update(ctx, migrated))
+}
+
+// TestTenantMigration verifies that a migration moves the tenant through every step and
+// decommissions the source once the target is Ready.
+func TestTenantMigration(t *testing.T) {
+	ctx := context.Background()
+	source, target, r := migrationFixture(t, time.Hour)
+
+	tm := reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationSnapshotting, tm.Status.Phase)
+	assert.NotNil(t, tm.Status.StartedAt)
+
+	tm = reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationProvisioning, tm.Status.Phase)
+	require.NotEmpty(t, tm.Status.Snapshot)
+	snapshot := &platformv1alpha1.TenantSnapshot{}
+	require.NoError(t, source.Get(ctx, client.ObjectKey{Name: tm.Status.Snapshot}, snapshot))
+	assert.Equal(t, platformv1alpha1.SnapshotMigration, snapshot.Spec.Trigger)
+	assert.Equal(t, platformv1alpha1.SnapshotCompleted, snapshot.Status.Phase)
+
+	tm = reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationRestoring, tm.Status.Phase)
+	migrated := &platformv1alpha1.Tenant{}
+	require.NoError(t, target.Get(ctx, client.ObjectKey{Name: "acme"}, migrated))
+	assert.Equal(t, "acme-to-eu", migrated.Annotations[controller.MigratedByAnnotation])
+	assert.Equal(t, platformv1alpha1.SilverTier, migrated.Spec.Tier)
+	assert.Nil(t, migrated.Spec.TemplateRef, "the template is already merged into the spec")
+
+	// Restoring waits for the target namespace
+	tm = reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationRestoring, tm.Status.Phase)
+
+	setTargetStatus(t, ctx, target, platformv1alpha1.StateProvisioning)
+	tm = reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationVerifying, tm.Status.Phase)
+	assert.Equal(t, "tenant-acme", tm.Status.TargetNamespace)
+	assert.EqualValues(t, 1, tm.Status.RestoredConfigMaps)
+	restored := &corev1.ConfigMap{}
+	require.NoError(t, target.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "app-config"}, restored))
+	assert.Equal(t, "debug", restored.Data["LOG_LEVEL"])
+	err := target.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "fluent-bit"}, &corev1.ConfigMap{})
+	assert.True(t, apierrors.IsNotFound(err), "operator-managed ConfigMaps are recreated by the target operator")
+
+	// Verifying waits for Ready
+	tm = reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationVerifying, tm.Status.Phase)
+
+	setTargetStatus(t, ctx, target, platformv1alpha1.StateReady)
+	tm = reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationDecommissioning, tm.Status.Phase)
+
+	tm = reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationCompleted, tm.Status.Phase)
+	assert.NotNil(t, tm.Status.CompletedAt)
+	err = source.Get(ctx, client.ObjectKey{Name: "acme"}, &platformv1alpha1.Tenant{})
+	assert.True(t, apierrors.IsNotFound(err), "source tenant should be decommissioned")
+	require.NoError(t, target.Get(ctx, client.ObjectKey{Name: "acme"}, &platformv1alpha1.Tenant{}))
+
+	var phases []platformv1alpha1.MigrationPhase
+	for _, step := range tm.Status.Steps {
+		phases = append(phases, step.Phase)
+		assert.NotNil(t, step.CompletedAt, "step %s should be completed", step.Phase)
+	}
+	assert.Equal(t, []platformv1alpha1.MigrationPhase{
+		platformv1alpha1.MigrationSnapshotting,
+		platformv1alpha1.MigrationProvisioning,
+		platformv1alpha1.MigrationRestoring,
+		platformv1alpha1.MigrationVerifying,
+		platformv1alpha1.MigrationDecommissioning,
+	}, phases)
+}
+
+// TestTenantMigrationRollback verifies that a target tenant that does not become Ready in
+// time is deleted and the source tenant is left in place.
+func TestTenantMigrationRollback(t *testing.T) {
+	ctx := context.Background()
+	source, target, r := migrationFixture(t, time.Nanosecond)
+
+	for i := 0; i < 3; i++ {
+		reconcileMigration(t, ctx, source, r)
+	}
+	setTargetStatus(t, ctx, target, platformv1alpha1.StateProvisioning)
+	tm := reconcileMigration(t, ctx, source, r)
+	require.Equal(t, platformv1alpha1.MigrationVerifying, tm.Status.Phase)
+
+	tm = reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationRolledBack, tm.Status.Phase)
+	assert.Contains(t, tm.Status.Message, "did not become Ready")
+	err := target.Get(ctx, client.ObjectKey{Name: "acme"}, &platformv1alpha1.Tenant{})
+	assert.True(t, apierrors.IsNotFound(err), "target tenant should be rolled back")
+	require.NoError(t, source.Get(ctx, client.ObjectKey{Name: "acme"}, &platformv1alpha1.Tenant{}))
+}
+
+// TestTenantMigrationTargetConflict verifies that a Tenant of the same name already on the
+// target cluster fails the migration and is left alone.
+func TestTenantMigrationTargetConflict(t *testing.T) {
+	ctx := context.Background()
+	existing := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.GoldTier, Owner: "other@example.com"},
+	}
+	source, target, r := migrationFixture(t, time.Hour, existing)
+
+	for i := 0; i < 2; i++ {
+		reconcileMigration(t, ctx, source, r)
+	}
+	tm := reconcileMigration(t, ctx, source, r)
+	assert.Equal(t, platformv1alpha1.MigrationFailed, tm.Status.Phase)
+	assert.Contains(t, tm.Status.Message, "already exists on the target cluster")
+
+	kept := &platformv1alpha1.Tenant{}
+	require.NoError(t, target.Get(ctx, client.ObjectKey{Name: "acme"}, kept))
+	assert.Equal(t, "other@example.com", kept.Spec.Owner)
+	require.NoError(t, source.Get(ctx, client.ObjectKey{Name: "acme"}, &platformv1alpha1.Tenant{}))
+}
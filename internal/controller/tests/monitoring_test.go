@@ -0,0 +1,145 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestMonitoring verifies that spec.observability.monitoring labels the tenant
+// namespaces and creates a ServiceMonitor over them and a PrometheusRule with the
+// default alerts, and that clearing it removes all three.
+func TestMonitoring(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:          platformv1alpha1.SilverTier,
+			Owner:         "owner@example.com",
+			Environments:  []platformv1alpha1.TenantEnvironment{{Name: "dev"}},
+			Observability: &platformv1alpha1.ObservabilityConfig{Monitoring: true},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "shop"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	for _, name := range []string{"tenant-shop", "tenant-shop-dev"} {
+		ns := &corev1.Namespace{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: name}, ns))
+		assert.Equal(t, "true", ns.Labels[controller.MonitoringLabelKey], name)
+	}
+
+	serviceMonitor := &unstructured.Unstructured{}
+	serviceMonitor.SetGroupVersionKind(schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"})
+	serviceMonitorKey := types.NamespacedName{Namespace: "tenant-shop", Name: controller.MonitoringServiceMonitorName}
+	require.NoError(t, cl.Get(ctx, serviceMonitorKey, serviceMonitor))
+	assert.Equal(t, "true", serviceMonitor.GetLabels()[controller.MonitoringLabelKey])
+	matchNames, _, _ := unstructured.NestedStringSlice(serviceMonitor.Object, "spec", "namespaceSelector", "matchNames")
+	assert.Equal(t, []string{"tenant-shop", "tenant-shop-dev"}, matchNames)
+
+	rule := &unstructured.Unstructured{}
+	rule.SetGroupVersionKind(schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"})
+	ruleKey := types.NamespacedName{Namespace: "tenant-shop", Name: controller.MonitoringRuleName}
+	require.NoError(t, cl.Get(ctx, ruleKey, rule))
+	groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+	require.Len(t, groups, 1)
+	rules, _, _ := unstructured.NestedSlice(groups[0].(map[string]interface{}), "rules")
+	alerts := map[string]string{}
+	for _, rl := range rules {
+		alerts[rl.(map[string]interface{})["alert"].(string)] = rl.(map[string]interface{})["expr"].(string)
+	}
+	assert.Contains(t, alerts["TenantQuotaNearLimit"], `kube_resourcequota{namespace=~"tenant-shop|tenant-shop-dev",type="used"}`)
+	assert.Contains(t, alerts["TenantQuotaNearLimit"], "> 0.9")
+	assert.Contains(t, alerts["TenantPodCrashLooping"], `reason="CrashLoopBackOff"`)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionMonitoringReady))
+
+	// Turning monitoring off removes the label and the Prometheus operator objects
+	current.Spec.Observability = nil
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	ns := &corev1.Namespace{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-shop"}, ns))
+	assert.NotContains(t, ns.Labels, controller.MonitoringLabelKey)
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, serviceMonitorKey, serviceMonitor)))
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, ruleKey, rule)))
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Nil(t, apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionMonitoringReady))
+}
+
+// TestMonitoringValidation verifies that spec.observability is limited to Silver and
+// Gold tenants.
+func TestMonitoringValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(tier platformv1alpha1.TenantTier) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:          tier,
+				Owner:         "admin@example.com",
+				Observability: &platformv1alpha1.ObservabilityConfig{Monitoring: true},
+			},
+		}
+	}
+
+	_, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.GoldTier))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.BronzeTier))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "observability is only supported for Silver and Gold tier tenants")
+}
@@ -0,0 +1,320 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestDualStackInternetEgress verifies that internet egress gets a CIDR rule per
+// configured IP family, each excluding the cloud metadata endpoint and the private and
+// Service ranges of that family.
+func TestDualStackInternetEgress(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:    platformv1alpha1.SilverTier,
+			Owner:   "admin@example.com",
+			Network: platformv1alpha1.NetworkConfig{AllowInternetAccess: true},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	cfg.Network.IPFamilies = []string{config.IPFamilyIPv4, config.IPFamilyIPv6}
+	cfg.Network.ServiceCIDRs = []string{"100.64.0.0/16", "fd00:10:96::/112"}
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: cfg}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "edge"}})
+	require.NoError(t, err)
+
+	policy := &netv1.NetworkPolicy{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-edge", Name: controller.DefaultNetworkPolicyName}, policy))
+
+	blocks := map[string][]string{}
+	for _, rule := range policy.Spec.Egress {
+		for _, peer := range rule.To {
+			if peer.IPBlock != nil {
+				blocks[peer.IPBlock.CIDR] = peer.IPBlock.Except
+			}
+		}
+	}
+	assert.Equal(t, []string{controller.MetadataCIDRIPv4, "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/16"},
+		blocks["0.0.0.0/0"])
+	assert.Equal(t, []string{controller.MetadataCIDRIPv6, "fd00:10:96::/112"}, blocks["::/0"])
+}
+
+// TestCIDREgress verifies that allowedCIDRs are reachable without internet access,
+// except the denied CIDRs inside them, and that deniedCIDRs are carved out of the
+// internet when it is allowed.
+func TestCIDREgress(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "partner", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "admin@example.com",
+			Network: platformv1alpha1.NetworkConfig{
+				AllowedCIDRs: []string{"203.0.113.0/24", "198.51.100.7/32", "169.254.0.0/16"},
+				DeniedCIDRs:  []string{"203.0.113.128/25", "198.51.100.0/24", "10.0.0.0/8"},
+			},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "partner"}}
+
+	ipBlocks := func() map[string][]string {
+		policy := &netv1.NetworkPolicy{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-partner", Name: controller.DefaultNetworkPolicyName}, policy))
+		blocks := map[string][]string{}
+		for _, rule := range policy.Spec.Egress {
+			for _, peer := range rule.To {
+				if peer.IPBlock != nil {
+					blocks[peer.IPBlock.CIDR] = peer.IPBlock.Except
+				}
+			}
+		}
+		return blocks
+	}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"203.0.113.0/24": {"203.0.113.128/25"},
+		"169.254.0.0/16": {controller.MetadataCIDRIPv4},
+	}, ipBlocks(), "198.51.100.7/32 is wholly denied")
+
+	// With internet access, the allowed CIDRs are superseded and the denied ones excepted
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	current.Spec.Network.AllowInternetAccess = true
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"0.0.0.0/0": {controller.MetadataCIDRIPv4, "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "203.0.113.128/25", "198.51.100.0/24"},
+	}, ipBlocks())
+}
+
+// TestAllowedIngressNamespaces verifies that allowedIngressNamespaces opens ingress from
+// the listed namespaces while excluding tenant namespaces, including the shared Bronze
+// namespace, and that the webhook rejects tenant namespaces outright.
+func TestAllowedIngressNamespaces(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "storefront", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:    platformv1alpha1.SilverTier,
+			Owner:   "admin@example.com",
+			Network: platformv1alpha1.NetworkConfig{AllowedIngressNamespaces: []string{"ingress-nginx", "monitoring"}},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "storefront"}})
+	require.NoError(t, err)
+
+	policy := &netv1.NetworkPolicy{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-storefront", Name: controller.DefaultNetworkPolicyName}, policy))
+	require.Len(t, policy.Spec.Ingress, 2)
+	assert.Equal(t, &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+		{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: []string{"ingress-nginx", "monitoring"}},
+		{Key: controller.TenantNameLabelKey, Operator: metav1.LabelSelectorOpDoesNotExist},
+		{Key: controller.TierLabelKey, Operator: metav1.LabelSelectorOpDoesNotExist},
+	}}, policy.Spec.Ingress[1].From[0].NamespaceSelector)
+	assert.Nil(t, policy.Spec.Ingress[1].From[0].PodSelector)
+
+	// The shared Bronze namespace carries no tenant name label, only the tier label
+	selector, err := metav1.LabelSelectorAsSelector(policy.Spec.Ingress[1].From[0].NamespaceSelector)
+	require.NoError(t, err)
+	assert.True(t, selector.Matches(labels.Set{corev1.LabelMetadataName: "monitoring"}))
+	assert.False(t, selector.Matches(labels.Set{
+		corev1.LabelMetadataName: controller.BronzeNamespace,
+		controller.TierLabelKey:  string(platformv1alpha1.BronzeTier),
+	}))
+
+	invalid := tenant.DeepCopy()
+	invalid.Spec.Network.AllowedIngressNamespaces = []string{"tenant-other", "Monitoring", controller.BronzeNamespace}
+	_, err = (&validating.TenantValidatingWebhook{}).ValidateCreate(ctx, invalid)
+	assert.ErrorContains(t, err, "must not be a tenant namespace")
+	assert.ErrorContains(t, err, "allowedIngressNamespaces[1]")
+	assert.ErrorContains(t, err, "allowedIngressNamespaces[2]")
+}
+
+// TestWhitelistedServicePorts verifies that whitelisted services allow egress only to the
+// pods selected by the Service, on the target ports of all or the referenced service
+// port, and that missing services are left out.
+func TestWhitelistedServicePorts(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "admin@example.com",
+			Network: platformv1alpha1.NetworkConfig{WhitelistedServices: []string{
+				"shared-services/auth-api",
+				"monitoring/prometheus:9090",
+				"shared-services/auth-api:grpc",
+				"shared-services/missing",
+				"monitoring/prometheus:8080",
+			}},
+		},
+	}
+	authAPI := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "auth-api", Namespace: "shared-services"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "auth"},
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt32(8080)},
+				{Name: "grpc", Port: 9000, TargetPort: intstr.FromString("grpc"), Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+	prometheus := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus", Namespace: "monitoring"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "prometheus"},
+			Ports:    []corev1.ServicePort{{Name: "web", Port: 9090}},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, authAPI, prometheus).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "shop"}})
+	require.NoError(t, err)
+
+	policy := &netv1.NetworkPolicy{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-shop", Name: controller.DefaultNetworkPolicyName}, policy))
+
+	type allowed struct {
+		namespace string
+		pods      map[string]string
+		ports     []string
+	}
+	var got []allowed
+	for _, rule := range policy.Spec.Egress {
+		if len(rule.To) != 1 || rule.To[0].PodSelector == nil || rule.To[0].NamespaceSelector == nil {
+			continue
+		}
+		var ports []string
+		for _, port := range rule.Ports {
+			ports = append(ports, string(*port.Protocol)+"/"+port.Port.String())
+		}
+		got = append(got, allowed{
+			namespace: rule.To[0].NamespaceSelector.MatchLabels[corev1.LabelMetadataName],
+			pods:      rule.To[0].PodSelector.MatchLabels,
+			ports:     ports,
+		})
+	}
+	assert.Equal(t, []allowed{
+		{"shared-services", map[string]string{"app": "auth"}, []string{"TCP/8080", "TCP/grpc"}},
+		{"monitoring", map[string]string{"app": "prometheus"}, []string{"TCP/9090"}},
+		{"shared-services", map[string]string{"app": "auth"}, []string{"TCP/grpc"}},
+	}, got)
+}
+
+// TestParseServiceRef verifies the whitelistedServices reference format.
+func TestParseServiceRef(t *testing.T) {
+	tests := []struct {
+		ref     string
+		want    controller.ServiceRef
+		wantErr bool
+	}{
+		{ref: "shared-services/auth-api", want: controller.ServiceRef{Namespace: "shared-services", Name: "auth-api"}},
+		{ref: "monitoring/prometheus:9090", want: controller.ServiceRef{Namespace: "monitoring", Name: "prometheus", Port: "9090"}},
+		{ref: "auth-api:grpc", want: controller.ServiceRef{Namespace: "default", Name: "auth-api", Port: "grpc"}},
+		{ref: "monitoring/prometheus:", wantErr: true},
+		{ref: "monitoring/prometheus:70000", wantErr: true},
+		{ref: "Monitoring/prometheus", wantErr: true},
+		{ref: "monitoring/", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := controller.ParseServiceRef(tt.ref)
+		if tt.wantErr {
+			assert.Error(t, err, tt.ref)
+			continue
+		}
+		require.NoError(t, err, tt.ref)
+		assert.Equal(t, tt.want, got)
+	}
+}
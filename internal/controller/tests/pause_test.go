@@ -0,0 +1,95 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestPausedReconciliation verifies that the paused annotation stops provisioning and
+// surfaces the ReconciliationPaused condition until it is removed.
+func TestPausedReconciliation(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "surgery",
+			Finalizers:  []string{controller.TenantFinalizerName},
+			Annotations: map[string]string{controller.PausedAnnotation: "true"},
+		},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "owner@example.com",
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "surgery"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	ns := &corev1.Namespace{}
+	err = cl.Get(ctx, types.NamespacedName{Name: "tenant-surgery"}, ns)
+	assert.True(t, apierrors.IsNotFound(err), "paused tenants must not be provisioned")
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionReconciliationPaused))
+	assert.Empty(t, current.Status.State)
+
+	// Resuming provisions the tenant and clears the condition
+	delete(current.Annotations, controller.PausedAnnotation)
+	require.NoError(t, cl.Update(ctx, current))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-surgery"}, ns))
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.True(t, apimeta.IsStatusConditionFalse(current.Status.Conditions, platformv1alpha1.ConditionReconciliationPaused))
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+}
@@ -0,0 +1,185 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+const edgeKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: edge
+  cluster:
+    server: https://edge.example.com:6443
+contexts:
+- name: edge
+  context:
+    cluster: edge
+    user: operator
+current-context: edge
+users:
+- name: operator
+  user:
+    token: secret-token
+`
+
+// TestPlacementOnRemoteCluster verifies that a tenant placed on a registered cluster gets
+// its namespace there rather than on the local cluster, labeled with the tenant UID in
+// place of an owner reference, that status.placement reports the cluster, and that the
+// remote namespace is deleted with the tenant.
+func TestPlacementOnRemoteCluster(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge-app", UID: "4b9e0f6a-edge", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:      platformv1alpha1.SilverTier,
+			Owner:     "owner@example.com",
+			Placement: &platformv1alpha1.PlacementConfig{Cluster: "edge"},
+		},
+	}
+	registration := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-edge",
+			Namespace: controller.OperatorNamespace,
+			Labels:    map[string]string{controller.ClusterNameLabelKey: "edge"},
+		},
+		Data: map[string][]byte{"kubeconfig": []byte(edgeKubeconfig)},
+	}
+	local := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &platformv1alpha1.TenantSnapshot{}).
+		Build()
+	remote := fake.NewClientBuilder().WithScheme(s).Build()
+	var kubeconfigs []string
+	r := &controller.TenantReconciler{
+		Client: local,
+		Scheme: s,
+		Log:    logr.Discard(),
+		RemoteClient: func(kubeconfig []byte) (client.Client, error) {
+			kubeconfigs = append(kubeconfigs, string(kubeconfig))
+			return remote, nil
+		},
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "edge-app"}}
+	nsKey := types.NamespacedName{Name: "tenant-edge-app"}
+
+	// The cluster is not registered yet
+	_, err := r.Reconcile(ctx, req)
+	require.Error(t, err)
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, local.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateFailed, current.Status.State)
+	placement := apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionPlacementReady)
+	require.NotNil(t, placement)
+	assert.Equal(t, "ClusterNotRegistered", placement.Reason)
+	require.NotNil(t, current.Status.Placement)
+	assert.False(t, current.Status.Placement.Connected)
+
+	require.NoError(t, local.Create(ctx, registration))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{edgeKubeconfig}, kubeconfigs)
+
+	ns := &corev1.Namespace{}
+	require.NoError(t, remote.Get(ctx, nsKey, ns))
+	assert.Equal(t, "4b9e0f6a-edge", ns.Labels[controller.TenantUIDLabelKey])
+	assert.Empty(t, ns.OwnerReferences, "owner references cannot point at the local Tenant")
+	assert.True(t, apierrors.IsNotFound(local.Get(ctx, nsKey, &corev1.Namespace{})), "nothing is created locally")
+	require.NoError(t, remote.Get(ctx, types.NamespacedName{Namespace: "tenant-edge-app", Name: controller.DefaultNetworkPolicyName}, &netv1.NetworkPolicy{}))
+
+	require.NoError(t, local.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionPlacementReady))
+	require.NotNil(t, current.Status.Placement)
+	assert.Equal(t, "edge", current.Status.Placement.Cluster)
+	assert.Equal(t, "https://edge.example.com:6443", current.Status.Placement.Server)
+	assert.True(t, current.Status.Placement.Connected)
+	assert.NotNil(t, current.Status.Placement.LastConnectedTime)
+
+	// Deleting the tenant deletes its namespace on the remote cluster
+	require.NoError(t, local.Delete(ctx, current))
+	for i := 0; i < 3 && err == nil; i++ {
+		_, err = r.Reconcile(ctx, req)
+		require.NoError(t, err)
+		err = local.Get(ctx, req.NamespacedName, current)
+	}
+	assert.True(t, apierrors.IsNotFound(err), "got %v", err)
+	assert.True(t, apierrors.IsNotFound(remote.Get(ctx, nsKey, ns)))
+}
+
+// TestPlacementValidation verifies that spec.placement cannot be set for Platinum tenants
+// or changed after the tenant is created.
+func TestPlacementValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(tier platformv1alpha1.TenantTier, cluster string) *platformv1alpha1.Tenant {
+		tenant := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "edge-app"},
+			Spec:       platformv1alpha1.TenantSpec{Tier: tier, Owner: "admin@example.com"},
+		}
+		if cluster != "" {
+			tenant.Spec.Placement = &platformv1alpha1.PlacementConfig{Cluster: cluster}
+		}
+		return tenant
+	}
+
+	_, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.GoldTier, "edge"))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.PlatinumTier, "edge"))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "placement is not supported for Platinum tier tenants")
+
+	_, err = w.ValidateUpdate(ctx, newTenant(platformv1alpha1.GoldTier, "edge"), newTenant(platformv1alpha1.GoldTier, "edge"))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateUpdate(ctx, newTenant(platformv1alpha1.GoldTier, "edge"), newTenant(platformv1alpha1.GoldTier, "west"))
+	assert.True(t, apierrors.IsForbidden(err), "got %v", err)
+	assert.ErrorContains(t, err, "spec.placement can only be set when the tenant is created")
+
+	_, err = w.ValidateUpdate(ctx, newTenant(platformv1alpha1.GoldTier, ""), newTenant(platformv1alpha1.GoldTier, "edge"))
+	assert.True(t, apierrors.IsForbidden(err), "got %v", err)
+}
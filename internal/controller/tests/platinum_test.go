@@ -0,0 +1,183 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestPlatinumTierProvisioning verifies that a Platinum tenant gets a Cluster API cluster
+// from the configured ClusterClass, stays Provisioning until the cluster is provisioned,
+// then publishes its endpoint and kubeconfig, and that deleting the tenant waits for the
+// cluster to be torn down.
+func TestPlatinumTierProvisioning(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	workers := int32(5)
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "bank", UID: "6f1c2d3e-bank", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:    platformv1alpha1.PlatinumTier,
+			Owner:   "owner@example.com",
+			Cluster: &platformv1alpha1.ClusterConfig{WorkerReplicas: workers},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &platformv1alpha1.TenantSnapshot{}).
+		Build()
+	cfg := config.Default()
+	cfg.ClusterAPI.ClusterClass = "aws-dedicated"
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: cfg}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "bank"}}
+	clusterKey := types.NamespacedName{Namespace: "tenant-bank", Name: "bank-cluster"}
+
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Requeue.Pending, result.RequeueAfter, "should check again for the cluster")
+
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "Cluster"})
+	require.NoError(t, cl.Get(ctx, clusterKey, cluster))
+	class, _, _ := unstructured.NestedString(cluster.Object, "spec", "topology", "class")
+	assert.Equal(t, "aws-dedicated", class)
+	version, _, _ := unstructured.NestedString(cluster.Object, "spec", "topology", "version")
+	assert.Equal(t, cfg.ClusterAPI.KubernetesVersion, version)
+	controlPlane, _, _ := unstructured.NestedInt64(cluster.Object, "spec", "topology", "controlPlane", "replicas")
+	assert.Equal(t, int64(3), controlPlane)
+	machineDeployments, _, _ := unstructured.NestedSlice(cluster.Object, "spec", "topology", "workers", "machineDeployments")
+	require.Len(t, machineDeployments, 1)
+	assert.Equal(t, int64(workers), machineDeployments[0].(map[string]interface{})["replicas"])
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateProvisioning, current.Status.State)
+	ready := apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, "ClusterNotReady", ready.Reason)
+	require.NotNil(t, current.Status.Cluster)
+	assert.Equal(t, "bank-cluster", current.Status.Cluster.Name)
+
+	// Cluster API provisions the cluster and writes its kubeconfig
+	cluster.SetFinalizers([]string{"cluster.cluster.x-k8s.io"})
+	require.NoError(t, unstructured.SetNestedMap(cluster.Object, map[string]interface{}{
+		"host": "bank.clusters.example.com",
+		"port": int64(6443),
+	}, "spec", "controlPlaneEndpoint"))
+	require.NoError(t, unstructured.SetNestedField(cluster.Object, "Provisioned", "status", "phase"))
+	require.NoError(t, unstructured.SetNestedField(cluster.Object, true, "status", "controlPlaneReady"))
+	require.NoError(t, cl.Update(ctx, cluster))
+	require.NoError(t, cl.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "bank-cluster-kubeconfig", Namespace: "tenant-bank"},
+		Data:       map[string][]byte{"value": []byte("dedicated-kubeconfig")},
+	}))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionClusterProvisioned))
+	assert.Equal(t, "https://bank.clusters.example.com:6443", current.Status.APIEndpoint)
+	assert.Equal(t, "Provisioned", current.Status.Cluster.Phase)
+	assert.Equal(t, "bank-kubeconfig", current.Status.AdminKubeconfigSecret)
+	kubeconfig := &corev1.Secret{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-bank", Name: "bank-kubeconfig"}, kubeconfig))
+	assert.Equal(t, []byte("dedicated-kubeconfig"), kubeconfig.Data["kubeconfig"])
+
+	// Deleting the tenant deletes the cluster first and keeps the namespace holding it
+	require.NoError(t, cl.Delete(ctx, current))
+	result, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter)
+	require.NoError(t, cl.Get(ctx, clusterKey, cluster))
+	assert.NotNil(t, cluster.GetDeletionTimestamp())
+	ns := &corev1.Namespace{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-bank"}, ns))
+	assert.True(t, ns.DeletionTimestamp.IsZero())
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Contains(t, current.Finalizers, controller.TenantFinalizerName)
+	assert.Equal(t, "Deleting", current.Status.Cluster.Phase)
+
+	// Once Cluster API released the infrastructure, the teardown finishes
+	cluster.SetFinalizers(nil)
+	require.NoError(t, cl.Update(ctx, cluster))
+	for i := 0; i < 3 && err == nil; i++ {
+		_, err = r.Reconcile(ctx, req)
+		require.NoError(t, err)
+		err = cl.Get(ctx, req.NamespacedName, current)
+	}
+	assert.True(t, apierrors.IsNotFound(err), "got %v", err)
+}
+
+// TestPlatinumTierValidation verifies that spec.cluster is limited to Platinum tenants and
+// that tenants cannot move to or from the Platinum tier.
+func TestPlatinumTierValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(tier platformv1alpha1.TenantTier) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "bank"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:    tier,
+				Owner:   "admin@example.com",
+				Cluster: &platformv1alpha1.ClusterConfig{Class: "aws-dedicated"},
+			},
+		}
+	}
+
+	_, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.PlatinumTier))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.GoldTier))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "cluster settings are only supported for Platinum tier tenants")
+
+	gold := newTenant(platformv1alpha1.GoldTier)
+	gold.Spec.Cluster = nil
+	_, err = w.ValidateUpdate(ctx, gold, newTenant(platformv1alpha1.PlatinumTier))
+	assert.True(t, apierrors.IsForbidden(err), "got %v", err)
+	assert.ErrorContains(t, err, "cannot be migrated to or from the Platinum tier")
+}
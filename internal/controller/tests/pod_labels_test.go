@@ -0,0 +1,244 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/mutating"
+)
+
+// TestPodTenantLabels verifies that Pods in tenant namespaces get the tenant and tier
+// labels of their namespace, and that other Pods are left alone.
+func TestPodTenantLabels(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+
+	tenantNS := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: "tenant-acme",
+		Labels: map[string]string{
+			controller.TenantNameLabelKey: "acme",
+			controller.TierLabelKey:       "Silver",
+		},
+	}}
+	otherNS := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(tenantNS, otherNS).Build()
+	w := &mutating.PodMutatingWebhook{Client: cl}
+
+	// Spoofed labels are overwritten
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "api",
+		Namespace: "tenant-acme",
+		Labels:    map[string]string{"app": "api", controller.TenantNameLabelKey: "someone-else"},
+	}}
+	require.NoError(t, w.Default(ctx, pod))
+	assert.Equal(t, "acme", pod.Labels[controller.TenantNameLabelKey])
+	assert.Equal(t, "Silver", pod.Labels[controller.TierLabelKey])
+	assert.Equal(t, "api", pod.Labels["app"])
+
+	// Pods created by controllers take the namespace from the admission request
+	generated := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{GenerateName: "api-"}}
+	reqCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Namespace: "tenant-acme"}})
+	require.NoError(t, w.Default(reqCtx, generated))
+	assert.Equal(t, "acme", generated.Labels[controller.TenantNameLabelKey])
+
+	outside := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	require.NoError(t, w.Default(ctx, outside))
+	assert.Empty(t, outside.Labels)
+}
+
+// TestPodPackingPolicy verifies that Pods get the scheduling hints of their namespace's
+// packing policy unless they set their own.
+func TestPodPackingPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+
+	namespace := func(name, tenant, policy string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      map[string]string{controller.TenantNameLabelKey: tenant},
+			Annotations: map[string]string{controller.PackingPolicyAnnotation: policy},
+		}}
+	}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		namespace("tenant-dense", "dense", "BinPack"),
+		namespace("tenant-spread", "spread", "Spread"),
+	).Build()
+	w := &mutating.PodMutatingWebhook{Client: cl}
+
+	packed := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "tenant-dense"}}
+	require.NoError(t, w.Default(ctx, packed))
+	require.NotNil(t, packed.Spec.Affinity)
+	require.NotNil(t, packed.Spec.Affinity.PodAffinity)
+	terms := packed.Spec.Affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	require.Len(t, terms, 1)
+	assert.Equal(t, corev1.LabelHostname, terms[0].PodAffinityTerm.TopologyKey)
+	assert.Equal(t, "dense", terms[0].PodAffinityTerm.LabelSelector.MatchLabels[controller.TenantNameLabelKey])
+	assert.Empty(t, packed.Spec.TopologySpreadConstraints)
+
+	spread := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "tenant-spread"}}
+	require.NoError(t, w.Default(ctx, spread))
+	require.Len(t, spread.Spec.TopologySpreadConstraints, 1)
+	assert.Equal(t, corev1.ScheduleAnyway, spread.Spec.TopologySpreadConstraints[0].WhenUnsatisfiable)
+	assert.Equal(t, "spread", spread.Spec.TopologySpreadConstraints[0].LabelSelector.MatchLabels[controller.TenantNameLabelKey])
+	assert.Nil(t, spread.Spec.Affinity)
+
+	// Workload-defined placement wins
+	own := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "tenant-spread"},
+		Spec: corev1.PodSpec{TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+			MaxSkew:           2,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+		}}},
+	}
+	require.NoError(t, w.Default(ctx, own))
+	require.Len(t, own.Spec.TopologySpreadConstraints, 1)
+	assert.Equal(t, corev1.LabelTopologyZone, own.Spec.TopologySpreadConstraints[0].TopologyKey)
+}
+
+// TestPodDefaultTolerations verifies that spec.scheduling.tolerations reach the tenant
+// namespace and are added to Pods that do not already tolerate the same taints.
+func TestPodDefaultTolerations(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	dedicated := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "acme", Effect: corev1.TaintEffectNoSchedule}
+	gpu := corev1.Toleration{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:       platformv1alpha1.SilverTier,
+			Owner:      "owner@example.com",
+			Scheduling: platformv1alpha1.SchedulingConfig{Tolerations: []corev1.Toleration{dedicated, gpu}},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}})
+	require.NoError(t, err)
+
+	ns := &corev1.Namespace{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-acme"}, ns))
+	require.Contains(t, ns.Annotations, controller.DefaultTolerationsAnnotation)
+
+	w := &mutating.PodMutatingWebhook{Client: cl}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainer", Namespace: "tenant-acme"},
+		Spec:       corev1.PodSpec{Tolerations: []corev1.Toleration{gpu}},
+	}
+	require.NoError(t, w.Default(ctx, pod))
+	assert.Equal(t, []corev1.Toleration{gpu, dedicated}, pod.Spec.Tolerations)
+
+	// Reinvocation does not duplicate tolerations
+	require.NoError(t, w.Default(ctx, pod))
+	assert.Len(t, pod.Spec.Tolerations, 2)
+}
+
+// TestPodDNSConfig verifies that spec.network.dnsConfig reaches tenant pods through the
+// namespace annotation and that the NetworkPolicy allows DNS to the nameservers.
+func TestPodDNSConfig(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "owner@example.com",
+			Network: platformv1alpha1.NetworkConfig{DNSConfig: &platformv1alpha1.DNSConfig{
+				Nameservers: []string{"10.20.0.53", "fd00::53"},
+				Searches:    []string{"corp.example.com"},
+			}},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}})
+	require.NoError(t, err)
+
+	policy := &netv1.NetworkPolicy{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-acme", Name: controller.DefaultNetworkPolicyName}, policy))
+	var cidrs []string
+	for _, rule := range policy.Spec.Egress {
+		for _, peer := range rule.To {
+			if peer.IPBlock != nil {
+				cidrs = append(cidrs, peer.IPBlock.CIDR)
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"10.20.0.53/32", "fd00::53/128"}, cidrs)
+
+	w := &mutating.PodMutatingWebhook{Client: cl}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "tenant-acme"},
+		Spec: corev1.PodSpec{DNSConfig: &corev1.PodDNSConfig{
+			Nameservers: []string{"10.20.0.53"},
+			Searches:    []string{"svc.cluster.local"},
+		}},
+	}
+	require.NoError(t, w.Default(ctx, pod))
+	assert.Equal(t, []string{"10.20.0.53", "fd00::53"}, pod.Spec.DNSConfig.Nameservers)
+	assert.Equal(t, []string{"svc.cluster.local", "corp.example.com"}, pod.Spec.DNSConfig.Searches)
+
+	// Pods with dnsPolicy None keep their own DNS config
+	custom := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "resolver", Namespace: "tenant-acme"},
+		Spec:       corev1.PodSpec{DNSPolicy: corev1.DNSNone},
+	}
+	require.NoError(t, w.Default(ctx, custom))
+	assert.Nil(t, custom.Spec.DNSConfig)
+}
@@ -0,0 +1,113 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestPodSecurityLevels verifies that tenant namespaces enforce the Pod Security level of
+// their tier, relaxed to privileged once privileged workloads are approved, and that the
+// level is reported in the status.
+func TestPodSecurityLevels(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	silver := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "guarded", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "admin@example.com"},
+	}
+	bronze := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "thrifty", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:     platformv1alpha1.BronzeTier,
+			Owner:    "admin@example.com",
+			Security: platformv1alpha1.SecurityConfig{AllowPrivileged: true},
+		},
+	}
+	bronze.Annotations = map[string]string{controller.PrivilegedApprovedByAnnotation: "platform-admin"}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(silver, bronze).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	cfg := config.Default()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: cfg}
+
+	reconcile := func(name, namespace string) (string, string) {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+		require.NoError(t, err)
+		ns := &corev1.Namespace{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: namespace}, ns))
+		tenant := &platformv1alpha1.Tenant{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: name}, tenant))
+		return ns.Labels[controller.PodSecurityEnforceLabelKey], tenant.Status.PodSecurityLevel
+	}
+
+	label, status := reconcile("guarded", "tenant-guarded")
+	assert.Equal(t, config.PodSecurityRestricted, label)
+	assert.Equal(t, config.PodSecurityRestricted, status)
+
+	// The shared Bronze namespace keeps the Bronze level despite the approval
+	label, status = reconcile("thrifty", controller.BronzeNamespace)
+	assert.Equal(t, config.PodSecurityRestricted, label)
+	assert.Equal(t, config.PodSecurityRestricted, status)
+
+	// Approved privileged workloads relax the tenant's own namespace
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "guarded"}, current))
+	current.Annotations = map[string]string{controller.PrivilegedApprovedByAnnotation: "platform-admin"}
+	current.Spec.Security.AllowPrivileged = true
+	require.NoError(t, cl.Update(ctx, current))
+	label, status = reconcile("guarded", "tenant-guarded")
+	assert.Equal(t, config.PodSecurityPrivileged, label)
+	assert.Equal(t, config.PodSecurityPrivileged, status)
+
+	// An empty level removes the label
+	cfg.PodSecurity.Silver = ""
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "guarded"}, current))
+	current.Spec.Security.AllowPrivileged = false
+	require.NoError(t, cl.Update(ctx, current))
+	label, status = reconcile("guarded", "tenant-guarded")
+	assert.Empty(t, label)
+	assert.Empty(t, status)
+}
@@ -0,0 +1,291 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestPrivilegedPodBan verifies that host access and privileged containers are rejected
+// in Silver tenant namespaces unless the opt-out has been approved.
+func TestPrivilegedPodBan(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	newTenant := func(name string, allow bool, approvedBy string) (*platformv1alpha1.Tenant, *corev1.Namespace) {
+		tenant := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:     platformv1alpha1.SilverTier,
+				Owner:    "owner@example.com",
+				Security: platformv1alpha1.SecurityConfig{AllowPrivileged: allow},
+			},
+		}
+		if approvedBy != "" {
+			tenant.Annotations = map[string]string{controller.PrivilegedApprovedByAnnotation: approvedBy}
+		}
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "tenant-" + name,
+			Labels: map[string]string{controller.TenantNameLabelKey: name},
+		}}
+		return tenant, ns
+	}
+	locked, lockedNS := newTenant("locked", false, "")
+	pending, pendingNS := newTenant("pending", true, "")
+	approved, approvedNS := newTenant("approved", true, "admin@example.com")
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(locked, lockedNS, pending, pendingNS, approved, approvedNS).
+		Build()
+	w := &validating.PodValidatingWebhook{Client: cl}
+
+	privileged := true
+	newPod := func(namespace string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "dind", Namespace: namespace},
+			Spec: corev1.PodSpec{
+				HostNetwork: true,
+				Volumes: []corev1.Volume{{
+					Name:         "docker-sock",
+					VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/run/docker.sock"}},
+				}},
+				Containers: []corev1.Container{{
+					Name:            "docker",
+					SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+				}},
+			},
+		}
+	}
+
+	_, err := w.ValidateCreate(ctx, newPod("tenant-locked"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.hostNetwork")
+	assert.Contains(t, err.Error(), "spec.volumes[0].hostPath")
+	assert.Contains(t, err.Error(), "spec.containers[0].securityContext.privileged")
+
+	// The opt-out alone is not enough without an admin approval
+	_, err = w.ValidateCreate(ctx, newPod("tenant-pending"))
+	assert.Error(t, err)
+
+	_, err = w.ValidateCreate(ctx, newPod("tenant-approved"))
+	assert.NoError(t, err)
+
+	// Ordinary Pods and non-tenant namespaces are unaffected
+	_, err = w.ValidateCreate(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "tenant-locked"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web"}}},
+	})
+	assert.NoError(t, err)
+	_, err = w.ValidateCreate(ctx, newPod("kube-system"))
+	assert.NoError(t, err)
+}
+
+// TestPrivilegedEphemeralContainers verifies that a privileged ephemeral container,
+// as added by "kubectl debug --profile=sysadmin", cannot bypass the Pod ban.
+func TestPrivilegedEphemeralContainers(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "locked"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "owner@example.com"},
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "tenant-locked",
+		Labels: map[string]string{controller.TenantNameLabelKey: "locked"},
+	}}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(tenant, ns).Build()
+	w := &validating.PodValidatingWebhook{Client: cl}
+
+	privileged := true
+	debugger := func(name string, sc *corev1.SecurityContext) corev1.EphemeralContainer {
+		return corev1.EphemeralContainer{EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name: name, Image: "busybox", SecurityContext: sc,
+		}}
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "tenant-locked"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web"}}},
+	}
+
+	debugged := pod.DeepCopy()
+	debugged.Spec.EphemeralContainers = []corev1.EphemeralContainer{
+		debugger("debugger-sysadmin", &corev1.SecurityContext{Privileged: &privileged}),
+	}
+	_, err := w.ValidateUpdate(ctx, pod, debugged)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.ephemeralContainers[0].securityContext.privileged")
+
+	// Unprivileged debug containers are allowed
+	debugged.Spec.EphemeralContainers = []corev1.EphemeralContainer{debugger("debugger-general", nil)}
+	_, err = w.ValidateUpdate(ctx, pod, debugged)
+	assert.NoError(t, err)
+
+	// Only the containers an update adds are checked
+	running := pod.DeepCopy()
+	running.Spec.EphemeralContainers = []corev1.EphemeralContainer{
+		debugger("debugger-sysadmin", &corev1.SecurityContext{Privileged: &privileged}),
+	}
+	debugged = running.DeepCopy()
+	debugged.Spec.EphemeralContainers = append(debugged.Spec.EphemeralContainers, debugger("debugger-general", nil))
+	_, err = w.ValidateUpdate(ctx, running, debugged)
+	assert.NoError(t, err)
+}
+
+// TestPrivilegedPodBronzeNamespace verifies that privileged Pods in the shared Bronze
+// namespace are rejected, whether they run as a Bronze tenant's ServiceAccount or as a
+// ServiceAccount of no tenant.
+func TestPrivilegedPodBronzeNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "trial"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.BronzeTier, Owner: "owner@example.com"},
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   controller.BronzeNamespace,
+		Labels: map[string]string{controller.TierLabelKey: string(platformv1alpha1.BronzeTier)},
+	}}
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+		Name:      "trial-sa",
+		Namespace: controller.BronzeNamespace,
+		Labels:    map[string]string{controller.TenantNameLabelKey: "trial"},
+	}}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(tenant, ns, sa).Build()
+	w := &validating.PodValidatingWebhook{Client: cl}
+
+	privileged := true
+	newPod := func(serviceAccount string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "dind", Namespace: controller.BronzeNamespace},
+			Spec: corev1.PodSpec{
+				ServiceAccountName: serviceAccount,
+				Containers: []corev1.Container{{
+					Name:            "docker",
+					SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+				}},
+			},
+		}
+	}
+
+	_, err := w.ValidateCreate(ctx, newPod("trial-sa"))
+	require.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.Contains(t, err.Error(), "spec.containers[0].securityContext.privileged")
+	assert.Contains(t, err.Error(), `Tenant "trial" (Bronze tier)`)
+
+	for _, serviceAccount := range []string{"default", "", "missing"} {
+		_, err = w.ValidateCreate(ctx, newPod(serviceAccount))
+		require.True(t, apierrors.IsInvalid(err), "ServiceAccount %q: got %v", serviceAccount, err)
+		assert.Contains(t, err.Error(), controller.BronzeNamespace)
+	}
+
+	_, err = w.ValidateCreate(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: controller.BronzeNamespace},
+		Spec:       corev1.PodSpec{ServiceAccountName: "trial-sa", Containers: []corev1.Container{{Name: "web"}}},
+	})
+	assert.NoError(t, err)
+}
+
+// TestPrivilegedOptOutTiers verifies that Bronze tenants, whose shared namespace keeps
+// the Bronze Pod Security level, cannot request privileged workloads.
+func TestPrivilegedOptOutTiers(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(tier platformv1alpha1.TenantTier) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "ci"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:     tier,
+				Owner:    "owner@example.com",
+				Security: platformv1alpha1.SecurityConfig{AllowPrivileged: true},
+			},
+		}
+	}
+
+	warnings, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.SilverTier))
+	require.NoError(t, err)
+	assert.NotEmpty(t, warnings, "the opt-out needs an approval")
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.BronzeTier))
+	require.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "spec.security.allowPrivileged")
+}
+
+// TestPrivilegedApprovalRequiresPermission verifies that only users granted the
+// approve-privileged verb can set the approval annotation.
+func TestPrivilegedApprovalRequiresPermission(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+
+	// The fake client does not evaluate SubjectAccessReviews, so access is never granted
+	cl := fake.NewClientBuilder().WithScheme(s).Build()
+	w := &validating.TenantValidatingWebhook{Client: cl}
+
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "developer@example.com"},
+		},
+	})
+
+	old := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci"},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:     platformv1alpha1.SilverTier,
+			Owner:    "owner@example.com",
+			Security: platformv1alpha1.SecurityConfig{AllowPrivileged: true},
+		},
+	}
+	warnings, err := w.ValidateCreate(ctx, old)
+	require.NoError(t, err)
+	assert.NotEmpty(t, warnings)
+
+	updated := old.DeepCopy()
+	updated.Annotations = map[string]string{controller.PrivilegedApprovedByAnnotation: "developer@example.com"}
+	_, err = w.ValidateUpdate(ctx, old, updated)
+	assert.Error(t, err)
+
+	// Revoking an approval is always allowed
+	_, err = w.ValidateUpdate(ctx, updated, old)
+	assert.NoError(t, err)
+}
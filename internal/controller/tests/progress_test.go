@@ -0,0 +1,273 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestProvisioningResumesAfterRestart verifies that a Gold tenant whose vCluster was
+// deployed before an operator restart skips the readiness check, stores its kubeconfig,
+// and keeps its original ProvisioningStartTime.
+func TestProvisioningResumesAfterRestart(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	startTime := metav1.NewTime(time.Now().Add(-10 * time.Minute).Truncate(time.Second))
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "resume",
+			Finalizers: []string{controller.TenantFinalizerName},
+		},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.GoldTier,
+			Owner: "owner@example.com",
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "resume"}}
+
+	// Progress recorded before the restart: vCluster deployed, kubeconfig missing
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	current.Status.State = platformv1alpha1.StateProvisioning
+	current.Status.ProvisioningStartTime = &startTime
+	apimeta.SetStatusCondition(&current.Status.Conditions, metav1.Condition{
+		Type:               platformv1alpha1.ConditionVClusterDeployed,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: current.Generation,
+		Reason:             "Completed",
+	})
+	require.NoError(t, cl.Status().Update(ctx, current))
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := r.Reconcile(ctx, req)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("reconcile re-ran the vCluster readiness check")
+	}
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+	assert.True(t, startTime.Equal(current.Status.ProvisioningStartTime), "ProvisioningStartTime must not be reset")
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionBaseResourcesProvisioned))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionVClusterDeployed))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionKubeconfigAvailable))
+	assert.Equal(t, "resume-kubeconfig", current.Status.AdminKubeconfigSecret)
+}
+
+// TestProvisioningProgress verifies that status.progress counts the completed steps of
+// the tenant's tier, is published while provisioning, and names the failed step.
+func TestProvisioningProgress(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	t.Run("Gold", func(t *testing.T) {
+		tenant := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "steps", Finalizers: []string{controller.TenantFinalizerName}},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:  platformv1alpha1.GoldTier,
+				Owner: "owner@example.com",
+			},
+		}
+		cl := fake.NewClientBuilder().
+			WithScheme(s).
+			WithObjects(tenant).
+			WithStatusSubresource(&platformv1alpha1.Tenant{}).
+			Build()
+		req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "steps"}}
+
+		// Skip the vCluster readiness check
+		current := &platformv1alpha1.Tenant{}
+		require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+		apimeta.SetStatusCondition(&current.Status.Conditions, metav1.Condition{
+			Type:               platformv1alpha1.ConditionVClusterDeployed,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: current.Generation,
+			Reason:             "Completed",
+		})
+		require.NoError(t, cl.Status().Update(ctx, current))
+
+		var published []string
+		r := &controller.TenantReconciler{Scheme: s, Log: logr.Discard()}
+		r.Client = interceptor.NewClient(cl, interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				if progress := obj.(*platformv1alpha1.Tenant).Status.Progress; progress != nil {
+					published = append(published, progress.Summary)
+				}
+				return c.SubResource(subResource).Update(ctx, obj, opts...)
+			},
+		})
+		_, err := r.Reconcile(ctx, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"1/6 Namespace", "5/6 Kubeconfig", "6/6", "6/6"}, published)
+		require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+		assert.Equal(t, &platformv1alpha1.ProvisioningProgress{
+			CompletedSteps: 6,
+			TotalSteps:     6,
+			Percent:        100,
+			Summary:        "6/6",
+		}, current.Status.Progress)
+	})
+
+	t.Run("failed step", func(t *testing.T) {
+		tenant := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "stalled", Finalizers: []string{controller.TenantFinalizerName}},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:  platformv1alpha1.SilverTier,
+				Owner: "owner@example.com",
+			},
+		}
+		cl := fake.NewClientBuilder().
+			WithScheme(s).
+			WithObjects(tenant).
+			WithStatusSubresource(&platformv1alpha1.Tenant{}).
+			Build()
+		r := &controller.TenantReconciler{Scheme: s, Log: logr.Discard()}
+		r.Client = interceptor.NewClient(cl, interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if _, ok := obj.(*corev1.ResourceQuota); ok {
+					return errors.New("admission denied")
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		})
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "stalled"}})
+		require.NoError(t, err) // requeued with backoff
+
+		current := &platformv1alpha1.Tenant{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "stalled"}, current))
+		require.NotNil(t, current.Status.Progress)
+		assert.Equal(t, "1/4 ResourceQuota", current.Status.Progress.Summary)
+		assert.Equal(t, int32(25), current.Status.Progress.Percent)
+	})
+}
+
+// TestVClusterReadinessRequeues verifies that a Gold tenant whose vCluster is still
+// starting stays Provisioning and is requeued instead of blocking the reconcile, and
+// becomes Ready once the StatefulSet is ready.
+func TestVClusterReadinessRequeues(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, appsv1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "starting", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.GoldTier,
+			Owner: "owner@example.com",
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &appsv1.StatefulSet{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "starting"}}
+
+	start := time.Now()
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second, "reconcile must not wait for the vCluster")
+	assert.Equal(t, 10*time.Second, result.RequeueAfter)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateProvisioning, current.Status.State)
+	assert.True(t, apimeta.IsStatusConditionFalse(current.Status.Conditions, platformv1alpha1.ConditionVClusterReady))
+	assert.True(t, apimeta.IsStatusConditionFalse(current.Status.Conditions, platformv1alpha1.ConditionReady))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionBaseResourcesProvisioned))
+	assert.Nil(t, apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionKubeconfigAvailable),
+		"the kubeconfig is exported once the vCluster runs")
+
+	// The StatefulSet comes up with some replicas still starting
+	ss := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "starting-vcluster", Namespace: "tenant-starting"}}
+	require.NoError(t, cl.Create(ctx, ss))
+	ss.Status.Replicas, ss.Status.ReadyReplicas = 3, 1
+	require.NoError(t, cl.Status().Update(ctx, ss))
+	result, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, result.RequeueAfter)
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateProvisioning, current.Status.State)
+	assert.Contains(t, apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionVClusterReady).Message,
+		"1 of 3 replicas ready")
+
+	ss.Status.ReadyReplicas = 3
+	require.NoError(t, cl.Status().Update(ctx, ss))
+	result, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, result.RequeueAfter) // periodic resync only
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionVClusterReady))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionKubeconfigAvailable))
+}
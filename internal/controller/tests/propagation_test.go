@@ -0,0 +1,163 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestSecretAndConfigMapPropagation verifies that Secrets and ConfigMaps labeled for
+// propagation are copied into tenant namespaces of the tiers they apply to, kept in sync
+// with their source, and removed once their source is gone.
+func TestSecretAndConfigMapPropagation(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	propagated := map[string]string{controller.PropagateLabelKey: "true"}
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "admin@example.com"},
+	}
+	registry := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: controller.OperatorNamespace, Labels: propagated},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+	}
+	goldOnly := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "platform-config",
+			Namespace:   controller.OperatorNamespace,
+			Labels:      propagated,
+			Annotations: map[string]string{controller.PropagateTiersAnnotation: "Gold"},
+		},
+		Data: map[string]string{"region": "eu-west-1"},
+	}
+	unlabeled := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "operator-settings", Namespace: controller.OperatorNamespace},
+	}
+	// The tenant's own ConfigMap of the same name as a propagated one is left alone
+	clashing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-settings", Namespace: controller.OperatorNamespace, Labels: propagated},
+		Data:       map[string]string{"owner": "platform"},
+	}
+	owned := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-settings", Namespace: "tenant-acme"},
+		Data:       map[string]string{"owner": "acme"},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, registry, goldOnly, unlabeled, clashing, owned).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	reconcile := func() {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}})
+		require.NoError(t, err)
+	}
+	reconcile()
+
+	secret := &corev1.Secret{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "registry"}, secret))
+	assert.Equal(t, registry.Data, secret.Data)
+	assert.Equal(t, corev1.SecretTypeDockerConfigJson, secret.Type)
+	assert.Equal(t, "true", secret.Labels[controller.PropagatedLabelKey])
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "platform-config"}, &corev1.ConfigMap{})))
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "operator-settings"}, &corev1.ConfigMap{})))
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "team-settings"}, cm))
+	assert.Equal(t, "acme", cm.Data["owner"])
+
+	// Updates reach the copy, and widening the tiers propagates the ConfigMap
+	registry.Data = map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{}}}`)}
+	require.NoError(t, cl.Update(ctx, registry))
+	goldOnly.Annotations[controller.PropagateTiersAnnotation] = "silver, gold"
+	require.NoError(t, cl.Update(ctx, goldOnly))
+	reconcile()
+
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(secret), secret))
+	assert.Equal(t, registry.Data, secret.Data)
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "platform-config"}, cm))
+	assert.Equal(t, "eu-west-1", cm.Data["region"])
+
+	// Deleting or unlabeling the source removes the copy
+	require.NoError(t, cl.Delete(ctx, registry))
+	delete(goldOnly.Labels, controller.PropagateLabelKey)
+	require.NoError(t, cl.Update(ctx, goldOnly))
+	reconcile()
+
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "registry"}, &corev1.Secret{})))
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "platform-config"}, &corev1.ConfigMap{})))
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: "tenant-acme", Name: "team-settings"}, cm))
+	assert.Equal(t, "acme", cm.Data["owner"])
+}
+
+// TestPropagationSourceFanOut verifies that a change to a propagated object reconciles
+// every Silver and Gold tenant, and no Bronze tenant, which gets no copies.
+func TestPropagationSourceFanOut(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	var objs []client.Object
+	for name, tier := range map[string]platformv1alpha1.TenantTier{
+		"acme":    platformv1alpha1.SilverTier,
+		"bigbank": platformv1alpha1.GoldTier,
+		"thrifty": platformv1alpha1.BronzeTier,
+	} {
+		objs = append(objs, &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       platformv1alpha1.TenantSpec{Tier: tier, Owner: "admin@example.com"},
+		})
+	}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+
+	registry := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      "registry",
+		Namespace: controller.OperatorNamespace,
+		Labels:    map[string]string{controller.PropagateLabelKey: "true"},
+	}}
+	var names []string
+	for _, req := range r.PropagationSourceRequests(context.Background(), registry) {
+		names = append(names, req.Name)
+	}
+	assert.ElementsMatch(t, []string{"acme", "bigbank"}, names)
+}
@@ -0,0 +1,88 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/mutating"
+)
+
+// TestQuantityNormalization verifies the mutating webhook rewrites CPU in millicores
+// and memory in the largest whole binary unit, and rejects quantities the controller
+// would round or that would block every pod.
+func TestQuantityNormalization(t *testing.T) {
+	ctx := context.Background()
+	w := &mutating.TenantMutatingWebhook{}
+
+	newTenant := func(cpu, memory string) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "units"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:      platformv1alpha1.SilverTier,
+				Owner:     "owner@example.com",
+				Resources: platformv1alpha1.ResourceRequirements{CPU: cpu, Memory: memory},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		cpu, memory      string
+		wantCPU, wantMem string
+	}{
+		{"2", "2048Mi", "2000m", "2Gi"},
+		{"1.5", "1536Mi", "1500m", "1536Mi"},
+		{".25", "1024Gi", "250m", "1Ti"},
+		{"2.", "8Gi", "2000m", "8Gi"},
+		{"4000m", "3072Mi", "4000m", "3Gi"},
+	} {
+		tenant := newTenant(tc.cpu, tc.memory)
+		require.NoError(t, w.Default(ctx, tenant), "cpu=%s memory=%s", tc.cpu, tc.memory)
+		assert.Equal(t, tc.wantCPU, tenant.Spec.Resources.CPU, "cpu %s", tc.cpu)
+		assert.Equal(t, tc.wantMem, tenant.Spec.Resources.Memory, "memory %s", tc.memory)
+	}
+
+	// Nested quantities are normalized too
+	tenant := newTenant("1", "1Gi")
+	tenant.Spec.Resources.Storage = "102400Mi"
+	tenant.Spec.Resources.Burst = &platformv1alpha1.BurstConfig{CPU: "0.5", Memory: "512Mi"}
+	tenant.Spec.Quotas.ByPriorityClass = []platformv1alpha1.PriorityClassQuota{{PriorityClassName: "batch", CPU: "1", Memory: "4096Mi"}}
+	require.NoError(t, w.Default(ctx, tenant))
+	assert.Equal(t, "100Gi", tenant.Spec.Resources.Storage)
+	assert.Equal(t, "500m", tenant.Spec.Resources.Burst.CPU)
+	assert.Equal(t, "512Mi", tenant.Spec.Resources.Burst.Memory)
+	assert.Equal(t, "1000m", tenant.Spec.Quotas.ByPriorityClass[0].CPU)
+	assert.Equal(t, "4Gi", tenant.Spec.Quotas.ByPriorityClass[0].Memory)
+
+	for _, tc := range []struct{ cpu, memory, field string }{
+		{"0.0005", "1Gi", "spec.resources.cpu"},
+		{"1.2345", "1Gi", "spec.resources.cpu"},
+		{"0", "1Gi", "spec.resources.cpu"},
+		{"1", "0Mi", "spec.resources.memory"},
+	} {
+		err := w.Default(ctx, newTenant(tc.cpu, tc.memory))
+		require.True(t, apierrors.IsInvalid(err), "cpu=%s memory=%s: %v", tc.cpu, tc.memory, err)
+		assert.Contains(t, err.Error(), tc.field)
+	}
+}
@@ -0,0 +1,117 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestQuotaRequestsAndLimits verifies that the quota takes requests and limits from
+// spec.resources.requests and limits, with unset limits scaled by the overcommit ratio.
+func TestQuotaRequestsAndLimits(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "batch", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "owner@example.com",
+			Resources: platformv1alpha1.ResourceRequirements{
+				CPU:      "1000m",
+				Memory:   "2Gi",
+				Requests: &platformv1alpha1.ResourceAmounts{CPU: "2000m"},
+				Limits:   &platformv1alpha1.ResourceAmounts{Memory: "8Gi"},
+			},
+		},
+		Status: platformv1alpha1.TenantStatus{State: platformv1alpha1.StateProvisioning},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	cfg.Quota.LimitsOvercommitRatio = 1.5
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: cfg}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "batch"}})
+	require.NoError(t, err)
+
+	rq := &corev1.ResourceQuota{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-batch", Name: "batch-quota"}, rq))
+	for name, want := range map[corev1.ResourceName]string{
+		corev1.ResourceRequestsCPU:    "2",
+		corev1.ResourceLimitsCPU:      "3",
+		corev1.ResourceRequestsMemory: "2Gi",
+		corev1.ResourceLimitsMemory:   "8Gi",
+	} {
+		got := rq.Spec.Hard[name]
+		assert.True(t, got.Equal(resource.MustParse(want)), "%s: got %s, want %s", name, got.String(), want)
+	}
+}
+
+// TestQuotaLimitsBelowRequests verifies that limits lower than the requests they cap are rejected.
+func TestQuotaLimitsBelowRequests(t *testing.T) {
+	w := &validating.TenantValidatingWebhook{}
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "batch"},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "owner@example.com",
+			Resources: platformv1alpha1.ResourceRequirements{
+				CPU:      "4000m",
+				Memory:   "4Gi",
+				Requests: &platformv1alpha1.ResourceAmounts{Memory: "2Gi"},
+				Limits:   &platformv1alpha1.ResourceAmounts{CPU: "2000m", Memory: "2Gi"},
+			},
+		},
+	}
+
+	_, err := w.ValidateCreate(context.Background(), tenant)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.resources.limits.cpu")
+	assert.NotContains(t, err.Error(), "spec.resources.limits.memory")
+
+	tenant.Spec.Resources.Limits.CPU = "8000m"
+	_, err = w.ValidateCreate(context.Background(), tenant)
+	assert.NoError(t, err)
+}
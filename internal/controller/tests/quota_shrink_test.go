@@ -0,0 +1,143 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestQuotaShrinkBelowUsage verifies that lowering spec.resources below the usage summed
+// over the tenant's namespaces is rejected unless overridden, and then only warns.
+func TestQuotaShrinkBelowUsage(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+
+	quota := func(name, namespace, cpu string, labels map[string]string) *corev1.ResourceQuota {
+		labels[controller.TenantNameLabelKey] = "acme"
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Status: corev1.ResourceQuotaStatus{Used: corev1.ResourceList{
+				corev1.ResourceRequestsCPU:    resource.MustParse(cpu),
+				corev1.ResourceRequestsMemory: resource.MustParse("1Gi"),
+			}},
+		}
+	}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		quota("acme-quota", "tenant-acme-dev", "1500m", map[string]string{}),
+		quota("acme-quota", "tenant-acme-prod", "2", map[string]string{}),
+		// Scoped quotas repeat usage already counted above
+		quota("acme-high-priority", "tenant-acme-prod", "2", map[string]string{controller.QuotaScopeLabelKey: controller.QuotaScopePriorityClass}),
+	).Build()
+	w := &validating.TenantValidatingWebhook{Client: cl}
+
+	old := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:      platformv1alpha1.SilverTier,
+			Owner:     "owner@example.com",
+			Resources: platformv1alpha1.ResourceRequirements{CPU: "8", Memory: "8Gi"},
+		},
+	}
+
+	// 3500m in use: 4 cores fit, 3 do not
+	fits := old.DeepCopy()
+	fits.Spec.Resources.CPU = "4"
+	warnings, err := w.ValidateUpdate(ctx, old, fits)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	shrunk := old.DeepCopy()
+	shrunk.Spec.Resources.CPU = "3"
+	_, err = w.ValidateUpdate(ctx, old, shrunk)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "3500m")
+	assert.Contains(t, err.Error(), controller.AllowQuotaShrinkAnnotation)
+
+	shrunk.Annotations = map[string]string{controller.AllowQuotaShrinkAnnotation: "true"}
+	warnings, err = w.ValidateUpdate(ctx, old, shrunk)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "spec.resources.cpu 3 is below the current requests.cpu usage of 3500m")
+
+	// Unchanged values are not re-checked, so other edits to an over-used tenant go through
+	relabeled := shrunk.DeepCopy()
+	relabeled.Annotations = nil
+	relabeled.Spec.Notifications.Recipients = []string{"finops@example.com"}
+	_, err = w.ValidateUpdate(ctx, shrunk, relabeled)
+	assert.NoError(t, err)
+}
+
+// TestQuotaShrinkBronze verifies that a Bronze tenant, whose only quota is the one scoped
+// to its PriorityClass in the shared namespace, cannot shrink below its usage either.
+func TestQuotaShrinkBronze(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(&corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "trial-quota",
+			Namespace: controller.BronzeNamespace,
+			Labels: map[string]string{
+				controller.TenantNameLabelKey: "trial",
+				controller.QuotaScopeLabelKey: controller.QuotaScopePriorityClass,
+			},
+		},
+		Status: corev1.ResourceQuotaStatus{Used: corev1.ResourceList{
+			corev1.ResourceRequestsCPU:    resource.MustParse("800m"),
+			corev1.ResourceRequestsMemory: resource.MustParse("512Mi"),
+		}},
+	}).Build()
+	w := &validating.TenantValidatingWebhook{Client: cl}
+
+	old := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "trial"},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:      platformv1alpha1.BronzeTier,
+			Owner:     "owner@example.com",
+			Resources: platformv1alpha1.ResourceRequirements{CPU: "1", Memory: "1Gi"},
+		},
+	}
+	shrunk := old.DeepCopy()
+	shrunk.Spec.Resources.CPU = "500m"
+	_, err := w.ValidateUpdate(ctx, old, shrunk)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "800m")
+
+	fits := old.DeepCopy()
+	fits.Spec.Resources.CPU = "900m"
+	_, err = w.ValidateUpdate(ctx, old, fits)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,118 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestResourceUsage verifies that status.resourceUsage sums the used and hard amounts
+// of the quotas in the tenant namespace and its environments, and that the reporter
+// refreshes it between reconciles, with the summaries and suspend state shown by
+// kubectl get tenants.
+func TestResourceUsage(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, appsv1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:         platformv1alpha1.SilverTier,
+			Owner:        "owner@example.com",
+			Resources:    platformv1alpha1.ResourceRequirements{CPU: "4", Memory: "8Gi"},
+			Environments: []platformv1alpha1.TenantEnvironment{{Name: "dev"}},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &corev1.ResourceQuota{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "shop"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	require.NotNil(t, current.Status.ResourceUsage)
+	assert.Equal(t, "0", current.Status.ResourceUsage.CPU.Used)
+	assert.NotEmpty(t, current.Status.ResourceUsage.CPU.Hard)
+	assert.NotNil(t, current.Status.ResourceUsage.LastUpdateTime)
+
+	setUsed := func(namespace, cpu, memory, pods string) {
+		rq := &corev1.ResourceQuota{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shop-quota"}, rq))
+		rq.Status.Used = corev1.ResourceList{
+			corev1.ResourceRequestsCPU:    resource.MustParse(cpu),
+			corev1.ResourceRequestsMemory: resource.MustParse(memory),
+			corev1.ResourcePods:           resource.MustParse(pods),
+		}
+		require.NoError(t, cl.Status().Update(ctx, rq))
+	}
+	setUsed("tenant-shop", "1500m", "2Gi", "3")
+	setUsed("tenant-shop-dev", "500m", "1Gi", "2")
+
+	reporter := &controller.ResourceUsageReporter{Client: cl, Log: logr.Discard()}
+	require.NoError(t, reporter.Refresh(ctx))
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	usage := current.Status.ResourceUsage
+	require.NotNil(t, usage)
+	assert.Equal(t, "2", usage.CPU.Used)
+	assert.Equal(t, "4", usage.CPU.Hard, "the budget is split across the namespaces")
+	assert.Equal(t, "2/4", usage.CPU.Summary)
+	assert.Equal(t, "3Gi", usage.Memory.Used)
+	assert.Equal(t, "5", usage.Pods.Used)
+	assert.Empty(t, usage.Storage, "no storage budget")
+	assert.False(t, usage.Suspended)
+	assert.NotNil(t, usage.LastUpdateTime)
+
+	// Suspending the tenant shows in the usage status right away
+	current.Spec.Suspend = true
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	require.NotNil(t, current.Status.ResourceUsage)
+	assert.True(t, current.Status.ResourceUsage.Suspended)
+}
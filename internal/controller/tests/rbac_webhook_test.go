@@ -0,0 +1,120 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestRBACEscalationBan verifies that tenants cannot bind cluster-admin, ServiceAccounts
+// of other namespaces, or system users and groups, nor grant escalating verbs, while
+// other namespaces and the operator itself are unaffected.
+func TestRBACEscalationBan(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "owner@example.com"},
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "tenant-shop",
+		Labels: map[string]string{controller.TenantNameLabelKey: "shop"},
+	}}
+	bronze := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   controller.BronzeNamespace,
+		Labels: map[string]string{controller.TierLabelKey: string(platformv1alpha1.BronzeTier)},
+	}}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(tenant, ns, bronze).Build()
+	operator := "system:serviceaccount:tenant-master-system:tenant-operator"
+	w := &validating.RBACValidatingWebhook{Client: cl, ExemptUsers: []string{operator}}
+
+	binding := func(namespace string, roleRef rbacv1.RoleRef, subjects ...rbacv1.Subject) *rbacv1.RoleBinding {
+		return &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: namespace},
+			RoleRef:    roleRef,
+			Subjects:   subjects,
+		}
+	}
+	role := func(namespace string, verbs ...string) *rbacv1.Role {
+		return &rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: namespace},
+			Rules:      []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: verbs}},
+		}
+	}
+	view := rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "view"}
+	user := rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "dev@example.com"}
+
+	for _, tc := range []struct {
+		name    string
+		obj     client.Object
+		user    string
+		wantErr string
+	}{
+		{"cluster-admin roleRef", binding("tenant-shop",
+			rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "cluster-admin"}, user), "", "roleRef.name"},
+		{"ServiceAccount of another namespace", binding("tenant-shop", view,
+			rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "default", Namespace: "kube-system"}), "", "subjects[0].namespace"},
+		{"system user", binding("tenant-shop", view,
+			rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "system:kube-controller-manager"}), "", "subjects[0].name"},
+		{"system group", binding("tenant-shop", view,
+			rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "system:masters"}), "", "subjects[0].name"},
+		{"escalate verb", role("tenant-shop", "get", "escalate"), "", `verb "escalate"`},
+		{"bind verb", role("tenant-shop", "bind"), "", `verb "bind"`},
+		{"impersonate verb", role("tenant-shop", "impersonate"), "", `verb "impersonate"`},
+		{"wildcard verb", role("tenant-shop", "*"), "", `verb "*"`},
+		{"ordinary binding", binding("tenant-shop", view, user,
+			rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "ci", Namespace: "tenant-shop"}), "", ""},
+		{"ordinary role", role("tenant-shop", "get", "list", "watch"), "", ""},
+		{"shared Bronze namespace", role(controller.BronzeNamespace, "*"), "", `verb "*"`},
+		{"non-tenant namespace", role("kube-system", "*"), "", ""},
+		{"operator", role("tenant-shop", "*"), operator, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: tc.user},
+				},
+			})
+			_, err := w.ValidateCreate(ctx, tc.obj)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestTenantRoles verifies that tenants get curated admin, edit, and view Roles that
+// cannot change the resources enforcing their tier, and that the ServiceAccount is bound
+// to the admin Role.
+func TestTenantRoles(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "admin@example.com"},
+	}
+	// A Role left over from the wildcard grant is replaced
+	legacy := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme-admin", Namespace: "tenant-acme"},
+		Rules:      []rbacv1.PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, legacy).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}})
+	require.NoError(t, err)
+
+	allows := func(rules []rbacv1.PolicyRule, group, resource, verb string) bool {
+		for _, rule := range rules {
+			if (slices.Contains(rule.APIGroups, group) || slices.Contains(rule.APIGroups, "*")) &&
+				(slices.Contains(rule.Resources, resource) || slices.Contains(rule.Resources, "*")) &&
+				(slices.Contains(rule.Verbs, verb) || slices.Contains(rule.Verbs, "*")) {
+				return true
+			}
+		}
+		return false
+	}
+
+	roles := map[string][]rbacv1.PolicyRule{}
+	for _, name := range []string{"acme-admin", "acme-edit", "acme-view"} {
+		role := &rbacv1.Role{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-acme", Name: name}, role))
+		assert.Equal(t, controller.ManagedByValue, role.Labels[controller.ManagedByLabelKey], name)
+		roles[name] = role.Rules
+
+		for _, protected := range []struct{ group, resource string }{
+			{"", "resourcequotas"}, {"", "limitranges"}, {"networking.k8s.io", "networkpolicies"},
+		} {
+			assert.True(t, allows(role.Rules, protected.group, protected.resource, "list"), "%s lists %s", name, protected.resource)
+			for _, verb := range []string{"create", "update", "patch", "delete"} {
+				assert.False(t, allows(role.Rules, protected.group, protected.resource, verb), "%s can %s %s", name, verb, protected.resource)
+			}
+		}
+	}
+
+	assert.True(t, allows(roles["acme-admin"], "rbac.authorization.k8s.io", "roles", "create"))
+	assert.False(t, allows(roles["acme-edit"], "rbac.authorization.k8s.io", "roles", "create"))
+	assert.True(t, allows(roles["acme-edit"], "apps", "deployments", "update"))
+	assert.True(t, allows(roles["acme-edit"], "", "pods/exec", "create"))
+	assert.False(t, allows(roles["acme-view"], "apps", "deployments", "update"))
+	assert.False(t, allows(roles["acme-view"], "", "secrets", "get"))
+
+	binding := &rbacv1.RoleBinding{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-acme", Name: "acme-admin-binding"}, binding))
+	assert.Equal(t, "acme-admin", binding.RoleRef.Name)
+}
+
+// TestManagedObjectProtection verifies that tenants cannot change or delete objects
+// the operator manages in their namespaces, while the operator and kube-system
+// controllers can.
+func TestManagedObjectProtection(t *testing.T) {
+	const operatorSA = "system:serviceaccount:tenant-system:tenant-operator"
+	w := &validating.ManagedObjectValidatingWebhook{ExemptUsers: []string{operatorSA}}
+
+	asUser := func(username string, groups ...string) context.Context {
+		return admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Resource: metav1.GroupVersionResource{Version: "v1", Resource: "resourcequotas"},
+				UserInfo: authenticationv1.UserInfo{Username: username, Groups: groups},
+			},
+		})
+	}
+	tenantUser := asUser("system:serviceaccount:tenant-acme:acme-sa", "system:serviceaccounts", "system:serviceaccounts:tenant-acme")
+
+	managed := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{
+		Name:      "acme-quota",
+		Namespace: "tenant-acme",
+		Labels:    map[string]string{controller.ManagedByLabelKey: controller.ManagedByValue},
+	}}
+	loosened := managed.DeepCopy()
+	loosened.Spec.Hard = corev1.ResourceList{}
+
+	_, err := w.ValidateUpdate(tenantUser, managed, loosened)
+	assert.True(t, apierrors.IsForbidden(err), "update: %v", err)
+	_, err = w.ValidateDelete(tenantUser, managed)
+	assert.True(t, apierrors.IsForbidden(err), "delete: %v", err)
+
+	// Removing the label does not escape the check
+	unlabeled := managed.DeepCopy()
+	unlabeled.Labels = nil
+	_, err = w.ValidateUpdate(tenantUser, managed, unlabeled)
+	assert.True(t, apierrors.IsForbidden(err), "unlabel: %v", err)
+
+	_, err = w.ValidateUpdate(asUser(operatorSA), managed, loosened)
+	assert.NoError(t, err)
+	_, err = w.ValidateDelete(asUser("system:serviceaccount:kube-system:namespace-controller", "system:serviceaccounts:kube-system"), managed)
+	assert.NoError(t, err)
+	// Controllers without their own ServiceAccount credentials run as the controller manager
+	_, err = w.ValidateDelete(asUser("system:kube-controller-manager", "system:authenticated"), managed)
+	assert.NoError(t, err)
+
+	// Tenant-owned objects are unaffected
+	_, err = w.ValidateDelete(tenantUser, &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: "mine", Namespace: "tenant-acme"}})
+	assert.NoError(t, err)
+
+	// Operator-managed Roles are protected by the RBAC webhook
+	rw := &validating.RBACValidatingWebhook{ExemptUsers: []string{operatorSA}}
+	role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{
+		Name:      "acme-view",
+		Namespace: "tenant-acme",
+		Labels:    map[string]string{controller.ManagedByLabelKey: controller.ManagedByValue},
+	}}
+	_, err = rw.ValidateDelete(tenantUser, role)
+	assert.True(t, apierrors.IsForbidden(err), "role delete: %v", err)
+	_, err = rw.ValidateDelete(asUser(operatorSA), role)
+	assert.NoError(t, err)
+}
+
+// TestAccessControlBindings verifies that spec.accessControl users and groups are bound
+// to the selected tenant Role, rebound when the Role changes, and unbound when removed.
+func TestAccessControlBindings(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "admin@example.com",
+			AccessControl: platformv1alpha1.AccessControlConfig{
+				Users:  []string{"oidc:alice@example.com"},
+				Groups: []string{"oidc:acme-devs"},
+				Role:   platformv1alpha1.AccessRoleEdit,
+			},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	reconcile := func(mutate func(*platformv1alpha1.Tenant)) {
+		t.Helper()
+		if mutate != nil {
+			current := &platformv1alpha1.Tenant{}
+			require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "acme"}, current))
+			mutate(current)
+			require.NoError(t, cl.Update(ctx, current))
+		}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}})
+		require.NoError(t, err)
+	}
+	bindingKey := types.NamespacedName{Namespace: "tenant-acme", Name: "acme-access-binding"}
+
+	reconcile(nil)
+	binding := &rbacv1.RoleBinding{}
+	require.NoError(t, cl.Get(ctx, bindingKey, binding))
+	assert.Equal(t, "acme-edit", binding.RoleRef.Name)
+	assert.Equal(t, []rbacv1.Subject{
+		{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "oidc:alice@example.com"},
+		{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "oidc:acme-devs"},
+	}, binding.Subjects)
+
+	// The roleRef is immutable, so a Role change recreates the binding
+	reconcile(func(t *platformv1alpha1.Tenant) { t.Spec.AccessControl.Role = platformv1alpha1.AccessRoleView })
+	require.NoError(t, cl.Get(ctx, bindingKey, binding))
+	assert.Equal(t, "acme-view", binding.RoleRef.Name)
+
+	reconcile(func(t *platformv1alpha1.Tenant) { t.Spec.AccessControl = platformv1alpha1.AccessControlConfig{} })
+	err := cl.Get(ctx, bindingKey, binding)
+	assert.True(t, apierrors.IsNotFound(err), "binding should be deleted: %v", err)
+
+	// The ServiceAccount keeps its admin binding throughout
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-acme", Name: "acme-admin-binding"}, binding))
+
+	// System subjects and Bronze tenants are rejected
+	w := &validating.TenantValidatingWebhook{}
+	invalid := tenant.DeepCopy()
+	invalid.Spec.AccessControl.Groups = []string{"system:masters"}
+	_, err = w.ValidateCreate(ctx, invalid)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.accessControl.groups[0]")
+
+	bronze := tenant.DeepCopy()
+	bronze.Spec.Tier = platformv1alpha1.BronzeTier
+	_, err = w.ValidateCreate(ctx, bronze)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.accessControl")
+}
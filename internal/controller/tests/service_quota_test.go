@@ -0,0 +1,148 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// reconcileServiceQuota reconciles tenant and returns the LoadBalancer and NodePort
+// counts of its namespace ResourceQuota.
+func reconcileServiceQuota(t *testing.T, tenant *platformv1alpha1.Tenant) (loadBalancers, nodePorts int64) {
+	t.Helper()
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	tenant.Finalizers = []string{controller.TenantFinalizerName}
+	tenant.Spec.Owner = "owner@example.com"
+	// Skip the vCluster readiness check of Gold tenants
+	tenant.Status = platformv1alpha1.TenantStatus{
+		State: platformv1alpha1.StateProvisioning,
+		Conditions: []metav1.Condition{{
+			Type:   platformv1alpha1.ConditionVClusterDeployed,
+			Status: metav1.ConditionTrue,
+			Reason: "Completed",
+		}},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &corev1.Service{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: tenant.Name}})
+	require.NoError(t, err)
+
+	rq := &corev1.ResourceQuota{}
+	key := types.NamespacedName{Namespace: "tenant-" + tenant.Name, Name: tenant.Name + "-quota"}
+	require.NoError(t, cl.Get(ctx, key, rq))
+	lb := rq.Spec.Hard[corev1.ResourceServicesLoadBalancers]
+	np := rq.Spec.Hard[corev1.ResourceServicesNodePorts]
+	return lb.Value(), np.Value()
+}
+
+// TestServiceQuotas verifies the per-tier LoadBalancer and NodePort quotas, their
+// spec.quotas.objects overrides, and that both are 0 unless the tenant may expose
+// Services externally.
+func TestServiceQuotas(t *testing.T) {
+	three := int64(3)
+	for _, tc := range []struct {
+		name              string
+		tier              platformv1alpha1.TenantTier
+		allowExternal     bool
+		objects           *platformv1alpha1.ObjectQuotas
+		wantLoadBalancers int64
+		wantNodePorts     int64
+	}{
+		{"silver default deny", platformv1alpha1.SilverTier, false, nil, 0, 0},
+		{"silver opted in", platformv1alpha1.SilverTier, true, nil, controller.DefaultSilverLoadBalancers, controller.DefaultSilverNodePorts},
+		{"gold default deny", platformv1alpha1.GoldTier, false, nil, 0, 0},
+		{"gold opted in", platformv1alpha1.GoldTier, true, nil, controller.DefaultGoldLoadBalancers, controller.DefaultGoldNodePorts},
+		{"override opted in", platformv1alpha1.SilverTier, true, &platformv1alpha1.ObjectQuotas{LoadBalancers: &three}, 3, controller.DefaultSilverNodePorts},
+		{"override without opt-in", platformv1alpha1.SilverTier, false, &platformv1alpha1.ObjectQuotas{LoadBalancers: &three}, 0, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant := &platformv1alpha1.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+				Spec: platformv1alpha1.TenantSpec{
+					Tier:    tc.tier,
+					Network: platformv1alpha1.NetworkConfig{AllowExternalServices: tc.allowExternal},
+					Quotas:  platformv1alpha1.QuotaConfig{Objects: tc.objects},
+				},
+			}
+			loadBalancers, nodePorts := reconcileServiceQuota(t, tenant)
+			assert.Equal(t, tc.wantLoadBalancers, loadBalancers, "services.loadbalancers")
+			assert.Equal(t, tc.wantNodePorts, nodePorts, "services.nodeports")
+		})
+	}
+}
+
+// TestExposureServiceQuota verifies that the quota admits the vCluster exposure Service
+// on top of the tenant's own allowance. Kubernetes counts a LoadBalancer Service as one
+// load balancer plus a node port per port, and a NodePort Service as a node port per port.
+func TestExposureServiceQuota(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		exposure          platformv1alpha1.ExposureType
+		allowExternal     bool
+		wantLoadBalancers int64
+		wantNodePorts     int64
+	}{
+		{"load balancer", platformv1alpha1.ExposureLoadBalancer, false, 1, 1},
+		{"node port", platformv1alpha1.ExposureNodePort, false, 0, 1},
+		{"ingress", platformv1alpha1.ExposureIngress, false, 0, 0},
+		{"load balancer opted in", platformv1alpha1.ExposureLoadBalancer, true,
+			controller.DefaultGoldLoadBalancers + 1, controller.DefaultGoldNodePorts + 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant := &platformv1alpha1.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "bank"},
+				Spec: platformv1alpha1.TenantSpec{
+					Tier:     platformv1alpha1.GoldTier,
+					Network:  platformv1alpha1.NetworkConfig{AllowExternalServices: tc.allowExternal},
+					Exposure: &platformv1alpha1.ExposureConfig{Type: tc.exposure, Hostname: "bank.example.com"},
+				},
+			}
+			loadBalancers, nodePorts := reconcileServiceQuota(t, tenant)
+			assert.Equal(t, tc.wantLoadBalancers, loadBalancers, "services.loadbalancers")
+			assert.Equal(t, tc.wantNodePorts, nodePorts, "services.nodeports")
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestExternalServiceBan verifies that LoadBalancer and NodePort Services and external
+// IPs are rejected in tenant namespaces unless the tenant opts in, and that only the
+// exposure Service controlled by the Tenant is exempt.
+func TestExternalServiceBan(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	newTenant := func(name string, allow bool) (*platformv1alpha1.Tenant, *corev1.Namespace) {
+		tenant := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name + "-uid")},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:     platformv1alpha1.GoldTier,
+				Owner:    "owner@example.com",
+				Network:  platformv1alpha1.NetworkConfig{AllowExternalServices: allow},
+				Exposure: &platformv1alpha1.ExposureConfig{Type: platformv1alpha1.ExposureLoadBalancer},
+			},
+		}
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "tenant-" + name,
+			Labels: map[string]string{controller.TenantNameLabelKey: name},
+		}}
+		return tenant, ns
+	}
+	locked, lockedNS := newTenant("locked", false)
+	open, openNS := newTenant("open", true)
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(locked, lockedNS, open, openNS).Build()
+	w := &validating.ServiceValidatingWebhook{Client: cl}
+
+	newService := func(namespace, name string, mutate func(*corev1.Service)) *corev1.Service {
+		svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		mutate(svc)
+		return svc
+	}
+	for _, tc := range []struct {
+		name    string
+		svc     *corev1.Service
+		wantErr string
+	}{
+		{"LoadBalancer", newService("tenant-locked", "web", func(svc *corev1.Service) {
+			svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+		}), "spec.type"},
+		{"NodePort", newService("tenant-locked", "web", func(svc *corev1.Service) {
+			svc.Spec.Type = corev1.ServiceTypeNodePort
+		}), "spec.type"},
+		{"external IPs", newService("tenant-locked", "web", func(svc *corev1.Service) {
+			svc.Spec.ExternalIPs = []string{"203.0.113.10"}
+		}), "spec.externalIPs"},
+		{"ClusterIP", newService("tenant-locked", "web", func(svc *corev1.Service) {
+			svc.Spec.Type = corev1.ServiceTypeClusterIP
+		}), ""},
+		{"opted in", newService("tenant-open", "web", func(svc *corev1.Service) {
+			svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+		}), ""},
+		{"non-tenant namespace", newService("ingress-nginx", "web", func(svc *corev1.Service) {
+			svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+		}), ""},
+		{"exposure Service", newService("tenant-locked", "locked-vcluster-external", func(svc *corev1.Service) {
+			svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+			require.NoError(t, controllerutil.SetControllerReference(locked, svc, s))
+		}), ""},
+		{"exposure Service name with a forged label", newService("tenant-locked", "locked-vcluster-external", func(svc *corev1.Service) {
+			svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+			svc.Labels = map[string]string{controller.ManagedByLabelKey: controller.ManagedByValue}
+		}), "spec.type"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := w.ValidateCreate(ctx, tc.svc)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+			assert.Contains(t, err.Error(), "allowExternalServices")
+		})
+	}
+}
+
+// TestExternalServiceBanBronzeNamespace verifies that Services are never exposed
+// externally from the shared Bronze namespace, which belongs to no single tenant.
+func TestExternalServiceBanBronzeNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   controller.BronzeNamespace,
+		Labels: map[string]string{controller.TierLabelKey: string(platformv1alpha1.BronzeTier)},
+	}}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(ns).Build()
+	w := &validating.ServiceValidatingWebhook{Client: cl}
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: controller.BronzeNamespace},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+	}
+	_, err := w.ValidateCreate(ctx, svc)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.type")
+
+	svc.Spec.Type = corev1.ServiceTypeClusterIP
+	_, err = w.ValidateCreate(ctx, svc)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,179 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestTenantSnapshotRestore verifies that a manual TenantSnapshot archives the tenant's
+// ConfigMaps, and that a recreated tenant restores them once from spec.restoreFrom, while
+// a snapshot of another tenant is refused.
+func TestTenantSnapshotRestore(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "admin@example.com"},
+		Status:     platformv1alpha1.TenantStatus{Namespace: "tenant-acme"},
+	}
+	appConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "tenant-acme"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	}
+	manual := &platformv1alpha1.TenantSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme-before-upgrade"},
+		Spec:       platformv1alpha1.TenantSnapshotSpec{TenantName: "acme", Trigger: platformv1alpha1.SnapshotManual},
+	}
+	other := &platformv1alpha1.TenantSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "globex-nightly"},
+		Spec:       platformv1alpha1.TenantSnapshotSpec{TenantName: "globex"},
+		Status: platformv1alpha1.TenantSnapshotStatus{
+			Phase:    platformv1alpha1.SnapshotCompleted,
+			Location: "snapshots/globex/globex-nightly.json",
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, appConfig, manual, other).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &platformv1alpha1.TenantSnapshot{}).
+		Build()
+	archive := &storage.Filesystem{Root: t.TempDir()}
+
+	snapshots := &controller.TenantSnapshotReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Archive: archive}
+	_, err := snapshots.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme-before-upgrade"}})
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(manual), manual))
+	assert.Equal(t, platformv1alpha1.SnapshotCompleted, manual.Status.Phase)
+	assert.Equal(t, "snapshots/acme/acme-before-upgrade.json", manual.Status.Location)
+	assert.Equal(t, "tenant-acme", manual.Status.SourceNamespace)
+	require.Len(t, manual.Status.Contents, 1)
+	assert.Equal(t, "app-config", manual.Status.Contents[0].Name)
+	assert.True(t, manual.Restorable())
+
+	// The tenant is deleted along with its namespace, then recreated from the snapshot
+	require.NoError(t, cl.Delete(ctx, tenant))
+	require.NoError(t, cl.Delete(ctx, appConfig))
+	restored := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:        platformv1alpha1.SilverTier,
+			Owner:       "admin@example.com",
+			RestoreFrom: &platformv1alpha1.TenantSnapshotReference{Name: "acme-before-upgrade"},
+		},
+	}
+	require.NoError(t, cl.Create(ctx, restored))
+
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Archive: archive}
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}})
+	require.NoError(t, err)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(appConfig), cm))
+	assert.Equal(t, "debug", cm.Data["LOG_LEVEL"])
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(restored), restored))
+	assert.Equal(t, "acme-before-upgrade", restored.Status.RestoredFrom)
+	assert.True(t, apimeta.IsStatusConditionTrue(restored.Status.Conditions, platformv1alpha1.ConditionSnapshotRestored))
+
+	// Restores run once: later changes to the ConfigMap are kept
+	cm.Data["LOG_LEVEL"] = "info"
+	require.NoError(t, cl.Update(ctx, cm))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "acme"}})
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(cm), cm))
+	assert.Equal(t, "info", cm.Data["LOG_LEVEL"])
+
+	// Another tenant's snapshot is never restored
+	intruder := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "initech", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:        platformv1alpha1.SilverTier,
+			Owner:       "admin@example.com",
+			RestoreFrom: &platformv1alpha1.TenantSnapshotReference{Name: "globex-nightly"},
+		},
+	}
+	require.NoError(t, cl.Create(ctx, intruder))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "initech"}})
+	assert.ErrorContains(t, err, "TenantSnapshot globex-nightly is a snapshot of tenant globex, not initech")
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(intruder), intruder))
+	assert.Empty(t, intruder.Status.RestoredFrom)
+	condition := apimeta.FindStatusCondition(intruder.Status.Conditions, platformv1alpha1.ConditionSnapshotRestored)
+	require.NotNil(t, condition)
+	assert.Equal(t, "SnapshotNotRestorable", condition.Reason)
+}
+
+// TestRestoreFromValidation verifies that spec.restoreFrom is rejected for Bronze tenants
+// and can only be set when a tenant is created.
+func TestRestoreFromValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(tier platformv1alpha1.TenantTier, restoreFrom string) *platformv1alpha1.Tenant {
+		tenant := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+			Spec:       platformv1alpha1.TenantSpec{Tier: tier, Owner: "admin@example.com"},
+		}
+		if restoreFrom != "" {
+			tenant.Spec.RestoreFrom = &platformv1alpha1.TenantSnapshotReference{Name: restoreFrom}
+		}
+		return tenant
+	}
+
+	_, err := w.ValidateCreate(ctx, newTenant(platformv1alpha1.SilverTier, "acme-before-upgrade"))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateCreate(ctx, newTenant(platformv1alpha1.BronzeTier, "acme-before-upgrade"))
+	assert.True(t, apierrors.IsInvalid(err), "got %v", err)
+	assert.ErrorContains(t, err, "restoreFrom is only supported for Silver and Gold tier tenants")
+
+	_, err = w.ValidateUpdate(ctx, newTenant(platformv1alpha1.SilverTier, "acme-before-upgrade"),
+		newTenant(platformv1alpha1.SilverTier, "acme-before-upgrade"))
+	assert.NoError(t, err)
+
+	for _, old := range []string{"", "acme-before-upgrade"} {
+		_, err = w.ValidateUpdate(ctx, newTenant(platformv1alpha1.SilverTier, old),
+			newTenant(platformv1alpha1.SilverTier, "acme-nightly"))
+		assert.True(t, apierrors.IsForbidden(err), "got %v", err)
+		assert.ErrorContains(t, err, "spec.restoreFrom can only be set when the tenant is created")
+	}
+}
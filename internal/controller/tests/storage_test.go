@@ -0,0 +1,280 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/audit"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+)
+
+// objectServer is an in-memory object store behind an HTTP API. list renders the
+// listing response for the stored names under a prefix.
+type objectServer struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	auth    []string
+}
+
+func newObjectServer(t *testing.T, container string, list func(w io.Writer, names []string)) *httptest.Server {
+	store := &objectServer{objects: map[string][]byte{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		store.auth = append(store.auth, r.Header.Get("Authorization"))
+
+		name, isObject := strings.CutPrefix(r.URL.Path, "/"+container+"/")
+		switch {
+		case !isObject && r.Method == http.MethodGet:
+			prefix := r.URL.Query().Get("prefix")
+			var names []string
+			for n := range store.objects {
+				if strings.HasPrefix(n, prefix) {
+					names = append(names, n)
+				}
+			}
+			sort.Strings(names)
+			list(w, names)
+		case r.Method == http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			store.objects[name] = body
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet:
+			body, ok := store.objects[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(body)
+		case r.Method == http.MethodDelete:
+			delete(store.objects, name)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		for _, auth := range store.auth {
+			assert.NotEmpty(t, auth, "unsigned request")
+		}
+	})
+	return srv
+}
+
+// exerciseStorage runs the same round trip against every backend.
+func exerciseStorage(t *testing.T, store storage.Storage) {
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "snapshots/acme/snap 1.json", []byte(`{"n":1}`)))
+	require.NoError(t, store.Put(ctx, "snapshots/acme/snap-2.json", []byte(`{"n":2}`)))
+	require.NoError(t, store.Put(ctx, "audit/acme/entry.json", []byte(`{}`)))
+
+	data, err := store.Get(ctx, "snapshots/acme/snap 1.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"n":1}`, string(data))
+
+	keys, err := store.List(ctx, "snapshots/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"snapshots/acme/snap 1.json", "snapshots/acme/snap-2.json"}, keys)
+
+	require.NoError(t, store.Delete(ctx, "snapshots/acme/snap-2.json"))
+	_, err = store.Get(ctx, "snapshots/acme/snap-2.json")
+	assert.True(t, errors.Is(err, storage.ErrNotFound), "got %v", err)
+
+	assert.Error(t, store.Put(ctx, "../escape.json", nil))
+}
+
+// TestStorageBackends verifies that the Filesystem, S3, and Azure Blob backends store,
+// list, and delete objects, and that the cloud backends sign their requests.
+func TestStorageBackends(t *testing.T) {
+	t.Run("Filesystem", func(t *testing.T) {
+		exerciseStorage(t, &storage.Filesystem{Root: t.TempDir()})
+	})
+
+	t.Run("S3", func(t *testing.T) {
+		var signed []string
+		srv := newObjectServer(t, "archive", func(w io.Writer, names []string) {
+			type object struct {
+				Key string `xml:"Key"`
+			}
+			result := struct {
+				XMLName  xml.Name `xml:"ListBucketResult"`
+				Contents []object `xml:"Contents"`
+			}{}
+			for _, n := range names {
+				result.Contents = append(result.Contents, object{Key: n})
+			}
+			_ = xml.NewEncoder(w).Encode(result)
+		})
+		store := &storage.S3{
+			Endpoint:        srv.URL,
+			Region:          "eu-west-1",
+			Bucket:          "archive",
+			Prefix:          "tm/",
+			AccessKeyID:     "AKIDEXAMPLE",
+			SecretAccessKey: "secret",
+			HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				signed = append(signed, r.Header.Get("Authorization"))
+				return http.DefaultTransport.RoundTrip(r)
+			})},
+		}
+		exerciseStorage(t, store)
+		require.NotEmpty(t, signed)
+		assert.Regexp(t, `^AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/\d{8}/eu-west-1/s3/aws4_request, `+
+			`SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=[0-9a-f]{64}$`, signed[0])
+
+		// Keys are stored below the prefix
+		data, err := (&storage.S3{Endpoint: srv.URL, Bucket: "archive", AccessKeyID: "a", SecretAccessKey: "b"}).
+			Get(context.Background(), "tm/audit/acme/entry.json")
+		require.NoError(t, err)
+		assert.Equal(t, "{}", string(data))
+	})
+
+	t.Run("AzureBlob", func(t *testing.T) {
+		var requests []*http.Request
+		srv := newObjectServer(t, "archive", func(w io.Writer, names []string) {
+			type blob struct {
+				Name string `xml:"Name"`
+			}
+			result := struct {
+				XMLName xml.Name `xml:"EnumerationResults"`
+				Blobs   []blob   `xml:"Blobs>Blob"`
+			}{}
+			for _, n := range names {
+				result.Blobs = append(result.Blobs, blob{Name: n})
+			}
+			_ = xml.NewEncoder(w).Encode(result)
+		})
+		store := &storage.AzureBlob{
+			Account:    "tenantmaster",
+			AccountKey: "c2VjcmV0",
+			Container:  "archive",
+			Endpoint:   srv.URL,
+			HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				requests = append(requests, r)
+				return http.DefaultTransport.RoundTrip(r)
+			})},
+		}
+		exerciseStorage(t, store)
+		require.NotEmpty(t, requests)
+		assert.Regexp(t, `^SharedKey tenantmaster:[A-Za-z0-9+/]+=*$`, requests[0].Header.Get("Authorization"))
+		assert.Equal(t, "BlockBlob", requests[0].Header.Get("x-ms-blob-type"))
+	})
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+// TestSnapshotArchive verifies that the pre-deletion snapshot and the deletion audit
+// entry are archived, with the namespace ConfigMaps but without its Secrets.
+func TestSnapshotArchive(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "archived", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "owner@example.com",
+		},
+		Status: platformv1alpha1.TenantStatus{Namespace: "tenant-archived"},
+	}
+	appConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "tenant-archived"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	}
+	appSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-secret", Namespace: "tenant-archived"},
+		StringData: map[string]string{"password": "hunter2"},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, appConfig, appSecret).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &platformv1alpha1.TenantSnapshot{}).
+		Build()
+
+	archive := &storage.Filesystem{Root: t.TempDir()}
+	r := &controller.TenantReconciler{
+		Client:  cl,
+		Scheme:  s,
+		Log:     logr.Discard(),
+		Audit:   &audit.Recorder{Client: cl, Namespace: controller.OperatorNamespace, Archive: archive},
+		Archive: archive,
+	}
+
+	require.NoError(t, cl.Delete(ctx, tenant))
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "archived"}})
+	require.NoError(t, err)
+
+	snapshots := &platformv1alpha1.TenantSnapshotList{}
+	require.NoError(t, cl.List(ctx, snapshots))
+	require.Len(t, snapshots.Items, 1)
+	assert.Equal(t, platformv1alpha1.SnapshotDeletion, snapshots.Items[0].Spec.Trigger)
+	assert.True(t, snapshots.Items[0].Restorable())
+	location := snapshots.Items[0].Status.Location
+	assert.True(t, strings.HasPrefix(location, "snapshots/archived/snapshot-archived-"), location)
+
+	data, err := archive.Get(ctx, location)
+	require.NoError(t, err)
+	var snapshot struct {
+		Spec       platformv1alpha1.TenantSpec `json:"spec"`
+		ConfigMaps []corev1.ConfigMap          `json:"configMaps"`
+	}
+	require.NoError(t, json.Unmarshal(data, &snapshot))
+	assert.Equal(t, "owner@example.com", snapshot.Spec.Owner)
+	require.Len(t, snapshot.ConfigMaps, 1)
+	assert.Equal(t, "debug", snapshot.ConfigMaps[0].Data["LOG_LEVEL"])
+	assert.NotContains(t, string(data), "hunter2")
+
+	entries, err := archive.List(ctx, "audit/archived/")
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
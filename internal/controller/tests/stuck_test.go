@@ -0,0 +1,118 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// TestStuckTenantDetector verifies that tenants over their tier's provisioning SLA
+// are flagged and escalated once, and cleared after they become Ready.
+func TestStuckTenantDetector(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	newTenant := func(name string, tier platformv1alpha1.TenantTier, started time.Duration) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       platformv1alpha1.TenantSpec{Tier: tier, Owner: name + "@example.com"},
+			Status: platformv1alpha1.TenantStatus{
+				State:                 platformv1alpha1.StateProvisioning,
+				ProvisioningStartTime: &metav1.Time{Time: now.Add(-started)},
+			},
+		}
+	}
+	stuck := newTenant("stuck-gold", platformv1alpha1.GoldTier, 45*time.Minute)
+	stuck.Status.State = platformv1alpha1.StateFailed
+	stuck.Status.LastError = "vCluster deployment failed: timeout"
+	recent := newTenant("recent-gold", platformv1alpha1.GoldTier, 10*time.Minute)
+	slowSilver := newTenant("slow-silver", platformv1alpha1.SilverTier, 45*time.Minute)
+	// Was Ready once, so later failures are not provisioning
+	degraded := newTenant("degraded-silver", platformv1alpha1.SilverTier, 45*time.Minute)
+	degraded.Status.State = platformv1alpha1.StateFailed
+	degraded.Status.LastUpdateTime = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(stuck, recent, slowSilver, degraded).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	cfg.Stuck.SilverSLA = 0
+	cfg.Stuck.EscalationRecipients = []string{"platform@example.com"}
+	recorder := record.NewFakeRecorder(10)
+	notifier := &recordingNotifier{}
+	d := &controller.StuckTenantDetector{
+		Client:   cl,
+		Recorder: recorder,
+		Notifier: notifier,
+		Config:   cfg,
+		Log:      logr.Discard(),
+	}
+
+	require.NoError(t, d.Check(ctx, now))
+
+	require.Len(t, notifier.sent, 1)
+	assert.Equal(t, []string{"platform@example.com"}, notifier.sent[0].To)
+	assert.Contains(t, notifier.sent[0].Subject, "stuck-gold")
+	assert.Contains(t, notifier.sent[0].Body, "vCluster deployment failed: timeout")
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Warning ProvisioningStuck provisioning has not completed after 45m0s (Gold tier SLA 30m0s)")
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.ProvisioningStuckGauge.WithLabelValues("stuck-gold", "Gold")))
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.ProvisioningStuckGauge))
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "stuck-gold"}, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionProvisioningStuck))
+
+	// Already flagged: no repeated escalation
+	require.NoError(t, d.Check(ctx, now.Add(time.Minute)))
+	assert.Len(t, notifier.sent, 1)
+	assert.Empty(t, recorder.Events)
+
+	// Reaching Ready clears the condition and the metric
+	current.Status.State = platformv1alpha1.StateReady
+	current.Status.LastUpdateTime = &metav1.Time{Time: now.Add(2 * time.Minute)}
+	require.NoError(t, cl.Status().Update(ctx, current))
+	require.NoError(t, d.Check(ctx, now.Add(2*time.Minute)))
+
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "stuck-gold"}, current))
+	assert.True(t, apimeta.IsStatusConditionFalse(current.Status.Conditions, platformv1alpha1.ConditionProvisioningStuck))
+	assert.Equal(t, 0, testutil.CollectAndCount(metrics.ProvisioningStuckGauge))
+}
@@ -0,0 +1,122 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestSuspendAndResume verifies that suspending a tenant scales its workloads to zero
+// and that resuming restores their replica counts.
+func TestSuspendAndResume(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, appsv1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	three := int32(3)
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "sleepy", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:    platformv1alpha1.SilverTier,
+			Owner:   "owner@example.com",
+			Suspend: true,
+		},
+		Status: platformv1alpha1.TenantStatus{State: platformv1alpha1.StateReady},
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "tenant-sleepy",
+		Labels: map[string]string{controller.TenantNameLabelKey: "sleepy"},
+	}}
+	api := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "tenant-sleepy"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &three},
+	}
+	// Unset replicas default to 1
+	db := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "tenant-sleepy"}}
+	// Workloads outside the tenant are left alone
+	other := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &three},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, ns, api, db, other).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "sleepy"}}
+
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.NotZero(t, result.RequeueAfter, "suspended tenants are re-checked for new workloads")
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateSuspended, current.Status.State)
+
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-sleepy", Name: "api"}, api))
+	assert.Equal(t, int32(0), *api.Spec.Replicas)
+	assert.Equal(t, "3", api.Annotations[controller.SuspendedReplicasAnnotation])
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-sleepy", Name: "db"}, db))
+	assert.Equal(t, int32(0), *db.Spec.Replicas)
+	assert.Equal(t, "1", db.Annotations[controller.SuspendedReplicasAnnotation])
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "web"}, other))
+	assert.Equal(t, int32(3), *other.Spec.Replicas)
+
+	// Reconciling again while suspended keeps the original counts
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-sleepy", Name: "api"}, api))
+	assert.Equal(t, "3", api.Annotations[controller.SuspendedReplicasAnnotation])
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	current.Spec.Suspend = false
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-sleepy", Name: "api"}, api))
+	assert.Equal(t, int32(3), *api.Spec.Replicas)
+	assert.NotContains(t, api.Annotations, controller.SuspendedReplicasAnnotation)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-sleepy", Name: "db"}, db))
+	assert.Equal(t, int32(1), *db.Spec.Replicas)
+}
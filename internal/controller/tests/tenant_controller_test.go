@@ -18,18 +18,29 @@ limitations under the License.
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
 	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
 )
 
 // TestSilverTierProvisioning verifies that Silver tier tenants are provisioned correctly.
@@ -223,28 +234,384 @@ func TestMutatingWebhookDefaults(t *testing.T) {
 	assert.NotEmpty(t, tenant.Spec.Owner)
 }
 
-// TestTenantDeletion verifies that tenant deletion triggers cleanup.
+// TestTenantDeletion verifies that the finalizer is kept until the deletion snapshot
+// completed and the tenant namespace has terminated, and that the tenant is requeued
+// while it waits.
 func TestTenantDeletion(t *testing.T) {
 	ctx := context.Background()
 
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
 	tenant := &platformv1alpha1.Tenant{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:              "test-delete",
-			DeletionTimestamp: &metav1.Time{Time: metav1.Now().Time},
-			Finalizers:        []string{controller.TenantFinalizerName},
+			Name:       "test-delete",
+			UID:        "0b7c5a9e-delete",
+			Finalizers: []string{controller.TenantFinalizerName},
 		},
 		Spec: platformv1alpha1.TenantSpec{
 			Tier:  platformv1alpha1.SilverTier,
 			Owner: "admin@example.com",
 		},
+		Status: platformv1alpha1.TenantStatus{State: platformv1alpha1.StateReady, Namespace: "tenant-test-delete"},
+	}
+	// A namespace finalizer stands in for the namespace controller emptying the namespace
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "tenant-test-delete",
+			Labels:          map[string]string{controller.TenantNameLabelKey: "test-delete"},
+			Finalizers:      []string{"example.com/teardown"},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(tenant, platformv1alpha1.GroupVersion.WithKind("Tenant"))},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, ns).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &platformv1alpha1.TenantSnapshot{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "test-delete"}}
+
+	require.NoError(t, cl.Delete(ctx, tenant))
+	for i := 0; i < 2; i++ {
+		result, err := r.Reconcile(ctx, req)
+		require.NoError(t, err)
+		assert.Positive(t, result.RequeueAfter)
 	}
 
-	// Verify deletion timestamp is set
-	assert.NotNil(t, tenant.DeletionTimestamp)
-	assert.Contains(t, tenant.Finalizers, controller.TenantFinalizerName)
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Contains(t, current.Finalizers, controller.TenantFinalizerName)
+	assert.Equal(t, platformv1alpha1.StateTerminating, current.Status.State)
+	ready := apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, "waiting for namespaces to terminate: tenant-test-delete", ready.Message)
+
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(ns), ns))
+	assert.False(t, ns.DeletionTimestamp.IsZero())
+
+	// One snapshot per deletion, however often the tenant is requeued
+	snapshots := &platformv1alpha1.TenantSnapshotList{}
+	require.NoError(t, cl.List(ctx, snapshots))
+	require.Len(t, snapshots.Items, 1)
+	assert.Equal(t, platformv1alpha1.SnapshotDeletion, snapshots.Items[0].Spec.Trigger)
+	assert.Equal(t, platformv1alpha1.SnapshotCompleted, snapshots.Items[0].Status.Phase)
+	assert.Equal(t, "0b7c5a9e-delete", snapshots.Items[0].Labels[controller.TenantUIDLabelKey])
+
+	// Once the namespace is gone the finalizer is removed
+	ns.Finalizers = nil
+	require.NoError(t, cl.Update(ctx, ns))
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	err = cl.Get(ctx, req.NamespacedName, current)
+	assert.True(t, apierrors.IsNotFound(err), "got %v", err)
+	require.NoError(t, cl.List(ctx, snapshots))
+	assert.Len(t, snapshots.Items, 1)
+}
+
+// TestDeletionProtection verifies that the webhook rejects deleting a tenant with
+// spec.deletionProtection, that the operator keeps a protected tenant deleted without the
+// webhook, and that clearing the flag lets the deletion finish.
+func TestDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-protected", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:               platformv1alpha1.SilverTier,
+			Owner:              "admin@example.com",
+			DeletionProtection: true,
+		},
+		Status: platformv1alpha1.TenantStatus{State: platformv1alpha1.StateReady},
+	}
+
+	w := &validating.TenantValidatingWebhook{}
+	_, err := w.ValidateDelete(ctx, tenant)
+	assert.True(t, apierrors.IsForbidden(err), "got %v", err)
+	assert.ErrorContains(t, err, "spec.deletionProtection")
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &platformv1alpha1.TenantSnapshot{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Recorder: recorder}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "test-protected"}}
+
+	require.NoError(t, cl.Delete(ctx, tenant))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Contains(t, current.Finalizers, controller.TenantFinalizerName)
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+	ready := apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, "DeletionProtected", ready.Reason)
+	assert.Contains(t, <-recorder.Events, "DeletionProtected")
+	snapshots := &platformv1alpha1.TenantSnapshotList{}
+	require.NoError(t, cl.List(ctx, snapshots))
+	assert.Empty(t, snapshots.Items)
+
+	current.Spec.DeletionProtection = false
+	_, err = w.ValidateDelete(ctx, current)
+	assert.NoError(t, err)
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	err = cl.Get(ctx, req.NamespacedName, current)
+	assert.True(t, apierrors.IsNotFound(err), "got %v", err)
+}
+
+// TestManagedResourcesStatus verifies that status.managedResources references the created child objects.
+func TestManagedResourcesStatus(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "admin@example.com",
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "inventory"}})
+	require.NoError(t, err)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "inventory"}, current))
+
+	refs := map[string]bool{}
+	for _, ref := range current.Status.ManagedResources {
+		refs[ref.String()] = true
+	}
+	assert.True(t, refs["Namespace/tenant-inventory"])
+	assert.True(t, refs["ResourceQuota/tenant-inventory/inventory-quota"])
+	assert.True(t, refs["NetworkPolicy/tenant-inventory/"+controller.DefaultNetworkPolicyName])
+	assert.True(t, refs["ServiceAccount/tenant-inventory/inventory-sa"])
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionReady))
+}
+
+// TestResourceConditions verifies that each child resource gets its own readiness
+// condition, and that a failing step is reported on its condition.
+func TestResourceConditions(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "billing", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "admin@example.com",
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "billing"}})
+	require.NoError(t, err)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "billing"}, current))
+	for _, condType := range []string{
+		platformv1alpha1.ConditionNamespaceReady,
+		platformv1alpha1.ConditionQuotaReady,
+		platformv1alpha1.ConditionRBACReady,
+		platformv1alpha1.ConditionNetworkPolicyReady,
+	} {
+		assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, condType), condType)
+	}
+	assert.Nil(t, apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionVClusterReady))
+
+	// A NetworkPolicy write failure flips only its condition
+	failing := interceptor.Funcs{
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if _, ok := obj.(*netv1.NetworkPolicy); ok {
+				return errors.New("admission denied")
+			}
+			return c.Update(ctx, obj, opts...)
+		},
+	}
+	current.Spec.Network.AllowInternetAccess = true
+	require.NoError(t, cl.Update(ctx, current))
+	r.Client = interceptor.NewClient(cl, failing)
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "billing"}})
+	require.NoError(t, err) // requeued with backoff
+
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "billing"}, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionRBACReady))
+	cond := apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionNetworkPolicyReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Contains(t, cond.Message, "admission denied")
+}
+
+// TestReconcileEvents verifies that state transitions and provisioning failures are
+// emitted as Events on the tenant.
+func TestReconcileEvents(t *testing.T) {
+	ctx := context.Background()
 
-	// TODO: Verify that cleanup logic removes the namespace
-	_ = ctx // ctx available for future integration with reconciliation
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "ledger", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "admin@example.com",
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	recorder := record.NewFakeRecorder(10)
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Recorder: recorder}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "ledger"}}
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"Normal Provisioning provisioning Silver tier tenant",
+		"Normal Ready all tenant resources are provisioned",
+	}, drainEvents(recorder))
+
+	// Steady state emits nothing
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, drainEvents(recorder))
+
+	// An ensure failure is reported, along with the transition to Failed
+	r.Client = interceptor.NewClient(cl, interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if _, ok := obj.(*corev1.Namespace); ok {
+				return errors.New("quota exceeded")
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	})
+	require.NoError(t, cl.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenant-ledger"}}))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err) // requeued with backoff
+	events := drainEvents(recorder)
+	require.Len(t, events, 2)
+	assert.Contains(t, events[0], "Warning ReconcileFailed namespace creation failed: quota exceeded")
+	assert.Contains(t, events[1], "Warning Failed")
+}
+
+// TestNamespaceNameConflicts verifies that tenants whose namespaces would collide with a
+// system namespace or another tenant's namespace fail with a validation error instead of
+// taking the namespace over.
+func TestNamespaceNameConflicts(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	newTenant := func(name string) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Finalizers: []string{controller.TenantFinalizerName}},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:  platformv1alpha1.SilverTier,
+				Owner: "admin@example.com",
+			},
+		}
+	}
+	operatorNS := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: controller.OperatorNamespace}}
+	envNS := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "tenant-acme-dev",
+		Labels: map[string]string{controller.TenantNameLabelKey: "acme"},
+	}}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(newTenant("master-system"), newTenant("acme-dev"), operatorNS, envNS).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+
+	for name, msg := range map[string]string{
+		"master-system": `namespace name "tenant-master-system" is reserved`,
+		"acme-dev":      `namespace "tenant-acme-dev" already belongs to tenant "acme"`,
+	} {
+		// Terminal by default: retrying cannot fix a name
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+		assert.ErrorContains(t, err, msg)
+
+		current := &platformv1alpha1.Tenant{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: name}, current))
+		assert.Equal(t, platformv1alpha1.StateFailed, current.Status.State, name)
+		assert.Contains(t, current.Status.LastError, msg)
+		ready := apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionReady)
+		require.NotNil(t, ready)
+		assert.Equal(t, "ValidationError", ready.Reason)
+	}
+
+	// Neither namespace was taken over
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: controller.OperatorNamespace}, operatorNS))
+	assert.Empty(t, operatorNS.OwnerReferences)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-acme-dev"}, envNS))
+	assert.Equal(t, "acme", envNS.Labels[controller.TenantNameLabelKey])
+
+	// The validating webhook rejects the reserved name up front
+	_, err := (&validating.TenantValidatingWebhook{}).ValidateCreate(ctx, newTenant("master-system"))
+	assert.ErrorContains(t, err, "reserved")
+}
+
+// drainEvents returns the Events recorded so far.
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
 }
 
 // BenchmarkTenantReconciliation measures reconciliation performance.
@@ -252,3 +619,125 @@ func BenchmarkTenantReconciliation(b *testing.B) {
 	// TODO: Implement performance benchmark
 	b.Skip("Performance benchmark not yet implemented")
 }
+
+// TestObjectCountQuotas verifies that the tenant ResourceQuota caps object counts with
+// the tier defaults, spec.quotas.objects overrides, and no external Services unless allowed.
+func TestObjectCountQuotas(t *testing.T) {
+	ctx := context.Background()
+	count := func(n int64) *int64 { return &n }
+
+	tests := []struct {
+		name     string
+		external bool
+		want     map[corev1.ResourceName]int64
+	}{
+		{
+			name: "external services not allowed",
+			want: map[corev1.ResourceName]int64{
+				corev1.ResourceServices:               5,
+				corev1.ResourceServicesLoadBalancers:  0,
+				corev1.ResourceServicesNodePorts:      0,
+				corev1.ResourcePersistentVolumeClaims: controller.DefaultSilverPVCs,
+				corev1.ResourceConfigMaps:             controller.DefaultSilverConfigMaps,
+				corev1.ResourceSecrets:                200,
+			},
+		},
+		{
+			name:     "external services allowed",
+			external: true,
+			want: map[corev1.ResourceName]int64{
+				corev1.ResourceServicesLoadBalancers: 4,
+				corev1.ResourceServicesNodePorts:     controller.DefaultSilverNodePorts,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			require.NoError(t, platformv1alpha1.AddToScheme(s))
+			require.NoError(t, corev1.AddToScheme(s))
+			require.NoError(t, netv1.AddToScheme(s))
+			require.NoError(t, rbacv1.AddToScheme(s))
+
+			tenant := &platformv1alpha1.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "objects", Finalizers: []string{controller.TenantFinalizerName}},
+				Spec: platformv1alpha1.TenantSpec{
+					Tier:    platformv1alpha1.SilverTier,
+					Owner:   "admin@example.com",
+					Network: platformv1alpha1.NetworkConfig{AllowExternalServices: tt.external},
+					Quotas: platformv1alpha1.QuotaConfig{Objects: &platformv1alpha1.ObjectQuotas{
+						Services:      count(5),
+						LoadBalancers: count(4),
+						Secrets:       count(200),
+					}},
+				},
+			}
+			cl := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(tenant).
+				WithStatusSubresource(&platformv1alpha1.Tenant{}).
+				Build()
+
+			r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "objects"}})
+			require.NoError(t, err)
+
+			rq := &corev1.ResourceQuota{}
+			require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-objects", Name: "objects-quota"}, rq))
+			for name, want := range tt.want {
+				got := rq.Spec.Hard[name]
+				assert.Equal(t, want, got.Value(), name)
+			}
+		})
+	}
+}
+
+// TestStorageQuota verifies that spec.resources.storage caps the total PVC storage and
+// the storage of spec.resources.storageClass, split across environments like the other
+// budgets, and that the class is recorded as the namespaces' default StorageClass.
+func TestStorageQuota(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+
+	dev := int32(25)
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "disks", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.SilverTier,
+			Owner: "admin@example.com",
+			Resources: platformv1alpha1.ResourceRequirements{
+				Storage:      "100Gi",
+				StorageClass: "fast-ssd",
+			},
+			Environments: []platformv1alpha1.TenantEnvironment{{Name: "dev", QuotaPercent: &dev}},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "disks"}})
+	require.NoError(t, err)
+
+	for ns, want := range map[string]string{"tenant-disks": "75Gi", "tenant-disks-dev": "25Gi"} {
+		rq := &corev1.ResourceQuota{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: ns, Name: "disks-quota"}, rq))
+		total := rq.Spec.Hard[corev1.ResourceRequestsStorage]
+		assert.Equal(t, want, total.String(), "total storage quota in %s", ns)
+		class := rq.Spec.Hard["fast-ssd.storageclass.storage.k8s.io/requests.storage"]
+		assert.Equal(t, want, class.String(), "fast-ssd storage quota in %s", ns)
+
+		namespace := &corev1.Namespace{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: ns}, namespace))
+		assert.Equal(t, "fast-ssd", namespace.Annotations[controller.DefaultStorageClassAnnotation])
+	}
+}
@@ -0,0 +1,190 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/mutating"
+)
+
+// TestTenantTemplateMerge verifies the mutating webhook fills the fields a Tenant leaves
+// empty from its TenantTemplate, only when the Tenant is created.
+func TestTenantTemplateMerge(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+
+	template := &platformv1alpha1.TenantTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "saas-customer"},
+		Spec: platformv1alpha1.TenantPresetSpec{
+			Tier: platformv1alpha1.GoldTier,
+			Resources: platformv1alpha1.ResourceRequirements{
+				CPU:     "16000m",
+				Memory:  "32Gi",
+				Storage: "200Gi",
+			},
+			Network: platformv1alpha1.NetworkConfig{
+				AllowInternetAccess: true,
+				WhitelistedServices: []string{"kube-system/coredns"},
+			},
+			Labels: map[string]string{"customer-facing": "true", "team": "sales"},
+		},
+	}
+	w := &mutating.TenantMutatingWebhook{
+		Client: fake.NewClientBuilder().WithScheme(s).WithObjects(template).Build(),
+	}
+	withOperation := func(op admissionv1.Operation) context.Context {
+		return admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{Operation: op},
+		})
+	}
+	newTenant := func(tier platformv1alpha1.TenantTier) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme", Labels: map[string]string{"team": "platform"}},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:        tier,
+				Owner:       "owner@acme.corp",
+				TemplateRef: &platformv1alpha1.TenantTemplateReference{Name: "saas-customer"},
+				Resources:   platformv1alpha1.ResourceRequirements{Memory: "64Gi"},
+			},
+		}
+	}
+
+	// The CRD default tier is replaced; set fields and labels win over the template
+	tenant := newTenant(platformv1alpha1.SilverTier)
+	require.NoError(t, w.Default(withOperation(admissionv1.Create), tenant))
+	assert.Equal(t, platformv1alpha1.GoldTier, tenant.Spec.Tier)
+	assert.Equal(t, "16000m", tenant.Spec.Resources.CPU)
+	assert.Equal(t, "64Gi", tenant.Spec.Resources.Memory)
+	assert.Equal(t, "200Gi", tenant.Spec.Resources.Storage)
+	assert.True(t, tenant.Spec.Network.AllowInternetAccess)
+	assert.Equal(t, []string{"kube-system/coredns"}, tenant.Spec.Network.WhitelistedServices)
+	assert.Equal(t, map[string]string{"customer-facing": "true", "team": "platform", controller.TierLabelKey: "Gold"}, tenant.Labels)
+
+	// An explicitly requested non-default tier is kept
+	bronze := newTenant(platformv1alpha1.BronzeTier)
+	require.NoError(t, w.Default(withOperation(admissionv1.Create), bronze))
+	assert.Equal(t, platformv1alpha1.BronzeTier, bronze.Spec.Tier)
+
+	// Updates never re-apply the template
+	updated := newTenant(platformv1alpha1.SilverTier)
+	require.NoError(t, w.Default(withOperation(admissionv1.Update), updated))
+	assert.Equal(t, platformv1alpha1.SilverTier, updated.Spec.Tier)
+	assert.Equal(t, "1000m", updated.Spec.Resources.CPU, "built-in default, not the template's")
+	assert.NotContains(t, updated.Labels, "customer-facing")
+
+	// Unknown templates are rejected
+	missing := newTenant(platformv1alpha1.SilverTier)
+	missing.Spec.TemplateRef.Name = "does-not-exist"
+	err := w.Default(withOperation(admissionv1.Create), missing)
+	require.Error(t, err)
+	assert.True(t, apierrors.IsBadRequest(err))
+	assert.ErrorContains(t, err, `TenantTemplate "does-not-exist" not found`)
+}
+
+// TestTenantTemplateInheritance verifies profile chains merge with the precedence
+// profile < template < Tenant spec, are recorded for status, and reject cycles.
+func TestTenantTemplateInheritance(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+
+	preset := func(name, profile string, spec platformv1alpha1.TenantPresetSpec) *platformv1alpha1.TenantTemplate {
+		if profile != "" {
+			spec.ProfileRef = &platformv1alpha1.TenantTemplateReference{Name: profile}
+		}
+		return &platformv1alpha1.TenantTemplate{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+	}
+	w := &mutating.TenantMutatingWebhook{
+		Client: fake.NewClientBuilder().WithScheme(s).WithObjects(
+			preset("org-baseline", "", platformv1alpha1.TenantPresetSpec{
+				Resources: platformv1alpha1.ResourceRequirements{CPU: "1000m", Memory: "2Gi", Storage: "20Gi"},
+				Labels:    map[string]string{"cost-center": "engineering", "team": "unassigned"},
+			}),
+			preset("team-standard", "org-baseline", platformv1alpha1.TenantPresetSpec{
+				Resources: platformv1alpha1.ResourceRequirements{CPU: "2000m"},
+				Labels:    map[string]string{"team": "product"},
+			}),
+			preset("gold-profile", "", platformv1alpha1.TenantPresetSpec{
+				Tier:      platformv1alpha1.GoldTier,
+				Resources: platformv1alpha1.ResourceRequirements{CPU: "8000m", StorageClass: "fast-ssd"},
+			}),
+			preset("loop-a", "loop-b", platformv1alpha1.TenantPresetSpec{}),
+			preset("loop-b", "loop-a", platformv1alpha1.TenantPresetSpec{}),
+			preset("orphan", "does-not-exist", platformv1alpha1.TenantPresetSpec{}),
+		).Build(),
+	}
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create},
+	})
+	newTenant := func(profile, template string) *platformv1alpha1.Tenant {
+		tenant := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "checkout"},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:      platformv1alpha1.SilverTier,
+				Owner:     "checkout@example.com",
+				Resources: platformv1alpha1.ResourceRequirements{Memory: "8Gi"},
+			},
+		}
+		if profile != "" {
+			tenant.Spec.ProfileRef = &platformv1alpha1.TenantTemplateReference{Name: profile}
+		}
+		if template != "" {
+			tenant.Spec.TemplateRef = &platformv1alpha1.TenantTemplateReference{Name: template}
+		}
+		return tenant
+	}
+
+	tenant := newTenant("gold-profile", "team-standard")
+	require.NoError(t, w.Default(ctx, tenant))
+	assert.Equal(t, platformv1alpha1.GoldTier, tenant.Spec.Tier, "set by the profile only")
+	assert.Equal(t, "2000m", tenant.Spec.Resources.CPU, "the template wins over the profiles")
+	assert.Equal(t, "8Gi", tenant.Spec.Resources.Memory, "the Tenant spec wins over everything")
+	assert.Equal(t, "20Gi", tenant.Spec.Resources.Storage, "inherited from the template's parent")
+	assert.Equal(t, "fast-ssd", tenant.Spec.Resources.StorageClass)
+	assert.Equal(t, map[string]string{"cost-center": "engineering", "team": "product", controller.TierLabelKey: "Gold"}, tenant.Labels)
+
+	var applied []platformv1alpha1.AppliedTemplate
+	require.NoError(t, json.Unmarshal([]byte(tenant.Annotations[controller.AppliedTemplatesAnnotation]), &applied))
+	assert.Equal(t, []platformv1alpha1.AppliedTemplate{
+		{Name: "gold-profile", Via: "profileRef"},
+		{Name: "org-baseline", Via: "templateRef"},
+		{Name: "team-standard", Via: "templateRef"},
+	}, applied)
+
+	// Cyclic and missing profiles are rejected
+	err := w.Default(ctx, newTenant("", "loop-a"))
+	require.Error(t, err)
+	assert.True(t, apierrors.IsBadRequest(err))
+	assert.ErrorContains(t, err, "spec.templateRef: TenantTemplate profiles form a cycle: loop-a -> loop-b -> loop-a")
+
+	err = w.Default(ctx, newTenant("orphan", ""))
+	require.Error(t, err)
+	assert.ErrorContains(t, err, `spec.profileRef: TenantTemplate "does-not-exist", the profile of "orphan", not found`)
+}
@@ -0,0 +1,162 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestTenantSetFanOut verifies that a TenantSet creates one Tenant per member and prunes removed members.
+func TestTenantSetFanOut(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+
+	set := &platformv1alpha1.TenantSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "workshop"},
+		Spec: platformv1alpha1.TenantSetSpec{
+			Template: platformv1alpha1.TenantTemplateSpec{
+				Labels: map[string]string{"cohort": "workshop"},
+				Spec: platformv1alpha1.TenantSpec{
+					Tier:  platformv1alpha1.SilverTier,
+					Owner: "instructor@example.com",
+				},
+			},
+			Tenants: []platformv1alpha1.TenantSetMember{
+				{Name: "student-a", Owner: "a@example.com"},
+				{Name: "student-b"},
+			},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(set).
+		WithStatusSubresource(&platformv1alpha1.TenantSet{}, &platformv1alpha1.Tenant{}).
+		Build()
+
+	r := &controller.TenantSetReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: set.Name}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	a := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "student-a"}, a))
+	assert.Equal(t, "a@example.com", a.Spec.Owner)
+	assert.Equal(t, platformv1alpha1.SilverTier, a.Spec.Tier)
+	assert.Equal(t, "workshop", a.Labels[controller.TenantSetLabelKey])
+	assert.Equal(t, "workshop", a.Labels["cohort"])
+
+	b := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "student-b"}, b))
+	assert.Equal(t, "instructor@example.com", b.Spec.Owner)
+
+	// Remove a member and mark the remaining one Ready
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, set))
+	set.Spec.Tenants = set.Spec.Tenants[:1]
+	require.NoError(t, cl.Update(ctx, set))
+
+	a.Status.State = platformv1alpha1.StateReady
+	require.NoError(t, cl.Status().Update(ctx, a))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	err = cl.Get(ctx, types.NamespacedName{Name: "student-b"}, &platformv1alpha1.Tenant{})
+	assert.Error(t, err)
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, set))
+	assert.Equal(t, int32(1), set.Status.Replicas)
+	assert.Equal(t, int32(1), set.Status.ReadyReplicas)
+}
+
+// TestTenantSetMemberOwnership verifies that a TenantSet does not adopt an existing
+// Tenant it does not control, and that it keeps defaults applied to its members' specs
+// until the template changes.
+func TestTenantSetMemberOwnership(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+
+	standalone := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "student-a"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.GoldTier, Owner: "someone@example.com"},
+	}
+	set := &platformv1alpha1.TenantSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "workshop"},
+		Spec: platformv1alpha1.TenantSetSpec{
+			Template: platformv1alpha1.TenantTemplateSpec{
+				Spec: platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "instructor@example.com"},
+			},
+			Tenants: []platformv1alpha1.TenantSetMember{{Name: "student-a"}, {Name: "student-b"}},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(set, standalone).
+		WithStatusSubresource(&platformv1alpha1.TenantSet{}, &platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantSetReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: set.Name}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	// The standalone Tenant is reported, not adopted
+	a := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "student-a"}, a))
+	assert.Equal(t, platformv1alpha1.GoldTier, a.Spec.Tier)
+	assert.Empty(t, a.OwnerReferences)
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, set))
+	assert.Equal(t, []string{"student-a"}, set.Status.ConflictingTenants)
+	assert.Equal(t, int32(1), set.Status.Replicas)
+
+	// A default filled in by the mutating webhook survives reconciles
+	b := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "student-b"}, b))
+	b.Spec.Resources.CPU = "2"
+	require.NoError(t, cl.Update(ctx, b))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "student-b"}, b))
+	assert.Equal(t, "2", b.Spec.Resources.CPU)
+
+	// A template change is rolled out
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, set))
+	set.Spec.Template.Spec.Tier = platformv1alpha1.GoldTier
+	require.NoError(t, cl.Update(ctx, set))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "student-b"}, b))
+	assert.Equal(t, platformv1alpha1.GoldTier, b.Spec.Tier)
+}
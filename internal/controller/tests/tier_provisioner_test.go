@@ -0,0 +1,114 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// copperProvisioner is a tier provisioner registered by the test, standing in for a tier
+// added without touching Reconcile.
+type copperProvisioner struct {
+	provisioned []string
+}
+
+func (p *copperProvisioner) Provision(_ context.Context, _ *controller.TenantReconciler, tenant *platformv1alpha1.Tenant, _ logr.Logger) error {
+	p.provisioned = append(p.provisioned, tenant.Name)
+	apimeta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:               "ClusterReady",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: tenant.Generation,
+		Reason:             "Provisioned",
+	})
+	tenant.Status.State = platformv1alpha1.StateReady
+	return nil
+}
+
+func (p *copperProvisioner) Steps() []controller.ProvisioningStep {
+	return []controller.ProvisioningStep{
+		{Name: "Cluster", Condition: "ClusterReady"},
+		{Name: "Addons", Condition: "AddonsReady"},
+	}
+}
+
+// TestTierProvisionerRegistry verifies that a tenant is provisioned by the provisioner
+// registered for its tier, whose steps make up status.progress and whose duration is
+// recorded per tier.
+func TestTierProvisionerRegistry(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	copper := &copperProvisioner{}
+	controller.RegisterTierProvisioner("Copper", copper)
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "plugin", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec:       platformv1alpha1.TenantSpec{Tier: "Copper", Owner: "owner@example.com"},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "plugin"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"plugin"}, copper.provisioned)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateReady, current.Status.State)
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, "ClusterReady"))
+	require.NotNil(t, current.Status.Progress)
+	assert.Equal(t, "1/2 Addons", current.Status.Progress.Summary)
+	duration := &dto.Metric{}
+	observer := metrics.ReconciliationDurationHistogram.WithLabelValues("Copper", "provision")
+	require.NoError(t, observer.(prometheus.Histogram).Write(duration))
+	assert.NotZero(t, duration.GetHistogram().GetSampleCount())
+
+	// A tier without a provisioner fails validation
+	current.Spec.Tier = "Tin"
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.Error(t, err)
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, platformv1alpha1.StateFailed, current.Status.State)
+	assert.Contains(t, current.Status.LastError, "unknown tier: Tin")
+}
@@ -0,0 +1,127 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/storage"
+)
+
+// TestUsageReports verifies that the day a tenant's metering closes is exported as a
+// JSON and CSV usage report to the operator namespace and the archive, and that daily
+// reports past the retention are pruned while monthly ones are kept.
+func TestUsageReports(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier},
+		Status: platformv1alpha1.TenantStatus{
+			ResourceUsage: &platformv1alpha1.ResourceUsage{
+				CPU: platformv1alpha1.QuotaUsage{Used: "2", Hard: "4"},
+			},
+			Billing: &platformv1alpha1.BillingStatus{
+				Period:       yesterday.Format("2006-01"),
+				CPUCoreHours: "10.000000",
+				Cost:         "0.400000",
+				Day: &platformv1alpha1.BillingTotals{
+					Period:       yesterday.Format("2006-01-02"),
+					CPUCoreHours: "4.000000",
+					Cost:         "0.160000",
+				},
+				LastSampleTime: &metav1.Time{Time: today.Add(-30 * time.Minute)},
+			},
+		},
+	}
+	report := func(name, granularity, period string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: controller.OperatorNamespace,
+			Labels: map[string]string{
+				controller.UsageReportLabelKey:       granularity,
+				controller.UsageReportPeriodLabelKey: period,
+			},
+		}}
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(
+			tenant,
+			report("usage-report-shop-2000-01-01", controller.UsageReportDaily, "2000-01-01"),
+			report("usage-report-shop-2000-01", controller.UsageReportMonthly, "2000-01"),
+		).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	cfg := config.Default()
+	cfg.Metering.Interval = 24 * time.Hour
+	archive := &storage.Filesystem{Root: t.TempDir()}
+	meter := &controller.Meter{Client: cl, Config: cfg, Archive: archive, Log: logr.Discard()}
+	require.NoError(t, meter.Sample(ctx))
+
+	// Yesterday's totals include the half hour before midnight: 1 core-hour at $0.04
+	day := yesterday.Format("2006-01-02")
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: controller.OperatorNamespace, Name: controller.UsageReportName("shop", day)}, cm))
+	assert.Equal(t, controller.UsageReportDaily, cm.Labels[controller.UsageReportLabelKey])
+	var got controller.UsageReport
+	require.NoError(t, json.Unmarshal([]byte(cm.Data[controller.UsageReportJSONKey]), &got))
+	assert.Equal(t, "shop", got.Tenant)
+	assert.Equal(t, day, got.Period)
+	assert.Equal(t, "5.000000", got.CPUCoreHours)
+	assert.Equal(t, "0.200000", got.Cost)
+	assert.Equal(t, "tenant,tier,granularity,period,cpu_core_hours,memory_gib_hours,storage_gib_hours,cost\n"+
+		"shop,Silver,daily,"+day+",5.000000,0.000000,0.000000,0.200000\n", cm.Data[controller.UsageReportCSVKey])
+
+	archived, err := archive.Get(ctx, "usage-reports/shop/"+day+".csv")
+	require.NoError(t, err)
+	assert.Equal(t, cm.Data[controller.UsageReportCSVKey], string(archived))
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "shop"}, current))
+	require.NotNil(t, current.Status.Billing.Day)
+	assert.Equal(t, today.Format("2006-01-02"), current.Status.Billing.Day.Period)
+
+	// Expired daily reports are pruned; monthly reports are kept
+	err = cl.Get(ctx, types.NamespacedName{Namespace: controller.OperatorNamespace, Name: "usage-report-shop-2000-01-01"}, cm)
+	assert.True(t, apierrors.IsNotFound(err), "got %v", err)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: controller.OperatorNamespace, Name: "usage-report-shop-2000-01"}, cm))
+}
@@ -0,0 +1,113 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// TestUtilizationCollector verifies that the pod metrics of a tenant's namespaces are
+// summed into status.resourceUsage.utilization and the tenant_resource_utilization
+// metric, and that Bronze tenants only count their own pods in the shared namespace.
+func TestUtilizationCollector(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	podMetrics := func(namespace, name, tenant string, usage ...string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("metrics.k8s.io/v1beta1")
+		obj.SetKind("PodMetrics")
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		if tenant != "" {
+			obj.SetLabels(map[string]string{controller.TenantNameLabelKey: tenant})
+		}
+		var containers []interface{}
+		for i := 0; i+1 < len(usage); i += 2 {
+			containers = append(containers, map[string]interface{}{
+				"name":  "c",
+				"usage": map[string]interface{}{"cpu": usage[i], "memory": usage[i+1]},
+			})
+		}
+		obj.Object["containers"] = containers
+		return obj
+	}
+	tenant := func(name string, tier platformv1alpha1.TenantTier, envs ...string) *platformv1alpha1.Tenant {
+		t := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       platformv1alpha1.TenantSpec{Tier: tier},
+			Status: platformv1alpha1.TenantStatus{
+				ResourceUsage: &platformv1alpha1.ResourceUsage{CPU: platformv1alpha1.QuotaUsage{Used: "1", Hard: "4"}},
+			},
+		}
+		for _, env := range envs {
+			t.Spec.Environments = append(t.Spec.Environments, platformv1alpha1.TenantEnvironment{Name: env})
+		}
+		return t
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(
+			tenant("shop", platformv1alpha1.SilverTier, "dev"),
+			tenant("tiny", platformv1alpha1.BronzeTier),
+			podMetrics("tenant-shop", "web-1", "", "250m", "256Mi", "50m", "64Mi"),
+			podMetrics("tenant-shop-dev", "web-1", "", "1", "1Gi"),
+			podMetrics(controller.BronzeNamespace, "api", "tiny", "100m", "128Mi"),
+			podMetrics(controller.BronzeNamespace, "other", "someone-else", "2", "2Gi"),
+		).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	collector := &controller.UtilizationCollector{Client: cl, Log: logr.Discard()}
+	require.NoError(t, collector.Collect(ctx))
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "shop"}, current))
+	utilization := current.Status.ResourceUsage.Utilization
+	require.NotNil(t, utilization)
+	assert.Equal(t, "1300m", utilization.CPU)
+	assert.Equal(t, "1344Mi", utilization.Memory)
+	assert.Equal(t, int32(2), utilization.Pods)
+	assert.NotNil(t, utilization.SampleTime)
+	assert.Equal(t, "4", current.Status.ResourceUsage.CPU.Hard, "quota usage is kept")
+
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tiny"}, current))
+	require.NotNil(t, current.Status.ResourceUsage.Utilization)
+	assert.Equal(t, "100m", current.Status.ResourceUsage.Utilization.CPU)
+
+	assert.Equal(t, 1.3, testutil.ToFloat64(metrics.ResourceUtilizationGauge.WithLabelValues("shop", "Silver", "cpu")))
+	assert.Equal(t, float64(128<<20), testutil.ToFloat64(metrics.ResourceUtilizationGauge.WithLabelValues("tiny", "Bronze", "memory")))
+}
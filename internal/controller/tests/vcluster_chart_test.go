@@ -0,0 +1,221 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestVClusterChartSource verifies that a Gold tenant's vCluster is installed from the
+// configured chart source, with the chart credentials copied next to the Helm values and
+// the image pulled from the configured mirror.
+func TestVClusterChartSource(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	newTenant := func() *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "airgap", Finalizers: []string{controller.TenantFinalizerName}},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:  platformv1alpha1.GoldTier,
+				Owner: "owner@example.com",
+			},
+			// Skip the vCluster readiness check
+			Status: platformv1alpha1.TenantStatus{
+				State: platformv1alpha1.StateProvisioning,
+				Conditions: []metav1.Condition{{
+					Type:   platformv1alpha1.ConditionVClusterDeployed,
+					Status: metav1.ConditionTrue,
+					Reason: "Completed",
+				}},
+			},
+		}
+	}
+	operatorConfig := config.Default()
+	operatorConfig.Chart.Source = config.VClusterChartOCI
+	operatorConfig.Chart.Repository = "oci://registry.internal/charts/"
+	operatorConfig.Chart.Version = "0.15.2"
+	operatorConfig.Chart.CredentialsSecret = "chart-credentials"
+	operatorConfig.Chart.ImageRepository = "registry.internal/loftsh/vcluster"
+
+	t.Run("OCI registry with credentials", func(t *testing.T) {
+		credentials := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "chart-credentials", Namespace: controller.OperatorNamespace},
+			Data: map[string][]byte{
+				controller.ChartUsernameKey: []byte("robot"),
+				controller.ChartPasswordKey: []byte("s3cret"),
+				"unrelated":                 []byte("x"),
+			},
+		}
+		// A copy stored in the tenant namespace by an earlier release
+		leaked := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "airgap-vcluster-chart-credentials", Namespace: "tenant-airgap"}}
+		cl := fake.NewClientBuilder().
+			WithScheme(s).
+			WithObjects(newTenant(), credentials, leaked).
+			WithStatusSubresource(&platformv1alpha1.Tenant{}).
+			Build()
+		r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: operatorConfig}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "airgap"}})
+		require.NoError(t, err)
+
+		values := &corev1.ConfigMap{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-airgap", Name: "airgap-vcluster-helm-values"}, values))
+		assert.Equal(t, config.VClusterChartOCI, values.Data["chart-source"])
+		assert.Equal(t, "oci://registry.internal/charts/vcluster", values.Data["chart-name"])
+		assert.Equal(t, "0.15.2", values.Data["chart-version"])
+		assert.NotContains(t, values.Data, "chart-repository")
+		assert.Equal(t, "chart-credentials", values.Data["chart-credentials-secret"])
+		assert.Equal(t, controller.OperatorNamespace, values.Data["chart-credentials-namespace"])
+		assert.Contains(t, values.Data["helm-values"], "repository: registry.internal/loftsh/vcluster")
+		assert.Contains(t, values.Data["helm-values"], "tag: 0.15.2")
+
+		// Tenants can read Secrets in their namespace, so the credentials never go there
+		secrets := &corev1.SecretList{}
+		require.NoError(t, cl.List(ctx, secrets, client.InNamespace("tenant-airgap")))
+		for _, secret := range secrets.Items {
+			assert.NotContains(t, secret.Data, controller.ChartPasswordKey, secret.Name)
+		}
+		err = cl.Get(ctx, types.NamespacedName{Namespace: "tenant-airgap", Name: "airgap-vcluster-chart-credentials"}, &corev1.Secret{})
+		assert.True(t, apierrors.IsNotFound(err), "got %v", err)
+
+		// Dropping the credentials removes the reference
+		r.Config = config.Default()
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "airgap"}})
+		require.NoError(t, err)
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-airgap", Name: "airgap-vcluster-helm-values"}, values))
+		assert.Equal(t, "vcluster", values.Data["chart-name"])
+		assert.Equal(t, "https://charts.loft.sh", values.Data["chart-repository"])
+		assert.NotContains(t, values.Data, "chart-credentials-secret")
+		assert.NotContains(t, values.Data, "chart-credentials-namespace")
+	})
+
+	t.Run("per-tenant version, distro, and values", func(t *testing.T) {
+		tenant := newTenant()
+		tenant.Spec.VCluster = &platformv1alpha1.VClusterConfig{
+			ChartVersion: "0.16.4",
+			Distro:       platformv1alpha1.VClusterDistroK8s,
+			Values:       "replicas: 3\nsyncer:\n  extraArgs:\n  - --sync-all-nodes\n",
+		}
+		cl := fake.NewClientBuilder().
+			WithScheme(s).
+			WithObjects(tenant).
+			WithStatusSubresource(&platformv1alpha1.Tenant{}).
+			Build()
+		r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: config.Default()}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "airgap"}})
+		require.NoError(t, err)
+
+		values := &corev1.ConfigMap{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-airgap", Name: "airgap-vcluster-helm-values"}, values))
+		assert.Equal(t, "vcluster-k8s", values.Data["chart-name"])
+		assert.Equal(t, "0.16.4", values.Data["chart-version"])
+		assert.Contains(t, values.Data["helm-values"], "tag: 0.16.4")
+		assert.Contains(t, values.Data["helm-values"], "replicas: 3")
+		assert.Contains(t, values.Data["helm-values"], "--sync-all-nodes")
+
+		// The bundled chart cannot provide another distro
+		bundled := config.Default()
+		bundled.Chart.Source = config.VClusterChartBundled
+		r.Config = bundled
+		_, _ = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "airgap"}})
+		current := &platformv1alpha1.Tenant{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "airgap"}, current))
+		assert.Contains(t, current.Status.LastError, "spec.vcluster.chartVersion 0.16.4 is not available")
+	})
+
+	t.Run("missing credentials Secret", func(t *testing.T) {
+		cl := fake.NewClientBuilder().
+			WithScheme(s).
+			WithObjects(newTenant()).
+			WithStatusSubresource(&platformv1alpha1.Tenant{}).
+			Build()
+		r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard(), Config: operatorConfig}
+		_, _ = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "airgap"}})
+
+		current := &platformv1alpha1.Tenant{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "airgap"}, current))
+		assert.Contains(t, current.Status.LastError, "chart-credentials")
+		err := cl.Get(ctx, types.NamespacedName{Namespace: "tenant-airgap", Name: "airgap-vcluster-helm-values"}, &corev1.ConfigMap{})
+		assert.True(t, apierrors.IsNotFound(err), "got %v", err)
+	})
+}
+
+// TestVClusterChartConfigValidate verifies the startup checks of the chart source.
+func TestVClusterChartConfigValidate(t *testing.T) {
+	bundled := filepath.Join(t.TempDir(), "vcluster.tgz")
+	require.NoError(t, os.WriteFile(bundled, []byte("chart"), 0o600))
+
+	tests := []struct {
+		name    string
+		mutate  func(*config.VClusterChartConfig)
+		wantErr bool
+	}{
+		{name: "default repository", mutate: func(*config.VClusterChartConfig) {}},
+		{name: "internal repository", mutate: func(c *config.VClusterChartConfig) { c.Repository = "http://charts.internal" }},
+		{name: "repository without scheme", mutate: func(c *config.VClusterChartConfig) { c.Repository = "charts.internal" }, wantErr: true},
+		{name: "OCI registry", mutate: func(c *config.VClusterChartConfig) {
+			c.Source, c.Repository = config.VClusterChartOCI, "oci://registry.internal/charts"
+		}},
+		{name: "OCI with http URL", mutate: func(c *config.VClusterChartConfig) { c.Source = config.VClusterChartOCI }, wantErr: true},
+		{name: "bundled chart", mutate: func(c *config.VClusterChartConfig) {
+			c.Source, c.BundledPath = config.VClusterChartBundled, bundled
+		}},
+		{name: "missing bundled chart", mutate: func(c *config.VClusterChartConfig) {
+			c.Source, c.BundledPath = config.VClusterChartBundled, bundled+".missing"
+		}, wantErr: true},
+		{name: "missing version", mutate: func(c *config.VClusterChartConfig) { c.Version = "" }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chart := config.Default().Chart
+			tt.mutate(&chart)
+			if tt.wantErr {
+				assert.Error(t, chart.Validate())
+			} else {
+				assert.NoError(t, chart.Validate())
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestVClusterExposure verifies that spec.exposure creates an Ingress or Service for the
+// vCluster API server, admits its traffic, publishes the external URL in status, and
+// removes the objects of the previous exposure type when the type changes.
+func TestVClusterExposure(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.GoldTier,
+			Owner: "owner@example.com",
+			Exposure: &platformv1alpha1.ExposureConfig{
+				Type:             platformv1alpha1.ExposureIngress,
+				Hostname:         "edge.k8s.example.com",
+				IngressClassName: "nginx",
+			},
+		},
+		// Skip the vCluster readiness check
+		Status: platformv1alpha1.TenantStatus{
+			State: platformv1alpha1.StateProvisioning,
+			Conditions: []metav1.Condition{{
+				Type:   platformv1alpha1.ConditionVClusterDeployed,
+				Status: metav1.ConditionTrue,
+				Reason: "Completed",
+			}},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}, &corev1.Service{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "edge"}}
+	external := types.NamespacedName{Namespace: "tenant-edge", Name: "edge-vcluster-external"}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	ingress := &netv1.Ingress{}
+	require.NoError(t, cl.Get(ctx, external, ingress))
+	assert.Equal(t, "edge.k8s.example.com", ingress.Annotations[controller.ExternalDNSHostnameAnnotation])
+	assert.Equal(t, "true", ingress.Annotations["nginx.ingress.kubernetes.io/ssl-passthrough"])
+	require.NotNil(t, ingress.Spec.IngressClassName)
+	assert.Equal(t, "nginx", *ingress.Spec.IngressClassName)
+	require.Len(t, ingress.Spec.Rules, 1)
+	assert.Equal(t, "edge.k8s.example.com", ingress.Spec.Rules[0].Host)
+	assert.Equal(t, "edge-vcluster", ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+
+	policy := &netv1.NetworkPolicy{}
+	require.NoError(t, cl.Get(ctx, external, policy))
+	require.Len(t, policy.Spec.Ingress, 1)
+	assert.Equal(t, int32(8443), policy.Spec.Ingress[0].Ports[0].Port.IntVal)
+
+	values := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-edge", Name: "edge-vcluster-helm-values"}, values))
+	assert.Contains(t, values.Data["helm-values"], "--tls-san=edge.k8s.example.com")
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, "https://edge.k8s.example.com", current.Status.APIEndpoint)
+
+	// Switch to a LoadBalancer without a hostname: the endpoint follows the LB address
+	current.Spec.Exposure = &platformv1alpha1.ExposureConfig{Type: platformv1alpha1.ExposureLoadBalancer}
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, external, &netv1.Ingress{})), "stale Ingress should be deleted")
+	service := &corev1.Service{}
+	require.NoError(t, cl.Get(ctx, external, service))
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, service.Spec.Type)
+	assert.Equal(t, "edge-vcluster", service.Spec.Selector["release"])
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, "https://edge-vcluster.tenant-edge.svc.cluster.local", current.Status.APIEndpoint,
+		"endpoint stays internal until the load balancer has an address")
+
+	service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "203.0.113.10"}}
+	require.NoError(t, cl.Status().Update(ctx, service))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Equal(t, "https://203.0.113.10", current.Status.APIEndpoint)
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-edge", Name: "edge-vcluster-helm-values"}, values))
+	assert.Contains(t, values.Data["helm-values"], "--tls-san=203.0.113.10")
+}
+
+// TestVClusterExposureCertificate verifies that spec.exposure.tls requests a cert-manager
+// Certificate for the hostname, waits for it to be issued, then serves it from the
+// vCluster API server and rewrites the kubeconfig to trust its issuer.
+func TestVClusterExposureCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.GoldTier,
+			Owner: "owner@example.com",
+			Exposure: &platformv1alpha1.ExposureConfig{
+				Type:     platformv1alpha1.ExposureIngress,
+				Hostname: "edge.k8s.example.com",
+				TLS: &platformv1alpha1.ExposureTLS{
+					IssuerRef: platformv1alpha1.CertificateIssuerRef{Name: "letsencrypt"},
+				},
+			},
+		},
+		// Skip the vCluster readiness check
+		Status: platformv1alpha1.TenantStatus{
+			State: platformv1alpha1.StateProvisioning,
+			Conditions: []metav1.Condition{{
+				Type:   platformv1alpha1.ConditionVClusterDeployed,
+				Status: metav1.ConditionTrue,
+				Reason: "Completed",
+			}},
+		},
+	}
+	vClusterKubeconfig := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vc-edge-vcluster", Namespace: "tenant-edge"},
+		Data: map[string][]byte{"config": []byte(`apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: dmNsdXN0ZXItY2E=
+    server: https://edge.k8s.example.com
+  name: my-vcluster
+contexts:
+- context:
+    cluster: my-vcluster
+    user: my-vcluster
+  name: my-vcluster
+current-context: my-vcluster
+users:
+- name: my-vcluster
+  user:
+    token: admin-token
+`)},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant, vClusterKubeconfig).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "edge"}}
+	certKey := types.NamespacedName{Namespace: "tenant-edge", Name: "edge-vcluster-external-tls"}
+	valuesKey := types.NamespacedName{Namespace: "tenant-edge", Name: "edge-vcluster-helm-values"}
+
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter, "should check again for the certificate")
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"})
+	require.NoError(t, cl.Get(ctx, certKey, certificate))
+	dnsNames, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "dnsNames")
+	assert.Equal(t, []string{"edge.k8s.example.com"}, dnsNames)
+	issuerKind, _, _ := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "kind")
+	assert.Equal(t, "ClusterIssuer", issuerKind)
+	secretName, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+	assert.Equal(t, certKey.Name, secretName)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.True(t, apimeta.IsStatusConditionFalse(current.Status.Conditions, platformv1alpha1.ConditionAPICertificateReady))
+	values := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(ctx, valuesKey, values))
+	assert.Contains(t, values.Data["helm-values"], "--tls-san=edge.k8s.example.com")
+	assert.NotContains(t, values.Data["helm-values"], "tls-sni-cert-key", "the API server cannot start before the certificate exists")
+
+	// cert-manager issues the certificate
+	require.NoError(t, cl.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: certKey.Name, Namespace: certKey.Namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       []byte("cert"),
+			corev1.TLSPrivateKeyKey: []byte("key"),
+			"ca.crt":                []byte("issuer-ca"),
+		},
+	}))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionAPICertificateReady))
+	require.NoError(t, cl.Get(ctx, valuesKey, values))
+	assert.Contains(t, values.Data["helm-values"],
+		"--kube-apiserver-arg=tls-sni-cert-key=/etc/vcluster/external-tls/tls.crt,/etc/vcluster/external-tls/tls.key:edge.k8s.example.com")
+	assert.Contains(t, values.Data["helm-values"], "secretName: edge-vcluster-external-tls")
+
+	kubeconfigSecret := &corev1.Secret{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-edge", Name: "edge-kubeconfig"}, kubeconfigSecret))
+	kubeconfig, err := clientcmd.Load(kubeconfigSecret.Data["kubeconfig"])
+	require.NoError(t, err)
+	require.Contains(t, kubeconfig.Clusters, "my-vcluster")
+	assert.Equal(t, "https://edge.k8s.example.com", kubeconfig.Clusters["my-vcluster"].Server)
+	assert.Equal(t, []byte("issuer-ca"), kubeconfig.Clusters["my-vcluster"].CertificateAuthorityData)
+	assert.Equal(t, "admin-token", kubeconfig.AuthInfos["my-vcluster"].Token)
+
+	// Dropping the TLS settings deletes the Certificate
+	current.Spec.Exposure.TLS = nil
+	require.NoError(t, cl.Update(ctx, current))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, apierrors.IsNotFound(cl.Get(ctx, certKey, certificate)), "stale Certificate should be deleted")
+	require.NoError(t, cl.Get(ctx, req.NamespacedName, current))
+	assert.Nil(t, apimeta.FindStatusCondition(current.Status.Conditions, platformv1alpha1.ConditionAPICertificateReady))
+}
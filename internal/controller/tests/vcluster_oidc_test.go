@@ -0,0 +1,107 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// TestVClusterOIDCKubeconfig verifies that a Gold tenant with spec.vcluster.oidc gets an
+// API server configured for OIDC and a kubeconfig that logs in with kubelogin instead
+// of client certificates.
+func TestVClusterOIDCKubeconfig(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	tenant := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "sso", Finalizers: []string{controller.TenantFinalizerName}},
+		Spec: platformv1alpha1.TenantSpec{
+			Tier:  platformv1alpha1.GoldTier,
+			Owner: "owner@example.com",
+			VCluster: &platformv1alpha1.VClusterConfig{
+				OIDC: &platformv1alpha1.VClusterOIDC{IssuerURL: "https://login.example.com", ClientID: "tenant-master"},
+			},
+		},
+		// Skip the vCluster readiness check
+		Status: platformv1alpha1.TenantStatus{
+			State: platformv1alpha1.StateProvisioning,
+			Conditions: []metav1.Condition{{
+				Type:   platformv1alpha1.ConditionVClusterDeployed,
+				Status: metav1.ConditionTrue,
+				Reason: "Completed",
+			}},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(tenant).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "sso"}})
+	require.NoError(t, err)
+
+	current := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "sso"}, current))
+	require.True(t, apimeta.IsStatusConditionTrue(current.Status.Conditions, platformv1alpha1.ConditionKubeconfigAvailable), current.Status.LastError)
+
+	values := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-sso", Name: "sso-vcluster-helm-values"}, values))
+	assert.Contains(t, values.Data["helm-values"], `"--kube-apiserver-arg=oidc-issuer-url=https://login.example.com"`)
+	assert.Contains(t, values.Data["helm-values"], `name: "owner@example.com"`)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "tenant-sso", Name: current.Status.AdminKubeconfigSecret}, secret))
+	require.Contains(t, secret.Data, "kubeconfig")
+	oidcConfig, err := clientcmd.Load(secret.Data[controller.OIDCKubeconfigKey])
+	require.NoError(t, err)
+
+	user := oidcConfig.AuthInfos[oidcConfig.Contexts[oidcConfig.CurrentContext].AuthInfo]
+	require.NotNil(t, user.Exec)
+	assert.Empty(t, user.ClientCertificateData)
+	assert.Equal(t, "kubectl", user.Exec.Command)
+	assert.Contains(t, user.Exec.Args, "--oidc-issuer-url=https://login.example.com")
+	assert.Contains(t, user.Exec.Args, "--oidc-client-id=tenant-master")
+	assert.Equal(t, "https://sso-vcluster.tenant-sso.svc.cluster.local:6443",
+		oidcConfig.Clusters[oidcConfig.Contexts[oidcConfig.CurrentContext].Cluster].Server)
+}
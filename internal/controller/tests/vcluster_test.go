@@ -0,0 +1,139 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestVClusterStorageValidation verifies that vCluster volumes must fit in the tenant
+// storage quota, that audit logging names exactly one sink, that raw values are a YAML
+// map, and that vcluster settings are rejected outside the Gold tier.
+func TestVClusterStorageValidation(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	replicas := int32(3)
+	disabled := false
+
+	tests := []struct {
+		name    string
+		tier    platformv1alpha1.TenantTier
+		storage string
+		vc      *platformv1alpha1.VClusterConfig
+		wantErr bool
+	}{
+		{name: "default volume fits", tier: platformv1alpha1.GoldTier, storage: "50Gi"},
+		{name: "default volume too large", tier: platformv1alpha1.GoldTier, storage: "5Gi", wantErr: true},
+		{
+			name: "HA replicas exceed quota", tier: platformv1alpha1.GoldTier, storage: "50Gi", wantErr: true,
+			vc: &platformv1alpha1.VClusterConfig{
+				Replicas:    &replicas,
+				Persistence: &platformv1alpha1.VClusterPersistence{Size: "20Gi"},
+			},
+		},
+		{
+			name: "persistence disabled", tier: platformv1alpha1.GoldTier, storage: "1Gi",
+			vc: &platformv1alpha1.VClusterConfig{
+				Replicas:    &replicas,
+				Persistence: &platformv1alpha1.VClusterPersistence{Enabled: &disabled},
+			},
+		},
+		{
+			name: "audit to Loki", tier: platformv1alpha1.GoldTier,
+			vc: &platformv1alpha1.VClusterConfig{Audit: &platformv1alpha1.VClusterAuditConfig{
+				Sink: platformv1alpha1.VClusterAuditSink{LokiURL: "https://loki.example.com/loki/api/v1/push"},
+			}},
+		},
+		{
+			name: "audit without sink", tier: platformv1alpha1.GoldTier, wantErr: true,
+			vc: &platformv1alpha1.VClusterConfig{Audit: &platformv1alpha1.VClusterAuditConfig{Level: "Request"}},
+		},
+		{
+			name: "audit with two sinks", tier: platformv1alpha1.GoldTier, wantErr: true,
+			vc: &platformv1alpha1.VClusterConfig{Audit: &platformv1alpha1.VClusterAuditConfig{
+				Sink: platformv1alpha1.VClusterAuditSink{
+					ObjectStoragePath: "s3://audit-logs/vclusters",
+					LokiURL:           "https://loki.example.com/loki/api/v1/push",
+				},
+			}},
+		},
+		{
+			name: "raw values", tier: platformv1alpha1.GoldTier,
+			vc: &platformv1alpha1.VClusterConfig{Values: "syncer:\n  extraArgs:\n  - --sync-all-nodes\n"},
+		},
+		{
+			name: "raw values not a map", tier: platformv1alpha1.GoldTier, wantErr: true,
+			vc: &platformv1alpha1.VClusterConfig{Values: "- one\n- two\n"},
+		},
+		{
+			name: "not Gold tier", tier: platformv1alpha1.SilverTier, wantErr: true,
+			vc: &platformv1alpha1.VClusterConfig{Replicas: &replicas},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := &platformv1alpha1.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "vc"},
+				Spec: platformv1alpha1.TenantSpec{
+					Tier:      tt.tier,
+					Owner:     "admin@example.com",
+					Resources: platformv1alpha1.ResourceRequirements{Storage: tt.storage},
+					VCluster:  tt.vc,
+				},
+			}
+			_, err := w.ValidateCreate(ctx, tenant)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestVClusterDistroImmutable verifies a Gold tier tenant cannot switch its vCluster to
+// another distro, with an unset distro counting as k3s.
+func TestVClusterDistroImmutable(t *testing.T) {
+	ctx := context.Background()
+	w := &validating.TenantValidatingWebhook{}
+	newTenant := func(distro platformv1alpha1.VClusterDistro) *platformv1alpha1.Tenant {
+		tenant := &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc"},
+			Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.GoldTier, Owner: "admin@example.com"},
+		}
+		if distro != "" {
+			tenant.Spec.VCluster = &platformv1alpha1.VClusterConfig{Distro: distro}
+		}
+		return tenant
+	}
+
+	_, err := w.ValidateUpdate(ctx, newTenant(""), newTenant(platformv1alpha1.VClusterDistroK3s))
+	assert.NoError(t, err)
+
+	_, err = w.ValidateUpdate(ctx, newTenant(""), newTenant(platformv1alpha1.VClusterDistroK8s))
+	assert.True(t, apierrors.IsForbidden(err), "got %v", err)
+	assert.ErrorContains(t, err, "spec.vcluster.distro cannot change from k3s to k8s")
+}
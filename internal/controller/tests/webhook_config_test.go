@@ -0,0 +1,131 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+)
+
+// loadWebhookNamespaceSelectors returns the namespaceSelector of every webhook in the
+// webhook manifest, keyed by webhook name.
+func loadWebhookNamespaceSelectors(t *testing.T) map[string]*metav1.LabelSelector {
+	t.Helper()
+	raw, err := os.ReadFile("../../../config/webhook/webhook.yaml")
+	require.NoError(t, err)
+
+	selectors := map[string]*metav1.LabelSelector{}
+	for _, doc := range strings.Split(string(raw), "\n---\n") {
+		var meta metav1.TypeMeta
+		require.NoError(t, yaml.Unmarshal([]byte(doc), &meta))
+		switch meta.Kind {
+		case "MutatingWebhookConfiguration":
+			var cfg admissionregistrationv1.MutatingWebhookConfiguration
+			require.NoError(t, yaml.Unmarshal([]byte(doc), &cfg))
+			for _, wh := range cfg.Webhooks {
+				selectors[wh.Name] = wh.NamespaceSelector
+			}
+		case "ValidatingWebhookConfiguration":
+			var cfg admissionregistrationv1.ValidatingWebhookConfiguration
+			require.NoError(t, yaml.Unmarshal([]byte(doc), &cfg))
+			for _, wh := range cfg.Webhooks {
+				selectors[wh.Name] = wh.NamespaceSelector
+			}
+		}
+	}
+	return selectors
+}
+
+// TestWebhookNamespaceSelectors verifies that the webhooks scoped to tenant namespaces
+// select the namespaces the operator creates, including the shared Bronze namespace,
+// and leave other namespaces alone.
+func TestWebhookNamespaceSelectors(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, netv1.AddToScheme(s))
+	require.NoError(t, rbacv1.AddToScheme(s))
+	require.NoError(t, schedulingv1.AddToScheme(s))
+
+	newTenant := func(name string, tier platformv1alpha1.TenantTier) *platformv1alpha1.Tenant {
+		return &platformv1alpha1.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Finalizers: []string{controller.TenantFinalizerName}},
+			Spec: platformv1alpha1.TenantSpec{
+				Tier:      tier,
+				Owner:     "owner@example.com",
+				Resources: platformv1alpha1.ResourceRequirements{CPU: "500m", Memory: "512Mi"},
+			},
+		}
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(newTenant("trial", platformv1alpha1.BronzeTier), newTenant("shop", platformv1alpha1.SilverTier)).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+	r := &controller.TenantReconciler{Client: cl, Scheme: s, Log: logr.Discard()}
+	for _, name := range []string{"trial", "shop"} {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+		require.NoError(t, err)
+	}
+
+	bronze := &corev1.Namespace{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: controller.BronzeNamespace}, bronze))
+	silver := &corev1.Namespace{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "tenant-shop"}, silver))
+
+	selectors := loadWebhookNamespaceSelectors(t)
+	for _, name := range []string{"mpod.platform.io", "vpod.platform.io", "vservice.platform.io",
+		"vrole.platform.io", "vrolebinding.platform.io", "vresourcequota.platform.io", "vbronzeownership.platform.io"} {
+		require.Contains(t, selectors, name)
+		require.NotNil(t, selectors[name], name)
+	}
+	for name, sel := range selectors {
+		if sel == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(sel)
+		require.NoError(t, err, name)
+		assert.True(t, selector.Matches(labels.Set(bronze.Labels)), "%s must select %s", name, controller.BronzeNamespace)
+		// Only the shared Bronze namespace has several tenants to keep apart
+		assert.Equal(t, name != "vbronzeownership.platform.io", selector.Matches(labels.Set(silver.Labels)),
+			"%s selecting tenant-shop", name)
+		assert.False(t, selector.Matches(labels.Set{corev1.LabelMetadataName: "kube-system"}), "%s must not select kube-system", name)
+	}
+}
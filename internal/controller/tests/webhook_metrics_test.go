@@ -0,0 +1,56 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/instrument"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/validating"
+)
+
+// TestWebhookMetrics verifies that admissions and denial reasons are counted.
+func TestWebhookMetrics(t *testing.T) {
+	ctx := context.Background()
+	v := instrument.Validator("tenant-metrics-test", &validating.TenantValidatingWebhook{})
+
+	valid := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "ok"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "admin@example.com"},
+	}
+	_, err := v.ValidateCreate(ctx, valid)
+	require.NoError(t, err)
+
+	invalid := &platformv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "bad"},
+		Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier, Owner: "not-an-email"},
+	}
+	_, err = v.ValidateCreate(ctx, invalid)
+	require.Error(t, err)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.WebhookAdmissionsCounter.WithLabelValues("tenant-metrics-test", "create", "true")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.WebhookAdmissionsCounter.WithLabelValues("tenant-metrics-test", "create", "false")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.WebhookDenialsCounter.WithLabelValues("tenant-metrics-test", "FieldValueInvalid", "spec.owner")))
+}
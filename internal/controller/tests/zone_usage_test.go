@@ -0,0 +1,120 @@
+package tests
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// TestZoneUsage verifies that tenant pods are counted per zone and node pool of their
+// nodes, in status and metrics, skipping unscheduled and finished pods.
+func TestZoneUsage(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, platformv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	node := func(name, zone, pool string) *corev1.Node {
+		labels := map[string]string{"cloud.google.com/gke-nodepool": pool}
+		if zone != "" {
+			labels[corev1.LabelTopologyZone] = zone
+		}
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	pod := func(name, tenant, nodeName, cpu, memory string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "tenant-" + tenant,
+				Labels: map[string]string{controller.TenantNameLabelKey: tenant}},
+			Spec: corev1.PodSpec{NodeName: nodeName, Containers: []corev1.Container{{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				}},
+			}}},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(
+			&platformv1alpha1.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+				Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier},
+			},
+			&platformv1alpha1.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "idle"},
+				Spec:       platformv1alpha1.TenantSpec{Tier: platformv1alpha1.SilverTier},
+			},
+			node("node-a1", "eu-west-1a", "general"),
+			node("node-a2", "eu-west-1a", "general"),
+			node("node-b1", "eu-west-1b", "premium"),
+			node("node-x", "", "general"),
+			pod("web-1", "acme", "node-a1", "500m", "512Mi", corev1.PodRunning),
+			pod("web-2", "acme", "node-a2", "250m", "512Mi", corev1.PodRunning),
+			pod("db-0", "acme", "node-b1", "2", "4Gi", corev1.PodRunning),
+			pod("job-1", "acme", "node-x", "100m", "128Mi", corev1.PodPending),
+			pod("done", "acme", "node-b1", "1", "1Gi", corev1.PodSucceeded),
+			pod("queued", "acme", "", "1", "1Gi", corev1.PodPending),
+		).
+		WithStatusSubresource(&platformv1alpha1.Tenant{}).
+		Build()
+
+	reporter := &controller.ZoneUsageReporter{Client: cl, Config: config.Default(), Log: logr.Discard()}
+	require.NoError(t, reporter.Report(ctx))
+
+	tenant := &platformv1alpha1.Tenant{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "acme"}, tenant))
+	assert.Equal(t, []platformv1alpha1.ZoneUsage{
+		{Zone: "eu-west-1a", NodePool: "general", Pods: 2, CPU: "750m", Memory: "1Gi"},
+		{Zone: "eu-west-1b", NodePool: "premium", Pods: 1, CPU: "2", Memory: "4Gi"},
+		{Zone: "unknown", NodePool: "general", Pods: 1, CPU: "100m", Memory: "128Mi"},
+	}, tenant.Status.ZoneUsage)
+
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "idle"}, tenant))
+	assert.Empty(t, tenant.Status.ZoneUsage)
+
+	assert.Equal(t, 3, testutil.CollectAndCount(metrics.ZonePodsGauge))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.ZonePodsGauge.WithLabelValues("acme", "Silver", "eu-west-1a", "general")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.ZoneCPURequestsGauge.WithLabelValues("acme", "Silver", "eu-west-1b", "premium")))
+
+	// Pods that went away drop out of status and metrics
+	require.NoError(t, cl.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace("tenant-acme")))
+	require.NoError(t, reporter.Report(ctx))
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Name: "acme"}, tenant))
+	assert.Empty(t, tenant.Status.ZoneUsage)
+	assert.Equal(t, 0, testutil.CollectAndCount(metrics.ZonePodsGauge))
+}
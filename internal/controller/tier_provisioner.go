@@ -0,0 +1,149 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// TierProvisioner provisions the resources of one tenant tier. Reconcile hands a tenant to
+// the provisioner registered for its spec.tier once the shared lifecycle (pausing,
+// deletion, suspension) is handled, and takes care of the Ready condition and requeueing.
+type TierProvisioner interface {
+	// Provision creates or updates the tier's resources for tenant. It records a
+	// condition for each kind of resource it manages and completes the steps it returns
+	// from Steps, then sets status.state to Ready, or leaves it Provisioning while it
+	// waits for something to start. The status is persisted by the caller.
+	Provision(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, log logr.Logger) error
+
+	// Steps returns the tier's provisioning steps in order, shown in status.progress.
+	Steps() []ProvisioningStep
+}
+
+// TierDeprovisioner is implemented by tier provisioners that create resources which must
+// be released before the tenant namespaces are deleted, such as infrastructure outside
+// the cluster.
+type TierDeprovisioner interface {
+	// Deprovision starts releasing the tier's resources for a deleted tenant and reports
+	// whether they are gone. It is called again until they are.
+	Deprovision(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, log logr.Logger) (bool, error)
+}
+
+// ProvisioningStep is one step of a tier's provisioning, complete when its condition is
+// True for the tenant's current generation.
+type ProvisioningStep struct {
+	// Name is shown as the current step in status.progress, e.g. "Namespace".
+	Name string
+
+	// Condition is the status condition type that marks the step completed.
+	Condition string
+}
+
+// tierProvisioners holds the provisioner of every supported tier.
+var tierProvisioners = map[platformv1alpha1.TenantTier]TierProvisioner{}
+
+// RegisterTierProvisioner makes tenants of tier provisionable by p, replacing any
+// provisioner registered for the tier before. It is meant to be called from init
+// functions, before the manager starts.
+func RegisterTierProvisioner(tier platformv1alpha1.TenantTier, p TierProvisioner) {
+	tierProvisioners[tier] = p
+}
+
+func init() {
+	RegisterTierProvisioner(platformv1alpha1.BronzeTier, bronzeProvisioner{})
+	RegisterTierProvisioner(platformv1alpha1.SilverTier, silverProvisioner{})
+	RegisterTierProvisioner(platformv1alpha1.GoldTier, goldProvisioner{})
+	RegisterTierProvisioner(platformv1alpha1.PlatinumTier, platinumProvisioner{})
+}
+
+// provisionTier runs the provisioner of the tenant's tier and records its duration and
+// errors per tier.
+func (r *TenantReconciler) provisionTier(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	tier := string(tenant.Spec.Tier)
+	p, ok := tierProvisioners[tenant.Spec.Tier]
+	if !ok {
+		return newValidationError(fmt.Errorf("unknown tier: %s", tenant.Spec.Tier))
+	}
+
+	start := time.Now()
+	err := p.Provision(ctx, r, tenant, log)
+	metrics.ReconciliationDurationHistogram.WithLabelValues(tier, "provision").Observe(time.Since(start).Seconds())
+	if err != nil {
+		metrics.ErrorRateByTierCounter.WithLabelValues(tier, string(classifyError(err))).Inc()
+	}
+	return err
+}
+
+// deprovisionTier runs the deprovisioner of the tenant's tier, if it has one, and reports
+// whether the tier's resources are released.
+func (r *TenantReconciler) deprovisionTier(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) (bool, error) {
+	d, ok := tierProvisioners[tenant.Spec.Tier].(TierDeprovisioner)
+	if !ok {
+		return true, nil
+	}
+	return d.Deprovision(ctx, r, tenant, log)
+}
+
+// baseProvisioningSteps are the steps every built-in tier starts with.
+var baseProvisioningSteps = []ProvisioningStep{
+	{Name: "Namespace", Condition: platformv1alpha1.ConditionNamespaceReady},
+	{Name: "ResourceQuota", Condition: platformv1alpha1.ConditionQuotaReady},
+	{Name: "RBAC", Condition: platformv1alpha1.ConditionRBACReady},
+}
+
+// bronzeProvisioner provisions Bronze tenants in the shared namespace.
+type bronzeProvisioner struct{}
+
+func (bronzeProvisioner) Provision(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	return r.reconcileBronzeTier(ctx, tenant, log)
+}
+
+func (bronzeProvisioner) Steps() []ProvisioningStep {
+	return baseProvisioningSteps
+}
+
+// silverProvisioner provisions Silver tenants in a namespace of their own.
+type silverProvisioner struct{}
+
+func (silverProvisioner) Provision(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	return r.reconcileSilverTier(ctx, tenant, log)
+}
+
+func (silverProvisioner) Steps() []ProvisioningStep {
+	return append(append([]ProvisioningStep{}, baseProvisioningSteps...),
+		ProvisioningStep{Name: "NetworkPolicy", Condition: platformv1alpha1.ConditionNetworkPolicyReady})
+}
+
+// goldProvisioner provisions Gold tenants: the Silver tier resources plus a vCluster.
+type goldProvisioner struct{}
+
+func (goldProvisioner) Provision(ctx context.Context, r *TenantReconciler, tenant *platformv1alpha1.Tenant, log logr.Logger) error {
+	return r.reconcileGoldTier(ctx, tenant, log)
+}
+
+func (goldProvisioner) Steps() []ProvisioningStep {
+	return append(silverProvisioner{}.Steps(),
+		ProvisioningStep{Name: "VCluster", Condition: platformv1alpha1.ConditionVClusterDeployed},
+		ProvisioningStep{Name: "Kubeconfig", Condition: platformv1alpha1.ConditionKubeconfigAvailable})
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+const (
+	// UsageReportLabelKey marks the usage report ConfigMaps in the operator namespace;
+	// its value is the granularity of the report.
+	UsageReportLabelKey = "tenant.platform.io/usage-report"
+
+	// UsageReportPeriodLabelKey records the day or month a usage report covers.
+	UsageReportPeriodLabelKey = "tenant.platform.io/usage-report-period"
+
+	// UsageReportJSONKey and UsageReportCSVKey hold the two renderings of a report.
+	UsageReportJSONKey = "report.json"
+	UsageReportCSVKey  = "report.csv"
+)
+
+// Usage report granularities.
+const (
+	UsageReportDaily   = "daily"
+	UsageReportMonthly = "monthly"
+)
+
+// usageReportCSVHeader is the header row of the CSV rendering.
+var usageReportCSVHeader = []string{"tenant", "tier", "granularity", "period", "cpu_core_hours", "memory_gib_hours", "storage_gib_hours", "cost"}
+
+// UsageReport is the metered usage of a tenant in a closed day or month, exported for
+// chargeback.
+type UsageReport struct {
+	Tenant          string    `json:"tenant"`
+	Tier            string    `json:"tier"`
+	Granularity     string    `json:"granularity"`
+	Period          string    `json:"period"`
+	CPUCoreHours    string    `json:"cpuCoreHours"`
+	MemoryGiBHours  string    `json:"memoryGiBHours"`
+	StorageGiBHours string    `json:"storageGiBHours"`
+	Cost            string    `json:"cost"`
+	GeneratedAt     time.Time `json:"generatedAt"`
+}
+
+// UsageReportName returns the name of the ConfigMap holding a tenant's report for a
+// period, e.g. "usage-report-acme-2026-10".
+func UsageReportName(tenant, period string) string {
+	return fmt.Sprintf("usage-report-%s-%s", tenant, period)
+}
+
+// newUsageReport builds the report of a closed day or month of a tenant.
+func newUsageReport(tenant *platformv1alpha1.Tenant, totals platformv1alpha1.BillingTotals, now time.Time) UsageReport {
+	granularity := UsageReportDaily
+	if len(totals.Period) == len(billingPeriodLayout) {
+		granularity = UsageReportMonthly
+	}
+	decimal := func(v string) string {
+		if v == "" {
+			return "0"
+		}
+		return v
+	}
+	return UsageReport{
+		Tenant:          tenant.Name,
+		Tier:            string(tenant.Spec.Tier),
+		Granularity:     granularity,
+		Period:          totals.Period,
+		CPUCoreHours:    decimal(totals.CPUCoreHours),
+		MemoryGiBHours:  decimal(totals.MemoryGiBHours),
+		StorageGiBHours: decimal(totals.StorageGiBHours),
+		Cost:            decimal(totals.Cost),
+		GeneratedAt:     now.UTC(),
+	}
+}
+
+// renderUsageReport returns the JSON and CSV renderings of a report.
+func renderUsageReport(report UsageReport) (jsonData, csvData []byte, err error) {
+	jsonData, err = json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode usage report: %w", err)
+	}
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	_ = w.Write(usageReportCSVHeader)
+	_ = w.Write([]string{report.Tenant, report.Tier, report.Granularity, report.Period,
+		report.CPUCoreHours, report.MemoryGiBHours, report.StorageGiBHours, report.Cost})
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, nil, fmt.Errorf("failed to render usage report CSV: %w", err)
+	}
+	return jsonData, b.Bytes(), nil
+}
+
+// exportUsageReports stores the reports of the periods a tenant just closed in the
+// operator namespace, where the BFF serves them, and in the archive, if any, as
+// "usage-reports/<tenant>/<period>.json" and ".csv". Reports outlive their tenant.
+func (m *Meter) exportUsageReports(ctx context.Context, tenant *platformv1alpha1.Tenant, closed []platformv1alpha1.BillingTotals, now time.Time) error {
+	for _, totals := range closed {
+		report := newUsageReport(tenant, totals, now)
+		jsonData, csvData, err := renderUsageReport(report)
+		if err != nil {
+			return err
+		}
+
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      UsageReportName(tenant.Name, report.Period),
+			Namespace: OperatorNamespace,
+		}}
+		if _, err := controllerutil.CreateOrUpdate(ctx, m.Client, cm, func() error {
+			cm.Labels = map[string]string{
+				TenantNameLabelKey:        tenant.Name,
+				ManagedByLabelKey:         ManagedByValue,
+				UsageReportLabelKey:       report.Granularity,
+				UsageReportPeriodLabelKey: report.Period,
+			}
+			cm.Data = map[string]string{
+				UsageReportJSONKey: string(jsonData),
+				UsageReportCSVKey:  string(csvData),
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to store usage report %s: %w", cm.Name, err)
+		}
+
+		if m.Archive != nil {
+			key := fmt.Sprintf("usage-reports/%s/%s", tenant.Name, report.Period)
+			if err := m.Archive.Put(ctx, key+".json", jsonData); err != nil {
+				return fmt.Errorf("failed to archive usage report %s: %w", cm.Name, err)
+			}
+			if err := m.Archive.Put(ctx, key+".csv", csvData); err != nil {
+				return fmt.Errorf("failed to archive usage report %s: %w", cm.Name, err)
+			}
+		}
+		m.Log.Info("exported usage report", "tenant", tenant.Name, "period", report.Period, "cost", report.Cost)
+	}
+	return nil
+}
+
+// pruneUsageReports deletes the daily usage report ConfigMaps older than the report
+// retention. Monthly reports and archived reports are kept.
+func (m *Meter) pruneUsageReports(ctx context.Context, now time.Time) error {
+	retention := m.Config.Metering.ReportRetention
+	if retention <= 0 {
+		return nil
+	}
+	list := &corev1.ConfigMapList{}
+	if err := m.Client.List(ctx, list, client.InNamespace(OperatorNamespace),
+		client.MatchingLabels{UsageReportLabelKey: UsageReportDaily}); err != nil {
+		return fmt.Errorf("failed to list usage reports: %w", err)
+	}
+	for i := range list.Items {
+		cm := &list.Items[i]
+		day, err := time.Parse(billingDayLayout, cm.Labels[UsageReportPeriodLabelKey])
+		if err != nil || now.Sub(day) <= retention {
+			continue
+		}
+		if err := m.Client.Delete(ctx, cm); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete usage report %s: %w", cm.Name, err)
+		}
+	}
+	return nil
+}
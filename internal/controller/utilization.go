@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// utilizationInterval is how often the UtilizationCollector samples metrics-server.
+const utilizationInterval = time.Minute
+
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list
+
+// podMetricsListGVK is the metrics-server list kind, read as unstructured like the other
+// optional APIs. Unstructured reads bypass the manager cache, which metrics.k8s.io
+// could not fill since it cannot be watched.
+var podMetricsListGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetricsList"}
+
+// UtilizationCollector samples the CPU and memory the pods of every tenant are using
+// from metrics-server and publishes them in status.resourceUsage.utilization and the
+// tenant_resource_utilization metric. It runs as a manager Runnable, so only the
+// elected leader collects. Tenants without status.resourceUsage, such as Platinum
+// tenants and tenants placed on a remote cluster, are skipped.
+type UtilizationCollector struct {
+	Client client.Client
+	Log    logr.Logger
+}
+
+// Start collects tenant utilization until ctx is cancelled.
+func (u *UtilizationCollector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(utilizationInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.Collect(ctx); err != nil {
+			u.Log.Error(err, "failed to collect tenant utilization")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect samples the utilization of every tenant and records it where it changed.
+// Without metrics-server there is nothing to collect.
+func (u *UtilizationCollector) Collect(ctx context.Context) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := u.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	var series []metrics.ResourceUtilization
+	var errs []string
+	now := time.Now()
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		if !tenant.DeletionTimestamp.IsZero() || tenant.Spec.Placement != nil || tenant.Status.ResourceUsage == nil {
+			continue
+		}
+		cpu, memory, pods, err := u.readUtilization(ctx, tenant)
+		if apimeta.IsNoMatchError(err) {
+			u.Log.V(1).Info("metrics.k8s.io is not available; skipping tenant utilization")
+			metrics.SetResourceUtilization(nil)
+			return nil
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+			continue
+		}
+		series = append(series, metrics.ResourceUtilization{
+			Tenant:      tenant.Name,
+			Tier:        string(tenant.Spec.Tier),
+			CPUCores:    float64(cpu.MilliValue()) / 1000,
+			MemoryBytes: float64(memory.Value()),
+		})
+
+		utilization := &platformv1alpha1.ResourceUtilization{
+			CPU:    resource.NewMilliQuantity(cpu.MilliValue(), resource.DecimalSI).String(),
+			Memory: resource.NewQuantity(memory.Value(), resource.BinarySI).String(),
+			Pods:   pods,
+		}
+		if previous := tenant.Status.ResourceUsage.Utilization; previous != nil &&
+			previous.CPU == utilization.CPU && previous.Memory == utilization.Memory && previous.Pods == utilization.Pods {
+			continue
+		}
+		patch := client.MergeFrom(tenant.DeepCopy())
+		utilization.SampleTime = &metav1.Time{Time: now}
+		tenant.Status.ResourceUsage.Utilization = utilization
+		if err := u.Client.Status().Patch(ctx, tenant, patch); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+		}
+	}
+	metrics.SetResourceUtilization(series)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("utilization failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// readUtilization sums the container usage of the PodMetrics in the namespaces holding
+// the tenant's quotas, only counting the tenant's own pods in the shared Bronze namespace.
+func (u *UtilizationCollector) readUtilization(ctx context.Context, tenant *platformv1alpha1.Tenant) (cpu, memory resource.Quantity, pods int32, err error) {
+	for _, key := range tenantQuotaKeys(tenant) {
+		opts := []client.ListOption{client.InNamespace(key.Namespace)}
+		if tenant.Spec.Tier == platformv1alpha1.BronzeTier {
+			opts = append(opts, client.MatchingLabels{TenantNameLabelKey: tenant.Name})
+		}
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(podMetricsListGVK)
+		if err := u.Client.List(ctx, list, opts...); err != nil {
+			return cpu, memory, 0, err
+		}
+		for _, item := range list.Items {
+			containers, _, _ := unstructured.NestedSlice(item.Object, "containers")
+			for _, c := range containers {
+				container, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				usage, _, _ := unstructured.NestedStringMap(container, "usage")
+				if q, err := resource.ParseQuantity(usage["cpu"]); err == nil {
+					cpu.Add(q)
+				}
+				if q, err := resource.ParseQuantity(usage["memory"]); err == nil {
+					memory.Add(q)
+				}
+			}
+			pods++
+		}
+	}
+
+	return cpu, memory, pods, nil
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// Paths used by the audit policy, log, and shipper inside the vCluster pod.
+const (
+	vClusterAuditPolicyDir = "/etc/kubernetes/audit"
+	vClusterAuditLogDir    = "/var/log/kubernetes/audit"
+	vClusterAuditShipper   = "fluent/fluent-bit:2.2.0"
+)
+
+// ensureVClusterAudit creates the ConfigMap holding the audit policy and log shipper
+// configuration when spec.vcluster.audit is set, and removes it otherwise.
+func (r *TenantReconciler) ensureVClusterAudit(ctx context.Context, tenant *platformv1alpha1.Tenant, releaseName string, log logr.Logger) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-audit", releaseName),
+			Namespace: buildNamespaceName(tenant),
+			Labels: map[string]string{
+				TenantNameLabelKey: tenant.Name,
+				"app":              "vcluster",
+				ManagedByLabelKey:  ManagedByValue,
+			},
+		},
+	}
+
+	audit := vClusterAudit(tenant)
+	if audit == nil {
+		if err := r.Delete(ctx, cm); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete vCluster audit config: %w", err)
+		}
+		return nil
+	}
+
+	shipper, err := buildAuditShipperConfig(tenant, audit.Sink)
+	if err != nil {
+		return err
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		cm.Data = map[string]string{
+			"policy.yaml":     buildVClusterAuditPolicy(audit.Level),
+			"fluent-bit.conf": shipper,
+		}
+		return controllerutil.SetControllerReference(tenant, cm, r.Scheme)
+	})
+	if err != nil {
+		log.Error(err, "failed to create or update vCluster audit config")
+		return err
+	}
+
+	log.Info("ensured vCluster audit config", "configmap", cm.Name, "level", auditLevel(audit), "operation", result)
+	return nil
+}
+
+// vClusterAudit returns the tenant's audit settings, or nil when audit logging is off.
+func vClusterAudit(tenant *platformv1alpha1.Tenant) *platformv1alpha1.VClusterAuditConfig {
+	if tenant.Spec.VCluster == nil {
+		return nil
+	}
+	return tenant.Spec.VCluster.Audit
+}
+
+// auditLevel returns the configured audit level, defaulting to Metadata.
+func auditLevel(audit *platformv1alpha1.VClusterAuditConfig) string {
+	if audit.Level == "" {
+		return "Metadata"
+	}
+	return audit.Level
+}
+
+// buildVClusterAuditPolicy renders an audit.k8s.io Policy logging requests at level.
+// Read-only health and discovery noise is dropped, and objects that may carry
+// credentials are only ever logged at Metadata.
+func buildVClusterAuditPolicy(level string) string {
+	if level == "" {
+		level = "Metadata"
+	}
+	return fmt.Sprintf(`apiVersion: audit.k8s.io/v1
+kind: Policy
+omitStages:
+- RequestReceived
+rules:
+- level: None
+  nonResourceURLs:
+  - /healthz*
+  - /livez*
+  - /readyz*
+  - /version
+  - /openapi*
+- level: None
+  users:
+  - system:kube-proxy
+  verbs:
+  - watch
+- level: Metadata
+  resources:
+  - group: ""
+    resources:
+    - secrets
+    - configmaps
+    - serviceaccounts/token
+  - group: authentication.k8s.io
+    resources:
+    - tokenreviews
+- level: %s
+`, level)
+}
+
+// buildAuditShipperConfig renders the Fluent Bit configuration that tails the audit log
+// and ships it to the tenant's sink.
+func buildAuditShipperConfig(tenant *platformv1alpha1.Tenant, sink platformv1alpha1.VClusterAuditSink) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, `[SERVICE]
+    Flush        5
+    Log_Level    info
+
+[INPUT]
+    Name         tail
+    Path         %s/audit.log
+    Tag          audit
+    DB           %s/fluent-bit.db
+
+`, vClusterAuditLogDir, vClusterAuditLogDir)
+
+	switch {
+	case sink.ObjectStoragePath != "":
+		u, err := url.Parse(sink.ObjectStoragePath)
+		if err != nil || u.Scheme != "s3" || u.Host == "" {
+			return "", fmt.Errorf("invalid audit objectStoragePath %q", sink.ObjectStoragePath)
+		}
+		prefix := strings.Trim(u.Path, "/")
+		if prefix != "" {
+			prefix += "/"
+		}
+		fmt.Fprintf(&b, `[OUTPUT]
+    Name             s3
+    Match            audit
+    bucket           %s
+    s3_key_format    /%s%s/%%Y/%%m/%%d/%%H%%M%%S-$UUID.log
+    total_file_size  50M
+    upload_timeout   5m
+`, u.Host, prefix, tenant.Name)
+
+	case sink.LokiURL != "":
+		ep, err := parseFluentBitEndpoint(sink.LokiURL, "/loki/api/v1/push")
+		if err != nil {
+			return "", fmt.Errorf("invalid audit lokiURL: %w", err)
+		}
+		fmt.Fprintf(&b, `[OUTPUT]
+    Name    loki
+    Match   audit
+    Host    %s
+    Port    %s
+    Uri     %s
+    tls     %s
+    Labels  job=vcluster-audit, tenant=%s
+`, ep.Host, ep.Port, ep.Path, ep.TLS, tenant.Name)
+
+	default:
+		return "", fmt.Errorf("audit sink requires objectStoragePath or lokiURL")
+	}
+
+	return b.String(), nil
+}
+
+// vClusterAuditArgs returns the API server flags that enable audit logging, or nil when
+// audit logging is off.
+func vClusterAuditArgs(tenant *platformv1alpha1.Tenant) []string {
+	if vClusterAudit(tenant) == nil {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("--kube-apiserver-arg=audit-policy-file=%s/policy.yaml", vClusterAuditPolicyDir),
+		fmt.Sprintf("--kube-apiserver-arg=audit-log-path=%s/audit.log", vClusterAuditLogDir),
+		"--kube-apiserver-arg=audit-log-maxage=7",
+		"--kube-apiserver-arg=audit-log-maxsize=100",
+	}
+}
+
+// vClusterAuditVolumeMounts renders the vcluster.volumeMounts Helm values for the audit
+// policy and logs, or returns "" when audit logging is off.
+func vClusterAuditVolumeMounts(tenant *platformv1alpha1.Tenant) string {
+	if vClusterAudit(tenant) == nil {
+		return ""
+	}
+	return fmt.Sprintf(`  volumeMounts:
+  - name: audit-config
+    mountPath: %[1]s
+    readOnly: true
+  - name: audit-logs
+    mountPath: %[2]s
+`, vClusterAuditPolicyDir, vClusterAuditLogDir)
+}
+
+// buildVClusterAuditValues renders the Helm values that run the audit log shipper as a
+// sidecar; the API server side is part of the vcluster section. It returns "" when
+// audit logging is off.
+func buildVClusterAuditValues(tenant *platformv1alpha1.Tenant, releaseName string) string {
+	audit := vClusterAudit(tenant)
+	if audit == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(`syncer:
+  extraContainers:
+  - name: audit-shipper
+    image: %[3]s
+    args:
+    - --config=%[1]s/fluent-bit.conf
+    volumeMounts:
+    - name: audit-config
+      mountPath: %[1]s
+      readOnly: true
+    - name: audit-logs
+      mountPath: %[2]s
+volumes:
+- name: audit-config
+  configMap:
+    name: %[4]s-audit
+- name: audit-logs
+  emptyDir: {}
+`, vClusterAuditPolicyDir, vClusterAuditLogDir, vClusterAuditShipper, releaseName)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// certificateGVK is the cert-manager Certificate kind. The operator does not depend on
+// the cert-manager API module, so Certificates are managed as unstructured objects.
+var certificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+// vClusterExternalCertDir is where the cert-manager issued certificate is mounted in
+// the vCluster API server container.
+const vClusterExternalCertDir = "/etc/vcluster/external-tls"
+
+// vClusterCertificateName names the Certificate and its Secret for a vCluster release.
+func vClusterCertificateName(releaseName string) string {
+	return fmt.Sprintf("%s-external-tls", releaseName)
+}
+
+// ensureVClusterCertificate requests a certificate for the spec.exposure hostname from
+// cert-manager when spec.exposure.tls is set, and deletes a stale one otherwise. It
+// returns the issued certificate Secret, or nil while cert-manager has not issued it.
+func (r *TenantReconciler) ensureVClusterCertificate(ctx context.Context, tenant *platformv1alpha1.Tenant, releaseName string, log logr.Logger) (*corev1.Secret, error) {
+	namespaceName := buildNamespaceName(tenant)
+	name := vClusterCertificateName(releaseName)
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(certificateGVK)
+	certificate.SetNamespace(namespaceName)
+	certificate.SetName(name)
+
+	exposure := tenant.Spec.Exposure
+	if exposure == nil || exposure.TLS == nil || exposure.Hostname == "" {
+		apimeta.RemoveStatusCondition(&tenant.Status.Conditions, platformv1alpha1.ConditionAPICertificateReady)
+		// Without cert-manager installed there is nothing to clean up
+		if err := r.Delete(ctx, certificate); client.IgnoreNotFound(err) != nil && !apimeta.IsNoMatchError(err) {
+			return nil, fmt.Errorf("failed to delete stale vCluster certificate %s: %w", name, err)
+		}
+		return nil, nil
+	}
+
+	issuer := exposure.TLS.IssuerRef
+	if issuer.Kind == "" {
+		issuer.Kind = "ClusterIssuer"
+	}
+	if issuer.Group == "" {
+		issuer.Group = certificateGVK.Group
+	}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, certificate, func() error {
+		certificate.SetLabels(map[string]string{
+			TenantNameLabelKey: tenant.Name,
+			"app":              "vcluster",
+			ManagedByLabelKey:  ManagedByValue,
+		})
+		certificate.Object["spec"] = map[string]interface{}{
+			"secretName": name,
+			"dnsNames":   []interface{}{exposure.Hostname},
+			"usages":     []interface{}{"server auth"},
+			"issuerRef": map[string]interface{}{
+				"name":  issuer.Name,
+				"kind":  issuer.Kind,
+				"group": issuer.Group,
+			},
+			// Label the Secret so it is attributed to the tenant like its other objects
+			"secretTemplate": map[string]interface{}{
+				"labels": map[string]interface{}{
+					TenantNameLabelKey: tenant.Name,
+					ManagedByLabelKey:  ManagedByValue,
+				},
+			},
+		}
+		return controllerutil.SetControllerReference(tenant, certificate, r.Scheme)
+	})
+	if apimeta.IsNoMatchError(err) {
+		return nil, newValidationError(fmt.Errorf("spec.exposure.tls requires cert-manager, which is not installed"))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to request vCluster certificate: %w", err)
+	}
+	log.Info("ensured vCluster certificate", "certificate", name, "hostname", exposure.Hostname, "operation", result)
+
+	secret, err := r.issuedVClusterCertificate(ctx, tenant, releaseName)
+	if err != nil {
+		return nil, err
+	}
+	var pending error
+	if secret == nil {
+		pending = fmt.Errorf("waiting for cert-manager to issue Certificate %s", name)
+	}
+	setResourceCondition(tenant, platformv1alpha1.ConditionAPICertificateReady, "Pending", pending)
+	return secret, nil
+}
+
+// issuedVClusterCertificate returns the Secret of the issued external API server
+// certificate, or nil when spec.exposure.tls is not set or the certificate is not
+// issued yet.
+func (r *TenantReconciler) issuedVClusterCertificate(ctx context.Context, tenant *platformv1alpha1.Tenant, releaseName string) (*corev1.Secret, error) {
+	if exposure := tenant.Spec.Exposure; exposure == nil || exposure.TLS == nil || exposure.Hostname == "" {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: buildNamespaceName(tenant), Name: vClusterCertificateName(releaseName)}
+	if err := r.Get(ctx, key, secret); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	if len(secret.Data[corev1.TLSCertKey]) == 0 || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		return nil, nil
+	}
+	return secret, nil
+}
+
+// vClusterCertificateValues returns the API server flag that presents the issued
+// certificate to clients connecting with the external hostname, and the volume that
+// mounts it. The vCluster's own certificate still serves in-cluster connections. They
+// are empty until the certificate is issued, since the API server does not start
+// without its certificate files.
+func vClusterCertificateValues(secret *corev1.Secret, host string) (args []string, volumeMounts, volumes []interface{}) {
+	if secret == nil {
+		return nil, nil, nil
+	}
+	args = []string{fmt.Sprintf("--kube-apiserver-arg=tls-sni-cert-key=%[1]s/%[2]s,%[1]s/%[3]s:%[4]s",
+		vClusterExternalCertDir, corev1.TLSCertKey, corev1.TLSPrivateKeyKey, host)}
+	volumeMounts = []interface{}{map[string]interface{}{
+		"name":      "external-tls",
+		"mountPath": vClusterExternalCertDir,
+		"readOnly":  true,
+	}}
+	volumes = []interface{}{map[string]interface{}{
+		"name":   "external-tls",
+		"secret": map[string]interface{}{"secretName": secret.Name},
+	}}
+	return args, volumeMounts, volumes
+}
+
+// rewriteKubeconfigServer points every cluster of a kubeconfig at server and replaces
+// its CA with caData. Empty caData drops the CA, so clients verify the server against
+// the system roots, as for publicly trusted issuers such as ACME.
+func rewriteKubeconfigServer(kubeconfig []byte, server string, caData []byte) ([]byte, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vCluster kubeconfig: %w", err)
+	}
+	if len(config.Clusters) == 0 {
+		return nil, errors.New("vCluster kubeconfig has no clusters")
+	}
+	for _, cluster := range config.Clusters {
+		cluster.Server = server
+		cluster.CertificateAuthority = ""
+		cluster.CertificateAuthorityData = caData
+		cluster.InsecureSkipTLSVerify = false
+	}
+	return clientcmd.Write(*config)
+}
+
+// certificatePending reports whether a Gold tenant is waiting for cert-manager
+// to issue its external API server certificate.
+func certificatePending(tenant *platformv1alpha1.Tenant) bool {
+	return apimeta.IsStatusConditionFalse(tenant.Status.Conditions, platformv1alpha1.ConditionAPICertificateReady)
+}
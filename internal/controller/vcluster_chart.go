@@ -0,0 +1,115 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+)
+
+// Keys of the chart credentials Secret.
+const (
+	ChartUsernameKey = "username"
+	ChartPasswordKey = "password"
+	ChartCAKey       = "ca.crt"
+)
+
+// tenantVClusterChart applies spec.vcluster.chartVersion and distro to the operator's
+// chart. The k0s and k8s distros install the vcluster-k0s and vcluster-k8s charts next
+// to the default one; the bundled chart has a single version and distro.
+func tenantVClusterChart(chart config.VClusterChartConfig, tenant *platformv1alpha1.Tenant) (config.VClusterChartConfig, error) {
+	vc := tenant.Spec.VCluster
+	if vc == nil {
+		return chart, nil
+	}
+	distro := vc.Distro
+	if distro == "" {
+		distro = platformv1alpha1.VClusterDistroK3s
+	}
+	if chart.Source == config.VClusterChartBundled {
+		if vc.ChartVersion != "" && vc.ChartVersion != chart.Version {
+			return chart, newValidationError(fmt.Errorf("spec.vcluster.chartVersion %s is not available: the operator installs the bundled vCluster chart %s",
+				vc.ChartVersion, chart.Version))
+		}
+		if distro != platformv1alpha1.VClusterDistroK3s {
+			return chart, newValidationError(fmt.Errorf("spec.vcluster.distro %s is not available: the operator installs the bundled k3s vCluster chart", distro))
+		}
+		return chart, nil
+	}
+
+	if vc.ChartVersion != "" {
+		chart.Version = vc.ChartVersion
+	}
+	if distro != platformv1alpha1.VClusterDistroK3s {
+		chart.Name += "-" + string(distro)
+	}
+	return chart, nil
+}
+
+// vClusterChartData returns the entries of the vCluster Helm values ConfigMap that tell
+// the installer where to pull the chart from.
+func vClusterChartData(chart config.VClusterChartConfig) map[string]string {
+	data := map[string]string{
+		"chart-source":  chart.Source,
+		"chart-version": chart.Version,
+	}
+	switch chart.Source {
+	case config.VClusterChartOCI:
+		data["chart-name"] = strings.TrimSuffix(chart.Repository, "/") + "/" + chart.Name
+	case config.VClusterChartBundled:
+		data["chart-name"] = chart.BundledPath
+	default:
+		data["chart-name"] = chart.Name
+		data["chart-repository"] = chart.Repository
+	}
+	return data
+}
+
+// vClusterChartCredentials checks the chart repository credentials Secret, which stays
+// in the operator namespace out of the tenant's reach, and returns its name, or "" when
+// no credentials are configured. Copies that earlier releases stored in the tenant
+// namespace are deleted.
+func (r *TenantReconciler) vClusterChartCredentials(ctx context.Context, tenant *platformv1alpha1.Tenant, releaseName string) (string, error) {
+	stale := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      fmt.Sprintf("%s-chart-credentials", releaseName),
+		Namespace: buildNamespaceName(tenant),
+	}}
+	if err := r.Delete(ctx, stale); client.IgnoreNotFound(err) != nil {
+		return "", fmt.Errorf("failed to delete stale vCluster chart credentials: %w", err)
+	}
+
+	name := r.config().Chart.CredentialsSecret
+	if name == "" {
+		return "", nil
+	}
+	source := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: OperatorNamespace, Name: name}, source); err != nil {
+		return "", fmt.Errorf("failed to get vCluster chart credentials Secret %s: %w", name, err)
+	}
+	if len(source.Data[ChartUsernameKey]) == 0 || len(source.Data[ChartPasswordKey]) == 0 {
+		return "", fmt.Errorf("vCluster chart credentials Secret %s must have %q and %q keys", name, ChartUsernameKey, ChartPasswordKey)
+	}
+	return name, nil
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+
+// vClusterAPIPort is the port the vCluster API server listens on inside its pod.
+const vClusterAPIPort = 8443
+
+// ExternalDNSHostnameAnnotation asks external-dns to publish a hostname for an Ingress
+// or Service.
+const ExternalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+
+// ensureVClusterExposure creates the Ingress or Service for spec.exposure, with a
+// NetworkPolicy admitting external traffic to the vCluster API server, and removes the
+// objects of other exposure types.
+func (r *TenantReconciler) ensureVClusterExposure(ctx context.Context, tenant *platformv1alpha1.Tenant, releaseName string, log logr.Logger) error {
+	namespaceName := buildNamespaceName(tenant)
+	name := fmt.Sprintf("%s-external", releaseName)
+	labels := map[string]string{
+		TenantNameLabelKey: tenant.Name,
+		"app":              "vcluster",
+		ManagedByLabelKey:  ManagedByValue,
+	}
+	exposure := tenant.Spec.Exposure
+
+	ingress := &netv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespaceName}}
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespaceName}}
+	policy := &netv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespaceName}}
+	var stale []client.Object
+	switch {
+	case exposure == nil:
+		stale = []client.Object{ingress, service, policy}
+	case exposure.Type == platformv1alpha1.ExposureIngress:
+		stale = []client.Object{service}
+	default:
+		stale = []client.Object{ingress}
+	}
+	for _, obj := range stale {
+		if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete stale vCluster exposure %T %s: %w", obj, name, err)
+		}
+	}
+	if exposure == nil {
+		return nil
+	}
+
+	annotations := map[string]string{}
+	if exposure.Hostname != "" {
+		annotations[ExternalDNSHostnameAnnotation] = exposure.Hostname
+	}
+
+	var target client.Object
+	var mutate func() error
+	if exposure.Type == platformv1alpha1.ExposureIngress {
+		// The API server terminates TLS itself, so the controller passes it through and
+		// the client sees the vCluster or cert-manager certificate
+		annotations["nginx.ingress.kubernetes.io/ssl-passthrough"] = "true"
+		annotations["nginx.ingress.kubernetes.io/backend-protocol"] = "HTTPS"
+		pathType := netv1.PathTypePrefix
+		target, mutate = ingress, func() error {
+			ingress.Labels = labels
+			ingress.Annotations = mergeAnnotations(annotations, exposure.Annotations)
+			ingress.Spec = netv1.IngressSpec{
+				Rules: []netv1.IngressRule{{
+					Host: exposure.Hostname,
+					IngressRuleValue: netv1.IngressRuleValue{HTTP: &netv1.HTTPIngressRuleValue{
+						Paths: []netv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: netv1.IngressBackend{Service: &netv1.IngressServiceBackend{
+								Name: releaseName,
+								Port: netv1.ServiceBackendPort{Number: 443},
+							}},
+						}},
+					}},
+				}},
+			}
+			if exposure.IngressClassName != "" {
+				className := exposure.IngressClassName
+				ingress.Spec.IngressClassName = &className
+			}
+			return controllerutil.SetControllerReference(tenant, ingress, r.Scheme)
+		}
+	} else {
+		target, mutate = service, func() error {
+			service.Labels = labels
+			service.Annotations = mergeAnnotations(annotations, exposure.Annotations)
+			service.Spec.Type = corev1.ServiceType(exposure.Type)
+			service.Spec.Selector = map[string]string{"app": "vcluster", "release": releaseName}
+			// Keep an allocated node port across updates
+			nodePort := int32(0)
+			if len(service.Spec.Ports) > 0 {
+				nodePort = service.Spec.Ports[0].NodePort
+			}
+			service.Spec.Ports = []corev1.ServicePort{{
+				Name:       "https",
+				Protocol:   corev1.ProtocolTCP,
+				Port:       443,
+				TargetPort: intstr.FromInt(vClusterAPIPort),
+				NodePort:   nodePort,
+			}}
+			return controllerutil.SetControllerReference(tenant, service, r.Scheme)
+		}
+	}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, target, mutate)
+	if err != nil {
+		return fmt.Errorf("failed to expose vCluster API server: %w", err)
+	}
+	log.Info("ensured vCluster exposure", "type", exposure.Type, "name", name, "operation", result)
+
+	// The tenant's default-deny policy would otherwise drop the external traffic
+	tcp := corev1.ProtocolTCP
+	port := intstr.FromInt(vClusterAPIPort)
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, policy, func() error {
+		policy.Labels = labels
+		policy.Spec = netv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "vcluster", "release": releaseName}},
+			PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeIngress},
+			Ingress:     []netv1.NetworkPolicyIngressRule{{Ports: []netv1.NetworkPolicyPort{{Protocol: &tcp, Port: &port}}}},
+		}
+		return controllerutil.SetControllerReference(tenant, policy, r.Scheme)
+	}); err != nil {
+		return fmt.Errorf("failed to allow external vCluster API traffic: %w", err)
+	}
+	return nil
+}
+
+// vClusterExternalAddress returns the host and port the exposed vCluster API server is
+// reached at, or "" while a load balancer address or node port is not assigned yet.
+// Without a hostname, NodePort exposure uses the external, else internal, IP of the
+// first node.
+func (r *TenantReconciler) vClusterExternalAddress(ctx context.Context, tenant *platformv1alpha1.Tenant, releaseName string) (string, int32, error) {
+	exposure := tenant.Spec.Exposure
+	if exposure == nil {
+		return "", 0, nil
+	}
+	if exposure.Type == platformv1alpha1.ExposureIngress {
+		return exposure.Hostname, 443, nil
+	}
+
+	service := &corev1.Service{}
+	key := client.ObjectKey{Namespace: buildNamespaceName(tenant), Name: fmt.Sprintf("%s-external", releaseName)}
+	if err := r.Get(ctx, key, service); err != nil {
+		return "", 0, client.IgnoreNotFound(err)
+	}
+
+	if exposure.Type == platformv1alpha1.ExposureLoadBalancer {
+		host := exposure.Hostname
+		if lb := service.Status.LoadBalancer.Ingress; host == "" && len(lb) > 0 {
+			host = lb[0].Hostname
+			if host == "" {
+				host = lb[0].IP
+			}
+		}
+		return host, 443, nil
+	}
+
+	if len(service.Spec.Ports) == 0 || service.Spec.Ports[0].NodePort == 0 {
+		return "", 0, nil
+	}
+	nodePort := service.Spec.Ports[0].NodePort
+	if exposure.Hostname != "" {
+		return exposure.Hostname, nodePort, nil
+	}
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return "", 0, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	sort.Slice(nodes.Items, func(i, j int) bool { return nodes.Items[i].Name < nodes.Items[j].Name })
+	for _, addressType := range []corev1.NodeAddressType{corev1.NodeExternalIP, corev1.NodeInternalIP} {
+		for _, node := range nodes.Items {
+			for _, address := range node.Status.Addresses {
+				if address.Type == addressType {
+					return address.Address, nodePort, nil
+				}
+			}
+		}
+	}
+	return "", 0, nil
+}
+
+// vClusterEndpointURL formats the API server URL, leaving out the default HTTPS port.
+func vClusterEndpointURL(host string, port int32) string {
+	if port == 443 {
+		return "https://" + host
+	}
+	return fmt.Sprintf("https://%s:%d", host, port)
+}
+
+// vClusterExposureValues are the Helm values spec.exposure adds on top of the tenant's
+// own values.
+type vClusterExposureValues struct {
+	syncerArgs    []string
+	apiServerArgs []string
+	volumeMounts  []interface{}
+	volumes       []interface{}
+}
+
+// buildVClusterExposureValues returns the syncer arguments that add the external
+// address to the API server certificate and to the kubeconfig the vCluster generates,
+// and the values that serve the cert-manager certificate once it is issued. They are
+// empty when the address is not known.
+func buildVClusterExposureValues(host string, port int32, certificate *corev1.Secret) vClusterExposureValues {
+	if host == "" {
+		return vClusterExposureValues{}
+	}
+	v := vClusterExposureValues{
+		syncerArgs: []string{"--tls-san=" + host, "--out-kube-config-server=" + vClusterEndpointURL(host, port)},
+	}
+	v.apiServerArgs, v.volumeMounts, v.volumes = vClusterCertificateValues(certificate, host)
+	return v
+}
+
+func (v vClusterExposureValues) empty() bool {
+	return len(v.syncerArgs) == 0 && len(v.apiServerArgs) == 0
+}
+
+// apply appends the exposure values to values, keeping the arguments and volumes set
+// through spec.vcluster.values or valuesFrom.
+func (v vClusterExposureValues) apply(values map[string]interface{}) error {
+	args := func(in []string) []interface{} {
+		out := make([]interface{}, 0, len(in))
+		for _, arg := range in {
+			out = append(out, arg)
+		}
+		return out
+	}
+	if err := appendValuesList(values, []string{"syncer", "extraArgs"}, args(v.syncerArgs)); err != nil {
+		return err
+	}
+	if err := appendValuesList(values, []string{"vcluster", "extraArgs"}, args(v.apiServerArgs)); err != nil {
+		return err
+	}
+	if err := appendValuesList(values, []string{"vcluster", "volumeMounts"}, v.volumeMounts); err != nil {
+		return err
+	}
+	return appendValuesList(values, []string{"volumes"}, v.volumes)
+}
+
+// appendValuesList appends items to the list at path in values, creating the maps
+// along the way.
+func appendValuesList(values map[string]interface{}, path []string, items []interface{}) error {
+	if len(items) == 0 {
+		return nil
+	}
+	parent := values
+	for i, key := range path[:len(path)-1] {
+		child, ok := parent[key].(map[string]interface{})
+		if !ok {
+			if parent[key] != nil {
+				return newValidationError(fmt.Errorf("vCluster values: %s must be a map", strings.Join(path[:i+1], ".")))
+			}
+			child = map[string]interface{}{}
+			parent[key] = child
+		}
+		parent = child
+	}
+	key := path[len(path)-1]
+	list, ok := parent[key].([]interface{})
+	if !ok && parent[key] != nil {
+		return newValidationError(fmt.Errorf("vCluster values: %s must be a list", strings.Join(path, ".")))
+	}
+	parent[key] = append(list, items...)
+	return nil
+}
+
+// reserveExposureQuota adds the quota the exposure Service of spec.exposure consumes to
+// hard, so the operator's Service is admitted even when the tenant's own LoadBalancers
+// and NodePorts are zeroed. A LoadBalancer Service also allocates a node port for its
+// single port.
+func reserveExposureQuota(hard corev1.ResourceList, exposure *platformv1alpha1.ExposureConfig) {
+	if exposure == nil {
+		return
+	}
+	var reserved []corev1.ResourceName
+	switch exposure.Type {
+	case platformv1alpha1.ExposureLoadBalancer:
+		reserved = []corev1.ResourceName{corev1.ResourceServices, corev1.ResourceServicesLoadBalancers, corev1.ResourceServicesNodePorts}
+	case platformv1alpha1.ExposureNodePort:
+		reserved = []corev1.ResourceName{corev1.ResourceServices, corev1.ResourceServicesNodePorts}
+	}
+	for _, name := range reserved {
+		if qty, ok := hard[name]; ok {
+			qty.Add(*resource.NewQuantity(1, resource.DecimalSI))
+			hard[name] = qty
+		}
+	}
+}
+
+// mergeAnnotations returns base with overrides applied.
+func mergeAnnotations(base, overrides map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(overrides))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// Defaults for spec.vcluster.oidc.
+const (
+	defaultOIDCUsernameClaim = "email"
+	defaultOIDCGroupsClaim   = "groups"
+)
+
+// kubeloginInstallHint is shown by kubectl when the exec plugin is missing.
+const kubeloginInstallHint = "This kubeconfig logs in with kubelogin: kubectl krew install oidc-login (https://github.com/int128/kubelogin)"
+
+// vClusterOIDC returns the tenant's OIDC settings with defaults applied, or nil when
+// OIDC is not configured.
+func vClusterOIDC(tenant *platformv1alpha1.Tenant) *platformv1alpha1.VClusterOIDC {
+	if tenant.Spec.VCluster == nil || tenant.Spec.VCluster.OIDC == nil {
+		return nil
+	}
+	oidc := *tenant.Spec.VCluster.OIDC
+	if oidc.UsernameClaim == "" {
+		oidc.UsernameClaim = defaultOIDCUsernameClaim
+	}
+	if oidc.GroupsClaim == "" {
+		oidc.GroupsClaim = defaultOIDCGroupsClaim
+	}
+	return &oidc
+}
+
+// vClusterOIDCArgs returns the API server flags that accept ID tokens from the tenant's
+// OIDC provider, or nil when OIDC is not configured.
+func vClusterOIDCArgs(tenant *platformv1alpha1.Tenant) []string {
+	oidc := vClusterOIDC(tenant)
+	if oidc == nil {
+		return nil
+	}
+	return []string{
+		"--kube-apiserver-arg=oidc-issuer-url=" + oidc.IssuerURL,
+		"--kube-apiserver-arg=oidc-client-id=" + oidc.ClientID,
+		"--kube-apiserver-arg=oidc-username-claim=" + oidc.UsernameClaim,
+		"--kube-apiserver-arg=oidc-groups-claim=" + oidc.GroupsClaim,
+	}
+}
+
+// buildVClusterOIDCValues renders Helm values that bind the tenant owner to cluster-admin
+// inside the vCluster, so the owner can log in with OIDC right away. Owners are only
+// known by email, so it returns "" unless the username claim is email.
+func buildVClusterOIDCValues(tenant *platformv1alpha1.Tenant) string {
+	oidc := vClusterOIDC(tenant)
+	if oidc == nil || oidc.UsernameClaim != defaultOIDCUsernameClaim {
+		return ""
+	}
+	return fmt.Sprintf(`init:
+  manifests: |-
+    apiVersion: rbac.authorization.k8s.io/v1
+    kind: ClusterRoleBinding
+    metadata:
+      name: tenant-owner
+    roleRef:
+      apiGroup: rbac.authorization.k8s.io
+      kind: ClusterRole
+      name: cluster-admin
+    subjects:
+    - apiGroup: rbac.authorization.k8s.io
+      kind: User
+      name: %q
+`, tenant.Spec.Owner)
+}
+
+// buildOIDCKubeconfig derives a kubeconfig from the vCluster admin kubeconfig that keeps
+// its cluster but authenticates with the kubelogin exec plugin, so credentials are
+// bound to the user, short-lived, and revoked at the identity provider.
+func buildOIDCKubeconfig(tenant *platformv1alpha1.Tenant, oidc *platformv1alpha1.VClusterOIDC, adminKubeconfig []byte) ([]byte, error) {
+	admin, err := clientcmd.Load(adminKubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vCluster kubeconfig: %w", err)
+	}
+	adminContext, ok := admin.Contexts[admin.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("vCluster kubeconfig has no current context %q", admin.CurrentContext)
+	}
+	cluster, ok := admin.Clusters[adminContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("vCluster kubeconfig has no cluster %q", adminContext.Cluster)
+	}
+
+	args := []string{
+		"oidc-login",
+		"get-token",
+		"--oidc-issuer-url=" + oidc.IssuerURL,
+		"--oidc-client-id=" + oidc.ClientID,
+	}
+	if oidc.UsernameClaim == defaultOIDCUsernameClaim {
+		args = append(args, "--oidc-extra-scope=email")
+	}
+
+	name := fmt.Sprintf("vcluster-%s", tenant.Name)
+	config := clientcmdapi.NewConfig()
+	config.Clusters[name] = cluster
+	config.AuthInfos["oidc"] = &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:      "client.authentication.k8s.io/v1beta1",
+			Command:         "kubectl",
+			Args:            args,
+			InstallHint:     kubeloginInstallHint,
+			InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+		},
+	}
+	config.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: "oidc"}
+	config.CurrentContext = name
+	return clientcmd.Write(*config)
+}
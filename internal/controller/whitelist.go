@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// ServiceRef is a parsed entry of spec.network.whitelistedServices.
+type ServiceRef struct {
+	Namespace string
+	Name      string
+
+	// Port is the Service port number or name, or empty for all of its ports.
+	Port string
+}
+
+// ParseServiceRef parses a service reference like "namespace/service" or
+// "namespace/service:port". A reference without a namespace is in the default namespace.
+func ParseServiceRef(serviceRef string) (ServiceRef, error) {
+	ref := ServiceRef{Namespace: metav1.NamespaceDefault, Name: serviceRef}
+	if namespace, name, ok := strings.Cut(serviceRef, "/"); ok {
+		ref.Namespace, ref.Name = namespace, name
+	}
+	if name, port, ok := strings.Cut(ref.Name, ":"); ok {
+		ref.Name, ref.Port = name, port
+		if port == "" {
+			return ref, fmt.Errorf("port after ':' must not be empty")
+		}
+	}
+
+	if msgs := validation.IsDNS1123Label(ref.Namespace); len(msgs) > 0 {
+		return ref, fmt.Errorf("invalid namespace %q: %s", ref.Namespace, strings.Join(msgs, "; "))
+	}
+	if msgs := validation.IsDNS1035Label(ref.Name); len(msgs) > 0 {
+		return ref, fmt.Errorf("invalid service name %q: %s", ref.Name, strings.Join(msgs, "; "))
+	}
+	if ref.Port != "" {
+		var msgs []string
+		if number, err := strconv.Atoi(ref.Port); err == nil {
+			msgs = validation.IsValidPortNum(number)
+		} else {
+			msgs = validation.IsValidPortName(ref.Port)
+		}
+		if len(msgs) > 0 {
+			return ref, fmt.Errorf("invalid port %q: %s", ref.Port, strings.Join(msgs, "; "))
+		}
+	}
+	return ref, nil
+}
+
+// whitelistedServiceRules allows egress to the pods behind each Service in
+// spec.network.whitelistedServices, on the container ports its service ports (or only
+// the one named in the reference) forward to, since NetworkPolicies match the
+// destination pod and port rather than the Service. Services that are missing or
+// cannot be resolved are left out, keeping egress to them blocked until a later
+// reconcile finds them.
+func (r *TenantReconciler) whitelistedServiceRules(ctx context.Context, tenant *platformv1alpha1.Tenant, log logr.Logger) ([]netv1.NetworkPolicyEgressRule, error) {
+	var rules []netv1.NetworkPolicyEgressRule
+	for _, entry := range tenant.Spec.Network.WhitelistedServices {
+		ref, err := ParseServiceRef(entry)
+		if err != nil {
+			log.Info("skipping invalid whitelisted service", "service", entry, "reason", err.Error())
+			continue
+		}
+
+		svc := &corev1.Service{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, svc); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("skipping whitelisted service that does not exist", "service", entry)
+				continue
+			}
+			return nil, fmt.Errorf("failed to get whitelisted service %s: %w", entry, err)
+		}
+		if svc.Spec.Type == corev1.ServiceTypeExternalName {
+			log.Info("skipping whitelisted ExternalName service, which has no pods", "service", entry)
+			continue
+		}
+
+		ports, err := serviceTargetPorts(svc, ref.Port)
+		if err != nil {
+			log.Info("skipping whitelisted service", "service", entry, "reason", err.Error())
+			continue
+		}
+
+		// Services without a selector have manually managed endpoints; allow the
+		// whole namespace, as the endpoints cannot be matched by label
+		rules = append(rules, netv1.NetworkPolicyEgressRule{
+			To: []netv1.NetworkPolicyPeer{{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{corev1.LabelMetadataName: ref.Namespace},
+				},
+				PodSelector: &metav1.LabelSelector{MatchLabels: svc.Spec.Selector},
+			}},
+			Ports: ports,
+		})
+		log.Info("added whitelisted service to NetworkPolicy", "namespace", ref.Namespace, "service", ref.Name, "ports", len(ports))
+	}
+	return rules, nil
+}
+
+// serviceTargetPorts returns the pod ports the service ports of svc forward to, or only
+// the one of the service port matching port by number or name when port is set.
+func serviceTargetPorts(svc *corev1.Service, port string) ([]netv1.NetworkPolicyPort, error) {
+	var ports []netv1.NetworkPolicyPort
+	for _, sp := range svc.Spec.Ports {
+		if port != "" && port != sp.Name && port != strconv.Itoa(int(sp.Port)) {
+			continue
+		}
+		target := sp.TargetPort
+		if target.Type == intstr.Int && target.IntVal == 0 {
+			target = intstr.FromInt32(sp.Port)
+		}
+		protocol := sp.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+		ports = append(ports, netv1.NetworkPolicyPort{Protocol: &protocol, Port: &target})
+	}
+	if port != "" && len(ports) == 0 {
+		return nil, fmt.Errorf("service has no port %s", port)
+	}
+	return ports, nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// zoneUsageInterval is how often the ZoneUsageReporter recomputes tenant placement.
+const zoneUsageInterval = 5 * time.Minute
+
+// unknownZone is reported for pods on nodes without a zone label.
+const unknownZone = "unknown"
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+
+// ZoneUsageReporter tracks which zones and node pools each tenant's pods are scheduled
+// on, using the topology labels of their nodes, and publishes the pod counts and
+// requests in status.zoneUsage and the tenant_zone_* metrics. It runs as a manager
+// Runnable, so only the elected leader reports.
+type ZoneUsageReporter struct {
+	Client client.Client
+	Config *config.OperatorConfig
+	Log    logr.Logger
+}
+
+// zoneKey identifies a zone and node pool.
+type zoneKey struct {
+	zone, nodePool string
+}
+
+// zoneTotals accumulates the pods and requests in one zone and node pool.
+type zoneTotals struct {
+	pods        int32
+	milliCPU    int64
+	memoryBytes int64
+}
+
+// Start reports tenant placement until ctx is cancelled.
+func (z *ZoneUsageReporter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(zoneUsageInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := z.Report(ctx); err != nil {
+			z.Log.Error(err, "failed to report tenant zone usage")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Report recomputes the zone usage of every tenant from its scheduled pods, which the
+// pod webhook labels with their tenant, and records it where it changed.
+func (z *ZoneUsageReporter) Report(ctx context.Context) error {
+	tenants := &platformv1alpha1.TenantList{}
+	if err := z.Client.List(ctx, tenants); err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+	nodes := &corev1.NodeList{}
+	if err := z.Client.List(ctx, nodes); err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	pods := &corev1.PodList{}
+	if err := z.Client.List(ctx, pods, client.HasLabels{TenantNameLabelKey}); err != nil {
+		return fmt.Errorf("failed to list tenant pods: %w", err)
+	}
+
+	placement := make(map[string]zoneKey, len(nodes.Items))
+	for _, node := range nodes.Items {
+		placement[node.Name] = z.nodeZone(&node)
+	}
+
+	totals := map[string]map[zoneKey]*zoneTotals{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		key, ok := placement[pod.Spec.NodeName]
+		if !ok {
+			key = zoneKey{zone: unknownZone}
+		}
+		tenantName := pod.Labels[TenantNameLabelKey]
+		if totals[tenantName] == nil {
+			totals[tenantName] = map[zoneKey]*zoneTotals{}
+		}
+		t := totals[tenantName][key]
+		if t == nil {
+			t = &zoneTotals{}
+			totals[tenantName][key] = t
+		}
+		cpu, memory := podRequests(pod)
+		t.pods++
+		t.milliCPU += cpu.MilliValue()
+		t.memoryBytes += memory.Value()
+	}
+
+	var series []metrics.ZoneUsage
+	var errs []string
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		usage := buildZoneUsage(totals[tenant.Name])
+		for _, u := range usage {
+			t := totals[tenant.Name][zoneKey{zone: u.Zone, nodePool: u.NodePool}]
+			series = append(series, metrics.ZoneUsage{
+				Tenant:      tenant.Name,
+				Tier:        string(tenant.Spec.Tier),
+				Zone:        u.Zone,
+				NodePool:    u.NodePool,
+				Pods:        u.Pods,
+				CPUCores:    float64(t.milliCPU) / 1000,
+				MemoryBytes: float64(t.memoryBytes),
+			})
+		}
+
+		if !tenant.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(tenant.Status.ZoneUsage, usage) {
+			continue
+		}
+		patch := client.MergeFrom(tenant.DeepCopy())
+		tenant.Status.ZoneUsage = usage
+		if err := z.Client.Status().Patch(ctx, tenant, patch); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tenant.Name, err))
+		}
+	}
+	metrics.SetZoneUsage(series)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("zone usage failures: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// nodeZone returns the zone and node pool labels of a node.
+func (z *ZoneUsageReporter) nodeZone(node *corev1.Node) zoneKey {
+	key := zoneKey{zone: node.Labels[corev1.LabelTopologyZone]}
+	if key.zone == "" {
+		key.zone = unknownZone
+	}
+	for _, label := range z.Config.ZoneUsage.NodePoolLabels {
+		if pool := node.Labels[label]; pool != "" {
+			key.nodePool = pool
+			break
+		}
+	}
+	return key
+}
+
+// buildZoneUsage renders a tenant's totals as status entries sorted by zone and node pool.
+func buildZoneUsage(totals map[zoneKey]*zoneTotals) []platformv1alpha1.ZoneUsage {
+	if len(totals) == 0 {
+		return nil
+	}
+	usage := make([]platformv1alpha1.ZoneUsage, 0, len(totals))
+	for key, t := range totals {
+		usage = append(usage, platformv1alpha1.ZoneUsage{
+			Zone:     key.zone,
+			NodePool: key.nodePool,
+			Pods:     t.pods,
+			CPU:      resource.NewMilliQuantity(t.milliCPU, resource.DecimalSI).String(),
+			Memory:   resource.NewQuantity(t.memoryBytes, resource.BinarySI).String(),
+		})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Zone != usage[j].Zone {
+			return usage[i].Zone < usage[j].Zone
+		}
+		return usage[i].NodePool < usage[j].NodePool
+	})
+	return usage
+}
+
+// podRequests sums the CPU and memory requests of a pod's containers and its overhead.
+func podRequests(pod *corev1.Pod) (resource.Quantity, resource.Quantity) {
+	var cpu, memory resource.Quantity
+	add := func(list corev1.ResourceList) {
+		if qty, ok := list[corev1.ResourceCPU]; ok {
+			cpu.Add(qty)
+		}
+		if qty, ok := list[corev1.ResourceMemory]; ok {
+			memory.Add(qty)
+		}
+	}
+	for _, c := range pod.Spec.Containers {
+		add(c.Resources.Requests)
+	}
+	add(pod.Spec.Overhead)
+	return cpu, memory
+}
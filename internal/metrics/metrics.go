@@ -17,6 +17,8 @@ limitations under the License.
 package metrics
 
 import (
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
@@ -64,7 +66,7 @@ var (
 	ResourceUtilizationGauge = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "tenant_resource_utilization",
-			Help: "Current resource utilization of a tenant (CPU, Memory)",
+			Help: "Current resource utilization of a tenant from metrics-server (CPU in cores, memory in bytes)",
 		},
 		[]string{"tenant", "tier", "resource_type"},
 	)
@@ -78,13 +80,152 @@ var (
 		[]string{"tier", "error_type"},
 	)
 
-	// NetworkPolicyDriftDetectedCounter tracks detected NetworkPolicy drifts.
-	NetworkPolicyDriftDetectedCounter = prometheus.NewCounterVec(
+	// DriftDetectedCounter tracks changes made outside the operator to a tenant's
+	// managed objects that were reverted, per object kind.
+	DriftDetectedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tenant_drift_detected_total",
+			Help: "Total times drift in a managed object was detected and corrected",
+		},
+		[]string{"tenant", "kind"},
+	)
+
+	// BurstActiveGauge is 1 while a tenant has a time-boxed quota boost applied.
+	BurstActiveGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_burst_active",
+			Help: "Whether a time-boxed quota boost is currently applied to a tenant",
+		},
+		[]string{"tenant"},
+	)
+
+	// BurstCPUCoreSecondsCounter accumulates extra CPU granted through quota boosts.
+	BurstCPUCoreSecondsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tenant_burst_cpu_core_seconds_total",
+			Help: "Extra CPU core-seconds granted to a tenant through quota boosts",
+		},
+		[]string{"tenant", "tier"},
+	)
+
+	// BurstMemoryGiBHoursCounter accumulates extra memory granted through quota boosts.
+	BurstMemoryGiBHoursCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
-			Name: "network_policy_drift_detected_total",
-			Help: "Total times NetworkPolicy drift was detected and corrected",
+			Name: "tenant_burst_memory_gib_hours_total",
+			Help: "Extra memory GiB-hours granted to a tenant through quota boosts",
 		},
-		[]string{"tenant", "namespace"},
+		[]string{"tenant", "tier"},
+	)
+
+	// ProvisioningStuckGauge is 1 for each tenant that exceeded its tier's provisioning SLA.
+	ProvisioningStuckGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_provisioning_stuck",
+			Help: "Whether a tenant has exceeded its tier's provisioning SLA without reaching Ready",
+		},
+		[]string{"tenant", "tier"},
+	)
+
+	// TTLRemainingGauge is the time left until each tenant with a spec.ttl expires.
+	TTLRemainingGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_ttl_remaining_seconds",
+			Help: "Seconds until a tenant's spec.ttl runs out; negative once it expired",
+		},
+		[]string{"tenant", "tier"},
+	)
+
+	// HibernationSuspendedGauge is the total time each tenant with spec.hibernation spent
+	// suspended by its schedule, to track the savings.
+	HibernationSuspendedGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_hibernation_suspended_seconds",
+			Help: "Total seconds a tenant was suspended by its hibernation schedule",
+		},
+		[]string{"tenant", "tier"},
+	)
+
+	// PlacementConnectedGauge is 1 while the remote cluster a tenant is placed on with
+	// spec.placement is reachable, and 0 while it is not.
+	PlacementConnectedGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_placement_cluster_connected",
+			Help: "Whether the remote cluster a tenant is placed on is reachable (1) or not (0)",
+		},
+		[]string{"tenant", "cluster"},
+	)
+
+	// TenantInfoGauge is 1 for every tenant, with its attributes as labels for PromQL joins.
+	TenantInfoGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_info",
+			Help: "Information about a tenant; always 1",
+		},
+		[]string{"tenant", "tier", "owner", "namespace", "state", "suspend"},
+	)
+
+	// ZonePodsGauge counts a tenant's scheduled pods per zone and node pool.
+	ZonePodsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_zone_pods",
+			Help: "Scheduled pods of a tenant per zone and node pool",
+		},
+		[]string{"tenant", "tier", "zone", "node_pool"},
+	)
+
+	// ZoneCPURequestsGauge sums the CPU requests of a tenant's pods per zone and node pool.
+	ZoneCPURequestsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_zone_cpu_requests_cores",
+			Help: "CPU requested by the scheduled pods of a tenant per zone and node pool, in cores",
+		},
+		[]string{"tenant", "tier", "zone", "node_pool"},
+	)
+
+	// ZoneMemoryRequestsGauge sums the memory requests of a tenant's pods per zone and node pool.
+	ZoneMemoryRequestsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_zone_memory_requests_bytes",
+			Help: "Memory requested by the scheduled pods of a tenant per zone and node pool, in bytes",
+		},
+		[]string{"tenant", "tier", "zone", "node_pool"},
+	)
+
+	// TenantCostCounter accumulates the metered cost of each tenant for chargeback.
+	TenantCostCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tenant_cost_total",
+			Help: "Cost of the resources requested by a tenant at its tier's unit prices",
+		},
+		[]string{"tenant", "tier", "resource"},
+	)
+
+	// WebhookAdmissionsCounter counts admission requests handled by the operator webhooks.
+	WebhookAdmissionsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tenant_webhook_admissions_total",
+			Help: "Total admission requests handled by the operator webhooks",
+		},
+		[]string{"webhook", "operation", "allowed"},
+	)
+
+	// WebhookDenialsCounter counts denied admission requests by reason.
+	WebhookDenialsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tenant_webhook_denials_total",
+			Help: "Total admission requests denied by the operator webhooks, by reason and field",
+		},
+		[]string{"webhook", "reason", "field"},
+	)
+
+	// WebhookLatencyHistogram measures how long the operator webhooks take to decide.
+	WebhookLatencyHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "tenant_webhook_duration_seconds",
+			Help:    "Time taken by the operator webhooks to handle an admission request in seconds",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 12), // 1ms to ~2s
+		},
+		[]string{"webhook", "operation"},
 	)
 )
 
@@ -98,7 +239,38 @@ func init() {
 	metrics.Registry.MustRegister(ReconciliationDurationHistogram)
 	metrics.Registry.MustRegister(ResourceUtilizationGauge)
 	metrics.Registry.MustRegister(ErrorRateByTierCounter)
-	metrics.Registry.MustRegister(NetworkPolicyDriftDetectedCounter)
+	metrics.Registry.MustRegister(DriftDetectedCounter)
+
+	// Quota boost metrics (consumed by cost reporting)
+	metrics.Registry.MustRegister(BurstActiveGauge)
+	metrics.Registry.MustRegister(BurstCPUCoreSecondsCounter)
+	metrics.Registry.MustRegister(BurstMemoryGiBHoursCounter)
+
+	// Stuck provisioning alerting
+	metrics.Registry.MustRegister(ProvisioningStuckGauge)
+
+	// Ephemeral tenant expiry
+	metrics.Registry.MustRegister(TTLRemainingGauge)
+	metrics.Registry.MustRegister(HibernationSuspendedGauge)
+
+	// Remote cluster placement
+	metrics.Registry.MustRegister(PlacementConnectedGauge)
+
+	// Tenant attributes for dashboard joins
+	metrics.Registry.MustRegister(TenantInfoGauge)
+
+	// Per-zone placement for capacity planning and charging
+	metrics.Registry.MustRegister(ZonePodsGauge)
+	metrics.Registry.MustRegister(ZoneCPURequestsGauge)
+	metrics.Registry.MustRegister(ZoneMemoryRequestsGauge)
+
+	// Chargeback metering
+	metrics.Registry.MustRegister(TenantCostCounter)
+
+	// Admission webhook metrics
+	metrics.Registry.MustRegister(WebhookAdmissionsCounter)
+	metrics.Registry.MustRegister(WebhookDenialsCounter)
+	metrics.Registry.MustRegister(WebhookLatencyHistogram)
 }
 
 // RecordProvisioningTime records the provisioning time for a tenant.
@@ -132,7 +304,135 @@ func RecordErrorByTier(tier, errorType string) {
 	ErrorRateByTierCounter.WithLabelValues(tier, errorType).Inc()
 }
 
-// RecordNetworkPolicyDriftDetected records when NetworkPolicy drift is detected.
-func RecordNetworkPolicyDriftDetected(tenant, namespace string) {
-	NetworkPolicyDriftDetectedCounter.WithLabelValues(tenant, namespace).Inc()
+// RecordDriftDetected records a reverted change to a tenant's managed object of the given kind.
+func RecordDriftDetected(tenant, kind string) {
+	DriftDetectedCounter.WithLabelValues(tenant, kind).Inc()
+}
+
+// SetBurstActive records whether a quota boost is currently applied to a tenant.
+func SetBurstActive(tenant string, active bool) {
+	value := 0.0
+	if active {
+		value = 1
+	}
+	BurstActiveGauge.WithLabelValues(tenant).Set(value)
+}
+
+// RecordBurstUsage accumulates the resources granted by a finished quota boost.
+func RecordBurstUsage(tenant, tier string, cpuCoreSeconds, memoryGiBHours float64) {
+	BurstCPUCoreSecondsCounter.WithLabelValues(tenant, tier).Add(cpuCoreSeconds)
+	BurstMemoryGiBHoursCounter.WithLabelValues(tenant, tier).Add(memoryGiBHours)
+}
+
+// SetProvisioningStuck replaces the set of tenants reported as stuck in provisioning,
+// keyed by tenant name with the tier as value.
+func SetProvisioningStuck(tenants map[string]string) {
+	ProvisioningStuckGauge.Reset()
+	for tenant, tier := range tenants {
+		ProvisioningStuckGauge.WithLabelValues(tenant, tier).Set(1)
+	}
+}
+
+// TTLRemaining is the time left until one tenant expires.
+type TTLRemaining struct {
+	Tier    string
+	Seconds float64
+}
+
+// SetTTLRemaining replaces the time-to-expiry series with those of the tenants that
+// currently have a TTL.
+func SetTTLRemaining(remaining map[string]TTLRemaining) {
+	TTLRemainingGauge.Reset()
+	for tenant, r := range remaining {
+		TTLRemainingGauge.WithLabelValues(tenant, r.Tier).Set(r.Seconds)
+	}
+}
+
+// SetHibernationSuspended publishes the total hibernation time of a tenant, replacing
+// the series with its previous tier.
+func SetHibernationSuspended(tenant, tier string, seconds float64) {
+	HibernationSuspendedGauge.DeletePartialMatch(prometheus.Labels{"tenant": tenant})
+	HibernationSuspendedGauge.WithLabelValues(tenant, tier).Set(seconds)
+}
+
+// SetTenantInfo publishes the info series of a tenant, replacing the series with its
+// previous labels (e.g. an older state).
+func SetTenantInfo(tenant, tier, owner, namespace, state string, suspend bool) {
+	TenantInfoGauge.DeletePartialMatch(prometheus.Labels{"tenant": tenant})
+	TenantInfoGauge.WithLabelValues(tenant, tier, owner, namespace, state, strconv.FormatBool(suspend)).Set(1)
+}
+
+// SetPlacementConnected publishes whether the cluster a tenant is placed on is reachable,
+// or removes the tenant's series when it is not placed on a remote cluster.
+func SetPlacementConnected(tenant, cluster string, connected bool) {
+	PlacementConnectedGauge.DeletePartialMatch(prometheus.Labels{"tenant": tenant})
+	if cluster == "" {
+		return
+	}
+	value := 0.0
+	if connected {
+		value = 1
+	}
+	PlacementConnectedGauge.WithLabelValues(tenant, cluster).Set(value)
+}
+
+// DeleteTenantInfo removes the info, hibernation, placement and cost series of a deleted tenant.
+func DeleteTenantInfo(tenant string) {
+	TenantInfoGauge.DeletePartialMatch(prometheus.Labels{"tenant": tenant})
+	HibernationSuspendedGauge.DeletePartialMatch(prometheus.Labels{"tenant": tenant})
+	PlacementConnectedGauge.DeletePartialMatch(prometheus.Labels{"tenant": tenant})
+	TenantCostCounter.DeletePartialMatch(prometheus.Labels{"tenant": tenant})
+}
+
+// ZoneUsage is the pod count and requests of one tenant in one zone and node pool.
+type ZoneUsage struct {
+	Tenant, Tier, Zone, NodePool string
+	Pods                         int32
+	CPUCores, MemoryBytes        float64
+}
+
+// SetZoneUsage replaces the per-zone usage series of all tenants.
+func SetZoneUsage(usage []ZoneUsage) {
+	ZonePodsGauge.Reset()
+	ZoneCPURequestsGauge.Reset()
+	ZoneMemoryRequestsGauge.Reset()
+	for _, u := range usage {
+		ZonePodsGauge.WithLabelValues(u.Tenant, u.Tier, u.Zone, u.NodePool).Set(float64(u.Pods))
+		ZoneCPURequestsGauge.WithLabelValues(u.Tenant, u.Tier, u.Zone, u.NodePool).Set(u.CPUCores)
+		ZoneMemoryRequestsGauge.WithLabelValues(u.Tenant, u.Tier, u.Zone, u.NodePool).Set(u.MemoryBytes)
+	}
+}
+
+// RecordTenantCost adds the cost of one metering sample of a tenant's CPU, memory, and
+// storage.
+func RecordTenantCost(tenant, tier string, cpu, memory, storage float64) {
+	TenantCostCounter.WithLabelValues(tenant, tier, "cpu").Add(cpu)
+	TenantCostCounter.WithLabelValues(tenant, tier, "memory").Add(memory)
+	TenantCostCounter.WithLabelValues(tenant, tier, "storage").Add(storage)
+}
+
+// ResourceUtilization is the CPU and memory consumption of one tenant.
+type ResourceUtilization struct {
+	Tenant, Tier          string
+	CPUCores, MemoryBytes float64
+}
+
+// SetResourceUtilization replaces the utilization series of all tenants.
+func SetResourceUtilization(usage []ResourceUtilization) {
+	ResourceUtilizationGauge.Reset()
+	for _, u := range usage {
+		RecordResourceUtilization(u.Tenant, u.Tier, "cpu", u.CPUCores)
+		RecordResourceUtilization(u.Tenant, u.Tier, "memory", u.MemoryBytes)
+	}
+}
+
+// RecordWebhookAdmission records the outcome and latency of one admission request.
+func RecordWebhookAdmission(webhook, operation string, allowed bool, seconds float64) {
+	WebhookAdmissionsCounter.WithLabelValues(webhook, operation, strconv.FormatBool(allowed)).Inc()
+	WebhookLatencyHistogram.WithLabelValues(webhook, operation).Observe(seconds)
+}
+
+// RecordWebhookDenial records one reason an admission request was denied.
+func RecordWebhookDenial(webhook, reason, field string) {
+	WebhookDenialsCounter.WithLabelValues(webhook, reason, field).Inc()
 }
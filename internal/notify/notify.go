@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify delivers operator notifications, such as scheduled digests, to
+// tenant owners. Email is sent through an SMTP relay; without one, notifications
+// are written to the operator log.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/go-logr/logr"
+)
+
+// Message is a plain-text notification.
+type Message struct {
+	// To lists the recipient email addresses.
+	To []string
+
+	// Subject is the email subject line.
+	Subject string
+
+	// Body is the plain-text message body.
+	Body string
+}
+
+// Notifier delivers messages.
+type Notifier interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPNotifier sends email through an SMTP relay.
+type SMTPNotifier struct {
+	// Addr is the relay address as host:port.
+	Addr string
+
+	// From is the sender address.
+	From string
+
+	// Username and Password enable PLAIN authentication when Username is set.
+	Username string
+	Password string
+}
+
+// Send delivers msg as a single email to all recipients.
+func (n *SMTPNotifier) Send(ctx context.Context, msg Message) error {
+	if len(msg.To) == 0 {
+		return fmt.Errorf("message %q has no recipients", msg.Subject)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if n.Username != "" {
+		host, _, err := net.SplitHostPort(n.Addr)
+		if err != nil {
+			return fmt.Errorf("invalid SMTP address %q: %w", n.Addr, err)
+		}
+		auth = smtp.PlainAuth("", n.Username, n.Password, host)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", n.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	if err := smtp.SendMail(n.Addr, auth, n.From, msg.To, []byte(b.String())); err != nil {
+		return fmt.Errorf("failed to send %q: %w", msg.Subject, err)
+	}
+	return nil
+}
+
+// LogNotifier writes messages to the log instead of delivering them.
+type LogNotifier struct {
+	Log logr.Logger
+}
+
+// Send logs msg.
+func (n *LogNotifier) Send(ctx context.Context, msg Message) error {
+	n.Log.Info("notification (no SMTP relay configured)", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
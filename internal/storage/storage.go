@@ -0,0 +1,191 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package storage stores operator archives, such as tenant snapshots and the audit
+// trail, outside the cluster. Backends are a local directory (e.g. a mounted PVC),
+// S3 or any S3-compatible service, Google Cloud Storage, and Azure Blob Storage.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/amartyaa/tenant-master/operator/internal/config"
+)
+
+// ErrNotFound is returned by Get and Delete when no object has the key.
+var ErrNotFound = errors.New("object not found")
+
+// Storage is a flat object store. Keys are slash-separated paths such as
+// "snapshots/acme/snapshot-acme-1700000000.json".
+type Storage interface {
+	// Put stores data under key, replacing any existing object.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get returns the object stored under key.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// List returns the keys starting with prefix, sorted.
+	List(ctx context.Context, prefix string) ([]string, error)
+
+	// Delete removes the object stored under key.
+	Delete(ctx context.Context, key string) error
+}
+
+// New returns the backend selected by cfg, with credentials read from the environment
+// variables documented on config.StorageConfig. It returns nil without a backend.
+func New(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Backend {
+	case "":
+		return nil, nil
+	case config.StorageFilesystem:
+		if cfg.Path == "" {
+			return nil, errors.New("the Filesystem storage backend needs a path")
+		}
+		return &Filesystem{Root: cfg.Path}, nil
+	case config.StorageS3:
+		if cfg.Bucket == "" {
+			return nil, errors.New("the S3 storage backend needs a bucket")
+		}
+		return &S3{
+			Endpoint:        cfg.Endpoint,
+			Region:          cfg.Region,
+			Bucket:          cfg.Bucket,
+			Prefix:          cfg.Prefix,
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}, nil
+	case config.StorageGCS:
+		if cfg.Bucket == "" {
+			return nil, errors.New("the GCS storage backend needs a bucket")
+		}
+		store := NewGCS(cfg.Bucket, cfg.Prefix, os.Getenv("GCS_HMAC_ACCESS_ID"), os.Getenv("GCS_HMAC_SECRET"))
+		if cfg.Endpoint != "" {
+			store.Endpoint = cfg.Endpoint
+		}
+		return store, nil
+	case config.StorageAzureBlob:
+		if cfg.Bucket == "" {
+			return nil, errors.New("the AzureBlob storage backend needs a container (--storage-bucket)")
+		}
+		return &AzureBlob{
+			Account:    os.Getenv("AZURE_STORAGE_ACCOUNT"),
+			AccountKey: os.Getenv("AZURE_STORAGE_KEY"),
+			Container:  cfg.Bucket,
+			Prefix:     cfg.Prefix,
+			Endpoint:   cfg.Endpoint,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+}
+
+// validateKey rejects keys that are empty or would escape the store root.
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") {
+		return fmt.Errorf("invalid storage key %q", key)
+	}
+	return nil
+}
+
+// Filesystem stores objects as files below a directory, e.g. a PersistentVolume
+// mounted into the operator pod.
+type Filesystem struct {
+	// Root is the directory holding the objects.
+	Root string
+}
+
+// Put writes data to a temporary file and renames it into place, so readers never
+// see a partial object.
+func (s *Filesystem) Put(ctx context.Context, key string, data []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	file := filepath.Join(s.Root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(file), 0o750); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+// Get reads the file stored under key.
+func (s *Filesystem) Get(ctx context.Context, key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(s.Root, filepath.FromSlash(key)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", key, ErrNotFound)
+	}
+	return data, err
+}
+
+// List walks the directory tree below Root.
+func (s *Filesystem) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := filepath.WalkDir(s.Root, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && file == s.Root {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".tmp-") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.Root, file)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	sort.Strings(keys)
+	return keys, err
+}
+
+// Delete removes the file stored under key.
+func (s *Filesystem) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(s.Root, filepath.FromSlash(key)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%s: %w", key, ErrNotFound)
+	}
+	return err
+}
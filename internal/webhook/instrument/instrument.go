@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instrument wraps admission webhooks with Prometheus metrics.
+package instrument
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/amartyaa/tenant-master/operator/internal/metrics"
+)
+
+// Admission operations used as the "operation" metric label.
+const (
+	OperationCreate  = "create"
+	OperationUpdate  = "update"
+	OperationDelete  = "delete"
+	OperationDefault = "default"
+)
+
+// listIndex matches list indices in field paths so the "field" label stays bounded.
+var listIndex = regexp.MustCompile(`\[[^\]]*\]`)
+
+// Validator wraps a CustomValidator so every call records admission metrics under name.
+func Validator(name string, v admission.CustomValidator) admission.CustomValidator {
+	return &validator{name: name, inner: v}
+}
+
+// Defaulter wraps a CustomDefaulter so every call records admission metrics under name.
+func Defaulter(name string, d admission.CustomDefaulter) admission.CustomDefaulter {
+	return &defaulter{name: name, inner: d}
+}
+
+type validator struct {
+	name  string
+	inner admission.CustomValidator
+}
+
+func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	start := time.Now()
+	warnings, err := v.inner.ValidateCreate(ctx, obj)
+	observe(v.name, OperationCreate, start, err)
+	return warnings, err
+}
+
+func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	start := time.Now()
+	warnings, err := v.inner.ValidateUpdate(ctx, oldObj, newObj)
+	observe(v.name, OperationUpdate, start, err)
+	return warnings, err
+}
+
+func (v *validator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	start := time.Now()
+	warnings, err := v.inner.ValidateDelete(ctx, obj)
+	observe(v.name, OperationDelete, start, err)
+	return warnings, err
+}
+
+type defaulter struct {
+	name  string
+	inner admission.CustomDefaulter
+}
+
+func (d *defaulter) Default(ctx context.Context, obj runtime.Object) error {
+	start := time.Now()
+	err := d.inner.Default(ctx, obj)
+	observe(d.name, OperationDefault, start, err)
+	return err
+}
+
+// observe records the outcome, latency, and denial reasons of one admission call.
+func observe(webhook, operation string, start time.Time, err error) {
+	metrics.RecordWebhookAdmission(webhook, operation, err == nil, time.Since(start).Seconds())
+	if err == nil {
+		return
+	}
+
+	var statusErr apierrors.APIStatus
+	if !errors.As(err, &statusErr) {
+		metrics.RecordWebhookDenial(webhook, "Error", "")
+		return
+	}
+
+	status := statusErr.Status()
+	if status.Details == nil || len(status.Details.Causes) == 0 {
+		metrics.RecordWebhookDenial(webhook, string(status.Reason), "")
+		return
+	}
+	for _, cause := range status.Details.Causes {
+		metrics.RecordWebhookDenial(webhook, string(cause.Type), listIndex.ReplaceAllString(cause.Field, "[*]"))
+	}
+}
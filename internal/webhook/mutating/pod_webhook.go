@@ -0,0 +1,257 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutating
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/instrument"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// PodMutatingWebhook labels Pods in tenant namespaces with their tenant and tier, so
+// cost tools, Prometheus, and network observability can attribute every container
+// without relying on namespace naming conventions. It also injects the scheduling
+// hints for the tenant's packing policy, its default tolerations, and its DNS config.
+type PodMutatingWebhook struct {
+	Client client.Client
+}
+
+// +kubebuilder:webhook:path=/mutate--v1-pod,mutating=true,failurePolicy=fail,sideEffects=None,groups="",resources=pods,verbs=create,versions=v1,name=mpod.platform.io,admissionReviewVersions={v1},reinvocationPolicy=IfNeeded,clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+
+func (w *PodMutatingWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&corev1.Pod{}).
+		WithDefaulter(instrument.Defaulter("pod-mutating", w)).
+		Complete()
+}
+
+// Default copies the tenant name and tier labels of the Pod's namespace onto the Pod,
+// overwriting any values set by the workload, and applies the namespace's packing policy,
+// default tolerations, and DNS config.
+func (w *PodMutatingWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+
+	// Pods created by controllers may not carry their namespace yet
+	namespace := pod.Namespace
+	if namespace == "" {
+		if req, err := admission.RequestFromContext(ctx); err == nil {
+			namespace = req.Namespace
+		}
+	}
+
+	ns := &corev1.Namespace{}
+	if err := w.Client.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return apierrors.NewInternalError(fmt.Errorf("failed to fetch namespace %s: %w", namespace, err))
+	}
+	tenantName := ns.Labels[controller.TenantNameLabelKey]
+	if tenantName == "" {
+		if namespace == controller.BronzeNamespace {
+			return w.assignBronzeTenant(ctx, pod, namespace)
+		}
+		return nil
+	}
+
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[controller.TenantNameLabelKey] = tenantName
+	if tier := ns.Labels[controller.TierLabelKey]; tier != "" {
+		pod.Labels[controller.TierLabelKey] = tier
+	}
+	applyPackingPolicy(pod, tenantName, platformv1alpha1.PackingPolicy(ns.Annotations[controller.PackingPolicyAnnotation]))
+	if raw := ns.Annotations[controller.DefaultTolerationsAnnotation]; raw != "" {
+		var tolerations []corev1.Toleration
+		if err := json.Unmarshal([]byte(raw), &tolerations); err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("invalid %s annotation on namespace %s: %w",
+				controller.DefaultTolerationsAnnotation, namespace, err))
+		}
+		addTolerations(pod, tolerations)
+	}
+	if raw := ns.Annotations[controller.DNSConfigAnnotation]; raw != "" {
+		var dnsConfig platformv1alpha1.DNSConfig
+		if err := json.Unmarshal([]byte(raw), &dnsConfig); err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("invalid %s annotation on namespace %s: %w",
+				controller.DNSConfigAnnotation, namespace, err))
+		}
+		addDNSConfig(pod, dnsConfig)
+	}
+	return nil
+}
+
+// assignBronzeTenant labels a Pod in the shared Bronze namespace with the tenant owning
+// its ServiceAccount and gives it the tenant's PriorityClass, which scopes the tenant
+// quota. Only the tenant quotas limit the shared namespace, so Pods running as a
+// ServiceAccount of no tenant, such as default, are rejected, as are Pods a Bronze
+// tenant creates with another tenant's ServiceAccount.
+func (w *PodMutatingWebhook) assignBronzeTenant(ctx context.Context, pod *corev1.Pod, namespace string) error {
+	tenantName, err := w.bronzeServiceAccountTenant(ctx, namespace, pod.Spec.ServiceAccountName)
+	if err != nil {
+		return err
+	}
+	if tenantName == "" {
+		return apierrors.NewForbidden(corev1.Resource("pods"), pod.Name,
+			fmt.Errorf("pods in the shared %s namespace must run as the ServiceAccount of a Bronze tenant, not %q",
+				namespace, pod.Spec.ServiceAccountName))
+	}
+
+	// Workload controllers create Pods on the tenant's behalf; their templates are
+	// checked by the Bronze ownership webhook
+	if req, err := admission.RequestFromContext(ctx); err == nil {
+		if saNamespace, saName, err := serviceaccount.SplitUsername(req.UserInfo.Username); err == nil && saNamespace == namespace {
+			requester, err := w.bronzeServiceAccountTenant(ctx, namespace, saName)
+			if err != nil {
+				return err
+			}
+			if requester != tenantName {
+				return apierrors.NewForbidden(corev1.Resource("pods"), pod.Name,
+					fmt.Errorf("ServiceAccount %q belongs to Bronze tenant %q", pod.Spec.ServiceAccountName, tenantName))
+			}
+		}
+	}
+
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[controller.TenantNameLabelKey] = tenantName
+	pod.Labels[controller.TierLabelKey] = string(platformv1alpha1.BronzeTier)
+
+	// Match the PriorityClass's value and policy, which the Priority admission plugin
+	// resolved for the Pod's original class before this webhook ran
+	zero := int32(0)
+	never := corev1.PreemptNever
+	pod.Spec.PriorityClassName = controller.BronzePriorityClassName(tenantName)
+	pod.Spec.Priority = &zero
+	pod.Spec.PreemptionPolicy = &never
+	return nil
+}
+
+// bronzeServiceAccountTenant returns the tenant label of a ServiceAccount in the shared
+// Bronze namespace, or "" for a missing ServiceAccount or one of no tenant.
+func (w *PodMutatingWebhook) bronzeServiceAccountTenant(ctx context.Context, namespace, name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	sa := &corev1.ServiceAccount{}
+	if err := w.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, sa); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", apierrors.NewInternalError(fmt.Errorf("failed to fetch ServiceAccount %s/%s: %w", namespace, name, err))
+	}
+	return sa.Labels[controller.TenantNameLabelKey], nil
+}
+
+// maxPodNameservers is the API server's limit on nameservers in a Pod's dnsConfig.
+const maxPodNameservers = 3
+
+// addDNSConfig appends the tenant's nameservers and search domains the Pod does not
+// already have. Pods with dnsPolicy None define their DNS config completely and are
+// left alone.
+func addDNSConfig(pod *corev1.Pod, dnsConfig platformv1alpha1.DNSConfig) {
+	if pod.Spec.DNSPolicy == corev1.DNSNone {
+		return
+	}
+	if pod.Spec.DNSConfig == nil {
+		pod.Spec.DNSConfig = &corev1.PodDNSConfig{}
+	}
+	for _, nameserver := range dnsConfig.Nameservers {
+		if len(pod.Spec.DNSConfig.Nameservers) < maxPodNameservers && !slices.Contains(pod.Spec.DNSConfig.Nameservers, nameserver) {
+			pod.Spec.DNSConfig.Nameservers = append(pod.Spec.DNSConfig.Nameservers, nameserver)
+		}
+	}
+	for _, search := range dnsConfig.Searches {
+		if !slices.Contains(pod.Spec.DNSConfig.Searches, search) {
+			pod.Spec.DNSConfig.Searches = append(pod.Spec.DNSConfig.Searches, search)
+		}
+	}
+}
+
+// addTolerations appends the default tolerations the Pod does not already have, like the
+// PodTolerationRestriction admission plugin.
+func addTolerations(pod *corev1.Pod, defaults []corev1.Toleration) {
+	for i := range defaults {
+		found := false
+		for j := range pod.Spec.Tolerations {
+			if pod.Spec.Tolerations[j].MatchToleration(&defaults[i]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			pod.Spec.Tolerations = append(pod.Spec.Tolerations, defaults[i])
+		}
+	}
+}
+
+// applyPackingPolicy adds a preferred affinity to the tenant's other pods (BinPack) or a
+// best-effort spread across nodes (Spread). Pods that already set pod affinity or
+// topology spread constraints are left alone.
+func applyPackingPolicy(pod *corev1.Pod, tenantName string, policy platformv1alpha1.PackingPolicy) {
+	tenantSelector := func() *metav1.LabelSelector {
+		return &metav1.LabelSelector{MatchLabels: map[string]string{controller.TenantNameLabelKey: tenantName}}
+	}
+
+	switch policy {
+	case platformv1alpha1.PackingPolicyBinPack:
+		if pod.Spec.Affinity != nil && pod.Spec.Affinity.PodAffinity != nil {
+			return
+		}
+		if pod.Spec.Affinity == nil {
+			pod.Spec.Affinity = &corev1.Affinity{}
+		}
+		// Count pods in all the tenant's namespaces, e.g. its environments
+		pod.Spec.Affinity.PodAffinity = &corev1.PodAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight: 100,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					LabelSelector:     tenantSelector(),
+					NamespaceSelector: tenantSelector(),
+					TopologyKey:       corev1.LabelHostname,
+				},
+			}},
+		}
+	case platformv1alpha1.PackingPolicySpread:
+		if len(pod.Spec.TopologySpreadConstraints) > 0 {
+			return
+		}
+		pod.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelHostname,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     tenantSelector(),
+		}}
+	}
+}
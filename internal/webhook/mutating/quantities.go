@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutating
+
+import (
+	"fmt"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// binaryUnits are the memory suffixes the CRD accepts, largest first.
+var binaryUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"Ti", 1 << 40},
+	{"Gi", 1 << 30},
+	{"Mi", 1 << 20},
+}
+
+// normalizeQuantities rewrites the tenant's CPU and memory quantities in canonical
+// form, millicores for CPU (e.g. "2" becomes "2000m") and the largest whole binary
+// unit for memory and storage (e.g. "2048Mi" becomes "2Gi"), so status, quota, and
+// cost calculations compare like with like. It rejects CPU finer than a millicore,
+// which the quota would round up, and a zero CPU or memory quota, which would block
+// every pod. Unparseable values are left for the validating webhook to report.
+func normalizeQuantities(spec *platformv1alpha1.TenantSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	resourcesPath := field.NewPath("spec", "resources")
+
+	allErrs = append(allErrs, normalizeCPU(resourcesPath.Child("cpu"), &spec.Resources.CPU, true)...)
+	allErrs = append(allErrs, normalizeMemory(resourcesPath.Child("memory"), &spec.Resources.Memory, true)...)
+	allErrs = append(allErrs, normalizeMemory(resourcesPath.Child("storage"), &spec.Resources.Storage, false)...)
+	if requests := spec.Resources.Requests; requests != nil {
+		allErrs = append(allErrs, normalizeCPU(resourcesPath.Child("requests", "cpu"), &requests.CPU, true)...)
+		allErrs = append(allErrs, normalizeMemory(resourcesPath.Child("requests", "memory"), &requests.Memory, true)...)
+	}
+	if limits := spec.Resources.Limits; limits != nil {
+		allErrs = append(allErrs, normalizeCPU(resourcesPath.Child("limits", "cpu"), &limits.CPU, true)...)
+		allErrs = append(allErrs, normalizeMemory(resourcesPath.Child("limits", "memory"), &limits.Memory, true)...)
+	}
+	if burst := spec.Resources.Burst; burst != nil {
+		allErrs = append(allErrs, normalizeCPU(resourcesPath.Child("burst", "cpu"), &burst.CPU, false)...)
+		allErrs = append(allErrs, normalizeMemory(resourcesPath.Child("burst", "memory"), &burst.Memory, false)...)
+	}
+	for i := range spec.Quotas.ByPriorityClass {
+		pcq := &spec.Quotas.ByPriorityClass[i]
+		path := field.NewPath("spec", "quotas", "byPriorityClass").Index(i)
+		allErrs = append(allErrs, normalizeCPU(path.Child("cpu"), &pcq.CPU, false)...)
+		allErrs = append(allErrs, normalizeMemory(path.Child("memory"), &pcq.Memory, false)...)
+	}
+	if spec.VCluster != nil && spec.VCluster.Persistence != nil {
+		path := field.NewPath("spec", "vcluster", "persistence", "size")
+		allErrs = append(allErrs, normalizeMemory(path, &spec.VCluster.Persistence.Size, false)...)
+	}
+	return allErrs
+}
+
+// normalizeCPU rewrites value in millicores.
+func normalizeCPU(path *field.Path, value *string, positive bool) field.ErrorList {
+	if *value == "" {
+		return nil
+	}
+	qty, err := resource.ParseQuantity(*value)
+	if err != nil {
+		return nil
+	}
+	milli := qty.MilliValue()
+	if resource.NewMilliQuantity(milli, resource.DecimalSI).Cmp(qty) != 0 {
+		return field.ErrorList{field.Invalid(path, *value, "must be a whole number of millicores, e.g. 1500m")}
+	}
+	if positive && milli <= 0 {
+		return field.ErrorList{field.Invalid(path, *value, "must be greater than zero")}
+	}
+	*value = fmt.Sprintf("%dm", milli)
+	return nil
+}
+
+// normalizeMemory rewrites value in the largest binary unit that divides it. Values
+// that are not a whole number of Mi are left for the CRD pattern to reject.
+func normalizeMemory(path *field.Path, value *string, positive bool) field.ErrorList {
+	if *value == "" {
+		return nil
+	}
+	qty, err := resource.ParseQuantity(*value)
+	if err != nil {
+		return nil
+	}
+	if positive && qty.Sign() <= 0 {
+		return field.ErrorList{field.Invalid(path, *value, "must be greater than zero")}
+	}
+	bytes, ok := qty.AsInt64()
+	if !ok {
+		return nil
+	}
+	if bytes == 0 {
+		*value = "0Mi"
+		return nil
+	}
+	for _, unit := range binaryUnits {
+		if bytes%unit.bytes == 0 {
+			*value = fmt.Sprintf("%d%s", bytes/unit.bytes, unit.suffix)
+			return nil
+		}
+	}
+	return nil
+}
@@ -18,25 +18,39 @@ package mutating
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
 	"strings"
 
 	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/instrument"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 var log = logf.Log.WithName("tenant-mutating-webhook")
 
-// TenantMutatingWebhook implements the mutating webhook for Tenants.
-type TenantMutatingWebhook struct{}
+// TenantMutatingWebhook implements the mutating webhook for Tenants. Client reads the
+// TenantTemplates referenced by spec.templateRef and spec.profileRef.
+type TenantMutatingWebhook struct {
+	Client client.Client
+}
 
 // +kubebuilder:webhook:path=/mutate-platform-io-v1alpha1-tenant,mutating=true,failurePolicy=fail,sideEffects=None,groups=platform.io,resources=tenants,verbs=create;update,versions=v1alpha1,name=mtenant.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+// +kubebuilder:rbac:groups=platform.io,resources=tenanttemplates,verbs=get;list;watch
 
 func (w *TenantMutatingWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&platformv1alpha1.Tenant{}).
-		WithDefaulter(w).
+		WithDefaulter(instrument.Defaulter("tenant-mutating", w)).
 		Complete()
 }
 
@@ -49,12 +63,23 @@ func (w *TenantMutatingWebhook) Default(ctx context.Context, obj runtime.Object)
 
 	log.Info("mutating webhook called", "tenant", tenant.Name)
 
+	// Merge the template before defaulting, so its values win over the built-in defaults
+	if err := w.applyTemplate(ctx, tenant); err != nil {
+		return err
+	}
+
 	// Default tier to Silver if not specified
 	if tenant.Spec.Tier == "" {
 		log.Info("defaulting tier to Silver", "tenant", tenant.Name)
 		tenant.Spec.Tier = platformv1alpha1.SilverTier
 	}
 
+	// Mirror the tier into a label so large tenant lists can be filtered server-side
+	if tenant.Labels == nil {
+		tenant.Labels = map[string]string{}
+	}
+	tenant.Labels[controller.TierLabelKey] = string(tenant.Spec.Tier)
+
 	// Normalize owner email to lowercase
 	if tenant.Spec.Owner != "" {
 		tenant.Spec.Owner = strings.ToLower(tenant.Spec.Owner)
@@ -67,6 +92,13 @@ func (w *TenantMutatingWebhook) Default(ctx context.Context, obj runtime.Object)
 	if tenant.Spec.Resources.Memory == "" {
 		tenant.Spec.Resources.Memory = "1Gi"
 	}
+	if allErrs := normalizeQuantities(&tenant.Spec); len(allErrs) > 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: platformv1alpha1.GroupVersion.Group, Kind: "Tenant"},
+			tenant.Name,
+			allErrs,
+		)
+	}
 
 	// Set default network config
 	if len(tenant.Spec.Network.WhitelistedServices) == 0 {
@@ -77,3 +109,195 @@ func (w *TenantMutatingWebhook) Default(ctx context.Context, obj runtime.Object)
 	log.Info("mutating webhook completed", "tenant", tenant.Name, "tier", tenant.Spec.Tier)
 	return nil
 }
+
+// maxTemplateChain bounds the TenantTemplates a single profileRef chain may contain.
+const maxTemplateChain = 8
+
+// applyTemplate merges the TenantTemplates named by spec.profileRef and spec.templateRef,
+// and their parent profiles, into a Tenant being created. The precedence is profile <
+// template < Tenant spec, and a template overrides the profile it names. The merged
+// templates are recorded in an annotation the controller copies to status. Updates are
+// left alone, so editing a template never changes existing Tenants.
+func (w *TenantMutatingWebhook) applyTemplate(ctx context.Context, tenant *platformv1alpha1.Tenant) error {
+	if tenant.Spec.TemplateRef == nil && tenant.Spec.ProfileRef == nil {
+		return nil
+	}
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.Operation != admissionv1.Create {
+		return nil
+	}
+
+	profiles, err := w.templateChain(ctx, "spec.profileRef", tenant.Spec.ProfileRef)
+	if err != nil {
+		return err
+	}
+	templates, err := w.templateChain(ctx, "spec.templateRef", tenant.Spec.TemplateRef)
+	if err != nil {
+		return err
+	}
+
+	// Fold the chains from the lowest precedence up, then fill the Tenant from the result
+	effective := &platformv1alpha1.TenantPresetSpec{}
+	var applied []platformv1alpha1.AppliedTemplate
+	for _, chain := range []struct {
+		via       string
+		templates []platformv1alpha1.TenantTemplate
+	}{{"profileRef", profiles}, {"templateRef", templates}} {
+		for i := len(chain.templates) - 1; i >= 0; i-- {
+			overlayPreset(effective, &chain.templates[i].Spec)
+			applied = append(applied, platformv1alpha1.AppliedTemplate{Name: chain.templates[i].Name, Via: chain.via})
+		}
+	}
+
+	log.Info("applying tenant templates", "tenant", tenant.Name, "templates", applied)
+	mergeTemplate(tenant, effective)
+
+	raw, err := json.Marshal(applied)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to encode applied templates: %w", err))
+	}
+	if tenant.Annotations == nil {
+		tenant.Annotations = map[string]string{}
+	}
+	tenant.Annotations[controller.AppliedTemplatesAnnotation] = string(raw)
+	return nil
+}
+
+// templateChain fetches the TenantTemplate named by ref and the parents named by their
+// spec.profileRef, highest precedence first. Unknown templates and cycles are rejected.
+func (w *TenantMutatingWebhook) templateChain(ctx context.Context, path string, ref *platformv1alpha1.TenantTemplateReference) ([]platformv1alpha1.TenantTemplate, error) {
+	var chain []platformv1alpha1.TenantTemplate
+	var names []string
+	for ref != nil {
+		name := ref.Name
+		if slices.Contains(names, name) {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("%s: TenantTemplate profiles form a cycle: %s -> %s",
+				path, strings.Join(names, " -> "), name))
+		}
+		if len(names) == maxTemplateChain {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("%s: TenantTemplate profile chain is longer than %d templates",
+				path, maxTemplateChain))
+		}
+
+		template := platformv1alpha1.TenantTemplate{}
+		if err := w.Client.Get(ctx, client.ObjectKey{Name: name}, &template); err != nil {
+			if apierrors.IsNotFound(err) {
+				if len(names) > 0 {
+					return nil, apierrors.NewBadRequest(fmt.Sprintf("%s: TenantTemplate %q, the profile of %q, not found",
+						path, name, names[len(names)-1]))
+				}
+				return nil, apierrors.NewBadRequest(fmt.Sprintf("%s: TenantTemplate %q not found", path, name))
+			}
+			return nil, apierrors.NewInternalError(fmt.Errorf("failed to fetch TenantTemplate %s: %w", name, err))
+		}
+		chain = append(chain, template)
+		names = append(names, name)
+		ref = template.Spec.ProfileRef
+	}
+	return chain, nil
+}
+
+// overlayPreset sets the fields of dst that src sets, so src takes precedence. As in
+// mergeTemplate, internet and external service access are allowed when either allows them.
+func overlayPreset(dst, src *platformv1alpha1.TenantPresetSpec) {
+	if src.Tier != "" {
+		dst.Tier = src.Tier
+	}
+
+	res := &dst.Resources
+	if src.Resources.CPU != "" {
+		res.CPU = src.Resources.CPU
+	}
+	if src.Resources.Memory != "" {
+		res.Memory = src.Resources.Memory
+	}
+	if src.Resources.Storage != "" {
+		res.Storage = src.Resources.Storage
+	}
+	if src.Resources.StorageClass != "" {
+		res.StorageClass = src.Resources.StorageClass
+	}
+	if src.Resources.Burst != nil {
+		res.Burst = src.Resources.Burst.DeepCopy()
+	}
+
+	network := &dst.Network
+	network.AllowInternetAccess = network.AllowInternetAccess || src.Network.AllowInternetAccess
+	network.AllowExternalServices = network.AllowExternalServices || src.Network.AllowExternalServices
+	if len(src.Network.WhitelistedServices) > 0 {
+		network.WhitelistedServices = slices.Clone(src.Network.WhitelistedServices)
+	}
+	if len(src.Network.AllowedCIDRs) > 0 {
+		network.AllowedCIDRs = slices.Clone(src.Network.AllowedCIDRs)
+	}
+	if len(src.Network.DeniedCIDRs) > 0 {
+		network.DeniedCIDRs = slices.Clone(src.Network.DeniedCIDRs)
+	}
+	if len(src.Network.AllowedIngressNamespaces) > 0 {
+		network.AllowedIngressNamespaces = slices.Clone(src.Network.AllowedIngressNamespaces)
+	}
+	if src.Network.DNSConfig != nil {
+		network.DNSConfig = src.Network.DNSConfig.DeepCopy()
+	}
+
+	for k, v := range src.Labels {
+		if dst.Labels == nil {
+			dst.Labels = map[string]string{}
+		}
+		dst.Labels[k] = v
+	}
+}
+
+// mergeTemplate fills the fields the Tenant leaves empty from preset. The CRD defaults
+// spec.tier to Silver before admission, so the template tier replaces Silver only.
+func mergeTemplate(tenant *platformv1alpha1.Tenant, preset *platformv1alpha1.TenantPresetSpec) {
+	spec := &tenant.Spec
+	if preset.Tier != "" && (spec.Tier == "" || spec.Tier == platformv1alpha1.SilverTier) {
+		spec.Tier = preset.Tier
+	}
+
+	res := &spec.Resources
+	if res.CPU == "" {
+		res.CPU = preset.Resources.CPU
+	}
+	if res.Memory == "" {
+		res.Memory = preset.Resources.Memory
+	}
+	if res.Storage == "" {
+		res.Storage = preset.Resources.Storage
+	}
+	if res.StorageClass == "" {
+		res.StorageClass = preset.Resources.StorageClass
+	}
+	if res.Burst == nil && preset.Resources.Burst != nil {
+		res.Burst = preset.Resources.Burst.DeepCopy()
+	}
+
+	network := &spec.Network
+	network.AllowInternetAccess = network.AllowInternetAccess || preset.Network.AllowInternetAccess
+	network.AllowExternalServices = network.AllowExternalServices || preset.Network.AllowExternalServices
+	if len(network.WhitelistedServices) == 0 {
+		network.WhitelistedServices = slices.Clone(preset.Network.WhitelistedServices)
+	}
+	if len(network.AllowedCIDRs) == 0 {
+		network.AllowedCIDRs = slices.Clone(preset.Network.AllowedCIDRs)
+	}
+	if len(network.DeniedCIDRs) == 0 {
+		network.DeniedCIDRs = slices.Clone(preset.Network.DeniedCIDRs)
+	}
+	if len(network.AllowedIngressNamespaces) == 0 {
+		network.AllowedIngressNamespaces = slices.Clone(preset.Network.AllowedIngressNamespaces)
+	}
+	if network.DNSConfig == nil && preset.Network.DNSConfig != nil {
+		network.DNSConfig = preset.Network.DNSConfig.DeepCopy()
+	}
+
+	for k, v := range preset.Labels {
+		if _, ok := tenant.Labels[k]; ok {
+			continue
+		}
+		if tenant.Labels == nil {
+			tenant.Labels = map[string]string{}
+		}
+		tenant.Labels[k] = v
+	}
+}
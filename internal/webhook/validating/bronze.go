@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// isBronzeNamespace reports whether namespace is the namespace shared by all Bronze
+// tenants. It carries the Bronze tier label but, belonging to no single tenant, no
+// tenant name label, so tenantForNamespace cannot resolve its objects.
+func isBronzeNamespace(ctx context.Context, c client.Client, namespace string) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to fetch namespace %s: %w", namespace, err)
+	}
+	return ns.Labels[controller.TierLabelKey] == string(platformv1alpha1.BronzeTier) &&
+		ns.Labels[controller.TenantNameLabelKey] == "", nil
+}
+
+// bronzeTenantForServiceAccount resolves the Bronze tenant owning a ServiceAccount in
+// the shared Bronze namespace via its tenant label. It returns nil for ServiceAccounts
+// of no tenant, such as the namespace's default ServiceAccount.
+func bronzeTenantForServiceAccount(ctx context.Context, c client.Client, name string) (*platformv1alpha1.Tenant, error) {
+	tenantName, err := bronzeServiceAccountTenant(ctx, c, name)
+	if err != nil || tenantName == "" {
+		return nil, err
+	}
+
+	tenant := &platformv1alpha1.Tenant{}
+	if err := c.Get(ctx, client.ObjectKey{Name: tenantName}, tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch tenant %s: %w", tenantName, err)
+	}
+	return tenant, nil
+}
+
+// bronzeServiceAccountTenant returns the tenant label of a ServiceAccount in the shared
+// Bronze namespace, or "" for a missing ServiceAccount or one of no tenant.
+func bronzeServiceAccountTenant(ctx context.Context, c client.Client, name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	sa := &corev1.ServiceAccount{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: controller.BronzeNamespace, Name: name}, sa); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to fetch ServiceAccount %s/%s: %w", controller.BronzeNamespace, name, err)
+	}
+	return sa.Labels[controller.TenantNameLabelKey], nil
+}
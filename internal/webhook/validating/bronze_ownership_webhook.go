@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/instrument"
+)
+
+// bronzeOwnershipPath serves every kind the Bronze Role grants, decoded as unstructured
+// objects, so it does not clash with the typed Pod, Service, and ConfigMap webhooks.
+const bronzeOwnershipPath = "/validate-bronze-ownership"
+
+// BronzeOwnershipValidatingWebhook keeps Bronze tenants sharing a namespace apart. RBAC
+// cannot match labels, so every Bronze tenant's Role covers the whole shared namespace;
+// this webhook only lets a Bronze tenant's ServiceAccount change objects labeled with
+// its own tenant name, and run workloads only as itself. Reads are not admission
+// requests, so tenants can still see each other's objects.
+type BronzeOwnershipValidatingWebhook struct {
+	Client client.Client
+}
+
+// +kubebuilder:webhook:path=/validate-bronze-ownership,mutating=false,failurePolicy=fail,sideEffects=None,groups="";apps;batch,resources=pods;pods/ephemeralcontainers;services;configmaps;persistentvolumeclaims;deployments;statefulsets;jobs;cronjobs,verbs=create;update;delete,versions=v1,name=vbronzeownership.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+
+func (w *BronzeOwnershipValidatingWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(bronzeOwnershipPath, admission.WithCustomValidator(
+		mgr.GetScheme(), &unstructured.Unstructured{}, instrument.Validator("bronze-ownership", w)))
+	return nil
+}
+
+// ValidateCreate implements the create validation logic.
+func (w *BronzeOwnershipValidatingWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(ctx, obj, nil)
+}
+
+// ValidateUpdate implements the update validation logic. The old object must be the
+// tenant's too, so tenants cannot relabel another tenant's object as their own.
+func (w *BronzeOwnershipValidatingWebhook) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(ctx, newObj, oldObj)
+}
+
+// ValidateDelete implements the delete validation logic.
+func (w *BronzeOwnershipValidatingWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(ctx, nil, obj)
+}
+
+// validate rejects the request if the requester is a Bronze tenant's ServiceAccount and
+// obj or oldObj is not labeled with its tenant, or obj runs Pods as another
+// ServiceAccount. Other requesters, such as workload controllers, are not checked.
+func (w *BronzeOwnershipValidatingWebhook) validate(ctx context.Context, obj, oldObj runtime.Object) error {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	saNamespace, saName, err := serviceaccount.SplitUsername(req.UserInfo.Username)
+	if err != nil || saNamespace != controller.BronzeNamespace {
+		return nil
+	}
+	tenantName, err := bronzeServiceAccountTenant(ctx, w.Client, saName)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if tenantName == "" {
+		return nil
+	}
+
+	gr := schema.GroupResource{Group: req.Resource.Group, Resource: req.Resource.Resource}
+	for _, o := range []runtime.Object{oldObj, obj} {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || u.GetLabels()[controller.TenantNameLabelKey] == tenantName {
+			continue
+		}
+		log.Info("rejected change to an object of another Bronze tenant", "kind", req.Kind.Kind, "name", u.GetName(),
+			"operation", req.Operation, "tenant", tenantName)
+		return apierrors.NewForbidden(gr, u.GetName(),
+			fmt.Errorf("Bronze tenant %q may only change objects labeled %s=%s in the shared %s namespace",
+				tenantName, controller.TenantNameLabelKey, tenantName, controller.BronzeNamespace))
+	}
+
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	if templateSA, isWorkload := podTemplateServiceAccount(u); isWorkload && templateSA != saName {
+		log.Info("rejected Bronze workload running as another ServiceAccount", "kind", req.Kind.Kind, "name", u.GetName(),
+			"serviceAccount", templateSA, "tenant", tenantName)
+		return apierrors.NewForbidden(gr, u.GetName(),
+			fmt.Errorf("workloads of Bronze tenant %q must run as ServiceAccount %q, not %q", tenantName, saName, templateSA))
+	}
+	return nil
+}
+
+// podTemplateServiceAccount returns the ServiceAccount a workload's Pod template runs as,
+// and whether obj is a workload at all. Pods are checked by the Pod mutating webhook.
+func podTemplateServiceAccount(obj *unstructured.Unstructured) (string, bool) {
+	var path []string
+	switch obj.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Group: "apps", Kind: "Deployment"},
+		schema.GroupKind{Group: "apps", Kind: "StatefulSet"},
+		schema.GroupKind{Group: "batch", Kind: "Job"}:
+		path = []string{"spec", "template", "spec", "serviceAccountName"}
+	case schema.GroupKind{Group: "batch", Kind: "CronJob"}:
+		path = []string{"spec", "jobTemplate", "spec", "template", "spec", "serviceAccountName"}
+	default:
+		return "", false
+	}
+	name, _, _ := unstructured.NestedString(obj.Object, path...)
+	return name, true
+}
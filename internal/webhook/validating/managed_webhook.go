@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/instrument"
+)
+
+// managedObjectExemptGroups may change operator-managed objects: kube-system
+// controllers such as the garbage collector and namespace controller, and cluster admins.
+var managedObjectExemptGroups = []string{
+	"system:serviceaccounts:kube-system",
+	"system:masters",
+}
+
+// managedObjectExemptUsers may change operator-managed objects too: the controller
+// manager runs its controllers as itself unless --use-service-account-credentials is set.
+var managedObjectExemptUsers = []string{
+	"system:kube-controller-manager",
+}
+
+// ManagedObjectValidatingWebhook prevents tenants from changing or deleting the objects
+// the operator manages in their namespaces, such as the ResourceQuota, LimitRanges, and
+// NetworkPolicies that enforce their tier.
+type ManagedObjectValidatingWebhook struct {
+	// ExemptUsers are usernames (typically the operator ServiceAccount) allowed to
+	// change operator-managed objects.
+	ExemptUsers []string
+}
+
+// +kubebuilder:webhook:path=/validate--v1-serviceaccount,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=serviceaccounts,verbs=create;update;delete,versions=v1,name=vserviceaccount.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+// +kubebuilder:webhook:path=/validate--v1-configmap,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=configmaps,verbs=create;update;delete,versions=v1,name=vconfigmap.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+// +kubebuilder:webhook:path=/validate--v1-secret,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=secrets,verbs=create;update;delete,versions=v1,name=vsecret.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+// +kubebuilder:webhook:path=/validate--v1-resourcequota,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=resourcequotas,verbs=create;update;delete,versions=v1,name=vresourcequota.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+// +kubebuilder:webhook:path=/validate--v1-limitrange,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=limitranges,verbs=create;update;delete,versions=v1,name=vlimitrange.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+// +kubebuilder:webhook:path=/validate-networking-k8s-io-v1-networkpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=networking.k8s.io,resources=networkpolicies,verbs=create;update;delete,versions=v1,name=vnetworkpolicy.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+
+func (w *ManagedObjectValidatingWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	for _, obj := range []client.Object{
+		&corev1.ServiceAccount{},
+		&corev1.ConfigMap{},
+		&corev1.Secret{},
+		&corev1.ResourceQuota{},
+		&corev1.LimitRange{},
+		&networkingv1.NetworkPolicy{},
+	} {
+		gvk, err := apiutil.GVKForObject(obj, mgr.GetScheme())
+		if err != nil {
+			return err
+		}
+		if err := ctrl.NewWebhookManagedBy(mgr).
+			For(obj).
+			WithValidator(instrument.Validator("managed-"+gvk.Kind, w)).
+			Complete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateCreate implements the create validation logic.
+func (w *ManagedObjectValidatingWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, protectManagedObject(ctx, w.ExemptUsers, obj)
+}
+
+// ValidateUpdate implements the update validation logic.
+func (w *ManagedObjectValidatingWebhook) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, protectManagedObject(ctx, w.ExemptUsers, oldObj, newObj)
+}
+
+// ValidateDelete implements the delete validation logic.
+func (w *ManagedObjectValidatingWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, protectManagedObject(ctx, w.ExemptUsers, obj)
+}
+
+// protectManagedObject rejects the request if any of objs is labeled as managed by
+// the operator and the requester is not exempt.
+func protectManagedObject(ctx context.Context, exemptUsers []string, objs ...runtime.Object) error {
+	for _, obj := range objs {
+		accessor, err := meta.Accessor(obj)
+		if err != nil || accessor.GetLabels()[controller.ManagedByLabelKey] != controller.ManagedByValue {
+			continue
+		}
+		req, err := admission.RequestFromContext(ctx)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if slices.Contains(exemptUsers, req.UserInfo.Username) || slices.Contains(managedObjectExemptUsers, req.UserInfo.Username) ||
+			slices.ContainsFunc(req.UserInfo.Groups, func(g string) bool { return slices.Contains(managedObjectExemptGroups, g) }) {
+			return nil
+		}
+
+		log.Info("rejected change to operator-managed object", "kind", req.Kind.Kind, "namespace", accessor.GetNamespace(),
+			"name", accessor.GetName(), "operation", req.Operation, "user", req.UserInfo.Username)
+		return apierrors.NewForbidden(
+			schema.GroupResource{Group: req.Resource.Group, Resource: req.Resource.Resource},
+			accessor.GetName(),
+			fmt.Errorf("the object is managed by the tenant operator; change the Tenant instead"),
+		)
+	}
+	return nil
+}
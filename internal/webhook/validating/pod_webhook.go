@@ -0,0 +1,193 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/instrument"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// PodValidatingWebhook rejects privileged containers, hostNetwork, and hostPath volumes
+// in Bronze and Silver tenant namespaces; Silver tenants may opt out with admin approval.
+type PodValidatingWebhook struct {
+	Client client.Client
+}
+
+// +kubebuilder:webhook:path=/validate--v1-pod,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=pods;pods/ephemeralcontainers,verbs=create;update,versions=v1,name=vpod.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+
+func (w *PodValidatingWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&corev1.Pod{}).
+		WithValidator(instrument.Validator("pod", w)).
+		Complete()
+}
+
+// ValidateCreate implements the create validation logic.
+func (w *PodValidatingWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, nil
+	}
+	return nil, w.validatePod(ctx, pod, validatePodSecurity(&pod.Spec))
+}
+
+// ValidateUpdate implements the update validation logic. The webhook only receives
+// updates of the pods/ephemeralcontainers subresource, e.g. from "kubectl debug"; the
+// other checked fields are immutable on running Pods.
+func (w *PodValidatingWebhook) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	oldPod, ok := oldObj.(*corev1.Pod)
+	if !ok {
+		return nil, nil
+	}
+	pod, ok := newObj.(*corev1.Pod)
+	if !ok {
+		return nil, nil
+	}
+	return nil, w.validatePod(ctx, pod, validateEphemeralContainers(oldPod, pod))
+}
+
+// ValidateDelete implements the delete validation logic (always allowed).
+func (w *PodValidatingWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validatePod rejects the host access and privileged settings in allErrs for tenants
+// that may not use them. In the shared Bronze namespace the tenant is resolved from the
+// Pod's ServiceAccount, and Pods of no tenant are rejected as well.
+func (w *PodValidatingWebhook) validatePod(ctx context.Context, pod *corev1.Pod, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	tenant, err := tenantForNamespace(ctx, w.Client, pod.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if tenant == nil {
+		// Pods in the shared Bronze namespace belong to the tenant of their ServiceAccount
+		bronze, err := isBronzeNamespace(ctx, w.Client, pod.Namespace)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if !bronze {
+			return nil
+		}
+		if tenant, err = bronzeTenantForServiceAccount(ctx, w.Client, pod.Spec.ServiceAccountName); err != nil {
+			return apierrors.NewInternalError(err)
+		}
+	}
+	if tenant != nil && privilegedWorkloadsAllowed(tenant) {
+		return nil
+	}
+
+	var reason string
+	switch {
+	case tenant == nil:
+		reason = fmt.Sprintf("privileged workloads are not allowed in the shared %s namespace", controller.BronzeNamespace)
+	case tenant.Spec.Tier == platformv1alpha1.BronzeTier:
+		reason = fmt.Sprintf("Tenant %q (Bronze tier) shares a namespace and cannot run privileged workloads", tenant.Name)
+	default:
+		reason = fmt.Sprintf("Tenant %q (%s tier) does not allow privileged workloads; a cluster admin must approve spec.security.allowPrivileged",
+			tenant.Name, tenant.Spec.Tier)
+	}
+	log.Info("rejected privileged Pod", "namespace", pod.Namespace, "pod", pod.Name, "reason", reason)
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: corev1.GroupName, Kind: "Pod"},
+		pod.Name,
+		append(allErrs, field.Forbidden(field.NewPath("tenant"), reason)),
+	)
+}
+
+// validatePodSecurity lists the host access and privileged settings used by a Pod spec.
+func validatePodSecurity(spec *corev1.PodSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if spec.HostNetwork {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("hostNetwork"), "host networking is not allowed"))
+	}
+	for i, vol := range spec.Volumes {
+		if vol.HostPath != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("volumes").Index(i).Child("hostPath"),
+				"hostPath volumes are not allowed"))
+		}
+	}
+
+	for i := range spec.InitContainers {
+		allErrs = append(allErrs, validatePrivileged(specPath.Child("initContainers").Index(i), spec.InitContainers[i].SecurityContext)...)
+	}
+	for i := range spec.Containers {
+		allErrs = append(allErrs, validatePrivileged(specPath.Child("containers").Index(i), spec.Containers[i].SecurityContext)...)
+	}
+	for i := range spec.EphemeralContainers {
+		allErrs = append(allErrs, validatePrivileged(specPath.Child("ephemeralContainers").Index(i), spec.EphemeralContainers[i].SecurityContext)...)
+	}
+
+	return allErrs
+}
+
+// validateEphemeralContainers lists the privileged ephemeral containers an update adds
+// to a Pod. Containers already running are not re-checked, so revoking an opt-out does
+// not block later debug sessions on other containers of the Pod.
+func validateEphemeralContainers(oldPod, pod *corev1.Pod) field.ErrorList {
+	existing := map[string]bool{}
+	for _, c := range oldPod.Spec.EphemeralContainers {
+		existing[c.Name] = true
+	}
+	var allErrs field.ErrorList
+	for i, c := range pod.Spec.EphemeralContainers {
+		if !existing[c.Name] {
+			allErrs = append(allErrs, validatePrivileged(field.NewPath("spec", "ephemeralContainers").Index(i), c.SecurityContext)...)
+		}
+	}
+	return allErrs
+}
+
+// validatePrivileged rejects a privileged container security context.
+func validatePrivileged(path *field.Path, sc *corev1.SecurityContext) field.ErrorList {
+	if sc != nil && sc.Privileged != nil && *sc.Privileged {
+		return field.ErrorList{field.Forbidden(path.Child("securityContext", "privileged"),
+			"privileged containers are not allowed")}
+	}
+	return nil
+}
+
+// privilegedWorkloadsAllowed reports whether a tenant may run privileged Pods. Gold
+// tenants are isolated by their vCluster; Silver tenants need the
+// spec.security.allowPrivileged opt-out plus an admin approval annotation. Bronze
+// tenants share a namespace and never may.
+func privilegedWorkloadsAllowed(tenant *platformv1alpha1.Tenant) bool {
+	switch tenant.Spec.Tier {
+	case platformv1alpha1.GoldTier:
+		return true
+	case platformv1alpha1.BronzeTier:
+		return false
+	}
+	return tenant.Spec.Security.AllowPrivileged && tenant.Annotations[controller.PrivilegedApprovedByAnnotation] != ""
+}
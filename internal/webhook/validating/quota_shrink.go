@@ -0,0 +1,202 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// quotaShrinkCheck maps a ResourceQuota entry to the spec.resources field that sets it.
+type quotaShrinkCheck struct {
+	resource corev1.ResourceName
+	field    func(platformv1alpha1.ResourceRequirements) (string, string)
+}
+
+// cpuRequestField returns the field setting the CPU request: requests.cpu, else cpu.
+func cpuRequestField(r platformv1alpha1.ResourceRequirements) (string, string) {
+	if r.Requests != nil && r.Requests.CPU != "" {
+		return "requests.cpu", r.Requests.CPU
+	}
+	return "cpu", r.CPU
+}
+
+// memoryRequestField returns the field setting the memory request: requests.memory, else memory.
+func memoryRequestField(r platformv1alpha1.ResourceRequirements) (string, string) {
+	if r.Requests != nil && r.Requests.Memory != "" {
+		return "requests.memory", r.Requests.Memory
+	}
+	return "memory", r.Memory
+}
+
+var quotaShrinkChecks = []quotaShrinkCheck{
+	{
+		resource: corev1.ResourceRequestsCPU,
+		field:    cpuRequestField,
+	},
+	{
+		resource: corev1.ResourceLimitsCPU,
+		field: func(r platformv1alpha1.ResourceRequirements) (string, string) {
+			if r.Limits != nil && r.Limits.CPU != "" {
+				return "limits.cpu", r.Limits.CPU
+			}
+			return cpuRequestField(r)
+		},
+	},
+	{
+		resource: corev1.ResourceRequestsMemory,
+		field:    memoryRequestField,
+	},
+	{
+		resource: corev1.ResourceLimitsMemory,
+		field: func(r platformv1alpha1.ResourceRequirements) (string, string) {
+			if r.Limits != nil && r.Limits.Memory != "" {
+				return "limits.memory", r.Limits.Memory
+			}
+			return memoryRequestField(r)
+		},
+	},
+	{
+		resource: corev1.ResourceRequestsStorage,
+		field: func(r platformv1alpha1.ResourceRequirements) (string, string) {
+			return "storage", r.Storage
+		},
+	},
+}
+
+// quotaEntry returns the quota entry a check guards for the given resources, and whether
+// the resources set it.
+func (w *TenantValidatingWebhook) quotaEntry(check quotaShrinkCheck, res platformv1alpha1.ResourceRequirements) (resource.Quantity, bool) {
+	if _, value := check.field(res); value == "" {
+		return resource.Quantity{}, false
+	}
+	if check.resource == corev1.ResourceRequestsStorage {
+		qty, err := parseQuantity(res.Storage)
+		return qty, err == nil
+	}
+	qty, ok := controller.QuotaComputeResources(res, w.LimitsOvercommitRatio)[check.resource]
+	return qty, ok
+}
+
+// validateQuotaShrink rejects lowering spec.resources below what the tenant's namespaces
+// currently use: existing pods keep running, but new pods, restarts, and rollouts would
+// fail quota admission. With the allow-quota-shrink annotation the change is admitted
+// with a warning instead.
+func (w *TenantValidatingWebhook) validateQuotaShrink(ctx context.Context, oldTenant, newTenant *platformv1alpha1.Tenant) (admission.Warnings, error) {
+	if w.Client == nil {
+		return nil, nil
+	}
+
+	var changed []quotaShrinkCheck
+	for _, check := range quotaShrinkChecks {
+		desired, ok := w.quotaEntry(check, newTenant.Spec.Resources)
+		if !ok {
+			continue
+		}
+		if current, ok := w.quotaEntry(check, oldTenant.Spec.Resources); !ok || desired.Cmp(current) != 0 {
+			changed = append(changed, check)
+		}
+	}
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	used, err := w.tenantQuotaUsage(ctx, newTenant.Name)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to read current quota usage: %w", err))
+	}
+
+	var problems []string
+	for _, check := range changed {
+		field, value := check.field(newTenant.Spec.Resources)
+		if _, err := parseQuantity(value); err != nil {
+			// Reported by validateTenant
+			continue
+		}
+		desired, _ := w.quotaEntry(check, newTenant.Spec.Resources)
+		usage, ok := used[check.resource]
+		if !ok || desired.Cmp(usage) >= 0 {
+			continue
+		}
+		if specified, _ := parseQuantity(value); specified.Cmp(desired) == 0 {
+			problems = append(problems, fmt.Sprintf("spec.resources.%s %s is below the current %s usage of %s",
+				field, value, check.resource, usage.String()))
+		} else {
+			problems = append(problems, fmt.Sprintf("spec.resources.%s %s sets %s to %s, below the current usage of %s",
+				field, value, check.resource, desired.String(), usage.String()))
+		}
+	}
+	if len(problems) == 0 {
+		return nil, nil
+	}
+
+	message := strings.Join(problems, "; ") +
+		"; new pods, restarts, and rollouts in the tenant namespaces will fail until usage drops"
+	if newTenant.Annotations[controller.AllowQuotaShrinkAnnotation] == "true" {
+		log.Info("quota shrink below usage allowed with annotation", "tenant", newTenant.Name)
+		return admission.Warnings{message}, nil
+	}
+	return nil, apierrors.NewForbidden(
+		schema.GroupResource{Group: platformv1alpha1.GroupVersion.Group, Resource: "tenants"},
+		newTenant.Name,
+		fmt.Errorf("%s. Set the %s=true annotation to proceed", message, controller.AllowQuotaShrinkAnnotation),
+	)
+}
+
+// tenantQuotaUsage sums the usage recorded by the tenant's ResourceQuotas across all of
+// its namespaces. PriorityClass-scoped quotas are skipped where a namespace quota already
+// counts their usage; in the shared Bronze namespace the scoped quota is the tenant's
+// only quota.
+func (w *TenantValidatingWebhook) tenantQuotaUsage(ctx context.Context, tenantName string) (corev1.ResourceList, error) {
+	quotas := &corev1.ResourceQuotaList{}
+	if err := w.Client.List(ctx, quotas, client.MatchingLabels{controller.TenantNameLabelKey: tenantName}); err != nil {
+		return nil, err
+	}
+
+	namespaceQuotas := map[string]bool{}
+	for _, rq := range quotas.Items {
+		if _, scoped := rq.Labels[controller.QuotaScopeLabelKey]; !scoped {
+			namespaceQuotas[rq.Namespace] = true
+		}
+	}
+
+	used := corev1.ResourceList{}
+	for _, rq := range quotas.Items {
+		if _, scoped := rq.Labels[controller.QuotaScopeLabelKey]; scoped && namespaceQuotas[rq.Namespace] {
+			continue
+		}
+		for name, qty := range rq.Status.Used {
+			total := used[name]
+			if total.Format == "" {
+				total = resource.Quantity{Format: qty.Format}
+			}
+			total.Add(qty)
+			used[name] = total
+		}
+	}
+	return used, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/instrument"
+)
+
+// forbiddenClusterRoles cannot be bound inside tenant namespaces.
+var forbiddenClusterRoles = map[string]bool{
+	"cluster-admin": true,
+}
+
+// escalatingVerbs allow a subject to grant itself permissions it does not hold. The
+// wildcard verb includes all of them.
+var escalatingVerbs = map[string]bool{
+	"escalate":     true,
+	"bind":         true,
+	"impersonate":  true,
+	rbacv1.VerbAll: true,
+}
+
+// RBACValidatingWebhook prevents tenants from escalating privileges through Roles and
+// RoleBindings created inside their namespaces, and from changing or deleting the
+// operator-managed ones.
+type RBACValidatingWebhook struct {
+	Client client.Client
+
+	// ExemptUsers are usernames (typically the operator ServiceAccount) whose
+	// requests bypass these checks so the operator can manage tenant RBAC.
+	ExemptUsers []string
+}
+
+// +kubebuilder:webhook:path=/validate-rbac-authorization-k8s-io-v1-rolebinding,mutating=false,failurePolicy=fail,sideEffects=None,groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=create;update;delete,versions=v1,name=vrolebinding.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+// +kubebuilder:webhook:path=/validate-rbac-authorization-k8s-io-v1-role,mutating=false,failurePolicy=fail,sideEffects=None,groups=rbac.authorization.k8s.io,resources=roles,verbs=create;update;delete,versions=v1,name=vrole.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+
+func (w *RBACValidatingWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&rbacv1.RoleBinding{}).
+		WithValidator(instrument.Validator("rolebinding", w)).
+		Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&rbacv1.Role{}).
+		WithValidator(instrument.Validator("role", w)).
+		Complete()
+}
+
+// ValidateCreate implements the create validation logic.
+func (w *RBACValidatingWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(ctx, obj)
+}
+
+// ValidateUpdate implements the update validation logic.
+func (w *RBACValidatingWebhook) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	if err := protectManagedObject(ctx, w.ExemptUsers, oldObj); err != nil {
+		return nil, err
+	}
+	return nil, w.validate(ctx, newObj)
+}
+
+// ValidateDelete implements the delete validation logic.
+func (w *RBACValidatingWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, protectManagedObject(ctx, w.ExemptUsers, obj)
+}
+
+// validate dispatches to the Role or RoleBinding checks.
+func (w *RBACValidatingWebhook) validate(ctx context.Context, obj runtime.Object) error {
+	if w.isExempt(ctx) {
+		return nil
+	}
+
+	var namespace, name, kind string
+	var allErrs field.ErrorList
+	switch o := obj.(type) {
+	case *rbacv1.RoleBinding:
+		namespace, name, kind = o.Namespace, o.Name, "RoleBinding"
+		allErrs = validateRoleBinding(o)
+	case *rbacv1.Role:
+		namespace, name, kind = o.Namespace, o.Name, "Role"
+		allErrs = validateRole(o)
+	default:
+		return nil
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	tenant, err := tenantForNamespace(ctx, w.Client, namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	tenantName := ""
+	if tenant != nil {
+		tenantName = tenant.Name
+	} else {
+		// The shared Bronze namespace belongs to every Bronze tenant
+		bronze, err := isBronzeNamespace(ctx, w.Client, namespace)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if !bronze {
+			return nil
+		}
+	}
+
+	log.Info("rejected escalating RBAC object", "kind", kind, "namespace", namespace, "name", name, "tenant", tenantName)
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: rbacv1.GroupName, Kind: kind},
+		name,
+		allErrs,
+	)
+}
+
+// isExempt reports whether the admission request was made by an exempt user.
+func (w *RBACValidatingWebhook) isExempt(ctx context.Context) bool {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return false
+	}
+	for _, u := range w.ExemptUsers {
+		if req.UserInfo.Username == u {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRoleBinding rejects bindings to forbidden ClusterRoles and subjects outside the namespace.
+func validateRoleBinding(rb *rbacv1.RoleBinding) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if rb.RoleRef.Kind == "ClusterRole" && forbiddenClusterRoles[rb.RoleRef.Name] {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("roleRef", "name"),
+			fmt.Sprintf("binding ClusterRole %q is not allowed in tenant namespaces", rb.RoleRef.Name)))
+	}
+
+	for i, subject := range rb.Subjects {
+		subjectPath := field.NewPath("subjects").Index(i)
+		switch subject.Kind {
+		case rbacv1.ServiceAccountKind:
+			if subject.Namespace != "" && subject.Namespace != rb.Namespace {
+				allErrs = append(allErrs, field.Forbidden(subjectPath.Child("namespace"),
+					fmt.Sprintf("ServiceAccounts from namespace %q cannot be bound in tenant namespace %q", subject.Namespace, rb.Namespace)))
+			}
+		case rbacv1.UserKind, rbacv1.GroupKind:
+			if strings.HasPrefix(subject.Name, "system:") {
+				allErrs = append(allErrs, field.Forbidden(subjectPath.Child("name"),
+					fmt.Sprintf("system subject %q cannot be bound in tenant namespaces", subject.Name)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateRole rejects rules that grant privilege-escalation verbs.
+func validateRole(role *rbacv1.Role) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, rule := range role.Rules {
+		for _, verb := range rule.Verbs {
+			if escalatingVerbs[verb] {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("rules").Index(i).Child("verbs"),
+					fmt.Sprintf("verb %q is not allowed in tenant Roles", verb)))
+			}
+		}
+	}
+
+	return allErrs
+}
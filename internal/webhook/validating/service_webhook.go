@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/instrument"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ServiceValidatingWebhook rejects externally exposed Services in tenant namespaces
+// unless the owning Tenant sets spec.network.allowExternalServices. The Service the
+// operator creates for spec.exposure is always allowed.
+type ServiceValidatingWebhook struct {
+	Client client.Client
+}
+
+// +kubebuilder:webhook:path=/validate--v1-service,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=services,verbs=create;update,versions=v1,name=vservice.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+
+func (w *ServiceValidatingWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&corev1.Service{}).
+		WithValidator(instrument.Validator("service", w)).
+		Complete()
+}
+
+// ValidateCreate implements the create validation logic.
+func (w *ServiceValidatingWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	svc, ok := obj.(*corev1.Service)
+	if !ok {
+		return nil, nil
+	}
+	return nil, w.validateService(ctx, svc)
+}
+
+// ValidateUpdate implements the update validation logic.
+func (w *ServiceValidatingWebhook) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	svc, ok := newObj.(*corev1.Service)
+	if !ok {
+		return nil, nil
+	}
+	return nil, w.validateService(ctx, svc)
+}
+
+// ValidateDelete implements the delete validation logic (always allowed).
+func (w *ServiceValidatingWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateService rejects NodePort/LoadBalancer Services and external IPs for tenants
+// that have not opted in to external exposure, and always in the shared Bronze namespace.
+func (w *ServiceValidatingWebhook) validateService(ctx context.Context, svc *corev1.Service) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if svc.Spec.Type == corev1.ServiceTypeNodePort || svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("type"),
+			fmt.Sprintf("Service type %s is not allowed in tenant namespaces", svc.Spec.Type)))
+	}
+	if len(svc.Spec.ExternalIPs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("externalIPs"),
+			"external IPs are not allowed in tenant namespaces"))
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	tenant, err := tenantForNamespace(ctx, w.Client, svc.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if tenant == nil {
+		// Bronze tenants share a namespace whose scoped quotas do not count load balancers
+		// or node ports, so they never expose Services externally
+		bronze, err := isBronzeNamespace(ctx, w.Client, svc.Namespace)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if !bronze {
+			return nil
+		}
+		log.Info("rejected externally exposed Service", "namespace", svc.Namespace, "service", svc.Name)
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: corev1.GroupName, Kind: "Service"},
+			svc.Name,
+			append(allErrs, field.Forbidden(field.NewPath("tenant"),
+				fmt.Sprintf("Services cannot be exposed externally from the shared %s namespace", controller.BronzeNamespace))),
+		)
+	}
+	if tenant.Spec.Network.AllowExternalServices {
+		// The tenant explicitly opted in
+		return nil
+	}
+	if isExposureService(tenant, svc) {
+		return nil
+	}
+
+	log.Info("rejected externally exposed Service", "namespace", svc.Namespace, "service", svc.Name, "tenant", tenant.Name)
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: corev1.GroupName, Kind: "Service"},
+		svc.Name,
+		append(allErrs, field.Forbidden(field.NewPath("tenant"),
+			fmt.Sprintf("set spec.network.allowExternalServices=true on Tenant %q to expose Services externally", tenant.Name))),
+	)
+}
+
+// isExposureService reports whether svc is the operator's Service for the tenant's
+// spec.exposure, of the requested type and without external IPs. Labels can be set by
+// the tenant, so the Service must be controlled by the Tenant itself.
+func isExposureService(tenant *platformv1alpha1.Tenant, svc *corev1.Service) bool {
+	exposure := tenant.Spec.Exposure
+	return exposure != nil &&
+		svc.Name == fmt.Sprintf("%s-vcluster-external", tenant.Name) &&
+		metav1.IsControlledBy(svc, tenant) &&
+		svc.Spec.Type == corev1.ServiceType(exposure.Type) &&
+		len(svc.Spec.ExternalIPs) == 0
+}
+
+// tenantForNamespace resolves the Tenant owning a namespace via its tenant label.
+// It returns nil if the namespace is not managed by Tenant-Master.
+func tenantForNamespace(ctx context.Context, c client.Client, namespace string) (*platformv1alpha1.Tenant, error) {
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch namespace %s: %w", namespace, err)
+	}
+
+	tenantName, ok := ns.Labels[controller.TenantNameLabelKey]
+	if !ok || tenantName == "" {
+		return nil, nil
+	}
+
+	tenant := &platformv1alpha1.Tenant{}
+	if err := c.Get(ctx, client.ObjectKey{Name: tenantName}, tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch tenant %s: %w", tenantName, err)
+	}
+	return tenant, nil
+}
@@ -19,30 +19,52 @@ package validating
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/mail"
+	"net/url"
+	"strings"
+	"time"
 
 	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+	"github.com/amartyaa/tenant-master/operator/internal/controller"
+	"github.com/amartyaa/tenant-master/operator/internal/webhook/instrument"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
 )
 
 var log = logf.Log.WithName("tenant-validating-webhook")
 
+// approvePrivilegedVerb is the custom verb on tenants a user needs to approve
+// spec.security.allowPrivileged via the privileged-approved-by annotation.
+const approvePrivilegedVerb = "approve-privileged"
+
 // TenantValidatingWebhook implements the validating webhook for Tenants.
-type TenantValidatingWebhook struct{}
+type TenantValidatingWebhook struct {
+	// Client issues SubjectAccessReviews for privileged-workload approvals and reads
+	// the tenant's quota usage.
+	Client client.Client
+
+	// LimitsOvercommitRatio is the operator's ratio of quota limits to requests, used to
+	// check changes that lower the limits side of the quota. Below 1 counts as 1.
+	LimitsOvercommitRatio float64
+}
 
-// +kubebuilder:webhook:path=/validate-platform-io-v1alpha1-tenant,mutating=false,failurePolicy=fail,sideEffects=None,groups=platform.io,resources=tenants,verbs=create;update,versions=v1alpha1,name=vtenant.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
+// +kubebuilder:webhook:path=/validate-platform-io-v1alpha1-tenant,mutating=false,failurePolicy=fail,sideEffects=None,groups=platform.io,resources=tenants,verbs=create;update;delete,versions=v1alpha1,name=vtenant.platform.io,admissionReviewVersions={v1},clientConfig={service:{name=webhook-service,namespace=system},caBundle=Cg==}
 
 func (w *TenantValidatingWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&platformv1alpha1.Tenant{}).
-		WithValidator(w).
+		WithValidator(instrument.Validator("tenant-validating", w)).
 		Complete()
 }
 
@@ -54,6 +76,9 @@ func (w *TenantValidatingWebhook) ValidateCreate(ctx context.Context, obj runtim
 	}
 
 	log.Info("validating webhook (create) called", "tenant", tenant.Name)
+	if err := w.validatePrivilegedApproval(ctx, nil, tenant); err != nil {
+		return nil, err
+	}
 	return w.validateTenant(tenant)
 }
 
@@ -75,11 +100,29 @@ func (w *TenantValidatingWebhook) ValidateUpdate(ctx context.Context, oldObj run
 	if err := w.validateTierMigration(oldTenant, newTenant); err != nil {
 		return nil, err
 	}
+	if err := w.validatePrivilegedApproval(ctx, oldTenant, newTenant); err != nil {
+		return nil, err
+	}
+	if err := validateVClusterDistroChange(oldTenant, newTenant); err != nil {
+		return nil, err
+	}
+	if err := validateRestoreFromChange(oldTenant, newTenant); err != nil {
+		return nil, err
+	}
+	if err := validatePlacementChange(oldTenant, newTenant); err != nil {
+		return nil, err
+	}
+	shrinkWarnings, err := w.validateQuotaShrink(ctx, oldTenant, newTenant)
+	if err != nil {
+		return nil, err
+	}
 
-	return w.validateTenant(newTenant)
+	warnings, err := w.validateTenant(newTenant)
+	return append(shrinkWarnings, warnings...), err
 }
 
-// ValidateDelete implements the delete validation logic (currently a no-op).
+// ValidateDelete implements the delete validation logic. Tenants with
+// spec.deletionProtection cannot be deleted; cleanup is otherwise handled by finalizers.
 func (w *TenantValidatingWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	tenant, ok := obj.(*platformv1alpha1.Tenant)
 	if !ok {
@@ -87,7 +130,13 @@ func (w *TenantValidatingWebhook) ValidateDelete(ctx context.Context, obj runtim
 	}
 
 	log.Info("validating webhook (delete) called", "tenant", tenant.Name)
-	// Allow deletion, cleanup is handled by finalizers
+	if tenant.Spec.DeletionProtection {
+		return nil, apierrors.NewForbidden(
+			schema.GroupResource{Group: platformv1alpha1.GroupVersion.Group, Resource: "tenants"},
+			tenant.Name,
+			fmt.Errorf("tenant has spec.deletionProtection set; clear it before deleting the tenant"),
+		)
+	}
 	return nil, nil
 }
 
@@ -100,6 +149,7 @@ func (w *TenantValidatingWebhook) validateTenant(tenant *platformv1alpha1.Tenant
 		platformv1alpha1.BronzeTier,
 		platformv1alpha1.SilverTier,
 		platformv1alpha1.GoldTier,
+		platformv1alpha1.PlatinumTier,
 	}
 	validTier := false
 	for _, t := range validTiers {
@@ -112,7 +162,17 @@ func (w *TenantValidatingWebhook) validateTenant(tenant *platformv1alpha1.Tenant
 		allErrs = append(allErrs, field.NotSupported(
 			field.NewPath("spec").Child("tier"),
 			tenant.Spec.Tier,
-			[]string{string(platformv1alpha1.BronzeTier), string(platformv1alpha1.SilverTier), string(platformv1alpha1.GoldTier)},
+			[]string{string(platformv1alpha1.BronzeTier), string(platformv1alpha1.SilverTier), string(platformv1alpha1.GoldTier),
+				string(platformv1alpha1.PlatinumTier)},
+		))
+	}
+
+	// The tenant namespace must not take over a system namespace
+	if namespace := fmt.Sprintf("%s-%s", controller.NamespacePrefix, tenant.Name); controller.IsReservedNamespace(namespace) {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("metadata").Child("name"),
+			tenant.Name,
+			fmt.Sprintf("namespace %q is reserved for the system", namespace),
 		))
 	}
 
@@ -153,24 +213,134 @@ func (w *TenantValidatingWebhook) validateTenant(tenant *platformv1alpha1.Tenant
 		}
 	}
 
+	if tenant.Spec.Resources.Storage != "" {
+		if _, err := parseQuantity(tenant.Spec.Resources.Storage); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec").Child("resources").Child("storage"),
+				tenant.Spec.Resources.Storage,
+				fmt.Sprintf("invalid quantity: %v", err),
+			))
+		}
+	}
+
+	// The class name becomes part of a quota resource name
+	if class := tenant.Spec.Resources.StorageClass; class != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(class) {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec").Child("resources").Child("storageClass"), class, msg))
+		}
+	}
+
+	allErrs = append(allErrs, validateRequestsAndLimits(tenant)...)
+	allErrs = append(allErrs, validatePriorityClassQuotas(tenant)...)
+	allErrs = append(allErrs, validateBurst(tenant)...)
+	allErrs = append(allErrs, validateEnvironments(tenant)...)
+	allErrs = append(allErrs, validateVCluster(tenant)...)
+	allErrs = append(allErrs, validateDNSConfig(tenant)...)
+	allErrs = append(allErrs, validateWhitelistedServices(tenant)...)
+	allErrs = append(allErrs, validateCIDRs(tenant)...)
+	allErrs = append(allErrs, validateAllowedIngressNamespaces(tenant)...)
+	allErrs = append(allErrs, validateAccessControl(tenant)...)
+	allErrs = append(allErrs, validateExposure(tenant)...)
+	allErrs = append(allErrs, validateSecurity(tenant)...)
+	allErrs = append(allErrs, validateRestoreFrom(tenant)...)
+	allErrs = append(allErrs, validateBackup(tenant)...)
+	allErrs = append(allErrs, validateTTL(tenant)...)
+	allErrs = append(allErrs, validateHibernation(tenant)...)
+	allErrs = append(allErrs, validateCluster(tenant)...)
+	allErrs = append(allErrs, validatePlacement(tenant)...)
+	allErrs = append(allErrs, validateGitOps(tenant)...)
+	allErrs = append(allErrs, validateMesh(tenant)...)
+	allErrs = append(allErrs, validateObservability(tenant)...)
+
+	var warnings admission.Warnings
+	if tenant.Spec.Security.AllowPrivileged && tenant.Annotations[controller.PrivilegedApprovedByAnnotation] == "" &&
+		tenant.Spec.Tier == platformv1alpha1.SilverTier {
+		warnings = append(warnings, fmt.Sprintf("spec.security.allowPrivileged has no effect until a cluster admin sets the %s annotation",
+			controller.PrivilegedApprovedByAnnotation))
+	}
+
 	if len(allErrs) == 0 {
-		return nil, nil
+		return warnings, nil
 	}
 
-	return nil, apierrors.NewInvalid(
+	return warnings, apierrors.NewInvalid(
 		schema.GroupKind{Group: platformv1alpha1.GroupVersion.Group, Kind: "Tenant"},
 		tenant.Name,
 		allErrs,
 	)
 }
 
+// validatePrivilegedApproval allows the privileged-approved-by annotation to be added or
+// changed only by users granted the approve-privileged verb on the Tenant. Removing the
+// annotation (revoking the approval) is always allowed.
+func (w *TenantValidatingWebhook) validatePrivilegedApproval(ctx context.Context, oldTenant, newTenant *platformv1alpha1.Tenant) error {
+	approval := newTenant.Annotations[controller.PrivilegedApprovedByAnnotation]
+	previous := ""
+	if oldTenant != nil {
+		previous = oldTenant.Annotations[controller.PrivilegedApprovedByAnnotation]
+	}
+	if approval == "" || approval == previous {
+		return nil
+	}
+
+	gr := schema.GroupResource{Group: platformv1alpha1.GroupVersion.Group, Resource: "tenants"}
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil || w.Client == nil {
+		return apierrors.NewForbidden(gr, newTenant.Name,
+			fmt.Errorf("cannot verify permission to set %s", controller.PrivilegedApprovedByAnnotation))
+	}
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(req.UserInfo.Extra))
+	for k, v := range req.UserInfo.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   req.UserInfo.Username,
+			Groups: req.UserInfo.Groups,
+			UID:    req.UserInfo.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    gr.Group,
+				Resource: gr.Resource,
+				Name:     newTenant.Name,
+				Verb:     approvePrivilegedVerb,
+			},
+		},
+	}
+	if err := w.Client.Create(ctx, sar); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to review access: %w", err))
+	}
+	if !sar.Status.Allowed {
+		log.Info("rejected privileged workload approval", "tenant", newTenant.Name, "user", req.UserInfo.Username)
+		return apierrors.NewForbidden(gr, newTenant.Name,
+			fmt.Errorf("user %q may not set %s (requires the %s verb on tenants)",
+				req.UserInfo.Username, controller.PrivilegedApprovedByAnnotation, approvePrivilegedVerb))
+	}
+
+	log.Info("privileged workloads approved", "tenant", newTenant.Name, "user", req.UserInfo.Username)
+	return nil
+}
+
 // validateTierMigration checks for unsafe tier downgrades.
 func (w *TenantValidatingWebhook) validateTierMigration(oldTenant, newTenant *platformv1alpha1.Tenant) error {
 	// Define tier order (lower = less isolated)
 	tierOrder := map[platformv1alpha1.TenantTier]int{
-		platformv1alpha1.BronzeTier: 0,
-		platformv1alpha1.SilverTier: 1,
-		platformv1alpha1.GoldTier:   2,
+		platformv1alpha1.BronzeTier:   0,
+		platformv1alpha1.SilverTier:   1,
+		platformv1alpha1.GoldTier:     2,
+		platformv1alpha1.PlatinumTier: 3,
+	}
+
+	// Workloads run in a dedicated cluster on Platinum, and cannot be moved in or out
+	if (oldTenant.Spec.Tier == platformv1alpha1.PlatinumTier) != (newTenant.Spec.Tier == platformv1alpha1.PlatinumTier) {
+		return apierrors.NewForbidden(
+			schema.GroupResource{Group: platformv1alpha1.GroupVersion.Group, Resource: "tenants"},
+			newTenant.Name,
+			fmt.Errorf("tenants cannot be migrated to or from the Platinum tier: %s -> %s",
+				oldTenant.Spec.Tier, newTenant.Spec.Tier),
+		)
 	}
 
 	oldOrder := tierOrder[oldTenant.Spec.Tier]
@@ -195,6 +365,628 @@ func (w *TenantValidatingWebhook) validateTierMigration(oldTenant, newTenant *pl
 	return nil
 }
 
+// validatePriorityClassQuotas checks spec.quotas.byPriorityClass for duplicate classes,
+// unparseable quantities, and budgets larger than the tenant's overall resources.
+func validatePriorityClassQuotas(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	var allErrs field.ErrorList
+	basePath := field.NewPath("spec").Child("quotas").Child("byPriorityClass")
+	seen := map[string]bool{}
+	cpuTotal, memoryTotal := requestedAmounts(tenant.Spec.Resources)
+
+	for i, pcq := range tenant.Spec.Quotas.ByPriorityClass {
+		path := basePath.Index(i)
+
+		if pcq.PriorityClassName == "" {
+			allErrs = append(allErrs, field.Required(path.Child("priorityClassName"), "priorityClassName must be specified"))
+		} else if seen[pcq.PriorityClassName] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("priorityClassName"), pcq.PriorityClassName))
+		}
+		seen[pcq.PriorityClassName] = true
+
+		allErrs = append(allErrs, validateSubBudget(path.Child("cpu"), pcq.CPU, cpuTotal)...)
+		allErrs = append(allErrs, validateSubBudget(path.Child("memory"), pcq.Memory, memoryTotal)...)
+	}
+
+	return allErrs
+}
+
+// requestedAmounts returns the tenant's CPU and memory requests as written in the spec:
+// spec.resources.requests, falling back to cpu and memory.
+func requestedAmounts(res platformv1alpha1.ResourceRequirements) (string, string) {
+	cpu, memory := res.CPU, res.Memory
+	if res.Requests != nil {
+		if res.Requests.CPU != "" {
+			cpu = res.Requests.CPU
+		}
+		if res.Requests.Memory != "" {
+			memory = res.Requests.Memory
+		}
+	}
+	return cpu, memory
+}
+
+// validateRequestsAndLimits checks spec.resources.requests and spec.resources.limits:
+// valid quantities, and limits no lower than the requests they cap.
+func validateRequestsAndLimits(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	var allErrs field.ErrorList
+	res := tenant.Spec.Resources
+	basePath := field.NewPath("spec").Child("resources")
+
+	for _, side := range []struct {
+		name    string
+		amounts *platformv1alpha1.ResourceAmounts
+	}{{"requests", res.Requests}, {"limits", res.Limits}} {
+		if side.amounts == nil {
+			continue
+		}
+		for _, amount := range [][2]string{{"cpu", side.amounts.CPU}, {"memory", side.amounts.Memory}} {
+			if amount[1] == "" {
+				continue
+			}
+			if _, err := parseQuantity(amount[1]); err != nil {
+				allErrs = append(allErrs, field.Invalid(basePath.Child(side.name, amount[0]), amount[1],
+					fmt.Sprintf("invalid quantity: %v", err)))
+			}
+		}
+	}
+	if res.Limits == nil || len(allErrs) > 0 {
+		return allErrs
+	}
+
+	cpu, memory := requestedAmounts(res)
+	for _, pair := range [][3]string{{"cpu", res.Limits.CPU, cpu}, {"memory", res.Limits.Memory, memory}} {
+		if pair[1] == "" || pair[2] == "" {
+			continue
+		}
+		limit, errLimit := parseQuantity(pair[1])
+		request, errRequest := parseQuantity(pair[2])
+		if errLimit == nil && errRequest == nil && limit.Cmp(request) < 0 {
+			allErrs = append(allErrs, field.Invalid(basePath.Child("limits", pair[0]), pair[1],
+				fmt.Sprintf("must not be lower than the %s request of %s", pair[0], pair[2])))
+		}
+	}
+	return allErrs
+}
+
+// validateSubBudget checks that a scoped budget parses and does not exceed the tenant total.
+func validateSubBudget(path *field.Path, value, total string) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+	qty, err := parseQuantity(value)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, value, fmt.Sprintf("invalid quantity: %v", err))}
+	}
+	if total == "" {
+		return nil
+	}
+	if totalQty, err := parseQuantity(total); err == nil && qty.Cmp(totalQty) > 0 {
+		return field.ErrorList{field.Invalid(path, value, fmt.Sprintf("exceeds tenant total of %s", total))}
+	}
+	return nil
+}
+
+// maxBurstDuration bounds how long a quota boost may last.
+const maxBurstDuration = 7 * 24 * time.Hour
+
+// validateBurst checks spec.resources.burst.
+func validateBurst(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	burst := tenant.Spec.Resources.Burst
+	if burst == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	path := field.NewPath("spec").Child("resources").Child("burst")
+
+	if burst.CPU == "" && burst.Memory == "" {
+		allErrs = append(allErrs, field.Required(path, "at least one of cpu or memory must be set"))
+	}
+	if burst.CPU != "" {
+		if _, err := parseQuantity(burst.CPU); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("cpu"), burst.CPU, fmt.Sprintf("invalid quantity: %v", err)))
+		}
+	}
+	if burst.Memory != "" {
+		if _, err := parseQuantity(burst.Memory); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("memory"), burst.Memory, fmt.Sprintf("invalid quantity: %v", err)))
+		}
+	}
+	if d := burst.Duration.Duration; d <= 0 || d > maxBurstDuration {
+		allErrs = append(allErrs, field.Invalid(path.Child("duration"), burst.Duration.Duration.String(),
+			fmt.Sprintf("must be greater than 0 and at most %s", maxBurstDuration)))
+	}
+
+	return allErrs
+}
+
+// validateEnvironments checks spec.environments: Silver tier only, unique names that
+// produce valid namespace names, and quota shares that fit in the tenant budget.
+func validateEnvironments(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	if len(tenant.Spec.Environments) == 0 {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	basePath := field.NewPath("spec").Child("environments")
+
+	if tenant.Spec.Tier != platformv1alpha1.SilverTier {
+		allErrs = append(allErrs, field.Forbidden(basePath, "environments are only supported for Silver tier tenants"))
+	}
+
+	seen := map[string]bool{}
+	var claimed int32
+	unset := 0
+	for i, env := range tenant.Spec.Environments {
+		path := basePath.Index(i)
+
+		if seen[env.Name] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), env.Name))
+		}
+		seen[env.Name] = true
+
+		namespace := fmt.Sprintf("tenant-%s-%s", tenant.Name, env.Name)
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(path.Child("name"), env.Name,
+				fmt.Sprintf("namespace %q is invalid: %s", namespace, msg)))
+		}
+
+		if env.QuotaPercent == nil {
+			unset++
+		} else {
+			claimed += *env.QuotaPercent
+		}
+	}
+
+	if claimed > 100 {
+		allErrs = append(allErrs, field.Invalid(basePath, claimed, "quotaPercent values add up to more than 100"))
+	} else if claimed == 100 && unset > 0 {
+		allErrs = append(allErrs, field.Invalid(basePath, claimed,
+			"quotaPercent values add up to 100, leaving no quota for environments without a quotaPercent"))
+	}
+
+	return allErrs
+}
+
+// validateDNSConfig checks that spec.network.dnsConfig lists IP addresses as nameservers
+// and DNS subdomains as search domains.
+func validateDNSConfig(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	dns := tenant.Spec.Network.DNSConfig
+	if dns == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	basePath := field.NewPath("spec").Child("network").Child("dnsConfig")
+	for i, nameserver := range dns.Nameservers {
+		if net.ParseIP(nameserver) == nil {
+			allErrs = append(allErrs, field.Invalid(basePath.Child("nameservers").Index(i), nameserver, "must be an IP address"))
+		}
+	}
+	for i, search := range dns.Searches {
+		for _, msg := range validation.IsDNS1123Subdomain(strings.TrimSuffix(search, ".")) {
+			allErrs = append(allErrs, field.Invalid(basePath.Child("searches").Index(i), search, msg))
+		}
+	}
+	return allErrs
+}
+
+// validateWhitelistedServices checks that spec.network.whitelistedServices entries have
+// the "namespace/service" or "namespace/service:port" format.
+func validateWhitelistedServices(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	var allErrs field.ErrorList
+	basePath := field.NewPath("spec").Child("network").Child("whitelistedServices")
+	for i, service := range tenant.Spec.Network.WhitelistedServices {
+		if _, err := controller.ParseServiceRef(service); err != nil {
+			allErrs = append(allErrs, field.Invalid(basePath.Index(i), service, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// validateCIDRs checks that spec.network.allowedCIDRs and deniedCIDRs are CIDRs.
+func validateCIDRs(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	var allErrs field.ErrorList
+	basePath := field.NewPath("spec").Child("network")
+	for _, list := range []struct {
+		child string
+		cidrs []string
+	}{{"allowedCIDRs", tenant.Spec.Network.AllowedCIDRs}, {"deniedCIDRs", tenant.Spec.Network.DeniedCIDRs}} {
+		for i, cidr := range list.cidrs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				allErrs = append(allErrs, field.Invalid(basePath.Child(list.child).Index(i), cidr,
+					"must be a CIDR, e.g. 203.0.113.0/24 or 2001:db8::/32"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateAllowedIngressNamespaces checks that spec.network.allowedIngressNamespaces
+// are namespace names outside the tenant namespaces, which are never allowed.
+func validateAllowedIngressNamespaces(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	var allErrs field.ErrorList
+	basePath := field.NewPath("spec").Child("network").Child("allowedIngressNamespaces")
+	for i, namespace := range tenant.Spec.Network.AllowedIngressNamespaces {
+		path := basePath.Index(i)
+		if msgs := validation.IsDNS1123Label(namespace); len(msgs) > 0 {
+			allErrs = append(allErrs, field.Invalid(path, namespace, strings.Join(msgs, "; ")))
+		} else if strings.HasPrefix(namespace, controller.NamespacePrefix+"-") || namespace == controller.BronzeNamespace {
+			allErrs = append(allErrs, field.Invalid(path, namespace,
+				"must not be a tenant namespace; ingress between tenants is always denied"))
+		}
+	}
+	return allErrs
+}
+
+// validateAccessControl checks spec.accessControl: Silver and Gold tiers only, and no
+// empty or system: subjects, which the RBAC webhook also rejects in tenant bindings.
+func validateAccessControl(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	access := tenant.Spec.AccessControl
+	basePath := field.NewPath("spec").Child("accessControl")
+	if len(access.Users) == 0 && len(access.Groups) == 0 {
+		return nil
+	}
+	if tenant.Spec.Tier == platformv1alpha1.BronzeTier {
+		return field.ErrorList{field.Forbidden(basePath, "accessControl users and groups are not supported for Bronze tier tenants")}
+	}
+
+	var allErrs field.ErrorList
+	for _, list := range []struct {
+		child    string
+		subjects []string
+	}{{"users", access.Users}, {"groups", access.Groups}} {
+		for i, subject := range list.subjects {
+			if subject == "" || strings.HasPrefix(subject, "system:") {
+				allErrs = append(allErrs, field.Invalid(basePath.Child(list.child).Index(i), subject,
+					"must be non-empty and must not start with system:"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateExposure checks spec.exposure: Gold tier only, a hostname for Ingress
+// exposure and for a cert-manager certificate, and an ingressClassName only for
+// Ingress exposure.
+func validateExposure(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	exposure := tenant.Spec.Exposure
+	if exposure == nil {
+		return nil
+	}
+	basePath := field.NewPath("spec").Child("exposure")
+	if tenant.Spec.Tier != platformv1alpha1.GoldTier {
+		return field.ErrorList{field.Forbidden(basePath, "exposure is only supported for Gold tier tenants, which run a vCluster")}
+	}
+
+	var allErrs field.ErrorList
+	switch {
+	case exposure.Hostname != "":
+	case exposure.Type == platformv1alpha1.ExposureIngress:
+		allErrs = append(allErrs, field.Required(basePath.Child("hostname"), "hostname is required for Ingress exposure"))
+	case exposure.TLS != nil:
+		allErrs = append(allErrs, field.Required(basePath.Child("hostname"), "hostname is required for a cert-manager certificate"))
+	}
+	if exposure.Type != platformv1alpha1.ExposureIngress && exposure.IngressClassName != "" {
+		allErrs = append(allErrs, field.Forbidden(basePath.Child("ingressClassName"),
+			fmt.Sprintf("ingressClassName only applies to Ingress exposure, not %s", exposure.Type)))
+	}
+	return allErrs
+}
+
+// validateSecurity checks spec.security: Bronze tenants share a namespace whose Pod
+// Security level no single tenant can relax, so they cannot opt into privileged workloads.
+func validateSecurity(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	if !tenant.Spec.Security.AllowPrivileged || tenant.Spec.Tier != platformv1alpha1.BronzeTier {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(field.NewPath("spec", "security", "allowPrivileged"),
+		"allowPrivileged is not supported for Bronze tier tenants, which share a namespace")}
+}
+
+// validateRestoreFrom checks spec.restoreFrom: Bronze tenants share a namespace, so there
+// is no namespace of their own to restore into.
+func validateRestoreFrom(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	if tenant.Spec.RestoreFrom == nil || tenant.Spec.Tier != platformv1alpha1.BronzeTier {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(field.NewPath("spec", "restoreFrom"),
+		"restoreFrom is only supported for Silver and Gold tier tenants")}
+}
+
+// validateBackup checks spec.backup: Silver and Gold tiers only, with a schedule the
+// operator can parse.
+func validateBackup(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	backup := tenant.Spec.Backup
+	if backup == nil {
+		return nil
+	}
+	basePath := field.NewPath("spec", "backup")
+	if tenant.Spec.Tier == platformv1alpha1.BronzeTier {
+		return field.ErrorList{field.Forbidden(basePath, "backup is only supported for Silver and Gold tier tenants")}
+	}
+	var allErrs field.ErrorList
+	if _, err := controller.ParseBackupSchedule(backup.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("schedule"), backup.Schedule, fmt.Sprintf("invalid cron schedule: %v", err)))
+	}
+	if backup.Retention < 0 || backup.Retention > 100 {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("retention"), backup.Retention, "must be between 1 and 100"))
+	}
+	return allErrs
+}
+
+// validateTTL requires a positive spec.ttl, which cannot be combined with
+// spec.deletionProtection as a protected tenant never expires.
+func validateTTL(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	ttl := tenant.Spec.TTL
+	if ttl == nil {
+		return nil
+	}
+	path := field.NewPath("spec", "ttl")
+	if ttl.Duration <= 0 {
+		return field.ErrorList{field.Invalid(path, ttl.Duration.String(), "must be greater than 0")}
+	}
+	if tenant.Spec.DeletionProtection {
+		return field.ErrorList{field.Forbidden(path, "ttl cannot be set on a tenant with spec.deletionProtection")}
+	}
+	return nil
+}
+
+// validateHibernation checks spec.hibernation: Silver and Gold tiers only, in a known time
+// zone, with window schedules the operator can parse.
+func validateHibernation(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	hibernation := tenant.Spec.Hibernation
+	if hibernation == nil {
+		return nil
+	}
+	basePath := field.NewPath("spec", "hibernation")
+	if tenant.Spec.Tier == platformv1alpha1.BronzeTier || tenant.Spec.Tier == platformv1alpha1.PlatinumTier {
+		return field.ErrorList{field.Forbidden(basePath, "hibernation is only supported for Silver and Gold tier tenants")}
+	}
+	if len(hibernation.Schedule) == 0 {
+		return field.ErrorList{field.Required(basePath.Child("schedule"), "at least one hibernation window is required")}
+	}
+	if _, err := time.LoadLocation(hibernation.TimeZone); err != nil {
+		return field.ErrorList{field.Invalid(basePath.Child("timeZone"), hibernation.TimeZone, fmt.Sprintf("unknown time zone: %v", err))}
+	}
+
+	var allErrs field.ErrorList
+	for i, window := range hibernation.Schedule {
+		path := basePath.Child("schedule").Index(i)
+		if _, _, err := controller.ParseHibernationWindow(window, hibernation.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(path, window, fmt.Sprintf("invalid cron schedule: %v", err)))
+		}
+	}
+	return allErrs
+}
+
+// validateCluster checks that spec.cluster is only set for Platinum tier tenants.
+func validateCluster(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	if tenant.Spec.Cluster != nil && tenant.Spec.Tier != platformv1alpha1.PlatinumTier {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec", "cluster"),
+			"cluster settings are only supported for Platinum tier tenants")}
+	}
+	return nil
+}
+
+// validatePlacement checks that spec.placement is not set for Platinum tier tenants,
+// whose dedicated cluster is created by Cluster API on the local cluster.
+func validatePlacement(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	if tenant.Spec.Placement != nil && tenant.Spec.Tier == platformv1alpha1.PlatinumTier {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec", "placement"),
+			"placement is not supported for Platinum tier tenants")}
+	}
+	return nil
+}
+
+// validateGitOps checks spec.gitops: Silver and Gold tiers only, with a Git repository
+// URL Argo CD and Flux can clone.
+func validateGitOps(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	gitops := tenant.Spec.GitOps
+	if gitops == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	basePath := field.NewPath("spec", "gitops")
+	if tenant.Spec.Tier != platformv1alpha1.SilverTier && tenant.Spec.Tier != platformv1alpha1.GoldTier {
+		allErrs = append(allErrs, field.Forbidden(basePath, "gitops is only supported for Silver and Gold tier tenants"))
+	}
+	if url := gitops.RepoURL; url != "" && (strings.ContainsAny(url, " \t\n") ||
+		!(strings.HasPrefix(url, "https://") || strings.HasPrefix(url, "ssh://") || strings.HasPrefix(url, "git@"))) {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("repoURL"), gitops.RepoURL,
+			"must be an https://, ssh://, or git@ repository URL"))
+	}
+	if gitops.Flux != nil && gitops.Flux.Enabled {
+		switch {
+		case gitops.RepoURL == "":
+			allErrs = append(allErrs, field.Required(basePath.Child("repoURL"), "flux requires repoURL"))
+		case strings.HasPrefix(gitops.RepoURL, "git@"):
+			allErrs = append(allErrs, field.Invalid(basePath.Child("repoURL"), gitops.RepoURL,
+				"Flux requires an https:// or ssh:// repository URL, e.g. ssh://git@host/org/repo"))
+		}
+	}
+	return allErrs
+}
+
+// validateMesh checks spec.mesh: Silver and Gold tiers only, with allowedNamespaces
+// limited to Istio and to namespaces outside the tenant namespaces.
+func validateMesh(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	mesh := tenant.Spec.Mesh
+	if mesh == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	basePath := field.NewPath("spec", "mesh")
+	if tenant.Spec.Tier != platformv1alpha1.SilverTier && tenant.Spec.Tier != platformv1alpha1.GoldTier {
+		allErrs = append(allErrs, field.Forbidden(basePath, "mesh is only supported for Silver and Gold tier tenants"))
+	}
+	if len(mesh.AllowedNamespaces) > 0 && mesh.Provider != platformv1alpha1.MeshProviderIstio {
+		allErrs = append(allErrs, field.Forbidden(basePath.Child("allowedNamespaces"),
+			"allowedNamespaces is only supported with the Istio provider"))
+	}
+	for i, namespace := range mesh.AllowedNamespaces {
+		path := basePath.Child("allowedNamespaces").Index(i)
+		if msgs := validation.IsDNS1123Label(namespace); len(msgs) > 0 {
+			allErrs = append(allErrs, field.Invalid(path, namespace, strings.Join(msgs, "; ")))
+		} else if strings.HasPrefix(namespace, controller.NamespacePrefix+"-") {
+			allErrs = append(allErrs, field.Invalid(path, namespace,
+				"must not be a tenant namespace; traffic between tenants is always denied"))
+		}
+	}
+	return allErrs
+}
+
+// validateObservability checks that spec.observability is only set for Silver and Gold
+// tier tenants, whose namespaces are their own.
+func validateObservability(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	if tenant.Spec.Observability != nil && tenant.Spec.Tier != platformv1alpha1.SilverTier && tenant.Spec.Tier != platformv1alpha1.GoldTier {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec", "observability"),
+			"observability is only supported for Silver and Gold tier tenants")}
+	}
+	return nil
+}
+
+// validatePlacementChange rejects setting, changing or clearing spec.placement on an
+// existing tenant, whose resources already exist on the cluster it was placed on.
+func validatePlacementChange(oldTenant, newTenant *platformv1alpha1.Tenant) error {
+	oldCluster, newCluster := "", ""
+	if oldTenant.Spec.Placement != nil {
+		oldCluster = oldTenant.Spec.Placement.Cluster
+	}
+	if newTenant.Spec.Placement != nil {
+		newCluster = newTenant.Spec.Placement.Cluster
+	}
+	if oldCluster == newCluster {
+		return nil
+	}
+	return apierrors.NewForbidden(
+		schema.GroupResource{Group: platformv1alpha1.GroupVersion.Group, Resource: "tenants"},
+		newTenant.Name,
+		fmt.Errorf("spec.placement can only be set when the tenant is created: use a TenantMigration to move the tenant to another cluster"),
+	)
+}
+
+// validateRestoreFromChange rejects setting or changing spec.restoreFrom on an existing
+// tenant, whose namespace already has contents a restore would overwrite.
+func validateRestoreFromChange(oldTenant, newTenant *platformv1alpha1.Tenant) error {
+	newRef := newTenant.Spec.RestoreFrom
+	if newRef == nil || (oldTenant.Spec.RestoreFrom != nil && *oldTenant.Spec.RestoreFrom == *newRef) {
+		return nil
+	}
+	return apierrors.NewForbidden(
+		schema.GroupResource{Group: platformv1alpha1.GroupVersion.Group, Resource: "tenants"},
+		newTenant.Name,
+		fmt.Errorf("spec.restoreFrom can only be set when the tenant is created: delete and recreate the tenant to restore %s", newRef.Name),
+	)
+}
+
+// validateVCluster checks spec.vcluster: Gold tier only, and the vCluster volumes for
+// all replicas (10Gi each by default) must fit in the tenant storage quota when one is set.
+func validateVCluster(tenant *platformv1alpha1.Tenant) field.ErrorList {
+	var allErrs field.ErrorList
+	basePath := field.NewPath("spec").Child("vcluster")
+	vc := tenant.Spec.VCluster
+
+	if vc != nil && tenant.Spec.Tier != platformv1alpha1.GoldTier {
+		allErrs = append(allErrs, field.Forbidden(basePath, "vcluster settings are only supported for Gold tier tenants"))
+	}
+	if tenant.Spec.Tier != platformv1alpha1.GoldTier {
+		return allErrs
+	}
+	if vc != nil && vc.Audit != nil {
+		allErrs = append(allErrs, validateVClusterAuditSink(basePath.Child("audit", "sink"), vc.Audit.Sink)...)
+	}
+	if vc != nil && vc.Values != "" {
+		values := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(vc.Values), &values); err != nil {
+			allErrs = append(allErrs, field.Invalid(basePath.Child("values"), vc.Values,
+				fmt.Sprintf("must be a YAML map of Helm values: %v", err)))
+		}
+	}
+
+	replicas := int64(1)
+	sizeValue := controller.DefaultVClusterPersistenceSize
+	if vc != nil {
+		if vc.Replicas != nil {
+			replicas = int64(*vc.Replicas)
+		}
+		if p := vc.Persistence; p != nil {
+			if p.Enabled != nil && !*p.Enabled {
+				return allErrs
+			}
+			if p.Size != "" {
+				sizeValue = p.Size
+			}
+		}
+	}
+
+	sizePath := basePath.Child("persistence").Child("size")
+	size, err := parseQuantity(sizeValue)
+	if err != nil {
+		return append(allErrs, field.Invalid(sizePath, sizeValue, fmt.Sprintf("invalid quantity: %v", err)))
+	}
+	if tenant.Spec.Resources.Storage == "" {
+		return allErrs
+	}
+	quota, err := parseQuantity(tenant.Spec.Resources.Storage)
+	if err != nil {
+		// Reported by the resources.storage check
+		return allErrs
+	}
+
+	total := resource.NewQuantity(size.Value()*replicas, resource.BinarySI)
+	if total.Cmp(quota) > 0 {
+		allErrs = append(allErrs, field.Invalid(sizePath, sizeValue,
+			fmt.Sprintf("%d replica(s) x %s exceeds the tenant storage quota of %s", replicas, sizeValue, tenant.Spec.Resources.Storage)))
+	}
+
+	return allErrs
+}
+
+// validateVClusterDistroChange rejects a new spec.vcluster.distro for a Gold tier tenant,
+// whose control plane data cannot move between distributions.
+func validateVClusterDistroChange(oldTenant, newTenant *platformv1alpha1.Tenant) error {
+	if oldTenant.Spec.Tier != platformv1alpha1.GoldTier || newTenant.Spec.Tier != platformv1alpha1.GoldTier {
+		return nil
+	}
+	oldDistro, newDistro := vClusterDistro(oldTenant), vClusterDistro(newTenant)
+	if oldDistro == newDistro {
+		return nil
+	}
+	return apierrors.NewForbidden(
+		schema.GroupResource{Group: platformv1alpha1.GroupVersion.Group, Resource: "tenants"},
+		newTenant.Name,
+		fmt.Errorf("spec.vcluster.distro cannot change from %s to %s: migrate the tenant to a new Tenant instead", oldDistro, newDistro),
+	)
+}
+
+// vClusterDistro returns spec.vcluster.distro, defaulting to k3s.
+func vClusterDistro(tenant *platformv1alpha1.Tenant) platformv1alpha1.VClusterDistro {
+	if tenant.Spec.VCluster == nil || tenant.Spec.VCluster.Distro == "" {
+		return platformv1alpha1.VClusterDistroK3s
+	}
+	return tenant.Spec.VCluster.Distro
+}
+
+// validateVClusterAuditSink requires exactly one audit destination with a usable URL.
+func validateVClusterAuditSink(path *field.Path, sink platformv1alpha1.VClusterAuditSink) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case sink.ObjectStoragePath == "" && sink.LokiURL == "":
+		allErrs = append(allErrs, field.Required(path, "one of objectStoragePath or lokiURL must be set"))
+	case sink.ObjectStoragePath != "" && sink.LokiURL != "":
+		allErrs = append(allErrs, field.Forbidden(path, "only one of objectStoragePath or lokiURL may be set"))
+	case sink.LokiURL != "":
+		u, err := url.Parse(sink.LokiURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("lokiURL"), sink.LokiURL, "must be an http(s) URL"))
+		}
+	}
+
+	return allErrs
+}
+
 // parseQuantity is a helper to parse Kubernetes resource quantities.
 func parseQuantity(s string) (resource.Quantity, error) {
 	if s == "" {
@@ -0,0 +1,351 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bff is a thin HTTP client for the Tenant Master BFF API.
+package bff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// Client calls the BFF API. Set Token for JWT auth or APIKey for a scoped API key
+// (read routes only); with neither, requests are sent unauthenticated.
+type Client struct {
+	// BaseURL of the BFF, e.g. "http://tenant-master-bff.tenant-master-system:8080"
+	BaseURL string
+	// Token is sent as "Authorization: Bearer <Token>"
+	Token string
+	// APIKey is sent as "X-API-Key"
+	APIKey string
+	// HTTPClient defaults to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// NewClient creates a client for the BFF at baseURL.
+func NewClient(baseURL string) *Client {
+	return &Client{BaseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// Error is a non-2xx BFF response.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bff: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// IsNotFound reports whether err is a 404 BFF response.
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(*Error)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// TenantSummary is a tenant as listed by the BFF.
+type TenantSummary struct {
+	Name             string    `json:"name"`
+	Tier             string    `json:"tier"`
+	Owner            string    `json:"owner"`
+	State            string    `json:"state,omitempty"`
+	Namespace        string    `json:"namespace,omitempty"`
+	CreatedAt        time.Time `json:"createdAt,omitempty"`
+	CPU              string    `json:"cpu,omitempty"`
+	Memory           string    `json:"memory,omitempty"`
+	APIEndpoint      string    `json:"apiEndpoint,omitempty"`
+	KubeconfigSecret string    `json:"kubeconfigSecret,omitempty"`
+}
+
+// ManagedResource references a child object created by the operator for a tenant.
+type ManagedResource struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// TenantDetail is a single tenant as returned by the BFF.
+type TenantDetail struct {
+	TenantSummary
+	NetworkPolicy    map[string]interface{} `json:"networkPolicy,omitempty"`
+	Events           []string               `json:"events,omitempty"`
+	ManagedResources []ManagedResource      `json:"managedResources,omitempty"`
+	ResourceUsage    map[string]interface{} `json:"resourceUsage,omitempty"`
+	Billing          map[string]interface{} `json:"billing,omitempty"`
+}
+
+// TenantToken is a short-lived token for the tenant ServiceAccount.
+type TenantToken struct {
+	Token          string    `json:"token"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	ServiceAccount string    `json:"serviceAccount"`
+	Namespace      string    `json:"namespace"`
+}
+
+// NetworkGraph is the effective network boundary of a tenant.
+type NetworkGraph struct {
+	Tenant              string        `json:"tenant"`
+	AllowInternetAccess bool          `json:"allowInternetAccess"`
+	Nodes               []NetworkNode `json:"nodes"`
+	Edges               []NetworkEdge `json:"edges"`
+}
+
+// NetworkNode is a traffic source or destination in a NetworkGraph.
+type NetworkNode struct {
+	ID       string   `json:"id"`
+	Kind     string   `json:"kind"`
+	Label    string   `json:"label"`
+	Tenant   string   `json:"tenant,omitempty"`
+	Ports    []string `json:"ports,omitempty"`
+	Resolved *bool    `json:"resolved,omitempty"`
+}
+
+// NetworkEdge allows traffic between two nodes of a NetworkGraph.
+type NetworkEdge struct {
+	From      string   `json:"from"`
+	To        string   `json:"to"`
+	Direction string   `json:"direction"`
+	Ports     []string `json:"ports,omitempty"`
+	Source    string   `json:"source"`
+}
+
+// TenantResource is a tenant as returned by ApplyTenant.
+type TenantResource struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	Spec            map[string]any `json:"spec"`
+	State           string         `json:"state"`
+	Namespace       string         `json:"namespace"`
+	Generation      int64          `json:"generation"`
+	ResourceVersion string         `json:"resourceVersion"`
+	CreatedAt       time.Time      `json:"createdAt"`
+}
+
+// LogQuery selects tenant log lines. Zero values use the BFF defaults.
+type LogQuery struct {
+	// Query is LogQL for Loki or a Lucene query string for Elasticsearch
+	Query string
+	Start time.Time
+	End   time.Time
+	Limit int
+}
+
+// ListTenants returns all tenants.
+func (c *Client) ListTenants(ctx context.Context) ([]TenantSummary, error) {
+	var tenants []TenantSummary
+	err := c.do(ctx, http.MethodGet, "/api/v1/tenants", nil, &tenants)
+	return tenants, err
+}
+
+// GetTenant returns one tenant.
+func (c *Client) GetTenant(ctx context.Context, name string) (*TenantDetail, error) {
+	detail := &TenantDetail{}
+	if err := c.do(ctx, http.MethodGet, tenantPath(name, ""), nil, detail); err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// CreateTenant creates a tenant with the given spec.
+func (c *Client) CreateTenant(ctx context.Context, name string, spec platformv1alpha1.TenantSpec) error {
+	body, err := specFields(spec)
+	if err != nil {
+		return err
+	}
+	body["name"] = name
+	return c.do(ctx, http.MethodPost, "/api/v1/tenants", body, nil)
+}
+
+// UpdateTenant replaces the top-level spec fields set in spec, e.g. only
+// resources when spec.Resources is the only non-empty field.
+func (c *Client) UpdateTenant(ctx context.Context, name string, spec platformv1alpha1.TenantSpec) error {
+	body, err := specFields(spec)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPatch, tenantPath(name, ""), body, nil)
+}
+
+// ApplyTenant creates the tenant or replaces its whole spec, and reports whether it
+// was created. Applying the same spec again does not modify the tenant.
+func (c *Client) ApplyTenant(ctx context.Context, name string, spec platformv1alpha1.TenantSpec) (*TenantResource, bool, error) {
+	body, err := specFields(spec)
+	if err != nil {
+		return nil, false, err
+	}
+	res := &TenantResource{}
+	status, err := c.doStatus(ctx, http.MethodPut, tenantPath(name, ""), body, res)
+	if err != nil {
+		return nil, false, err
+	}
+	return res, status == http.StatusCreated, nil
+}
+
+// DeleteTenant deletes a tenant without waiting for the teardown to finish.
+func (c *Client) DeleteTenant(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, tenantPath(name, ""), nil, nil)
+}
+
+// RevokeKubeconfig rotates the tenant credentials, invalidating issued kubeconfigs and tokens.
+func (c *Client) RevokeKubeconfig(ctx context.Context, name, requestedBy string) error {
+	body := map[string]string{"requestedBy": requestedBy}
+	return c.do(ctx, http.MethodPost, tenantPath(name, "/kubeconfig/revoke"), body, nil)
+}
+
+// CreateToken mints a short-lived token for the tenant ServiceAccount. A zero ttl
+// uses the BFF default.
+func (c *Client) CreateToken(ctx context.Context, name string, ttl time.Duration, requestedBy string) (*TenantToken, error) {
+	body := map[string]string{"requestedBy": requestedBy}
+	if ttl > 0 {
+		body["ttl"] = ttl.String()
+	}
+	token := &TenantToken{}
+	if err := c.do(ctx, http.MethodPost, tenantPath(name, "/token"), body, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// GetNetwork returns the tenant's effective network boundary.
+func (c *Client) GetNetwork(ctx context.Context, name string) (*NetworkGraph, error) {
+	graph := &NetworkGraph{}
+	if err := c.do(ctx, http.MethodGet, tenantPath(name, "/network"), nil, graph); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// QueryLogs queries the tenant's log backend and returns its raw JSON response.
+func (c *Client) QueryLogs(ctx context.Context, name string, q LogQuery) (json.RawMessage, error) {
+	params := url.Values{}
+	if q.Query != "" {
+		params.Set("query", q.Query)
+	}
+	if !q.Start.IsZero() {
+		params.Set("start", q.Start.Format(time.RFC3339))
+	}
+	if !q.End.IsZero() {
+		params.Set("end", q.End.Format(time.RFC3339))
+	}
+	if q.Limit > 0 {
+		params.Set("limit", strconv.Itoa(q.Limit))
+	}
+	path := tenantPath(name, "/logs/query")
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result json.RawMessage
+	err := c.do(ctx, http.MethodGet, path, nil, &result)
+	return result, err
+}
+
+// tenantPath returns the API path of a tenant sub-resource.
+func tenantPath(name, suffix string) string {
+	return "/api/v1/tenants/" + url.PathEscape(name) + suffix
+}
+
+// specFields converts a TenantSpec into the flat JSON object the BFF expects,
+// dropping empty fields.
+func specFields(spec platformv1alpha1.TenantSpec) (map[string]any, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	for k, v := range fields {
+		if m, ok := v.(map[string]any); v == nil || v == "" || (ok && len(m) == 0) {
+			delete(fields, k)
+		}
+	}
+	return fields, nil
+}
+
+// do sends a JSON request and decodes a JSON response into out (if non-nil).
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	_, err := c.doStatus(ctx, method, path, body, out)
+	return err
+}
+
+// doStatus is do, also returning the HTTP status code of successful responses.
+func (c *Client) doStatus(ctx context.Context, method, path string, body, out any) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	if c.APIKey != "" {
+		req.Header.Set("X-API-Key", c.APIKey)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var e struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(respBody, &e) != nil || e.Error == "" {
+			e.Error = strings.TrimSpace(string(respBody))
+		}
+		return resp.StatusCode, &Error{StatusCode: resp.StatusCode, Message: e.Error}
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return resp.StatusCode, nil
+	}
+	return resp.StatusCode, json.Unmarshal(respBody, out)
+}
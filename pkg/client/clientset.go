@@ -0,0 +1,263 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client provides a typed clientset and informers for the platform.io
+// v1alpha1 API, for services that integrate with the Tenant Master operator
+// without controller-runtime or unstructured objects.
+package client
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// Scheme knows the platform.io v1alpha1 types and the meta/v1 options used by the clientset.
+var Scheme = runtime.NewScheme()
+
+// Codecs encodes and decodes platform.io v1alpha1 objects.
+var Codecs = serializer.NewCodecFactory(Scheme)
+
+var parameterCodec = runtime.NewParameterCodec(Scheme)
+
+func init() {
+	if err := platformv1alpha1.AddToScheme(Scheme); err != nil {
+		panic(err)
+	}
+	metav1.AddToGroupVersion(Scheme, platformv1alpha1.GroupVersion)
+}
+
+// ResourceInterface has the typed operations for one cluster-scoped resource.
+type ResourceInterface[T, L runtime.Object] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	List(ctx context.Context, opts metav1.ListOptions) (L, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Create(ctx context.Context, obj T, opts metav1.CreateOptions) (T, error)
+	Update(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+	UpdateStatus(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (T, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+}
+
+// TenantInterface manages Tenants.
+type TenantInterface = ResourceInterface[*platformv1alpha1.Tenant, *platformv1alpha1.TenantList]
+
+// TenantSetInterface manages TenantSets.
+type TenantSetInterface = ResourceInterface[*platformv1alpha1.TenantSet, *platformv1alpha1.TenantSetList]
+
+// TenantAccessRequestInterface manages TenantAccessRequests.
+type TenantAccessRequestInterface = ResourceInterface[*platformv1alpha1.TenantAccessRequest, *platformv1alpha1.TenantAccessRequestList]
+
+// TenantTemplateInterface manages TenantTemplates.
+type TenantTemplateInterface = ResourceInterface[*platformv1alpha1.TenantTemplate, *platformv1alpha1.TenantTemplateList]
+
+// Interface is the platform.io v1alpha1 clientset.
+type Interface interface {
+	Tenants() TenantInterface
+	TenantSets() TenantSetInterface
+	TenantAccessRequests() TenantAccessRequestInterface
+	TenantTemplates() TenantTemplateInterface
+	RESTClient() rest.Interface
+}
+
+// Clientset implements Interface on top of a REST client.
+type Clientset struct {
+	restClient rest.Interface
+}
+
+var _ Interface = &Clientset{}
+
+// NewForConfig creates a Clientset for the given config. The config is copied, so
+// callers can reuse it for other clients.
+func NewForConfig(c *rest.Config) (*Clientset, error) {
+	config := rest.CopyConfig(c)
+	config.GroupVersion = &platformv1alpha1.GroupVersion
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = Codecs.WithoutConversion()
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	restClient, err := rest.RESTClientFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create platform.io REST client: %w", err)
+	}
+	return New(restClient), nil
+}
+
+// New creates a Clientset that uses restClient, e.g. a fake REST client in tests.
+func New(restClient rest.Interface) *Clientset {
+	return &Clientset{restClient: restClient}
+}
+
+// Tenants returns a client for Tenants.
+func (c *Clientset) Tenants() TenantInterface {
+	return &resourceClient[*platformv1alpha1.Tenant, *platformv1alpha1.TenantList]{
+		client:   c.restClient,
+		resource: "tenants",
+		newObj:   func() *platformv1alpha1.Tenant { return &platformv1alpha1.Tenant{} },
+		newList:  func() *platformv1alpha1.TenantList { return &platformv1alpha1.TenantList{} },
+	}
+}
+
+// TenantSets returns a client for TenantSets.
+func (c *Clientset) TenantSets() TenantSetInterface {
+	return &resourceClient[*platformv1alpha1.TenantSet, *platformv1alpha1.TenantSetList]{
+		client:   c.restClient,
+		resource: "tenantsets",
+		newObj:   func() *platformv1alpha1.TenantSet { return &platformv1alpha1.TenantSet{} },
+		newList:  func() *platformv1alpha1.TenantSetList { return &platformv1alpha1.TenantSetList{} },
+	}
+}
+
+// TenantAccessRequests returns a client for TenantAccessRequests.
+func (c *Clientset) TenantAccessRequests() TenantAccessRequestInterface {
+	return &resourceClient[*platformv1alpha1.TenantAccessRequest, *platformv1alpha1.TenantAccessRequestList]{
+		client:   c.restClient,
+		resource: "tenantaccessrequests",
+		newObj:   func() *platformv1alpha1.TenantAccessRequest { return &platformv1alpha1.TenantAccessRequest{} },
+		newList:  func() *platformv1alpha1.TenantAccessRequestList { return &platformv1alpha1.TenantAccessRequestList{} },
+	}
+}
+
+// TenantTemplates returns a client for TenantTemplates.
+func (c *Clientset) TenantTemplates() TenantTemplateInterface {
+	return &resourceClient[*platformv1alpha1.TenantTemplate, *platformv1alpha1.TenantTemplateList]{
+		client:   c.restClient,
+		resource: "tenanttemplates",
+		newObj:   func() *platformv1alpha1.TenantTemplate { return &platformv1alpha1.TenantTemplate{} },
+		newList:  func() *platformv1alpha1.TenantTemplateList { return &platformv1alpha1.TenantTemplateList{} },
+	}
+}
+
+// RESTClient returns the underlying REST client.
+func (c *Clientset) RESTClient() rest.Interface {
+	return c.restClient
+}
+
+// resourceClient implements ResourceInterface for a cluster-scoped resource.
+type resourceClient[T, L runtime.Object] struct {
+	client   rest.Interface
+	resource string
+	newObj   func() T
+	newList  func() L
+}
+
+func (r *resourceClient[T, L]) Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error) {
+	result := r.newObj()
+	err := r.client.Get().
+		Resource(r.resource).
+		Name(name).
+		VersionedParams(&opts, parameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (r *resourceClient[T, L]) List(ctx context.Context, opts metav1.ListOptions) (L, error) {
+	result := r.newList()
+	err := r.client.Get().
+		Resource(r.resource).
+		VersionedParams(&opts, parameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (r *resourceClient[T, L]) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return r.client.Get().
+		Resource(r.resource).
+		VersionedParams(&opts, parameterCodec).
+		Watch(ctx)
+}
+
+func (r *resourceClient[T, L]) Create(ctx context.Context, obj T, opts metav1.CreateOptions) (T, error) {
+	result := r.newObj()
+	err := r.client.Post().
+		Resource(r.resource).
+		VersionedParams(&opts, parameterCodec).
+		Body(obj).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (r *resourceClient[T, L]) Update(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error) {
+	return r.update(ctx, obj, opts, "")
+}
+
+func (r *resourceClient[T, L]) UpdateStatus(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error) {
+	return r.update(ctx, obj, opts, "status")
+}
+
+func (r *resourceClient[T, L]) update(ctx context.Context, obj T, opts metav1.UpdateOptions, subresource string) (T, error) {
+	result := r.newObj()
+	accessor, err := metaAccessor(obj)
+	if err != nil {
+		return result, err
+	}
+	req := r.client.Put().
+		Resource(r.resource).
+		Name(accessor.GetName())
+	if subresource != "" {
+		req = req.SubResource(subresource)
+	}
+	err = req.VersionedParams(&opts, parameterCodec).
+		Body(obj).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (r *resourceClient[T, L]) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (T, error) {
+	result := r.newObj()
+	err := r.client.Patch(pt).
+		Resource(r.resource).
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, parameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (r *resourceClient[T, L]) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return r.client.Delete().
+		Resource(r.resource).
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// metaAccessor returns the object metadata of obj.
+func metaAccessor(obj runtime.Object) (metav1.Object, error) {
+	accessor, ok := obj.(metav1.Object)
+	if !ok {
+		return nil, fmt.Errorf("%T has no object metadata", obj)
+	}
+	return accessor, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	platformv1alpha1 "github.com/amartyaa/tenant-master/operator/api/v1alpha1"
+)
+
+// Lister reads objects of one resource from an informer cache.
+type Lister[T runtime.Object] struct {
+	indexer  cache.Indexer
+	resource string
+}
+
+// List returns the cached objects matching selector.
+func (l Lister[T]) List(selector labels.Selector) ([]T, error) {
+	var out []T
+	err := cache.ListAll(l.indexer, selector, func(obj interface{}) {
+		out = append(out, obj.(T))
+	})
+	return out, err
+}
+
+// Get returns the cached object with the given name, or a NotFound error.
+func (l Lister[T]) Get(name string) (T, error) {
+	var zero T
+	obj, exists, err := l.indexer.GetByKey(name)
+	if err != nil {
+		return zero, err
+	}
+	if !exists {
+		return zero, apierrors.NewNotFound(platformv1alpha1.GroupVersion.WithResource(l.resource).GroupResource(), name)
+	}
+	return obj.(T), nil
+}
+
+// Informer is a shared informer and its lister for one resource.
+type Informer[T runtime.Object] struct {
+	informer cache.SharedIndexInformer
+	resource string
+}
+
+// Informer returns the shared informer, e.g. to add event handlers.
+func (i Informer[T]) Informer() cache.SharedIndexInformer {
+	return i.informer
+}
+
+// Lister returns a lister backed by the informer cache.
+func (i Informer[T]) Lister() Lister[T] {
+	return Lister[T]{indexer: i.informer.GetIndexer(), resource: i.resource}
+}
+
+// InformerFactory creates shared informers for the platform.io v1alpha1 resources.
+// Informers requested before Start are started by it; each resource has at most
+// one informer per factory.
+type InformerFactory struct {
+	client Interface
+	resync time.Duration
+
+	mu        sync.Mutex
+	informers map[string]cache.SharedIndexInformer
+	started   map[string]bool
+}
+
+// NewInformerFactory creates an InformerFactory. A zero resync disables periodic resyncs.
+func NewInformerFactory(c Interface, resync time.Duration) *InformerFactory {
+	return &InformerFactory{
+		client:    c,
+		resync:    resync,
+		informers: map[string]cache.SharedIndexInformer{},
+		started:   map[string]bool{},
+	}
+}
+
+// Tenants returns the shared Tenant informer.
+func (f *InformerFactory) Tenants() Informer[*platformv1alpha1.Tenant] {
+	return Informer[*platformv1alpha1.Tenant]{
+		informer: f.informerFor("tenants", &platformv1alpha1.Tenant{}, listWatch[*platformv1alpha1.Tenant, *platformv1alpha1.TenantList](f.client.Tenants())),
+		resource: "tenants",
+	}
+}
+
+// TenantSets returns the shared TenantSet informer.
+func (f *InformerFactory) TenantSets() Informer[*platformv1alpha1.TenantSet] {
+	return Informer[*platformv1alpha1.TenantSet]{
+		informer: f.informerFor("tenantsets", &platformv1alpha1.TenantSet{}, listWatch[*platformv1alpha1.TenantSet, *platformv1alpha1.TenantSetList](f.client.TenantSets())),
+		resource: "tenantsets",
+	}
+}
+
+// TenantAccessRequests returns the shared TenantAccessRequest informer.
+func (f *InformerFactory) TenantAccessRequests() Informer[*platformv1alpha1.TenantAccessRequest] {
+	return Informer[*platformv1alpha1.TenantAccessRequest]{
+		informer: f.informerFor("tenantaccessrequests", &platformv1alpha1.TenantAccessRequest{}, listWatch[*platformv1alpha1.TenantAccessRequest, *platformv1alpha1.TenantAccessRequestList](f.client.TenantAccessRequests())),
+		resource: "tenantaccessrequests",
+	}
+}
+
+// TenantTemplates returns the shared TenantTemplate informer.
+func (f *InformerFactory) TenantTemplates() Informer[*platformv1alpha1.TenantTemplate] {
+	return Informer[*platformv1alpha1.TenantTemplate]{
+		informer: f.informerFor("tenanttemplates", &platformv1alpha1.TenantTemplate{}, listWatch[*platformv1alpha1.TenantTemplate, *platformv1alpha1.TenantTemplateList](f.client.TenantTemplates())),
+		resource: "tenanttemplates",
+	}
+}
+
+// Start runs every informer requested so far that is not running yet, until stopCh closes.
+func (f *InformerFactory) Start(stopCh <-chan struct{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for resource, informer := range f.informers {
+		if !f.started[resource] {
+			go informer.Run(stopCh)
+			f.started[resource] = true
+		}
+	}
+}
+
+// WaitForCacheSync waits for the started informers to sync and reports, per
+// resource, whether it synced before stopCh closed.
+func (f *InformerFactory) WaitForCacheSync(stopCh <-chan struct{}) map[string]bool {
+	f.mu.Lock()
+	informers := map[string]cache.SharedIndexInformer{}
+	for resource, informer := range f.informers {
+		if f.started[resource] {
+			informers[resource] = informer
+		}
+	}
+	f.mu.Unlock()
+
+	synced := make(map[string]bool, len(informers))
+	for resource, informer := range informers {
+		synced[resource] = cache.WaitForCacheSync(stopCh, informer.HasSynced)
+	}
+	return synced
+}
+
+func (f *InformerFactory) informerFor(resource string, obj runtime.Object, lw cache.ListerWatcher) cache.SharedIndexInformer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if informer, ok := f.informers[resource]; ok {
+		return informer
+	}
+	informer := cache.NewSharedIndexInformer(lw, obj, f.resync, cache.Indexers{})
+	f.informers[resource] = informer
+	return informer
+}
+
+// listWatch adapts a typed resource client to a ListerWatcher.
+func listWatch[T, L runtime.Object](c ResourceInterface[T, L]) cache.ListerWatcher {
+	return &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return c.List(context.Background(), opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return c.Watch(context.Background(), opts)
+		},
+	}
+}